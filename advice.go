@@ -0,0 +1,16 @@
+package mysqlerr
+
+// ErrorAdvice is a short troubleshooting note for an error.
+type ErrorAdvice struct {
+	// Cause is the likely cause of the error.
+	Cause string
+	// Action is what usually fixes it.
+	Action string
+}
+
+// Advice returns the likely cause of the error code and the suggested action, for the most common errors.
+// The notes are curated in advice.json and merged into the package by mysqlerrgen -format advice.
+func Advice(code int) (ErrorAdvice, bool) {
+	a, ok := advice[code]
+	return a, ok
+}
@@ -0,0 +1,162 @@
+// Code generated mysqlerrgen DO NOT EDIT.
+
+package mysqlerr
+
+var advice = map[int]ErrorAdvice{
+	ER_CANT_CREATE_TABLE:                        {Cause: "The storage engine could not create the table; the errno in the message tells why, often a foreign key problem.", Action: "Run SHOW ENGINE INNODB STATUS for the details of the latest foreign key error."},
+	ER_CANT_CREATE_DB:                           {Cause: "The server could not create the directory of the database.", Action: "Check the permissions and free space of the data directory."},
+	ER_DB_CREATE_EXISTS:                         {Cause: "CREATE DATABASE targets a database that already exists.", Action: "Use CREATE DATABASE IF NOT EXISTS."},
+	ER_DB_DROP_EXISTS:                           {Cause: "DROP DATABASE targets a database that does not exist.", Action: "Use DROP DATABASE IF EXISTS."},
+	ER_CANT_LOCK:                                {Cause: "The storage engine could not lock the table.", Action: "Check the errno of the message with perror."},
+	ER_ERROR_ON_WRITE:                           {Cause: "The server could not write a file, usually because the disk is full or permissions are wrong.", Action: "Check the free space and the permissions of the data and temporary directories."},
+	ER_GET_ERRNO:                                {Cause: "The storage engine returned an operating system or engine error.", Action: "Look up the errno of the message with perror."},
+	ER_NOT_KEYFILE:                              {Cause: "The index file of the table is corrupted.", Action: "Run REPAIR TABLE."},
+	ER_OUTOFMEMORY:                              {Cause: "The server could not allocate memory.", Action: "Lower the per-connection buffers or max_connections, or add memory."},
+	ER_OUT_OF_SORTMEMORY:                        {Cause: "A sort needs more memory than sort_buffer_size.", Action: "Add an index that avoids the sort or raise sort_buffer_size for the session."},
+	ER_CON_COUNT_ERROR:                          {Cause: "The server reached max_connections.", Action: "Use a connection pool with a bounded size, close idle connections, or raise max_connections."},
+	ER_OUT_OF_RESOURCES:                         {Cause: "The server ran out of memory or another resource.", Action: "Check the memory use of the server and the operating system limits."},
+	ER_HANDSHAKE_ERROR:                          {Cause: "The connection failed during the handshake.", Action: "Check TLS settings, the client library version and proxies in between."},
+	ER_DBACCESS_DENIED_ERROR:                    {Cause: "The account has no privileges on the database.", Action: "GRANT the needed privileges on the database to the account, or connect with another account."},
+	ER_ACCESS_DENIED_ERROR:                      {Cause: "The user, host or password of the connection is wrong.", Action: "Check the credentials and the host pattern of the account in mysql.user; a password rotation often causes it."},
+	ER_NO_DB_ERROR:                              {Cause: "The statement uses unqualified table names but no database is selected.", Action: "Select a database in the DSN or with USE, or qualify the table names."},
+	ER_BAD_NULL_ERROR:                           {Cause: "A column declared NOT NULL would be set to NULL.", Action: "Provide a value for the column, or declare a default."},
+	ER_BAD_DB_ERROR:                             {Cause: "The database does not exist.", Action: "Check the database name of the DSN and create the database if needed."},
+	ER_TABLE_EXISTS_ERROR:                       {Cause: "CREATE TABLE or RENAME TABLE targets a table that already exists.", Action: "Use CREATE TABLE IF NOT EXISTS, or drop or rename the existing table first."},
+	ER_BAD_TABLE_ERROR:                          {Cause: "DROP TABLE or a multi-table statement names a table that does not exist.", Action: "Use DROP TABLE IF EXISTS, or check the table name."},
+	ER_NON_UNIQ_ERROR:                           {Cause: "A column name matches columns of more than one table of the statement.", Action: "Qualify the column with its table name or alias."},
+	ER_SERVER_SHUTDOWN:                          {Cause: "The server is shutting down.", Action: "Retry on another server or after it has restarted."},
+	ER_BAD_FIELD_ERROR:                          {Cause: "The statement references a column that does not exist in the tables it uses.", Action: "Check the column name and the schema version the application expects; run the pending migrations."},
+	ER_WRONG_FIELD_WITH_GROUP:                   {Cause: "A selected column is neither grouped nor aggregated under ONLY_FULL_GROUP_BY.", Action: "Add the column to GROUP BY, aggregate it, or wrap it in ANY_VALUE()."},
+	ER_WRONG_VALUE_COUNT:                        {Cause: "The number of columns and values differ.", Action: "Make the column list and the values match."},
+	ER_DUP_FIELDNAME:                            {Cause: "A CREATE TABLE, ALTER TABLE or view defines the same column name twice.", Action: "Rename or remove the duplicated column."},
+	ER_DUP_KEYNAME:                              {Cause: "An index with the same name already exists in the table.", Action: "Choose another index name or drop the existing index first."},
+	ER_DUP_ENTRY:                                {Cause: "A row with the same value already exists in a PRIMARY KEY or UNIQUE index.", Action: "Check for the row first, or use INSERT ... ON DUPLICATE KEY UPDATE or INSERT IGNORE if overwriting or skipping it is intended."},
+	ER_PARSE_ERROR:                              {Cause: "The statement is not valid SQL for this server version, often because of a reserved word or a missing quote.", Action: "Look at the text right after \"near\" in the message; quote identifiers with backticks and check the features of the server version."},
+	ER_NONUNIQ_TABLE:                            {Cause: "The same table name or alias appears twice in a statement.", Action: "Give each occurrence of the table its own alias."},
+	ER_INVALID_DEFAULT:                          {Cause: "The default value of a column is invalid for its type or sql_mode, often a zero date under NO_ZERO_DATE.", Action: "Use a valid default, such as CURRENT_TIMESTAMP or NULL."},
+	ER_MULTIPLE_PRI_KEY:                         {Cause: "The table definition declares more than one primary key.", Action: "Keep a single PRIMARY KEY and use UNIQUE for the others."},
+	ER_TOO_MANY_KEYS:                            {Cause: "The table has more indexes than allowed.", Action: "Drop unused indexes."},
+	ER_TOO_LONG_KEY:                             {Cause: "An index is longer than the storage engine allows, often a utf8mb4 VARCHAR(255) on an old row format.", Action: "Use the DYNAMIC row format, a prefix index, or a shorter column."},
+	ER_KEY_COLUMN_DOES_NOT_EXITS:                {Cause: "An index definition names a column that the table does not have.", Action: "Check the column names of the index definition."},
+	ER_WRONG_AUTO_KEY:                           {Cause: "An AUTO_INCREMENT column is not indexed, or there is more than one.", Action: "Make the AUTO_INCREMENT column the first column of an index."},
+	ER_NO_SUCH_INDEX:                            {Cause: "The statement references an index that does not exist.", Action: "Check the index name with SHOW INDEX."},
+	ER_CANT_DROP_FIELD_OR_KEY:                   {Cause: "ALTER TABLE tries to drop a column or index that does not exist.", Action: "Check the existing columns and indexes with SHOW CREATE TABLE."},
+	ER_UPDATE_TABLE_USED:                        {Cause: "The statement updates a table it also reads in a subquery.", Action: "Wrap the subquery in a derived table or use a JOIN."},
+	ER_TABLE_NOT_LOCKED:                         {Cause: "LOCK TABLES is active and the statement uses a table that was not locked.", Action: "Lock every table the statements use, with their aliases."},
+	ER_WRONG_DB_NAME:                            {Cause: "The database name is invalid.", Action: "Check the length and characters of the name."},
+	ER_WRONG_TABLE_NAME:                         {Cause: "The table name is invalid.", Action: "Check the length and characters of the name, and quote it with backticks."},
+	ER_TOO_BIG_SELECT:                           {Cause: "The query would examine more rows than max_join_size.", Action: "Add conditions or indexes, or SET SQL_BIG_SELECTS=1 if it is intended."},
+	ER_UNKNOWN_TABLE:                            {Cause: "A column qualifier names a table that is not part of the statement.", Action: "Check the table names and aliases used in the FROM clause."},
+	ER_FIELD_SPECIFIED_TWICE:                    {Cause: "The same column is assigned twice in an INSERT or UPDATE.", Action: "Remove the duplicated assignment."},
+	ER_INVALID_GROUP_FUNC_USE:                   {Cause: "An aggregate function is used where it is not allowed, such as in WHERE.", Action: "Move the condition on the aggregate to HAVING."},
+	ER_RECORD_FILE_FULL:                         {Cause: "The table is full, usually because the disk, a temporary table or a tablespace reached its limit.", Action: "Free disk space, and check tmp_table_size, max_heap_table_size and innodb_data_file_path."},
+	ER_UNKNOWN_CHARACTER_SET:                    {Cause: "The character set does not exist in this server.", Action: "Check the name; utf8mb4 is the one to use for Unicode."},
+	ER_TOO_MANY_TABLES:                          {Cause: "A join uses more tables than allowed.", Action: "Split the query."},
+	ER_TOO_MANY_FIELDS:                          {Cause: "The table has more columns than allowed.", Action: "Split the table."},
+	ER_TOO_BIG_ROWSIZE:                          {Cause: "The columns of the row exceed the maximum row size.", Action: "Change large VARCHAR columns to TEXT or BLOB, which are stored off the row."},
+	ER_FUNCTION_NOT_DEFINED:                     {Cause: "The statement calls a function that does not exist or is not loaded.", Action: "Check the spelling, and for loadable functions that the component or plugin is installed."},
+	ER_HOST_IS_BLOCKED:                          {Cause: "The server blocked the host after too many failed connections.", Action: "Fix the cause of the failures, then run FLUSH HOSTS or TRUNCATE performance_schema.host_cache; raise max_connect_errors if needed."},
+	ER_HOST_NOT_PRIVILEGED:                      {Cause: "No account matches the host the client connects from.", Action: "Create an account for the host or a wildcard host pattern."},
+	ER_PASSWORD_NO_MATCH:                        {Cause: "SET PASSWORD names an account that does not exist.", Action: "Check the user and host of the account."},
+	ER_WRONG_VALUE_COUNT_ON_ROW:                 {Cause: "An INSERT lists a different number of values than columns.", Action: "Make the column list and the values match."},
+	ER_CANT_REOPEN_TABLE:                        {Cause: "A temporary table is referenced more than once in the same query.", Action: "Copy the temporary table, or restructure the query."},
+	ER_MIX_OF_GROUP_FUNC_AND_FIELDS:             {Cause: "Aggregate functions are mixed with plain columns without GROUP BY.", Action: "Add a GROUP BY clause for the plain columns."},
+	ER_NONEXISTING_GRANT:                        {Cause: "REVOKE or SHOW GRANTS names a grant that does not exist.", Action: "Check the user, host and privilege with SHOW GRANTS."},
+	ER_TABLEACCESS_DENIED_ERROR:                 {Cause: "The account lacks the privilege the statement needs on the table.", Action: "GRANT the privilege named in the message on the table or database."},
+	ER_COLUMNACCESS_DENIED_ERROR:                {Cause: "The account lacks the privilege the statement needs on a column.", Action: "GRANT the privilege on the column or the table."},
+	ER_NO_SUCH_TABLE:                            {Cause: "The table does not exist in the database, or the name is spelled with a different case on a case-sensitive file system.", Action: "Check the database selected, the table name and lower_case_table_names, and run the pending migrations."},
+	ER_SYNTAX_ERROR:                             {Cause: "The statement is not valid SQL.", Action: "Check the statement against the reference manual of the server version."},
+	ER_ABORTING_CONNECTION:                      {Cause: "The server closed a connection, for example after wait_timeout or a network failure.", Action: "Use a pool whose connection lifetime is shorter than wait_timeout."},
+	ER_NET_PACKET_TOO_LARGE:                     {Cause: "A packet exceeds max_allowed_packet, usually a large BLOB or a big multi-row INSERT.", Action: "Raise max_allowed_packet on both the server and the client, or split the data."},
+	ER_NET_READ_ERROR:                           {Cause: "The connection failed while the server read from the client.", Action: "Check the network and the timeouts of proxies between the client and the server."},
+	ER_NET_READ_INTERRUPTED:                     {Cause: "The server timed out reading from the client.", Action: "Check net_read_timeout and the network."},
+	ER_NET_ERROR_ON_WRITE:                       {Cause: "The connection failed while the server wrote to the client.", Action: "Check the network and whether the client closed the connection."},
+	ER_NET_WRITE_INTERRUPTED:                    {Cause: "The server timed out writing to the client.", Action: "Check net_write_timeout and whether the client reads its results."},
+	ER_WRONG_COLUMN_NAME:                        {Cause: "The column name is invalid.", Action: "Check the length and characters of the name."},
+	ER_DUP_UNIQUE:                               {Cause: "A write would duplicate a value in a unique index.", Action: "Look up the existing row with the same key and decide whether to update it or reject the write."},
+	ER_REQUIRES_PRIMARY_KEY:                     {Cause: "The table needs a primary key, for example because sql_require_primary_key is on.", Action: "Add a PRIMARY KEY to the table."},
+	ER_CHECK_NOT_IMPLEMENTED:                    {Cause: "The storage engine does not support the operation.", Action: "Use an engine supporting it, such as InnoDB."},
+	ER_ERROR_DURING_COMMIT:                      {Cause: "The storage engine failed to commit the transaction.", Action: "Check the error log; retry if the cause was transient."},
+	ER_ERROR_DURING_ROLLBACK:                    {Cause: "The storage engine failed to roll back the transaction.", Action: "Check the error log of the server."},
+	ER_NEW_ABORTING_CONNECTION:                  {Cause: "The server closed a connection, for example after wait_timeout or a network failure.", Action: "Use a pool whose connection lifetime is shorter than wait_timeout."},
+	ER_LOCK_OR_ACTIVE_TRANSACTION:               {Cause: "The statement cannot run while tables are locked or a transaction is active.", Action: "Commit the transaction or UNLOCK TABLES first."},
+	ER_UNKNOWN_SYSTEM_VARIABLE:                  {Cause: "The system variable does not exist in this server version, or its plugin is not loaded.", Action: "Check the variable name and the server version."},
+	ER_CRASHED_ON_USAGE:                         {Cause: "The MyISAM table is marked as crashed.", Action: "Run REPAIR TABLE or convert the table to InnoDB."},
+	ER_TRANS_CACHE_FULL:                         {Cause: "A transaction exceeded max_binlog_cache_size.", Action: "Split the transaction or raise max_binlog_cache_size."},
+	ER_REPLICA_NOT_RUNNING:                      {Cause: "The statement needs the replication threads to be running.", Action: "Start the replica with START REPLICA."},
+	ER_TOO_MANY_USER_CONNECTIONS:                {Cause: "The account reached its max_user_connections limit.", Action: "Reduce the connections of the account or raise its limit."},
+	ER_LOCK_WAIT_TIMEOUT:                        {Cause: "The statement waited longer than innodb_lock_wait_timeout for a row lock held by another transaction.", Action: "Find the blocking transaction in sys.innodb_lock_waits, keep transactions short, and retry the statement or the transaction."},
+	ER_LOCK_TABLE_FULL:                          {Cause: "The InnoDB lock table does not fit in the buffer pool, usually because one transaction locks too many rows.", Action: "Split the statement into smaller transactions or increase innodb_buffer_pool_size."},
+	ER_WRONG_ARGUMENTS:                          {Cause: "A function was called with arguments of the wrong type or number.", Action: "Check the function signature in the reference manual."},
+	ER_LOCK_DEADLOCK:                            {Cause: "Two transactions waited for locks held by each other and InnoDB rolled this one back.", Action: "Retry the whole transaction; access rows in a consistent order and keep transactions short to make deadlocks rarer. SHOW ENGINE INNODB STATUS shows the latest deadlock."},
+	ER_CANNOT_ADD_FOREIGN:                       {Cause: "A foreign key could not be created, usually because the columns differ in type or the parent has no index on them.", Action: "Make the types, lengths, signedness and collations of the columns identical and index the parent columns."},
+	ER_NO_REFERENCED_ROW:                        {Cause: "A foreign key requires a row in the parent table that does not exist.", Action: "Insert the parent row first."},
+	ER_ROW_IS_REFERENCED:                        {Cause: "The row is referenced by a child table through a foreign key.", Action: "Delete or update the child rows first."},
+	ER_CANT_UPDATE_WITH_READLOCK:                {Cause: "A global read lock, such as one taken by a backup with FLUSH TABLES WITH READ LOCK, blocks writes.", Action: "Wait for the backup to finish."},
+	ER_USER_LIMIT_REACHED:                       {Cause: "The account exceeded one of its resource limits, such as max_questions.", Action: "Wait for the limit to reset or raise it with ALTER USER ... WITH."},
+	ER_SPECIFIC_ACCESS_DENIED_ERROR:             {Cause: "The statement needs a global or dynamic privilege the account does not have.", Action: "GRANT the privilege named in the message, or run the statement with an administrative account."},
+	ER_WRONG_VALUE_FOR_VAR:                      {Cause: "The value is invalid for the system variable.", Action: "Check the allowed values in the reference manual."},
+	ER_NOT_SUPPORTED_YET:                        {Cause: "The server version does not support the feature or the combination of options.", Action: "Check the limitations of the feature in the reference manual."},
+	ER_SOURCE_FATAL_ERROR_READING_BINLOG:        {Cause: "The replica could not read the binary log of the source, often because it was purged.", Action: "Re-provision the replica from a backup or point it at a binary log that still exists."},
+	ER_INCORRECT_GLOBAL_LOCAL_VAR:               {Cause: "The variable is set with the wrong scope, such as SESSION for a global-only variable.", Action: "Use SET GLOBAL or SET SESSION as the variable requires."},
+	ER_OPERAND_COLUMNS:                          {Cause: "An operand has the wrong number of columns, often a subquery selecting several columns.", Action: "Make the subquery select a single column."},
+	ER_SUBQUERY_NO_1_ROW:                        {Cause: "A scalar subquery returned more than one row.", Action: "Add a condition or LIMIT 1, or use IN instead of =."},
+	ER_UNKNOWN_STMT_HANDLER:                     {Cause: "The prepared statement does not exist, usually because the connection was reset or reconnected.", Action: "Prepare the statement again on the current connection."},
+	ER_NOT_SUPPORTED_AUTH_MODE:                  {Cause: "The client does not support the authentication plugin of the account.", Action: "Upgrade the client library or driver, or change the plugin of the account."},
+	ER_WARN_DATA_OUT_OF_RANGE:                   {Cause: "A numeric value is outside the range of the column type.", Action: "Validate the value or use a wider type, such as BIGINT or an UNSIGNED column."},
+	WARN_DATA_TRUNCATED:                         {Cause: "A value was truncated to fit the column.", Action: "Check the type of the column and the values written to it; enable strict mode to get errors instead."},
+	ER_CANT_AGGREGATE_2COLLATIONS:               {Cause: "An operation mixes strings of incompatible collations.", Action: "Convert the tables to the same collation or use COLLATE in the expression."},
+	ER_CANT_AGGREGATE_3COLLATIONS:               {Cause: "An operation mixes strings of incompatible collations.", Action: "Convert the tables to the same collation or use COLLATE in the expression."},
+	ER_UNKNOWN_COLLATION:                        {Cause: "The collation does not exist in this server, often utf8mb4_0900_ai_ci on a server older than 8.0.", Action: "Use a collation the server knows, such as utf8mb4_unicode_ci."},
+	ER_UNKNOWN_STORAGE_ENGINE:                   {Cause: "The storage engine is not available.", Action: "Use InnoDB, or install the plugin of the engine."},
+	ER_OPTION_PREVENTS_STATEMENT:                {Cause: "A server option such as read_only or super_read_only prevents the statement, often because the connection reached a replica or a demoted primary.", Action: "Reconnect to the current primary; refresh the connections of the pool after a failover."},
+	ER_TRUNCATED_WRONG_VALUE:                    {Cause: "A value could not be converted to the expected type, such as an invalid date.", Action: "Check the format of the value; dates must be YYYY-MM-DD and times HH:MM:SS."},
+	ER_SP_ALREADY_EXISTS:                        {Cause: "A stored routine with the same name already exists.", Action: "Drop it first or use CREATE ... IF NOT EXISTS."},
+	ER_SP_DOES_NOT_EXIST:                        {Cause: "The stored procedure or function does not exist in the database.", Action: "Check the routine name and database, and that the routine was created by the migrations."},
+	ER_QUERY_INTERRUPTED:                        {Cause: "The statement was killed with KILL QUERY or interrupted by the server.", Action: "Find who killed it, and retry if it was a timeout of the application."},
+	ER_SP_WRONG_NO_OF_ARGS:                      {Cause: "A stored routine was called with the wrong number of arguments.", Action: "Compare the call with SHOW CREATE PROCEDURE or SHOW CREATE FUNCTION."},
+	ER_VIEW_INVALID:                             {Cause: "A view references tables, columns or functions that no longer exist or are not accessible to the definer.", Action: "Recreate the view, or restore the objects and privileges it depends on."},
+	ER_TRG_DOES_NOT_EXIST:                       {Cause: "DROP TRIGGER names a trigger that does not exist.", Action: "Use DROP TRIGGER IF EXISTS or check the trigger name."},
+	ER_NO_DEFAULT_FOR_FIELD:                     {Cause: "An INSERT omits a NOT NULL column that has no default, and strict mode is on.", Action: "Provide a value for the column or add a DEFAULT to its definition."},
+	ER_DIVISION_BY_ZERO:                         {Cause: "A division by zero in strict mode with ERROR_FOR_DIVISION_BY_ZERO.", Action: "Guard the divisor with NULLIF(divisor, 0)."},
+	ER_TRUNCATED_WRONG_VALUE_FOR_FIELD:          {Cause: "A value is invalid for the type or character set of the column, often a 4-byte character in a utf8mb3 column.", Action: "Validate the value, and use utf8mb4 for text that may contain emoji."},
+	ER_CANNOT_USER:                              {Cause: "CREATE USER, DROP USER or a similar statement failed for the account.", Action: "Check whether the account already exists or does not exist, with its exact host part."},
+	ER_XAER_NOTA:                                {Cause: "The XA transaction identifier is unknown.", Action: "Check the xid with XA RECOVER."},
+	ER_XAER_RMFAIL:                              {Cause: "The statement is not allowed in the current state of the XA transaction.", Action: "Check the sequence of XA START, END, PREPARE and COMMIT."},
+	ER_DATA_TOO_LONG:                            {Cause: "A value is longer than the column in strict mode.", Action: "Validate lengths before writing, or widen the column."},
+	ER_DATETIME_FUNCTION_OVERFLOW:               {Cause: "A date or time function produced a value out of range.", Action: "Check the arguments of the function."},
+	ER_ROW_IS_REFERENCED_2:                      {Cause: "The row is referenced by a child table through a foreign key.", Action: "Delete or update the child rows first, or declare ON DELETE CASCADE or ON DELETE SET NULL."},
+	ER_NO_REFERENCED_ROW_2:                      {Cause: "A foreign key requires a row in the parent table that does not exist.", Action: "Insert the parent row first, or check the value of the foreign key column."},
+	ER_MAX_PREPARED_STMT_COUNT_REACHED:          {Cause: "The server reached max_prepared_stmt_count, usually because the application leaks prepared statements.", Action: "Close prepared statements after use, or switch the driver to client-side interpolation."},
+	ER_AUTOINC_READ_FAILED:                      {Cause: "The storage engine could not read the AUTO_INCREMENT value.", Action: "Check the table for corruption."},
+	ER_PLUGIN_IS_NOT_LOADED:                     {Cause: "The plugin the statement needs is not loaded.", Action: "Install the plugin, or load it with plugin-load."},
+	ER_CANT_CHANGE_TX_CHARACTERISTICS:           {Cause: "The isolation level or access mode cannot change inside a transaction.", Action: "Set the characteristics before starting the transaction."},
+	ER_DUP_ENTRY_WITH_KEY_NAME:                  {Cause: "A row with the same value already exists in the named unique index.", Action: "Find the conflicting row with the key named in the message, or make the write idempotent with ON DUPLICATE KEY UPDATE."},
+	ER_BINLOG_UNSAFE_STATEMENT:                  {Cause: "The statement is unsafe for statement-based replication.", Action: "Use binlog_format=ROW."},
+	ER_XA_RBDEADLOCK:                            {Cause: "The XA transaction was rolled back because of a deadlock.", Action: "Retry the XA transaction."},
+	ER_NEED_REPREPARE:                           {Cause: "A table used by the prepared statement changed since it was prepared.", Action: "Prepare the statement again; retrying usually succeeds."},
+	ER_TOO_MANY_CONCURRENT_TRXS:                 {Cause: "InnoDB ran out of undo slots for concurrent transactions.", Action: "Reduce the number of concurrent transactions or increase innodb_rollback_segments."},
+	ER_SIGNAL_EXCEPTION:                         {Cause: "A stored routine raised an error with SIGNAL without setting MYSQL_ERRNO.", Action: "Read the message set by the routine; it describes an application-level condition."},
+	ER_LOCK_ABORTED:                             {Cause: "The wait for a lock was aborted by a concurrent ALTER TABLE or DDL.", Action: "Retry the statement after the DDL has finished."},
+	ER_FOREIGN_DUPLICATE_KEY_WITH_CHILD_INFO:    {Cause: "A cascading foreign key action would write a duplicate key in a child table.", Action: "Check the unique indexes of the child table named in the message before changing the parent row."},
+	ER_FOREIGN_DUPLICATE_KEY_WITHOUT_CHILD_INFO: {Cause: "A cascading foreign key action would write a duplicate key in a child table.", Action: "Find the child tables referencing the parent and check their unique indexes."},
+	ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION:    {Cause: "The transaction was started READ ONLY.", Action: "Start the transaction without READ ONLY to write."},
+	ER_TABLESPACE_MISSING:                       {Cause: "The tablespace file of the table is missing.", Action: "Restore the file from a backup or drop the table."},
+	ER_TABLESPACE_EXISTS:                        {Cause: "A tablespace file of the table already exists, usually left by an interrupted operation.", Action: "Move the orphaned .ibd file away or discard the tablespace."},
+	ER_INNODB_INDEX_CORRUPT:                     {Cause: "An InnoDB index is corrupted.", Action: "Rebuild the index with ALTER TABLE ... FORCE, or restore from a backup."},
+	ER_MUST_CHANGE_PASSWORD:                     {Cause: "The password of the account has expired.", Action: "Change it with ALTER USER ... IDENTIFIED BY before running other statements."},
+	ER_FK_CANNOT_OPEN_PARENT:                    {Cause: "The parent table of a foreign key does not exist.", Action: "Create the parent table first, or disable foreign_key_checks while loading a dump."},
+	ER_READ_ONLY_MODE:                           {Cause: "The storage engine runs in read-only mode.", Action: "Reconnect to a writable server."},
+	ER_TABLE_CORRUPT:                            {Cause: "The table is corrupted.", Action: "Run CHECK TABLE and restore it from a backup if needed."},
+	ER_QUERY_TIMEOUT:                            {Cause: "The statement exceeded max_execution_time.", Action: "Optimize the query, add an index, or raise MAX_EXECUTION_TIME for it."},
+	ER_GTID_MODE_OFF:                            {Cause: "The statement needs GTID_MODE=ON.", Action: "Enable GTIDs on the server, or avoid the statement."},
+	ER_TRANSACTION_ROLLBACK_DURING_COMMIT:       {Cause: "A plugin, usually Group Replication, rolled back the transaction at commit because of a conflict.", Action: "Retry the transaction."},
+	ER_ACCOUNT_HAS_BEEN_LOCKED:                  {Cause: "The account is locked.", Action: "Unlock it with ALTER USER ... ACCOUNT UNLOCK if that is intended."},
+	ER_INVALID_JSON_TEXT:                        {Cause: "A value written to a JSON column is not valid JSON.", Action: "Validate the document before writing it, for example with JSON_VALID()."},
+	ER_INVALID_JSON_TEXT_IN_PARAM:               {Cause: "An argument of a JSON function is not valid JSON.", Action: "Validate the document passed to the function."},
+	ER_ROLE_NOT_GRANTED:                         {Cause: "The role is not granted to the account.", Action: "GRANT the role to the account, or SET ROLE to a granted one."},
+	ER_LOCK_NOWAIT:                              {Cause: "A lock could not be acquired immediately and the statement used NOWAIT.", Action: "Retry later, or use SKIP LOCKED to work on the rows nobody else holds."},
+	ER_FK_INCOMPATIBLE_COLUMNS:                  {Cause: "The columns of a foreign key and the referenced columns have incompatible types.", Action: "Make the types of both columns identical."},
+	ER_CHECK_CONSTRAINT_VIOLATED:                {Cause: "A row breaks a CHECK constraint of the table.", Action: "Validate the row against the constraint named in the message."},
+	ER_CLIENT_INTERACTION_TIMEOUT:               {Cause: "The server closed the connection after it was idle for wait_timeout seconds.", Action: "Set the maximum lifetime of pooled connections below wait_timeout and reconnect."},
+}
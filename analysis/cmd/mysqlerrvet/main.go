@@ -0,0 +1,16 @@
+// Command mysqlerrvet runs the analyzers of github.com/orisano/mysqlerr/analysis with go vet:
+//
+//	go install github.com/orisano/mysqlerr/analysis/cmd/mysqlerrvet@latest
+//	go vet -vettool=$(which mysqlerrvet) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/unitchecker"
+
+	"github.com/orisano/mysqlerr/analysis/magicnumber"
+	"github.com/orisano/mysqlerr/analysis/messagematch"
+)
+
+func main() {
+	unitchecker.Main(magicnumber.Analyzer, messagematch.Analyzer)
+}
@@ -0,0 +1,32 @@
+// Package imports edits the imports of the files the analyzers fix.
+package imports
+
+import (
+	"go/ast"
+	pathpkg "path"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Name returns the name the package path is imported as in file,
+// and the edit importing it if it is not imported yet.
+func Name(file *ast.File, path string) (string, []analysis.TextEdit) {
+	for _, spec := range file.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || p != path {
+			continue
+		}
+		if spec.Name != nil && spec.Name.Name != "_" && spec.Name.Name != "." {
+			return spec.Name.Name, nil
+		}
+		if spec.Name == nil {
+			return pathpkg.Base(path), nil
+		}
+	}
+	return pathpkg.Base(path), []analysis.TextEdit{{
+		Pos:     file.Name.End(),
+		End:     file.Name.End(),
+		NewText: []byte("\n\nimport " + strconv.Quote(path)),
+	}}
+}
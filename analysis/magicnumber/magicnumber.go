@@ -0,0 +1,122 @@
+// Package magicnumber defines an analyzer reporting MySQL error numbers written as literals,
+// such as merr.Number == 1062, and suggesting the constants of github.com/orisano/mysqlerr instead.
+package magicnumber
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/orisano/mysqlerr"
+	"github.com/orisano/mysqlerr/analysis/internal/imports"
+)
+
+const mysqlerrPath = "github.com/orisano/mysqlerr"
+
+// Analyzer reports literal MySQL error numbers compared with the Number of a driver error or with mysqlerr.Code.
+var Analyzer = &analysis.Analyzer{
+	Name:     "mysqlerrmagic",
+	Doc:      "report MySQL error numbers written as literals instead of mysqlerr constants",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{(*ast.File)(nil), (*ast.BinaryExpr)(nil), (*ast.SwitchStmt)(nil)}
+	var file *ast.File
+	insp.Preorder(filter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.File:
+			file = n
+		case *ast.BinaryExpr:
+			if n.Op != token.EQL && n.Op != token.NEQ {
+				return
+			}
+			if isErrorNumber(pass, n.X) {
+				check(pass, file, n.Y)
+			} else if isErrorNumber(pass, n.Y) {
+				check(pass, file, n.X)
+			}
+		case *ast.SwitchStmt:
+			if n.Tag == nil || !isErrorNumber(pass, n.Tag) {
+				return
+			}
+			for _, s := range n.Body.List {
+				for _, e := range s.(*ast.CaseClause).List {
+					check(pass, file, e)
+				}
+			}
+		}
+	})
+	return nil, nil
+}
+
+// isErrorNumber reports whether e is the Number field of a MySQL error or a call of mysqlerr.Code.
+func isErrorNumber(pass *analysis.Pass, e ast.Expr) bool {
+	switch e := astutil.Unparen(e).(type) {
+	case *ast.SelectorExpr:
+		if e.Sel.Name != "Number" {
+			return false
+		}
+		t := pass.TypesInfo.TypeOf(e.X)
+		if t == nil {
+			return false
+		}
+		if p, ok := t.(*types.Pointer); ok {
+			t = p.Elem()
+		}
+		named, ok := t.(*types.Named)
+		if !ok || named.Obj().Pkg() == nil {
+			return false
+		}
+		name, path := named.Obj().Name(), named.Obj().Pkg().Path()
+		return strings.HasSuffix(name, "MySQLError") || (path == mysqlerrPath && name == "Error")
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return false
+		}
+		fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+		return ok && fn.Pkg() != nil && fn.Pkg().Path() == mysqlerrPath && fn.Name() == "Code"
+	}
+	return false
+}
+
+func check(pass *analysis.Pass, file *ast.File, e ast.Expr) {
+	lit, ok := astutil.Unparen(e).(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return
+	}
+	code, ok := constant.Int64Val(constant.MakeFromLiteral(lit.Value, lit.Kind, 0))
+	if !ok {
+		return
+	}
+	name, ok := mysqlerr.Name(int(code))
+	if !ok || strings.HasPrefix(name, "OBSOLETE_") {
+		return
+	}
+	pkg, edits := imports.Name(file, mysqlerrPath)
+	edits = append(edits, analysis.TextEdit{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		NewText: []byte(pkg + "." + name),
+	})
+	pass.Report(analysis.Diagnostic{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		Message: fmt.Sprintf("MySQL error number %d should be written as %s.%s", code, pkg, name),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message:   "Use " + pkg + "." + name,
+			TextEdits: edits,
+		}},
+	})
+}
@@ -0,0 +1,194 @@
+// Package messagematch defines an analyzer reporting MySQL errors recognized by their message,
+// such as strings.Contains(err.Error(), "Duplicate entry"), and suggesting a check of the error code instead.
+// Messages change between server versions and are translated with lc_messages, codes do not.
+package messagematch
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/orisano/mysqlerr"
+	"github.com/orisano/mysqlerr/analysis/internal/imports"
+)
+
+const mysqlerrPath = "github.com/orisano/mysqlerr"
+
+// Analyzer reports the messages of MySQL errors searched with the strings package.
+var Analyzer = &analysis.Analyzer{
+	Name:     "mysqlerrmessage",
+	Doc:      "report MySQL errors recognized by their message instead of their code",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var matchFuncs = map[string]bool{
+	"Contains":  true,
+	"HasPrefix": true,
+	"HasSuffix": true,
+	"EqualFold": true,
+}
+
+// helpers are the mysqlerr helpers recognizing errors by a value embedded in their message.
+var helpers = []struct {
+	substrings []string
+	helper     string
+}{
+	{[]string{"read-only", "read_only", "READ ONLY"}, "IsReadOnly"},
+}
+
+var directive = regexp.MustCompile("%[-+ #0`]*[0-9*]*(?:\\.[0-9*]*)?[hlLqjzt]*[a-zA-Z%]")
+
+var (
+	literalsOnce sync.Once
+	// literals holds the literal parts of the known message templates by code.
+	literals map[int][]string
+)
+
+func loadLiterals() {
+	literals = map[int][]string{}
+	for code := 0; code <= 0xffff; code++ {
+		if tmpl, ok := mysqlerr.Message(code); ok {
+			literals[code] = directive.Split(tmpl, -1)
+		}
+	}
+}
+
+// codesContaining returns the codes of the known messages s is part of.
+func codesContaining(s string) []int {
+	literalsOnce.Do(loadLiterals)
+	var codes []int
+	for code, parts := range literals {
+		for _, p := range parts {
+			if strings.Contains(strings.ToLower(p), strings.ToLower(s)) {
+				codes = append(codes, code)
+				break
+			}
+		}
+	}
+	sort.Ints(codes)
+	return codes
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{(*ast.File)(nil), (*ast.CallExpr)(nil)}
+	var file *ast.File
+	insp.Preorder(filter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.File:
+			file = n
+		case *ast.CallExpr:
+			check(pass, file, n)
+		}
+	})
+	return nil, nil
+}
+
+func check(pass *analysis.Pass, file *ast.File, call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) != 2 || !matchFuncs[sel.Sel.Name] {
+		return
+	}
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "strings" {
+		return
+	}
+	errExpr := errorText(pass, call.Args[0])
+	if errExpr == nil {
+		return
+	}
+	tv, ok := pass.TypesInfo.Types[call.Args[1]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+	s := constant.StringVal(tv.Value)
+	if len(s) < 4 {
+		return
+	}
+	pkg, edits := imports.Name(file, mysqlerrPath)
+	errSrc := types.ExprString(errExpr)
+	var replacement string
+	for _, h := range helpers {
+		for _, sub := range h.substrings {
+			if strings.Contains(s, sub) {
+				replacement = fmt.Sprintf("%s.%s(%s)", pkg, h.helper, errSrc)
+			}
+		}
+	}
+	if replacement == "" {
+		var conds []string
+		for _, code := range codesContaining(s) {
+			if name, ok := mysqlerr.Name(code); ok {
+				conds = append(conds, fmt.Sprintf("%s.Code(%s) == %s.%s", pkg, errSrc, pkg, name))
+			}
+		}
+		if len(conds) == 0 || len(conds) > 3 {
+			return
+		}
+		replacement = strings.Join(conds, " || ")
+		if len(conds) > 1 {
+			replacement = "(" + replacement + ")"
+		}
+	}
+	edits = append(edits, analysis.TextEdit{Pos: call.Pos(), End: call.End(), NewText: []byte(replacement)})
+	pass.Report(analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: fmt.Sprintf("MySQL error recognized by its message %q; use %s", s, replacement),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message:   "Check the error code",
+			TextEdits: edits,
+		}},
+	})
+}
+
+// errorText returns the error whose text e is: X for X.Error() where X is an error,
+// and X for X.Message where X is a MySQL error implementing error. It returns nil otherwise.
+func errorText(pass *analysis.Pass, e ast.Expr) ast.Expr {
+	switch e := astutil.Unparen(e).(type) {
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Error" || len(e.Args) != 0 {
+			return nil
+		}
+		t := pass.TypesInfo.TypeOf(sel.X)
+		if t == nil || !types.Implements(t, errorType) {
+			return nil
+		}
+		return sel.X
+	case *ast.SelectorExpr:
+		if e.Sel.Name != "Message" {
+			return nil
+		}
+		t := pass.TypesInfo.TypeOf(e.X)
+		if t == nil {
+			return nil
+		}
+		if p, ok := t.(*types.Pointer); ok {
+			t = p.Elem()
+		}
+		named, ok := t.(*types.Named)
+		if !ok || named.Obj().Pkg() == nil {
+			return nil
+		}
+		name, path := named.Obj().Name(), named.Obj().Pkg().Path()
+		isMySQLError := strings.HasSuffix(name, "MySQLError") || (path == mysqlerrPath && name == "Error")
+		if isMySQLError && types.Implements(pass.TypesInfo.TypeOf(e.X), errorType) {
+			return e.X
+		}
+	}
+	return nil
+}
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
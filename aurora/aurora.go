@@ -0,0 +1,51 @@
+// Package aurora classifies the errors Amazon Aurora MySQL returns around a failover.
+//
+// During a failover the old writer is restarted as a reader: statements in flight
+// fail with connection resets, and connections that survive or reconnect through a
+// stale DNS entry reach a read-only instance. Retrying these immediately on the same
+// connection does not help; the application should reconnect and retry.
+package aurora
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"syscall"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// IsFailover reports whether err is one of the failures seen while Aurora fails over.
+func IsFailover(err error) bool {
+	return IsReadOnlyWriter(err) || IsConnectionReset(err) || mysqlerr.Code(err) == mysqlerr.ER_SERVER_SHUTDOWN
+}
+
+// IsReadOnlyWriter reports whether a write reached an instance that has been demoted to a reader,
+// which Aurora reports as ER_OPTION_PREVENTS_STATEMENT for the read_only option.
+func IsReadOnlyWriter(err error) bool {
+	e, ok := mysqlerr.FromError(err)
+	if !ok {
+		return false
+	}
+	switch e.Number {
+	case mysqlerr.ER_OPTION_PREVENTS_STATEMENT:
+		return strings.Contains(e.Message, "read-only") || strings.Contains(e.Message, "read_only")
+	case mysqlerr.ER_READ_ONLY_MODE:
+		return true
+	}
+	return false
+}
+
+// IsConnectionReset reports whether err is the connection being dropped when the writer changes.
+// go-sql-driver/mysql reports it as driver.ErrBadConn, "invalid connection" or an unexpected EOF.
+func IsConnectionReset(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	return strings.Contains(err.Error(), "invalid connection")
+}
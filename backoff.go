@@ -0,0 +1,58 @@
+package mysqlerr
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff is a suggested retry schedule: the n-th retry waits Initial*Multiplier^(n-1), up to Max,
+// randomized by ±Jitter of itself. MaxAttempts is the number of attempts in total,
+// so a zero Backoff means do not retry.
+type Backoff struct {
+	Initial     time.Duration
+	Max         time.Duration
+	Multiplier  float64
+	Jitter      float64
+	MaxAttempts int
+}
+
+var (
+	// Conflicts with other transactions clear up as soon as they finish.
+	conflictBackoff = Backoff{Initial: 5 * time.Millisecond, Max: 500 * time.Millisecond, Multiplier: 2, Jitter: 0.5, MaxAttempts: 5}
+	// A lock wait timeout has already waited innodb_lock_wait_timeout.
+	lockWaitBackoff = Backoff{Initial: 100 * time.Millisecond, Max: 2 * time.Second, Multiplier: 2, Jitter: 0.5, MaxAttempts: 3}
+	// Connection limits and restarts take seconds to clear, and quick retries only add load.
+	overloadBackoff = Backoff{Initial: 500 * time.Millisecond, Max: 10 * time.Second, Multiplier: 2, Jitter: 0.5, MaxAttempts: 5}
+	shutdownBackoff = Backoff{Initial: time.Second, Max: 30 * time.Second, Multiplier: 2, Jitter: 0.2, MaxAttempts: 10}
+)
+
+// BackoffPolicy returns the suggested retry schedule for the errors with code.
+// It returns a zero Backoff for the errors that should not be retried.
+func BackoffPolicy(code uint16) Backoff {
+	switch code {
+	case ER_LOCK_DEADLOCK, ER_TRANSACTION_ROLLBACK_DURING_COMMIT:
+		return conflictBackoff
+	case ER_LOCK_WAIT_TIMEOUT:
+		return lockWaitBackoff
+	case ER_CON_COUNT_ERROR, ER_TOO_MANY_USER_CONNECTIONS, ER_TOO_MANY_CONCURRENT_TRXS:
+		return overloadBackoff
+	case ER_SERVER_SHUTDOWN:
+		return shutdownBackoff
+	}
+	return Backoff{}
+}
+
+// Delay returns how long to wait before the attempt-th retry, counting from 1.
+func (b Backoff) Delay(attempt int) time.Duration {
+	d := float64(b.Initial)
+	for i := 1; i < attempt && d < float64(b.Max); i++ {
+		d *= b.Multiplier
+	}
+	if d > float64(b.Max) {
+		d = float64(b.Max)
+	}
+	if b.Jitter > 0 {
+		d += d * b.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(d)
+}
@@ -0,0 +1,46 @@
+// Package backoff adapts mysqlerr.Backoff to the BackOff interface of github.com/cenkalti/backoff/v4:
+//
+//	err := backoff.Retry(op, mysqlbackoff.New(mysqlerr.BackoffPolicy(mysqlerr.ER_LOCK_DEADLOCK)))
+//
+// It only implements the interface and does not import the module.
+package backoff
+
+import (
+	"time"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// Stop is the value of NextBackOff telling the caller to give up, as backoff.Stop.
+const Stop time.Duration = -1
+
+// BackOff follows a mysqlerr.Backoff.
+type BackOff struct {
+	policy  mysqlerr.Backoff
+	attempt int
+}
+
+// New returns a BackOff following p.
+func New(p mysqlerr.Backoff) *BackOff {
+	return &BackOff{policy: p}
+}
+
+// ForError returns a BackOff following the policy of err's MySQL error.
+// It stops right away for errors that should not be retried.
+func ForError(err error) *BackOff {
+	return New(mysqlerr.BackoffPolicy(uint16(mysqlerr.Code(err))))
+}
+
+// NextBackOff returns how long to wait before the next attempt, or Stop after the last one.
+func (b *BackOff) NextBackOff() time.Duration {
+	b.attempt++
+	if b.attempt >= b.policy.MaxAttempts {
+		return Stop
+	}
+	return b.policy.Delay(b.attempt)
+}
+
+// Reset starts over from the first attempt.
+func (b *BackOff) Reset() {
+	b.attempt = 0
+}
@@ -0,0 +1,53 @@
+package mysqlerr
+
+// Category is a coarse class of errors with few enough values to label metrics and logs with.
+type Category string
+
+// Categories returned by CategoryOf.
+const (
+	CategoryUnspecified Category = "unspecified"
+	CategoryConflict    Category = "conflict"
+	CategoryConstraint  Category = "constraint"
+	CategoryData        Category = "data"
+	CategorySchema      Category = "schema"
+	CategoryReadOnly    Category = "read_only"
+	CategoryResource    Category = "resource"
+	CategoryPrivilege   Category = "privilege"
+	CategoryConnection  Category = "connection"
+	CategorySyntax      Category = "syntax"
+	CategoryReplication Category = "replication"
+)
+
+// CategoryOf returns the category of the first MySQL error in err's chain,
+// or CategoryUnspecified if there is none or it fits no category.
+func CategoryOf(err error) Category {
+	e, ok := FromError(err)
+	if !ok {
+		return CategoryUnspecified
+	}
+	code := int(e.Number)
+	state := e.sqlState()
+	switch {
+	case IsRetryable(e):
+		return CategoryConflict
+	case IsReadOnly(e):
+		return CategoryReadOnly
+	case IsResourceExhausted(e):
+		return CategoryResource
+	case IsDataTruncation(e), IsOutOfRange(e):
+		return CategoryData
+	case IsSchemaConflict(e):
+		return CategorySchema
+	case code == ER_PARSE_ERROR || code == ER_SYNTAX_ERROR:
+		return CategorySyntax
+	case Subsystem(code) == SubsystemPrivileges:
+		return CategoryPrivilege
+	case IsReplicationError(e), IsGroupReplicationError(e):
+		return CategoryReplication
+	case ClassOf(state) == ClassIntegrityConstraintViolation:
+		return CategoryConstraint
+	case ClassOf(state) == ClassConnectionException:
+		return CategoryConnection
+	}
+	return CategoryUnspecified
+}
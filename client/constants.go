@@ -0,0 +1,160 @@
+// Copyright 2021-2023 Nao Yonashiro
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated documentation files (the “Software”), to deal in the Software without restriction, including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+package client
+
+// This file is a hand-written seed until it is regenerated from
+// the include/errmsg.h of mysql-8.4.2 with go generate.
+const CR_UNKNOWN_ERROR = 2000
+const CR_SOCKET_CREATE_ERROR = 2001
+const CR_CONNECTION_ERROR = 2002
+const CR_CONN_HOST_ERROR = 2003
+const CR_IPSOCK_ERROR = 2004
+const CR_UNKNOWN_HOST = 2005
+const CR_SERVER_GONE_ERROR = 2006
+const CR_VERSION_ERROR = 2007
+const CR_OUT_OF_MEMORY = 2008
+const CR_WRONG_HOST_INFO = 2009
+const CR_LOCALHOST_CONNECTION = 2010
+const CR_TCP_CONNECTION = 2011
+const CR_SERVER_HANDSHAKE_ERR = 2012
+const CR_SERVER_LOST = 2013
+const CR_COMMANDS_OUT_OF_SYNC = 2014
+const CR_NAMEDPIPE_CONNECTION = 2015
+const CR_NAMEDPIPEWAIT_ERROR = 2016
+const CR_NAMEDPIPEOPEN_ERROR = 2017
+const CR_NAMEDPIPESETSTATE_ERROR = 2018
+const CR_CANT_READ_CHARSET = 2019
+const CR_NET_PACKET_TOO_LARGE = 2020
+const CR_EMBEDDED_CONNECTION = 2021
+const CR_PROBE_SLAVE_STATUS = 2022
+const CR_PROBE_SLAVE_HOSTS = 2023
+const CR_PROBE_SLAVE_CONNECT = 2024
+const CR_PROBE_MASTER_CONNECT = 2025
+const CR_SSL_CONNECTION_ERROR = 2026
+const CR_MALFORMED_PACKET = 2027
+const CR_WRONG_LICENSE = 2028
+const CR_NULL_POINTER = 2029
+const CR_NO_PREPARE_STMT = 2030
+const CR_PARAMS_NOT_BOUND = 2031
+const CR_DATA_TRUNCATED = 2032
+const CR_NO_PARAMETERS_EXISTS = 2033
+const CR_INVALID_PARAMETER_NO = 2034
+const CR_INVALID_BUFFER_USE = 2035
+const CR_UNSUPPORTED_PARAM_TYPE = 2036
+const CR_SHARED_MEMORY_CONNECTION = 2037
+const CR_SHARED_MEMORY_CONNECT_REQUEST_ERROR = 2038
+const CR_SHARED_MEMORY_CONNECT_ANSWER_ERROR = 2039
+const CR_SHARED_MEMORY_CONNECT_FILE_MAP_ERROR = 2040
+const CR_SHARED_MEMORY_CONNECT_MAP_ERROR = 2041
+const CR_SHARED_MEMORY_FILE_MAP_ERROR = 2042
+const CR_SHARED_MEMORY_MAP_ERROR = 2043
+const CR_SHARED_MEMORY_EVENT_ERROR = 2044
+const CR_SHARED_MEMORY_CONNECT_ABANDONED_ERROR = 2045
+const CR_SHARED_MEMORY_CONNECT_SET_ERROR = 2046
+const CR_CONN_UNKNOW_PROTOCOL = 2047
+const CR_INVALID_CONN_HANDLE = 2048
+const CR_UNUSED_1 = 2049
+const CR_FETCH_CANCELED = 2050
+const CR_NO_DATA = 2051
+const CR_NO_STMT_METADATA = 2052
+const CR_NO_RESULT_SET = 2053
+const CR_NOT_IMPLEMENTED = 2054
+const CR_SERVER_LOST_EXTENDED = 2055
+const CR_STMT_CLOSED = 2056
+const CR_NEW_STMT_METADATA = 2057
+const CR_ALREADY_CONNECTED = 2058
+const CR_AUTH_PLUGIN_CANNOT_LOAD = 2059
+const CR_DUPLICATE_CONNECTION_ATTR = 2060
+const CR_AUTH_PLUGIN_ERR = 2061
+const CR_INSECURE_API_ERR = 2062
+const CR_FILE_NAME_TOO_LONG = 2063
+const CR_SSL_FIPS_MODE_ERR = 2064
+const CR_DEPRECATED_COMPRESSION_NOT_SUPPORTED = 2065
+const CR_COMPRESSION_WRONGLY_CONFIGURED = 2066
+const CR_KERBEROS_USER_NOT_FOUND = 2067
+const CR_LOAD_DATA_LOCAL_INFILE_REJECTED = 2068
+const CR_LOAD_DATA_LOCAL_INFILE_REALPATH_FAIL = 2069
+
+// Name returns the name of the client error code.
+func Name(code int) (string, bool) {
+	name, ok := names[code]
+	return name, ok
+}
+
+var names = map[int]string{
+	CR_UNKNOWN_ERROR:                         "CR_UNKNOWN_ERROR",
+	CR_SOCKET_CREATE_ERROR:                   "CR_SOCKET_CREATE_ERROR",
+	CR_CONNECTION_ERROR:                      "CR_CONNECTION_ERROR",
+	CR_CONN_HOST_ERROR:                       "CR_CONN_HOST_ERROR",
+	CR_IPSOCK_ERROR:                          "CR_IPSOCK_ERROR",
+	CR_UNKNOWN_HOST:                          "CR_UNKNOWN_HOST",
+	CR_SERVER_GONE_ERROR:                     "CR_SERVER_GONE_ERROR",
+	CR_VERSION_ERROR:                         "CR_VERSION_ERROR",
+	CR_OUT_OF_MEMORY:                         "CR_OUT_OF_MEMORY",
+	CR_WRONG_HOST_INFO:                       "CR_WRONG_HOST_INFO",
+	CR_LOCALHOST_CONNECTION:                  "CR_LOCALHOST_CONNECTION",
+	CR_TCP_CONNECTION:                        "CR_TCP_CONNECTION",
+	CR_SERVER_HANDSHAKE_ERR:                  "CR_SERVER_HANDSHAKE_ERR",
+	CR_SERVER_LOST:                           "CR_SERVER_LOST",
+	CR_COMMANDS_OUT_OF_SYNC:                  "CR_COMMANDS_OUT_OF_SYNC",
+	CR_NAMEDPIPE_CONNECTION:                  "CR_NAMEDPIPE_CONNECTION",
+	CR_NAMEDPIPEWAIT_ERROR:                   "CR_NAMEDPIPEWAIT_ERROR",
+	CR_NAMEDPIPEOPEN_ERROR:                   "CR_NAMEDPIPEOPEN_ERROR",
+	CR_NAMEDPIPESETSTATE_ERROR:               "CR_NAMEDPIPESETSTATE_ERROR",
+	CR_CANT_READ_CHARSET:                     "CR_CANT_READ_CHARSET",
+	CR_NET_PACKET_TOO_LARGE:                  "CR_NET_PACKET_TOO_LARGE",
+	CR_EMBEDDED_CONNECTION:                   "CR_EMBEDDED_CONNECTION",
+	CR_PROBE_SLAVE_STATUS:                    "CR_PROBE_SLAVE_STATUS",
+	CR_PROBE_SLAVE_HOSTS:                     "CR_PROBE_SLAVE_HOSTS",
+	CR_PROBE_SLAVE_CONNECT:                   "CR_PROBE_SLAVE_CONNECT",
+	CR_PROBE_MASTER_CONNECT:                  "CR_PROBE_MASTER_CONNECT",
+	CR_SSL_CONNECTION_ERROR:                  "CR_SSL_CONNECTION_ERROR",
+	CR_MALFORMED_PACKET:                      "CR_MALFORMED_PACKET",
+	CR_WRONG_LICENSE:                         "CR_WRONG_LICENSE",
+	CR_NULL_POINTER:                          "CR_NULL_POINTER",
+	CR_NO_PREPARE_STMT:                       "CR_NO_PREPARE_STMT",
+	CR_PARAMS_NOT_BOUND:                      "CR_PARAMS_NOT_BOUND",
+	CR_DATA_TRUNCATED:                        "CR_DATA_TRUNCATED",
+	CR_NO_PARAMETERS_EXISTS:                  "CR_NO_PARAMETERS_EXISTS",
+	CR_INVALID_PARAMETER_NO:                  "CR_INVALID_PARAMETER_NO",
+	CR_INVALID_BUFFER_USE:                    "CR_INVALID_BUFFER_USE",
+	CR_UNSUPPORTED_PARAM_TYPE:                "CR_UNSUPPORTED_PARAM_TYPE",
+	CR_SHARED_MEMORY_CONNECTION:              "CR_SHARED_MEMORY_CONNECTION",
+	CR_SHARED_MEMORY_CONNECT_REQUEST_ERROR:   "CR_SHARED_MEMORY_CONNECT_REQUEST_ERROR",
+	CR_SHARED_MEMORY_CONNECT_ANSWER_ERROR:    "CR_SHARED_MEMORY_CONNECT_ANSWER_ERROR",
+	CR_SHARED_MEMORY_CONNECT_FILE_MAP_ERROR:  "CR_SHARED_MEMORY_CONNECT_FILE_MAP_ERROR",
+	CR_SHARED_MEMORY_CONNECT_MAP_ERROR:       "CR_SHARED_MEMORY_CONNECT_MAP_ERROR",
+	CR_SHARED_MEMORY_FILE_MAP_ERROR:          "CR_SHARED_MEMORY_FILE_MAP_ERROR",
+	CR_SHARED_MEMORY_MAP_ERROR:               "CR_SHARED_MEMORY_MAP_ERROR",
+	CR_SHARED_MEMORY_EVENT_ERROR:             "CR_SHARED_MEMORY_EVENT_ERROR",
+	CR_SHARED_MEMORY_CONNECT_ABANDONED_ERROR: "CR_SHARED_MEMORY_CONNECT_ABANDONED_ERROR",
+	CR_SHARED_MEMORY_CONNECT_SET_ERROR:       "CR_SHARED_MEMORY_CONNECT_SET_ERROR",
+	CR_CONN_UNKNOW_PROTOCOL:                  "CR_CONN_UNKNOW_PROTOCOL",
+	CR_INVALID_CONN_HANDLE:                   "CR_INVALID_CONN_HANDLE",
+	CR_UNUSED_1:                              "CR_UNUSED_1",
+	CR_FETCH_CANCELED:                        "CR_FETCH_CANCELED",
+	CR_NO_DATA:                               "CR_NO_DATA",
+	CR_NO_STMT_METADATA:                      "CR_NO_STMT_METADATA",
+	CR_NO_RESULT_SET:                         "CR_NO_RESULT_SET",
+	CR_NOT_IMPLEMENTED:                       "CR_NOT_IMPLEMENTED",
+	CR_SERVER_LOST_EXTENDED:                  "CR_SERVER_LOST_EXTENDED",
+	CR_STMT_CLOSED:                           "CR_STMT_CLOSED",
+	CR_NEW_STMT_METADATA:                     "CR_NEW_STMT_METADATA",
+	CR_ALREADY_CONNECTED:                     "CR_ALREADY_CONNECTED",
+	CR_AUTH_PLUGIN_CANNOT_LOAD:               "CR_AUTH_PLUGIN_CANNOT_LOAD",
+	CR_DUPLICATE_CONNECTION_ATTR:             "CR_DUPLICATE_CONNECTION_ATTR",
+	CR_AUTH_PLUGIN_ERR:                       "CR_AUTH_PLUGIN_ERR",
+	CR_INSECURE_API_ERR:                      "CR_INSECURE_API_ERR",
+	CR_FILE_NAME_TOO_LONG:                    "CR_FILE_NAME_TOO_LONG",
+	CR_SSL_FIPS_MODE_ERR:                     "CR_SSL_FIPS_MODE_ERR",
+	CR_DEPRECATED_COMPRESSION_NOT_SUPPORTED:  "CR_DEPRECATED_COMPRESSION_NOT_SUPPORTED",
+	CR_COMPRESSION_WRONGLY_CONFIGURED:        "CR_COMPRESSION_WRONGLY_CONFIGURED",
+	CR_KERBEROS_USER_NOT_FOUND:               "CR_KERBEROS_USER_NOT_FOUND",
+	CR_LOAD_DATA_LOCAL_INFILE_REJECTED:       "CR_LOAD_DATA_LOCAL_INFILE_REJECTED",
+	CR_LOAD_DATA_LOCAL_INFILE_REALPATH_FAIL:  "CR_LOAD_DATA_LOCAL_INFILE_REALPATH_FAIL",
+}
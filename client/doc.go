@@ -0,0 +1,3 @@
+// Package client provides the CR_ error codes of the MySQL client library (include/errmsg.h),
+// such as CR_SERVER_GONE_ERROR and CR_SERVER_LOST, as reported by tools built on libmysqlclient.
+package client
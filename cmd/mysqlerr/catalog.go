@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// defaultCatalog is the catalog of the errors of the latest MySQL release, used without -catalog.
+//
+//go:embed catalog.json
+var defaultCatalog []byte
+
+func catalogFlag(fs *flag.FlagSet) *string {
+	return fs.String("catalog", os.Getenv("MYSQLERR_CATALOG"), "catalog exported by mysqlerrgen -format json, or a message file, mysqld_error.h or url (default: $MYSQLERR_CATALOG, or the embedded catalog of the latest MySQL release)")
+}
+
+func loadCatalog(name string) (*parser.Catalog, error) {
+	b := defaultCatalog
+	var err error
+	if name != "" {
+		b, err = readSource(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var cat *parser.Catalog
+	switch {
+	case bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")):
+		if err := json.Unmarshal(b, &cat); err != nil {
+			return nil, fmt.Errorf("decode catalog: %w", err)
+		}
+	case strings.HasSuffix(name, ".h"):
+		cat, err = parser.ParseHeader(bytes.NewReader(b))
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", name, err)
+		}
+	default:
+		cat, err = parser.Parse(bytes.NewReader(b))
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", name, err)
+		}
+	}
+	if cat.DefaultLanguage == "" {
+		cat.DefaultLanguage = "eng"
+	}
+	return cat, nil
+}
+
+var versionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+
+// sourceURL returns the url of the message file of a MySQL version, or name itself if it is not a version.
+func sourceURL(name string) string {
+	if !versionPattern.MatchString(name) {
+		return name
+	}
+	if strings.HasPrefix(name, "5.") {
+		return "https://raw.githubusercontent.com/mysql/mysql-server/mysql-" + name + "/sql/share/errmsg-utf8.txt"
+	}
+	return "https://raw.githubusercontent.com/mysql/mysql-server/mysql-" + name + "/share/messages_to_clients.txt"
+}
+
+func readSource(name string) ([]byte, error) {
+	if !strings.HasPrefix(name, "http://") && !strings.HasPrefix(name, "https://") {
+		return os.ReadFile(name)
+	}
+	resp, err := http.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s: %s", name, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// findError looks an error up by code or by name. Names are case-insensitive and the ER_ prefix may be omitted.
+func findError(cat *parser.Catalog, key string) *parser.Error {
+	if code, err := strconv.Atoi(key); err == nil {
+		e, _ := cat.ByCode(code)
+		return e
+	}
+	key = strings.ToUpper(key)
+	for _, name := range []string{key, "ER_" + key} {
+		if e, ok := cat.ByName(name); ok {
+			return e
+		}
+	}
+	return nil
+}
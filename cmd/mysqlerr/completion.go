@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+var completionWriters = map[string]func(w io.Writer, commands []string, cat *parser.Catalog){
+	"bash": writeBashCompletion,
+	"zsh":  writeZshCompletion,
+	"fish": writeFishCompletion,
+}
+
+// completion is registered here because it lists the other commands.
+func init() {
+	commands["completion"] = completionCommand
+}
+
+// completionCommand prints a completion script with the error names and codes
+// of the catalog, so that e.g. "mysqlerr lookup ER_DU<TAB>" completes.
+func completionCommand(args []string) error {
+	fs := flag.NewFlagSet("completion", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mysqlerr completion [flags] <bash|zsh|fish>")
+		fs.PrintDefaults()
+	}
+	catalogPath := catalogFlag(fs)
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	write, ok := completionWriters[fs.Arg(0)]
+	if !ok {
+		return fmt.Errorf("unknown shell: %s", fs.Arg(0))
+	}
+
+	cat, err := loadCatalog(*catalogPath)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	w := bufio.NewWriter(os.Stdout)
+	write(w, names, cat)
+	return w.Flush()
+}
+
+// errorWords returns the names followed by the codes of the errors.
+func errorWords(cat *parser.Catalog) []string {
+	var names, codes []string
+	for _, e := range cat.Errors {
+		names = append(names, e.Name)
+		codes = append(codes, strconv.Itoa(e.Code))
+	}
+	return append(names, codes...)
+}
+
+func writeBashCompletion(w io.Writer, commands []string, cat *parser.Catalog) {
+	fmt.Fprintln(w, "# bash completion for mysqlerr")
+	fmt.Fprintln(w, "# source <(mysqlerr completion bash)")
+	fmt.Fprintf(w, "_mysqlerr_commands=%q\n", strings.Join(commands, " "))
+	fmt.Fprintf(w, "_mysqlerr_errors=\"\n%s\n\"\n", strings.Join(errorWords(cat), "\n"))
+	io.WriteString(w, `_mysqlerr() {
+	local cur=${COMP_WORDS[COMP_CWORD]}
+	if [ "$COMP_CWORD" -eq 1 ]; then
+		COMPREPLY=($(compgen -W "$_mysqlerr_commands" -- "$cur"))
+		return
+	fi
+	case ${COMP_WORDS[1]} in
+	lookup | perror)
+		COMPREPLY=($(compgen -W "$_mysqlerr_errors" -- "$cur"))
+		;;
+	completion)
+		COMPREPLY=($(compgen -W "bash zsh fish" -- "$cur"))
+		;;
+	esac
+}
+complete -o default -F _mysqlerr mysqlerr
+`)
+}
+
+func writeZshCompletion(w io.Writer, commands []string, cat *parser.Catalog) {
+	fmt.Fprintln(w, "#compdef mysqlerr")
+	fmt.Fprintln(w, "# source <(mysqlerr completion zsh)")
+	fmt.Fprintf(w, "_mysqlerr_commands=(%s)\n", strings.Join(commands, " "))
+	fmt.Fprintf(w, "_mysqlerr_errors=(\n%s\n)\n", strings.Join(errorWords(cat), "\n"))
+	io.WriteString(w, `_mysqlerr() {
+	if (( CURRENT == 2 )); then
+		compadd -a _mysqlerr_commands
+		return
+	fi
+	case $words[2] in
+	lookup | perror)
+		compadd -a _mysqlerr_errors
+		;;
+	completion)
+		compadd bash zsh fish
+		;;
+	*)
+		_files
+		;;
+	esac
+}
+compdef _mysqlerr mysqlerr
+`)
+}
+
+func writeFishCompletion(w io.Writer, commands []string, cat *parser.Catalog) {
+	fmt.Fprintln(w, "# fish completion for mysqlerr")
+	fmt.Fprintln(w, "# mysqlerr completion fish | source")
+	fmt.Fprintf(w, "complete -c mysqlerr -n __fish_use_subcommand -f -a '%s'\n", strings.Join(commands, " "))
+	fmt.Fprintln(w, "complete -c mysqlerr -n '__fish_seen_subcommand_from completion' -f -a 'bash zsh fish'")
+	// Each candidate carries the other half of the name/code pair as its description.
+	for _, e := range cat.Errors {
+		fmt.Fprintf(w, "complete -c mysqlerr -n '__fish_seen_subcommand_from lookup' -f -a '%s' -d '%d'\n", e.Name, e.Code)
+		fmt.Fprintf(w, "complete -c mysqlerr -n '__fish_seen_subcommand_from lookup perror' -f -a '%d' -d '%s'\n", e.Code, e.Name)
+	}
+}
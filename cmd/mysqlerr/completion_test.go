@@ -0,0 +1,23 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCompletionDefaultCatalog(t *testing.T) {
+	cat, err := loadCatalog("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for shell, write := range completionWriters {
+		var buf bytes.Buffer
+		write(&buf, []string{"lookup", "perror"}, cat)
+		for _, word := range []string{"ER_DUP_ENTRY", "1062"} {
+			if !strings.Contains(buf.String(), word) {
+				t.Errorf("%s completion does not complete %s", shell, word)
+			}
+		}
+	}
+}
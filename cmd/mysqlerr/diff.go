@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+func diffCommand(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mysqlerr diff [flags] <old> <new>")
+		fmt.Fprintln(fs.Output(), "\n<old> and <new> are MySQL versions (e.g. 8.0.33), urls, message files or exported catalogs.")
+		fs.PrintDefaults()
+	}
+	lang := fs.String("lang", "eng", "message language to compare")
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	oldCat, err := loadCatalog(sourceURL(fs.Arg(0)))
+	if err != nil {
+		return err
+	}
+	newCat, err := loadCatalog(sourceURL(fs.Arg(1)))
+	if err != nil {
+		return err
+	}
+	printDiff(os.Stdout, oldCat, newCat, *lang)
+	return nil
+}
+
+func printDiff(w io.Writer, oldCat, newCat *parser.Catalog, lang string) {
+	d := oldCat.Diff(newCat)
+	var changed []parser.Change
+	for _, c := range d.Changed {
+		if c.MessageChanged(lang) {
+			changed = append(changed, c)
+		}
+	}
+
+	section := func(title string, errs []*parser.Error) {
+		if len(errs) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "%s (%d):\n", title, len(errs))
+		for _, e := range errs {
+			fmt.Fprintf(w, "  %s (%d)\n", e.Name, e.Code)
+		}
+		fmt.Fprintln(w)
+	}
+	changeSection := func(title string, cs []parser.Change, detail func(c parser.Change)) {
+		if len(cs) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "%s (%d):\n", title, len(cs))
+		for _, c := range cs {
+			fmt.Fprintf(w, "  %s (%d)\n", c.New.Name, c.New.Code)
+			if detail != nil {
+				detail(c)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	section("Added", d.Added)
+	section("Removed", d.Removed)
+	changeSection("Obsoleted", d.Obsoleted, nil)
+	changeSection("Renamed", d.Renamed, func(c parser.Change) {
+		fmt.Fprintf(w, "    was %s\n", c.Old.Name)
+	})
+	changeSection("Changed messages", changed, func(c parser.Change) {
+		fmt.Fprintf(w, "    - %s\n", c.Old.Message(lang))
+		fmt.Fprintf(w, "    + %s\n", c.New.Message(lang))
+	})
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr"
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// explainCommand prints what the package knows about errors, with the curated cause and action,
+// to help an operator who just got one. It needs no catalog, but uses its messages when there is one.
+func explainCommand(args []string) error {
+	fs := flag.NewFlagSet("explain", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mysqlerr explain [flags] <code|name>...")
+		fs.PrintDefaults()
+	}
+	catalogPath := catalogFlag(fs)
+	lang := fs.String("lang", "", "message language (default: the catalog's default language)")
+	version := fs.String("version", "", "MySQL version of the linked reference manual (default: "+mysqlerr.DocVersion+")")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	var cat *parser.Catalog
+	if *catalogPath != "" {
+		var err error
+		cat, err = loadCatalog(*catalogPath)
+		if err != nil {
+			return err
+		}
+		if *lang == "" {
+			*lang = cat.DefaultLanguage
+		}
+	}
+	for i, key := range fs.Args() {
+		if i > 0 {
+			fmt.Println()
+		}
+		code, ok := resolveCode(key)
+		if !ok {
+			return fmt.Errorf("unknown error: %s", key)
+		}
+		explain(os.Stdout, code, cat, *lang, *version)
+	}
+	return nil
+}
+
+// resolveCode returns the code of a code or a name known to the package, with or without the ER_ prefix.
+func resolveCode(key string) (int, bool) {
+	if code, err := strconv.Atoi(key); err == nil {
+		_, ok := mysqlerr.Name(code)
+		return code, ok
+	}
+	key = strings.ToUpper(key)
+	for code := 0; code <= 0xffff; code++ {
+		if name, ok := mysqlerr.Name(code); ok && (name == key || name == "ER_"+key) {
+			return code, true
+		}
+	}
+	return 0, false
+}
+
+func explain(w io.Writer, code int, cat *parser.Catalog, lang, version string) {
+	name, _ := mysqlerr.Name(code)
+	fmt.Fprintf(w, "Code:      %d\n", code)
+	fmt.Fprintf(w, "Name:      %s\n", name)
+	state := mysqlerr.SQLStateOf(code)
+	msg, _ := mysqlerr.Message(code)
+	if cat != nil {
+		if e := findError(cat, strconv.Itoa(code)); e != nil && e.Name == name {
+			if e.SQLState != "" {
+				state = e.SQLState
+			}
+			if m := e.Message(lang); m != "" {
+				msg = m
+			}
+		}
+	}
+	fmt.Fprintf(w, "SQLSTATE:  %s (%s)\n", state, mysqlerr.ClassOf(state).Description())
+	if msg != "" {
+		fmt.Fprintf(w, "Message:   %s\n", msg)
+	}
+	e := &mysqlerr.Error{Number: uint16(code), SQLState: state}
+	fmt.Fprintf(w, "Category:  %s\n", mysqlerr.CategoryOf(e))
+	if s := mysqlerr.Subsystem(code); s != "" {
+		fmt.Fprintf(w, "Subsystem: %s\n", s)
+	}
+	fmt.Fprintf(w, "Retryable: %t\n", mysqlerr.IsRetryable(e))
+	if a, ok := mysqlerr.Advice(code); ok {
+		fmt.Fprintf(w, "Cause:     %s\n", a.Cause)
+		fmt.Fprintf(w, "Action:    %s\n", a.Action)
+	}
+	if u := mysqlerr.DocURL(uint16(code), version); u != "" {
+		fmt.Fprintf(w, "Docs:      %s\n", u)
+	}
+}
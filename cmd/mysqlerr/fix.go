@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/orisano/mysqlerr"
+)
+
+const mysqlerrPath = "github.com/orisano/mysqlerr"
+
+// foreignPackages are the packages of MySQL error codes fix migrates from.
+var foreignPackages = map[string]bool{
+	"github.com/VividCortex/mysqlerr":          true,
+	"github.com/go-mysql-org/go-mysql/mysql":   true,
+	"github.com/siddontang/go-mysql/mysql":     true,
+	"github.com/pingcap/parser/mysql":          true,
+	"github.com/pingcap/tidb/parser/mysql":     true,
+	"github.com/pingcap/tidb/pkg/parser/mysql": true,
+	"github.com/pingcap/tidb/errno":            true,
+	"github.com/pingcap/tidb/pkg/errno":        true,
+}
+
+// localConstName matches the names of hand-maintained constants that look like MySQL error codes.
+var localConstName = regexp.MustCompile(`(?i)^(er_|err|mysql)`)
+
+func fixCommand(args []string) error {
+	fs := flag.NewFlagSet("fix", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mysqlerr fix [flags] <dir|dir/...>...")
+		fmt.Fprintln(fs.Output(), "Rewrites the MySQL error codes of other packages and of local constants to github.com/orisano/mysqlerr.")
+		fs.PrintDefaults()
+	}
+	dryRun := fs.Bool("n", false, "only print the files that would change")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	codes := codesByName()
+	for _, pattern := range fs.Args() {
+		files, err := goFiles(pattern)
+		if err != nil {
+			return err
+		}
+		for _, name := range files {
+			changed, err := fixFile(name, codes, *dryRun)
+			if err != nil {
+				return fmt.Errorf("fix %s: %w", name, err)
+			}
+			if changed {
+				fmt.Println(name)
+			}
+		}
+	}
+	return nil
+}
+
+// codesByName returns the codes of the constants of mysqlerr, the deprecated aliases of obsolete codes included.
+func codesByName() map[string]int {
+	codes := map[string]int{}
+	for code := 0; code <= 0xffff; code++ {
+		if name, ok := mysqlerr.Name(code); ok {
+			codes[name] = code
+			codes[strings.TrimPrefix(name, "OBSOLETE_")] = code
+		}
+	}
+	return codes
+}
+
+// goFiles returns the Go files of dir, or of dir and its subdirectories if the pattern ends with "/...".
+func goFiles(pattern string) ([]string, error) {
+	dir := strings.TrimSuffix(pattern, "/...")
+	recursive := dir != pattern
+	if dir == "" {
+		dir = "."
+	}
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != dir && (!recursive || name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(path, ".go") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func fixFile(name string, codes map[string]int, dryRun bool) (bool, error) {
+	src, err := os.ReadFile(name)
+	if err != nil {
+		return false, err
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		return false, err
+	}
+	if ast.IsGenerated(f) {
+		return false, nil
+	}
+	pkg := importedName(f, mysqlerrPath)
+	if pkg == "" {
+		pkg = "mysqlerr"
+		for _, spec := range f.Imports {
+			if localName(spec) == pkg && !isPath(spec, mysqlerrPath) {
+				pkg = "mysqlerrcodes"
+			}
+		}
+	}
+
+	changed := false
+	// Rewrite the constants of the foreign packages and drop the imports left unused.
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		if !foreignPackages[path] {
+			continue
+		}
+		local := localName(spec)
+		remaining := false
+		ast.Inspect(f, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			x, ok := sel.X.(*ast.Ident)
+			if !ok || x.Name != local {
+				return true
+			}
+			target, ok := targetName(sel.Sel.Name, codes)
+			if !ok {
+				log.Printf("%s: no constant for %s.%s", fset.Position(sel.Pos()), local, sel.Sel.Name)
+				remaining = true
+				return false
+			}
+			x.Name = pkg
+			sel.Sel.Name = target
+			changed = true
+			return false
+		})
+		if !remaining {
+			deleteImport(f, spec)
+			changed = true
+		}
+	}
+	// Rewrite the values of local constants to the constants of mysqlerr.
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Names) != 1 || len(vs.Values) != 1 || !localConstName.MatchString(vs.Names[0].Name) {
+				continue
+			}
+			lit, ok := vs.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				continue
+			}
+			code, err := strconv.Atoi(lit.Value)
+			if err != nil || code < 1000 {
+				continue
+			}
+			target, ok := mysqlerr.Name(code)
+			if !ok || strings.HasPrefix(target, "OBSOLETE_") {
+				continue
+			}
+			vs.Values[0] = &ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: &ast.Ident{Name: target, NamePos: lit.Pos()}}
+			changed = true
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+	if importedName(f, mysqlerrPath) == "" && usesName(f, pkg) {
+		addImport(f, pkg, mysqlerrPath)
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return false, err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return false, err
+	}
+	if bytes.Equal(out, src) {
+		return false, nil
+	}
+	if dryRun {
+		return true, nil
+	}
+	return true, os.WriteFile(name, out, 0666)
+}
+
+// targetName returns the name of the mysqlerr constant for the constant name of a foreign package,
+// translating the ErrDupEntry style of TiDB to ER_DUP_ENTRY.
+func targetName(name string, codes map[string]int) (string, bool) {
+	if _, ok := codes[name]; ok {
+		return name, true
+	}
+	if !strings.HasPrefix(name, "Err") {
+		return "", false
+	}
+	var b strings.Builder
+	b.WriteString("ER")
+	rs := []rune(strings.TrimPrefix(name, "Err"))
+	for i, r := range rs {
+		// Start a word at an upper case letter following a lower case one, or starting a word after an acronym.
+		if unicode.IsUpper(r) && (i == 0 || !unicode.IsUpper(rs[i-1]) || (i+1 < len(rs) && unicode.IsLower(rs[i+1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	for _, candidate := range []string{b.String(), b.String() + "_ERROR"} {
+		if _, ok := codes[candidate]; ok {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+func localName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	path, _ := strconv.Unquote(spec.Path.Value)
+	return filepath.Base(path)
+}
+
+func isPath(spec *ast.ImportSpec, path string) bool {
+	p, _ := strconv.Unquote(spec.Path.Value)
+	return p == path
+}
+
+// importedName returns the name path is imported as in f, or "" if it is not.
+func importedName(f *ast.File, path string) string {
+	for _, spec := range f.Imports {
+		if isPath(spec, path) {
+			return localName(spec)
+		}
+	}
+	return ""
+}
+
+func usesName(f *ast.File, name string) bool {
+	found := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == name {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func deleteImport(f *ast.File, spec *ast.ImportSpec) {
+	for i, s := range f.Imports {
+		if s == spec {
+			f.Imports = append(f.Imports[:i], f.Imports[i+1:]...)
+			break
+		}
+	}
+	for i, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		for j, s := range gd.Specs {
+			if s == spec {
+				gd.Specs = append(gd.Specs[:j], gd.Specs[j+1:]...)
+			}
+		}
+		if len(gd.Specs) == 0 {
+			f.Decls = append(f.Decls[:i], f.Decls[i+1:]...)
+		}
+		return
+	}
+}
+
+func addImport(f *ast.File, name, path string) {
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(path)}}
+	if name != filepath.Base(path) {
+		spec.Name = ast.NewIdent(name)
+	}
+	f.Imports = append(f.Imports, spec)
+	for _, decl := range f.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			if !gd.Lparen.IsValid() {
+				gd.Lparen = gd.Pos()
+				gd.Rparen = gd.End()
+			}
+			gd.Specs = append(gd.Specs, spec)
+			return
+		}
+	}
+	f.Decls = append([]ast.Decl{&ast.GenDecl{Tok: token.IMPORT, Specs: []ast.Spec{spec}}}, f.Decls...)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func lookupCommand(args []string) error {
+	fs := flag.NewFlagSet("lookup", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mysqlerr lookup [flags] <code|name>...")
+		fs.PrintDefaults()
+	}
+	catalogPath := catalogFlag(fs)
+	lang := fs.String("lang", "", "message language (default: the catalog's default language)")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cat, err := loadCatalog(*catalogPath)
+	if err != nil {
+		return err
+	}
+	if *lang == "" {
+		*lang = cat.DefaultLanguage
+	}
+	for i, key := range fs.Args() {
+		if i > 0 {
+			fmt.Println()
+		}
+		e := findError(cat, key)
+		if e == nil {
+			return fmt.Errorf("unknown error: %s", key)
+		}
+		printError(os.Stdout, e, *lang)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/orisano/mysqlerr"
+	"github.com/orisano/mysqlerr/parser"
+)
+
+var commands = map[string]func(args []string) error{
+	"diff":     diffCommand,
+	"explain":  explainCommand,
+	"fix":      fixCommand,
+	"lookup":   lookupCommand,
+	"match":    matchCommand,
+	"perror":   perrorCommand,
+	"search":   searchCommand,
+	"serve":    serveCommand,
+	"sqlstate": sqlStateCommand,
+	"verify":   verifyCommand,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mysqlerr: ")
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+	if err := cmd(os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(os.Stderr, "usage: mysqlerr <command> [arguments]\n\ncommands: %s\n", strings.Join(names, ", "))
+}
+
+func printError(w io.Writer, e *parser.Error, lang string) {
+	fmt.Fprintf(w, "Code:      %d\n", e.Code)
+	fmt.Fprintf(w, "Name:      %s\n", e.Name)
+	if e.SQLState != "" {
+		fmt.Fprintf(w, "SQLSTATE:  %s\n", e.SQLState)
+	}
+	if msg := e.Message(lang); msg != "" {
+		fmt.Fprintf(w, "Message:   %s\n", msg)
+	}
+	if e.Obsolete {
+		fmt.Fprintln(w, "Obsolete:  yes")
+	} else {
+		fmt.Fprintln(w, "Obsolete:  no")
+	}
+	if category := sqlStateClass(e.SQLState); category != "" {
+		fmt.Fprintf(w, "Category:  %s\n", category)
+	}
+	// Only link the reference of MySQL for the errors of MySQL, not those of other catalogs with the same code.
+	if name, ok := mysqlerr.Name(e.Code); ok && name == e.Name {
+		if u := mysqlerr.DocURL(uint16(e.Code), ""); u != "" {
+			fmt.Fprintf(w, "Docs:      %s\n", u)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr"
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// errorPrefix matches the prefix added by go-sql-driver/mysql and the mysql client.
+var errorPrefix = regexp.MustCompile(`^(?i:error) (\d+)(?: \(([0-9A-Z]{5})\))?: `)
+
+type templateMatch struct {
+	err    *parser.Error
+	tmpl   *mysqlerr.Template
+	values []string
+}
+
+func matchCommand(args []string) error {
+	fs := flag.NewFlagSet("match", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mysqlerr match [flags] <message>")
+		fs.PrintDefaults()
+	}
+	catalogPath := catalogFlag(fs)
+	lang := fs.String("lang", "", "message language (default: the catalog's default language)")
+	limit := fs.Int("n", 3, "maximum number of matches to print")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cat, err := loadCatalog(*catalogPath)
+	if err != nil {
+		return err
+	}
+	if *lang == "" {
+		*lang = cat.DefaultLanguage
+	}
+	msg := strings.TrimSpace(strings.Join(fs.Args(), " "))
+	code := 0
+	if m := errorPrefix.FindStringSubmatch(msg); m != nil {
+		code, _ = strconv.Atoi(m[1])
+		msg = msg[len(m[0]):]
+	}
+
+	matches := matchTemplates(cat, *lang, msg)
+	if len(matches) == 0 {
+		return fmt.Errorf("no template matches: %q", msg)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if (matches[i].err.Code == code) != (matches[j].err.Code == code) {
+			return matches[i].err.Code == code
+		}
+		return matches[i].tmpl.Specificity() > matches[j].tmpl.Specificity()
+	})
+	if len(matches) > *limit {
+		matches = matches[:*limit]
+	}
+	for i, m := range matches {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s (%d", m.err.Name, m.err.Code)
+		if m.err.SQLState != "" {
+			fmt.Printf(", SQLSTATE %s", m.err.SQLState)
+		}
+		fmt.Println(")")
+		fmt.Printf("  template: %s\n", m.tmpl)
+		if len(m.values) > 0 {
+			quoted := make([]string, len(m.values))
+			for i, v := range m.values {
+				quoted[i] = strconv.Quote(v)
+			}
+			fmt.Printf("  values:   %s\n", strings.Join(quoted, ", "))
+		}
+	}
+	return nil
+}
+
+func matchTemplates(cat *parser.Catalog, lang, msg string) []templateMatch {
+	var matches []templateMatch
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		text := e.Message(lang)
+		if text == "" {
+			continue
+		}
+		tmpl, err := mysqlerr.CompileTemplate(text)
+		if err != nil {
+			continue
+		}
+		if values, ok := tmpl.Match(msg); ok {
+			matches = append(matches, templateMatch{err: e, tmpl: tmpl, values: values})
+		}
+	}
+	return matches
+}
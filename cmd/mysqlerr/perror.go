@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// perrorCommand resolves codes like the perror utility bundled with MySQL:
+// each code is reported as an OS errno and as a MySQL error, whichever exist.
+func perrorCommand(args []string) error {
+	fs := flag.NewFlagSet("perror", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mysqlerr perror [flags] <code>...")
+		fs.PrintDefaults()
+	}
+	catalogPath := catalogFlag(fs)
+	lang := fs.String("lang", "", "message language (default: the catalog's default language)")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cat, err := loadCatalog(*catalogPath)
+	if err != nil {
+		return err
+	}
+	if *lang == "" {
+		*lang = cat.DefaultLanguage
+	}
+	byCode := map[int]int{}
+	for i := range cat.Errors {
+		byCode[cat.Errors[i].Code] = i
+	}
+	var unknown []string
+	for _, arg := range fs.Args() {
+		code, err := strconv.Atoi(arg)
+		if err != nil || code < 0 {
+			return fmt.Errorf("invalid code: %s", arg)
+		}
+		found := false
+		if msg, ok := osErrorMessage(code); ok {
+			fmt.Printf("OS error code %3d:  %s\n", code, msg)
+			found = true
+		}
+		if i, ok := byCode[code]; ok {
+			e := &cat.Errors[i]
+			fmt.Printf("MySQL error code MY-%06d (%s): %s\n", e.Code, e.Name, e.Message(*lang))
+			found = true
+		}
+		if !found {
+			unknown = append(unknown, arg)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("illegal error code: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// osErrorMessage returns the description of errno code on this platform.
+func osErrorMessage(code int) (string, bool) {
+	if code == 0 || code > 0xffff {
+		return "", false
+	}
+	msg := syscall.Errno(code).Error()
+	// The syscall package falls back to "errno N" ("winapi error #N" on Windows)
+	// for numbers it does not know.
+	if strings.HasPrefix(msg, "errno ") || strings.HasPrefix(msg, "winapi error #") {
+		return "", false
+	}
+	return strings.ToUpper(msg[:1]) + msg[1:], true
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+func searchCommand(args []string) error {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mysqlerr search [flags] <query>")
+		fs.PrintDefaults()
+	}
+	catalogPath := catalogFlag(fs)
+	lang := fs.String("lang", "", "message language to print (default: the catalog's default language)")
+	useRegexp := fs.Bool("regexp", false, "treat the query as a regular expression")
+	limit := fs.Int("n", 20, "maximum number of results (0 for all)")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cat, err := loadCatalog(*catalogPath)
+	if err != nil {
+		return err
+	}
+	if *lang == "" {
+		*lang = cat.DefaultLanguage
+	}
+	query := strings.Join(fs.Args(), " ")
+	if !*useRegexp {
+		query = regexp.QuoteMeta(query)
+	}
+	re, err := regexp.Compile("(?i)" + query)
+	if err != nil {
+		return fmt.Errorf("invalid query: %w", err)
+	}
+
+	// Matches on the name rank above matches on any message translation,
+	// and an exact name match ranks first.
+	type result struct {
+		index int
+		score int
+	}
+	var results []result
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		score := 0
+		if loc := re.FindStringIndex(e.Name); loc != nil {
+			score = 2
+			if loc[0] == 0 && loc[1] == len(e.Name) {
+				score = 3
+			}
+		}
+		if score == 0 {
+			for _, m := range e.Messages {
+				if re.MatchString(m.Text) {
+					score = 1
+					break
+				}
+			}
+		}
+		if score == 0 {
+			continue
+		}
+		// Prefer live errors over obsolete ones with the same kind of match.
+		score *= 2
+		if !e.Obsolete {
+			score++
+		}
+		results = append(results, result{index: i, score: score})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].score > results[j].score
+	})
+	if *limit > 0 && len(results) > *limit {
+		results = results[:*limit]
+	}
+	for _, r := range results {
+		e := &cat.Errors[r.index]
+		fmt.Printf("%s (%d): %s\n", e.Name, e.Code, e.Message(*lang))
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+func serveCommand(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	catalogPath := catalogFlag(fs)
+	addr := fs.String("addr", ":8080", "listen address")
+	fs.Parse(args)
+
+	cat, err := loadCatalog(*catalogPath)
+	if err != nil {
+		return err
+	}
+	log.Printf("serving %d errors on %s", len(cat.Errors), *addr)
+	return http.ListenAndServe(*addr, newServer(cat))
+}
+
+type server struct {
+	cat    *parser.Catalog
+	byCode map[int]*parser.Error
+}
+
+func newServer(cat *parser.Catalog) http.Handler {
+	s := &server{cat: cat, byCode: map[int]*parser.Error{}}
+	for i := range cat.Errors {
+		s.byCode[cat.Errors[i].Code] = &cat.Errors[i]
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/errors", s.handleSearch)
+	mux.HandleFunc("/errors/", s.handleCode)
+	return mux
+}
+
+// handleCode serves /errors/{code}.
+func (s *server) handleCode(w http.ResponseWriter, r *http.Request) {
+	code, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/errors/"))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid code")
+		return
+	}
+	e, ok := s.byCode[code]
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, "unknown code")
+		return
+	}
+	writeJSON(w, http.StatusOK, e)
+}
+
+// handleSearch serves /errors?name= and /errors?sqlstate=.
+func (s *server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	name, sqlState := strings.ToUpper(q.Get("name")), strings.ToUpper(q.Get("sqlstate"))
+	if name == "" && sqlState == "" {
+		writeJSONError(w, http.StatusBadRequest, "name or sqlstate is required")
+		return
+	}
+	errs := []*parser.Error{}
+	for i := range s.cat.Errors {
+		e := &s.cat.Errors[i]
+		if name != "" && e.Name != name {
+			continue
+		}
+		if sqlState != "" && e.SQLState != sqlState {
+			continue
+		}
+		errs = append(errs, e)
+	}
+	writeJSON(w, http.StatusOK, errs)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+func TestServeDefaultCatalog(t *testing.T) {
+	cat, err := loadCatalog("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(newServer(cat))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/errors/1213")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var e parser.Error
+	if err := json.NewDecoder(resp.Body).Decode(&e); err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK || e.Name != "ER_LOCK_DEADLOCK" || e.SQLState != "40001" {
+		t.Errorf("GET /errors/1213: %s %+v", resp.Status, e)
+	}
+
+	resp, err = http.Get(srv.URL + "/errors/999999")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GET /errors/999999: %s", resp.Status)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/orisano/mysqlerr"
+	"github.com/orisano/mysqlerr/parser"
+)
+
+func sqlStateClass(state string) string {
+	return mysqlerr.ClassOf(state).Description()
+}
+
+// defaultSQLState is what the server sends for errors without an SQLSTATE of their own.
+const defaultSQLState = "HY000"
+
+func sqlStateCommand(args []string) error {
+	fs := flag.NewFlagSet("sqlstate", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mysqlerr sqlstate [flags] <sqlstate|class>...")
+		fmt.Fprintln(fs.Output(), "       mysqlerr sqlstate -classes")
+		fs.PrintDefaults()
+	}
+	catalogPath := catalogFlag(fs)
+	lang := fs.String("lang", "", "message language (default: the catalog's default language)")
+	classes := fs.Bool("classes", false, "summarize the number of codes per SQLSTATE class")
+	fs.Parse(args)
+	if !*classes && fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	cat, err := loadCatalog(*catalogPath)
+	if err != nil {
+		return err
+	}
+	if *lang == "" {
+		*lang = cat.DefaultLanguage
+	}
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	if *classes {
+		printSQLStateClasses(w, cat)
+		return nil
+	}
+	for i, arg := range fs.Args() {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		// A two character argument selects the whole class.
+		state := strings.ToUpper(arg)
+		fmt.Fprintf(w, "%s: %s\n", state, sqlStateClass(state))
+		n := 0
+		for j := range cat.Errors {
+			e := &cat.Errors[j]
+			if s := errorSQLState(e); s == state || len(state) == 2 && strings.HasPrefix(s, state) {
+				fmt.Fprintf(w, "  %d %s (%s): %s\n", e.Code, e.Name, s, e.Message(*lang))
+				n++
+			}
+		}
+		if n == 0 {
+			return fmt.Errorf("no errors with SQLSTATE %s", arg)
+		}
+	}
+	return nil
+}
+
+func printSQLStateClasses(w io.Writer, cat *parser.Catalog) {
+	counts := map[string]map[string]int{}
+	for i := range cat.Errors {
+		s := errorSQLState(&cat.Errors[i])
+		class := s[:2]
+		if counts[class] == nil {
+			counts[class] = map[string]int{}
+		}
+		counts[class][s]++
+	}
+	var classes []string
+	for class := range counts {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "CLASS\tCODES\tDESCRIPTION\tSQLSTATES")
+	for _, class := range classes {
+		var states []string
+		total := 0
+		for s, n := range counts[class] {
+			states = append(states, fmt.Sprintf("%s(%d)", s, n))
+			total += n
+		}
+		sort.Strings(states)
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", class, total, mysqlerr.SQLStateClass(class).Description(), strings.Join(states, " "))
+	}
+	tw.Flush()
+}
+
+func errorSQLState(e *parser.Error) string {
+	if len(e.SQLState) < 2 {
+		return defaultSQLState
+	}
+	return e.SQLState
+}
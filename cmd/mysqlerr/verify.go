@@ -0,0 +1,40 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// generatorPackage is run with go run when mysqlerrgen is not installed.
+const generatorPackage = "github.com/orisano/mysqlerr/cmd/mysqlerrgen"
+
+// verifyCommand regenerates a package in memory with mysqlerrgen -check and
+// fails with a diff if the committed files are stale.
+// The arguments are the mysqlerrgen flags used to generate the package.
+func verifyCommand(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "usage: mysqlerr verify <mysqlerrgen flags>")
+		fmt.Fprintln(os.Stderr, "example: mysqlerr verify -pkg mysqlerr8 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt")
+		os.Exit(2)
+	}
+	var cmd *exec.Cmd
+	if path, err := exec.LookPath("mysqlerrgen"); err == nil {
+		cmd = exec.Command(path, append([]string{"-check"}, args...)...)
+	} else {
+		cmd = exec.Command("go", append([]string{"run", generatorPackage, "-check"}, args...)...)
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.ExitCode())
+		}
+		return fmt.Errorf("run mysqlerrgen: %w", err)
+	}
+	fmt.Fprintln(os.Stderr, "up to date")
+	return nil
+}
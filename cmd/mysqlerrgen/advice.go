@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// adviceEntry is an entry of the curated advice file, keyed by error name.
+type adviceEntry struct {
+	Name   string `json:"name"`
+	Cause  string `json:"cause"`
+	Action string `json:"action"`
+}
+
+func readAdvice(name string) ([]adviceEntry, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	var entries []adviceEntry
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", name, err)
+	}
+	return entries, nil
+}
+
+// writeAdvice writes the table of mysqlerr.Advice, merging the advice file with the catalog
+// so that advice for names the source no longer has fails the generation instead of going stale.
+func writeAdvice(w io.Writer, cat *parser.Catalog, opts *exportOptions) error {
+	if opts.advice == "" {
+		return fmt.Errorf("-advice is required")
+	}
+	entries, err := readAdvice(opts.advice)
+	if err != nil {
+		return err
+	}
+	codes := map[string]int{}
+	for _, e := range cat.Errors {
+		if !e.Obsolete {
+			codes[e.Name] = e.Code
+		}
+	}
+	seen := map[string]bool{}
+	for _, a := range entries {
+		if _, ok := codes[a.Name]; !ok {
+			return fmt.Errorf("advice for unknown or obsolete error: %s", a.Name)
+		}
+		if seen[a.Name] {
+			return fmt.Errorf("duplicate advice: %s", a.Name)
+		}
+		seen[a.Name] = true
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return codes[entries[i].Name] < codes[entries[j].Name] })
+
+	pkg := opts.pkg
+	if pkg == "" {
+		pkg = "mysqlerr"
+	}
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package", pkg)
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var advice = map[int]ErrorAdvice{")
+	for _, a := range entries {
+		fmt.Fprintf(&buf, "%s: {Cause: %q, Action: %q},\n", a.Name, a.Cause, a.Action)
+	}
+	fmt.Fprintln(&buf, "}")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
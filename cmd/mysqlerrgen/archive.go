@@ -0,0 +1,123 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// archiveMembers are the message files looked for in a source archive when no member is given,
+// messages_to_clients.txt of MySQL 8 before errmsg-utf8.txt of MySQL 5.7 and MariaDB.
+var archiveMembers = []string{"messages_to_clients.txt", "errmsg-utf8.txt"}
+
+// isArchive reports whether b starts like a zip, gzip or tar file.
+func isArchive(b []byte) bool {
+	return bytes.HasPrefix(b, []byte("PK\x03\x04")) ||
+		bytes.HasPrefix(b, []byte{0x1f, 0x8b}) ||
+		len(b) >= 262 && string(b[257:262]) == "ustar"
+}
+
+// extractSource returns the message file of a mysql-server or MariaDB source archive,
+// or the member file, such as messages_to_error_log.txt or share/messages_to_error_log.txt, in a share directory.
+func extractSource(r io.ReaderAt, size int64, member string) ([]byte, error) {
+	members := archiveMembers
+	if member != "" {
+		members = []string{path.Base(member)}
+	}
+	head := make([]byte, 262)
+	n, _ := r.ReadAt(head, 0)
+	head = head[:n]
+	var (
+		b   []byte
+		err error
+	)
+	if bytes.HasPrefix(head, []byte("PK")) {
+		b, err = extractZip(r, size, members)
+	} else {
+		b, err = extractTar(io.NewSectionReader(r, 0, size), bytes.HasPrefix(head, []byte{0x1f, 0x8b}), members)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("archive: %w", err)
+	}
+	if b == nil {
+		return nil, fmt.Errorf("archive: no share/%s", strings.Join(members, " or share/"))
+	}
+	return b, nil
+}
+
+// memberRank returns the index of the member name matches in members, or -1.
+func memberRank(name string, members []string) int {
+	dir, file := path.Split(strings.TrimSuffix(name, "/"))
+	if path.Base(dir) != "share" {
+		return -1
+	}
+	for i, m := range members {
+		if file == m {
+			return i
+		}
+	}
+	return -1
+}
+
+func extractZip(r io.ReaderAt, size int64, members []string) ([]byte, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	var found *zip.File
+	best := len(members)
+	for _, f := range zr.File {
+		if rank := memberRank(f.Name, members); rank >= 0 && rank < best {
+			found, best = f, rank
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	rc, err := found.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// extractTar streams the archive, which may be a release tarball of hundreds of megabytes,
+// keeping only the best member seen so far.
+func extractTar(r io.Reader, gzipped bool, members []string) ([]byte, error) {
+	if gzipped {
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		r = gr
+	}
+	tr := tar.NewReader(r)
+	var found []byte
+	best := len(members)
+	for best > 0 {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		if rank := memberRank(h.Name, members); rank >= 0 && rank < best {
+			if found, err = io.ReadAll(tr); err != nil {
+				return nil, err
+			}
+			best = rank
+		}
+	}
+	return found, nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var archiveFiles = []struct{ name, body string }{
+	{"mysql-8.4.2/README", "readme"},
+	{"mysql-8.4.2/share/messages_to_error_log.txt", "error log"},
+	{"mysql-8.4.2/share/messages_to_clients.txt", "clients"},
+	{"mysql-8.4.2/sql/share/errmsg-utf8.txt", "errmsg"},
+}
+
+func tarArchive(t *testing.T, gzipped bool) []byte {
+	var buf bytes.Buffer
+	var gw *gzip.Writer
+	w := tar.NewWriter(&buf)
+	if gzipped {
+		gw = gzip.NewWriter(&buf)
+		w = tar.NewWriter(gw)
+	}
+	for _, f := range archiveFiles {
+		if err := w.WriteHeader(&tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.body)), Typeflag: tar.TypeReg, Format: tar.FormatUSTAR}); err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(f.body))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if gw != nil {
+		gw.Close()
+	}
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range archiveFiles {
+		fw, err := w.Create(f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write([]byte(f.body))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadArchive(t *testing.T) {
+	archives := map[string][]byte{
+		"src.tar":    tarArchive(t, false),
+		"src.tar.gz": tarArchive(t, true),
+		"src.zip":    zipArchive(t),
+	}
+	dir := t.TempDir()
+	tests := []struct {
+		member string
+		want   string
+	}{
+		{"", "clients"},
+		{"messages_to_error_log.txt", "error log"},
+		{"share/messages_to_error_log.txt", "error log"},
+		{"errmsg-utf8.txt", "errmsg"},
+	}
+	for name, b := range archives {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, b, 0666); err != nil {
+			t.Fatal(err)
+		}
+		for _, tt := range tests {
+			f := &fetcher{member: tt.member}
+			got, err := f.readSource(p)
+			if err != nil {
+				t.Errorf("%s %q: %v", name, tt.member, err)
+				continue
+			}
+			if string(got) != tt.want {
+				t.Errorf("%s %q: read %q, want %q", name, tt.member, got, tt.want)
+			}
+			// Archives read from a pipe are buffered.
+			got, err = f.extract(b, nil)
+			if err != nil || string(got) != tt.want {
+				t.Errorf("%s %q from memory: read %q, %v, want %q", name, tt.member, got, err, tt.want)
+			}
+		}
+		if _, err := (&fetcher{member: "missing.txt"}).readSource(p); err == nil {
+			t.Errorf("%s: extracted a missing member", name)
+		}
+	}
+}
+
+func TestReadPlainSource(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "messages_to_clients.txt")
+	// Longer than the header read to detect archives.
+	src := bytes.Repeat([]byte("ER_A\n  eng \"a\"\n"), 100)
+	if err := os.WriteFile(p, src, 0666); err != nil {
+		t.Fatal(err)
+	}
+	got, err := (&fetcher{}).readSource(p)
+	if err != nil || !bytes.Equal(got, src) {
+		t.Errorf("read %d bytes, %v, want %d", len(got), err, len(src))
+	}
+}
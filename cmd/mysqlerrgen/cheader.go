@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// writeCHeader writes a header in the style of MySQL's generated include/mysqld_error.h.
+func writeCHeader(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+	var starts, sizes []int
+	for i, e := range cat.Errors {
+		if i == 0 || e.Code != cat.Errors[i-1].Code+1 {
+			starts = append(starts, e.Code)
+			sizes = append(sizes, 0)
+		}
+		sizes[len(sizes)-1]++
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "/* Code generated mysqlerrgen DO NOT EDIT. */")
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "#ifndef MYSQLD_ERROR_INCLUDED")
+	fmt.Fprintln(bw, "#define MYSQLD_ERROR_INCLUDED")
+	fmt.Fprintln(bw)
+	if len(cat.Errors) > 0 {
+		fmt.Fprintf(bw, "static const int errmsg_section_start[] = { %s };\n", joinInts(starts))
+		fmt.Fprintf(bw, "static const int errmsg_section_size[] = { %s };\n", joinInts(sizes))
+		fmt.Fprintln(bw)
+	}
+	fmt.Fprintf(bw, "static const int total_error_count = %d;\n", len(cat.Errors))
+	fmt.Fprintln(bw)
+	for _, e := range cat.Errors {
+		if e.Obsolete {
+			fmt.Fprintf(bw, "//#define %s %d\n", e.Name, e.Code)
+		} else {
+			fmt.Fprintf(bw, "#define %s %d\n", e.Name, e.Code)
+		}
+	}
+	if len(cat.Errors) > 0 {
+		fmt.Fprintf(bw, "#define ER_ERROR_FIRST %d\n", cat.Errors[0].Code)
+		fmt.Fprintf(bw, "#define ER_ERROR_LAST %d\n", cat.Errors[len(cat.Errors)-1].Code)
+	}
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "#endif")
+	return bw.Flush()
+}
+
+func joinInts(a []int) string {
+	s := make([]string, len(a))
+	for i, n := range a {
+		s[i] = strconv.Itoa(n)
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// compatStyle names the constants the way another error code package does,
+// so that code written against it keeps compiling after switching to the generated package.
+type compatStyle struct {
+	doc  string
+	name func(e *parser.Error) []string
+}
+
+var compatStyles = map[string]compatStyle{
+	"vividcortex": {
+		doc:  "named like github.com/VividCortex/mysqlerr and github.com/go-mysql-org/go-mysql: obsolete codes without the OBSOLETE_ prefix and replication errors in the MASTER/SLAVE terminology",
+		name: legacyNames,
+	},
+	"tidb": {
+		doc:  "named like github.com/pingcap/tidb/pkg/parser/mysql, such as ErrDupEntry for ER_DUP_ENTRY",
+		name: func(e *parser.Error) []string { return []string{camelName(e.Name)} },
+	},
+}
+
+type compatFlag []string
+
+func (f *compatFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *compatFlag) Set(v string) error {
+	for _, s := range strings.Split(v, ",") {
+		if _, ok := compatStyles[s]; !ok {
+			return fmt.Errorf("unknown style: %q", s)
+		}
+		*f = append(*f, s)
+	}
+	return nil
+}
+
+var legacyWords = map[string]string{"REPLICA": "SLAVE", "REPLICAS": "SLAVES", "SOURCE": "MASTER"}
+
+func legacyNames(e *parser.Error) []string {
+	name := strings.TrimPrefix(e.Name, "OBSOLETE_")
+	names := []string{name}
+	if subsystemOf(e) != "Replication" || strings.Contains(name, "DATA_SOURCE") {
+		return names
+	}
+	words := strings.Split(strings.Replace(name, "CHANGE_REPLICATION_SOURCE", "CHANGE_MASTER", 1), "_")
+	for i, w := range words {
+		if l, ok := legacyWords[w]; ok {
+			words[i] = l
+		}
+	}
+	return append(names, strings.Join(words, "_"))
+}
+
+// initialisms are kept upper case by camelName.
+var initialisms = map[string]bool{
+	"DB": true, "SQL": true, "GTID": true, "SSL": true, "FK": true, "UDF": true, "ID": true,
+	"XA": true, "JSON": true, "GIS": true, "SRS": true, "UUID": true, "TLS": true, "IO": true,
+}
+
+// camelName turns ER_BAD_DB_ERROR into ErrBadDB.
+func camelName(name string) string {
+	words := strings.Split(strings.TrimPrefix(strings.TrimPrefix(name, "OBSOLETE_"), "ER_"), "_")
+	if len(words) > 1 && words[len(words)-1] == "ERROR" {
+		words = words[:len(words)-1]
+	}
+	var b strings.Builder
+	b.WriteString("Err")
+	for _, w := range words {
+		if initialisms[w] || w == "" {
+			b.WriteString(w)
+			continue
+		}
+		b.WriteString(w[:1])
+		b.WriteString(strings.ToLower(w[1:]))
+	}
+	return b.String()
+}
+
+// writeCompat writes the aliases of style for the errors of cat.
+// Names that are constants already, or that an earlier error claimed, are skipped.
+func writeCompat(w io.Writer, style string, cat *parser.Catalog, cs *constants) {
+	taken := map[string]bool{}
+	for _, e := range cat.Errors {
+		taken[e.Name] = true
+	}
+	for name := range cs.byName {
+		taken[name] = true
+	}
+	type alias struct{ name, target string }
+	var aliases []alias
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		for _, name := range compatStyles[style].name(e) {
+			if taken[name] || !isIdentifier(name) {
+				continue
+			}
+			taken[name] = true
+			aliases = append(aliases, alias{name, e.Name})
+		}
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].name < aliases[j].name })
+	fmt.Fprintf(w, "// The constants of this file alias the error codes %s.\n", compatStyles[style].doc)
+	fmt.Fprintln(w, "const (")
+	for _, a := range aliases {
+		fmt.Fprintln(w, a.name, "=", a.target)
+	}
+	fmt.Fprintln(w, ")")
+}
+
+func isIdentifier(s string) bool {
+	for i, r := range s {
+		if !(r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || i > 0 && '0' <= r && r <= '9') {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// configEntry is a flag set by the config file.
+type configEntry struct {
+	line   int
+	key    string
+	values []string
+}
+
+// applyConfig sets the flags of fs from the config file, except those already given on the command line.
+// The file is the subset of YAML a flat mapping of flag names needs:
+//
+//	# mysqlerrgen.yaml
+//	pkg: mysqlerr84
+//	url: https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt
+//	lookup: sorted
+//	compat: [vividcortex, tidb]
+//	history:
+//	  - 8.0.39=https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.0.39/share/messages_to_clients.txt
+//
+// Lists set a repeatable flag once per item.
+func applyConfig(fs *flag.FlagSet, name string) error {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	entries, err := parseConfig(b)
+	if err != nil {
+		return fmt.Errorf("%s:%w", name, err)
+	}
+	given := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { given[f.Name] = true })
+	for _, e := range entries {
+		if e.key == "config" || fs.Lookup(e.key) == nil {
+			return fmt.Errorf("%s:%d: unknown option %q", name, e.line, e.key)
+		}
+		if given[e.key] {
+			continue
+		}
+		for _, v := range e.values {
+			if err := fs.Set(e.key, v); err != nil {
+				return fmt.Errorf("%s:%d: %s: %w", name, e.line, e.key, err)
+			}
+		}
+	}
+	return nil
+}
+
+func parseConfig(b []byte) ([]configEntry, error) {
+	var entries []configEntry
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimRight(stripComment(s.Text()), " \t")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if item := strings.TrimSpace(line); strings.HasPrefix(item, "- ") || item == "-" {
+			if line[0] != ' ' && line[0] != '\t' || len(entries) == 0 {
+				return nil, fmt.Errorf("%d: list item outside of a list", n)
+			}
+			v, err := configScalar(strings.TrimSpace(strings.TrimPrefix(item, "-")))
+			if err != nil {
+				return nil, fmt.Errorf("%d: %w", n, err)
+			}
+			last := &entries[len(entries)-1]
+			last.values = append(last.values, v)
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			return nil, fmt.Errorf("%d: nested mappings are not supported", n)
+		}
+		i := strings.Index(line, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("%d: want key: value", n)
+		}
+		e := configEntry{line: n, key: strings.TrimSpace(line[:i])}
+		value := strings.TrimSpace(line[i+1:])
+		switch {
+		case value == "":
+			// The items of a block list follow.
+		case strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]"):
+			for _, item := range strings.Split(value[1:len(value)-1], ",") {
+				if item = strings.TrimSpace(item); item == "" {
+					continue
+				}
+				v, err := configScalar(item)
+				if err != nil {
+					return nil, fmt.Errorf("%d: %w", n, err)
+				}
+				e.values = append(e.values, v)
+			}
+		default:
+			v, err := configScalar(value)
+			if err != nil {
+				return nil, fmt.Errorf("%d: %w", n, err)
+			}
+			e.values = []string{v}
+		}
+		entries = append(entries, e)
+	}
+	return entries, s.Err()
+}
+
+// stripComment removes a # comment, which starts a line or follows a space outside of quotes.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func configScalar(s string) (string, error) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		return strconv.Unquote(s)
+	case strings.HasPrefix(s, "'"):
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return "", fmt.Errorf("unterminated string: %s", s)
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	}
+	return s, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// writeTable writes one row per error and language.
+func writeTable(w io.Writer, cat *parser.Catalog, comma rune) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	cw.Write([]string{"name", "code", "sqlstate", "odbc_state", "obsolete", "lang", "message"})
+	for _, e := range cat.Errors {
+		row := []string{e.Name, strconv.Itoa(e.Code), e.SQLState, e.ODBCState, strconv.FormatBool(e.Obsolete)}
+		if len(e.Messages) == 0 {
+			cw.Write(append(row, "", ""))
+		}
+		for _, m := range e.Messages {
+			cw.Write(append(row[:len(row):len(row)], m.Lang, m.Text))
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeWideTable writes one row per error with a message column per language.
+func writeWideTable(w io.Writer, cat *parser.Catalog, comma rune) error {
+	var langs []string
+	seen := map[string]bool{}
+	for _, l := range cat.Languages {
+		if !seen[l.ShortName] {
+			seen[l.ShortName] = true
+			langs = append(langs, l.ShortName)
+		}
+	}
+	for _, e := range cat.Errors {
+		for _, m := range e.Messages {
+			if !seen[m.Lang] {
+				seen[m.Lang] = true
+				langs = append(langs, m.Lang)
+			}
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	cw.Write(append([]string{"name", "code", "sqlstate", "odbc_state", "obsolete"}, langs...))
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		row := []string{e.Name, strconv.Itoa(e.Code), e.SQLState, e.ODBCState, strconv.FormatBool(e.Obsolete)}
+		for _, lang := range langs {
+			row = append(row, e.Message(lang))
+		}
+		cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+type exportOptions struct {
+	pkg     string
+	version string
+	// dialect names the server flavor in the registry format.
+	dialect string
+	// advice is the curated advice file of the advice format.
+	advice string
+}
+
+var exporters = map[string]func(io.Writer, *parser.Catalog, *exportOptions) error{
+	"json": writeJSON,
+	"yaml": writeYAML,
+	"csv": func(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+		return writeTable(w, cat, ',')
+	},
+	"tsv": func(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+		return writeTable(w, cat, '\t')
+	},
+	"csv-wide": func(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+		return writeWideTable(w, cat, ',')
+	},
+	"tsv-wide": func(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+		return writeWideTable(w, cat, '\t')
+	},
+	"proto":      writeProto,
+	"sql":        writeSQL,
+	"markdown":   writeMarkdown,
+	"typescript": writeTypeScript,
+	"python":     writePython,
+	"rust":       writeRust,
+	"c":          writeCHeader,
+	"java":       writeJava,
+	"kotlin":     writeKotlin,
+	"registry":   writeRegistry,
+	"subsystem":  writeSubsystems,
+	"names":      writeNames,
+	"advice":     writeAdvice,
+	"messages":   writeMessages,
+}
+
+func writeJSON(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(cat)
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fetcher reads the sources, downloading the http(s) ones.
+type fetcher struct {
+	client *http.Client
+	// retries is how many times a failed download is retried, with an exponential backoff from backoff.
+	retries int
+	backoff time.Duration
+	header  http.Header
+	// snapshots is the directory of the snapshots read instead of downloading, if any.
+	snapshots string
+	// member is the message file extracted from source archives, one of archiveMembers if empty.
+	member string
+}
+
+// newFetcher returns a fetcher whose requests time out after timeout and go through the proxy of
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY. A token is sent as a bearer token unless header has an Authorization.
+func newFetcher(timeout time.Duration, retries int, header http.Header, token string) *fetcher {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if token != "" && header.Get("Authorization") == "" {
+		header = header.Clone()
+		header.Set("Authorization", "Bearer "+token)
+	}
+	return &fetcher{
+		client:  &http.Client{Timeout: timeout, Transport: transport},
+		retries: retries,
+		backoff: 500 * time.Millisecond,
+		header:  header,
+	}
+}
+
+// readSource reads the source from an http(s) url, a file, or stdin if url is empty.
+// The source may be a tar.gz, tar or zip archive of the MySQL or MariaDB sources, from which the message file is extracted.
+func (f *fetcher) readSource(url string) ([]byte, error) {
+	if url == "" {
+		return f.extract(io.ReadAll(os.Stdin))
+	}
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return f.readFile(url)
+	}
+	if f.snapshots != "" {
+		b, ok, err := readSnapshot(f.snapshots, url)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return f.extract(b, nil)
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		b, retryAfter, err := f.get(url)
+		if err == nil {
+			return f.extract(b, nil)
+		}
+		if retryAfter < 0 || attempt >= f.retries {
+			return nil, err
+		}
+		wait := f.backoff << attempt
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+		log.Printf("%v; retrying in %v", err, wait)
+		time.Sleep(wait)
+	}
+}
+
+// readFile reads a file, extracting archives without loading them into memory unless the file is a pipe.
+func (f *fetcher) readFile(name string) ([]byte, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	fi, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !fi.Mode().IsRegular() {
+		return f.extract(io.ReadAll(file))
+	}
+	head := make([]byte, 262)
+	n, _ := io.ReadFull(file, head)
+	if !isArchive(head[:n]) {
+		rest, err := io.ReadAll(file)
+		return append(head[:n], rest...), err
+	}
+	return extractSource(file, fi.Size(), f.member)
+}
+
+func (f *fetcher) extract(b []byte, err error) ([]byte, error) {
+	if err != nil || !isArchive(b) {
+		return b, err
+	}
+	return extractSource(bytes.NewReader(b), int64(len(b)), f.member)
+}
+
+// get downloads url once. On failure, retryAfter is negative if retrying cannot help,
+// and otherwise the delay the server asked for, if any.
+func (f *fetcher) get(url string) (b []byte, retryAfter time.Duration, err error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, -1, fmt.Errorf("get: %w", err)
+	}
+	for k, v := range f.header {
+		req.Header[k] = v
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get: %w", err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return nil, parseRetryAfter(resp.Header.Get("Retry-After")), fmt.Errorf("get %s: %s", url, resp.Status)
+	default:
+		return nil, -1, fmt.Errorf("get %s: %s", url, resp.Status)
+	}
+	b, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get %s: %w", url, err)
+	}
+	return b, 0, nil
+}
+
+// parseRetryAfter returns the delay of a Retry-After header in seconds or as a date, 0 if there is none.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if sec, err := strconv.Atoi(v); err == nil && sec > 0 {
+		return time.Duration(sec) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// headerFlag collects "Name: value" request headers.
+type headerFlag http.Header
+
+func (f headerFlag) String() string {
+	var s []string
+	for k := range f {
+		s = append(s, k)
+	}
+	return strings.Join(s, ",")
+}
+
+func (f headerFlag) Set(v string) error {
+	i := strings.Index(v, ":")
+	if i <= 0 {
+		return fmt.Errorf("want Name: value: %q", v)
+	}
+	http.Header(f).Add(strings.TrimSpace(v[:i]), strings.TrimSpace(v[i+1:]))
+	return nil
+}
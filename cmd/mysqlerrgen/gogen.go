@@ -0,0 +1,445 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	goparser "go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+type goGenerator struct {
+	pkg           string
+	dir           string
+	header        string
+	prov          *provenance
+	lookup        lookupWriter
+	nodataTag     string
+	test          bool
+	verify        bool
+	allowRenumber bool
+	// history holds older sources; when set, history.go with IntroducedIn/RemovedIn is generated.
+	history []historySource
+	// report is the path of the change report, written only when there was a previous generation.
+	report string
+	// alias is the package whose constants are aliased instead of defined.
+	alias *aliasTarget
+	// out is the path of the constants file, - for stdout; the other files are written to dir.
+	out string
+	// constType is the type of the constants, untyped if empty.
+	constType string
+	// languages are the languages of -languages; when set, the lookup also has MessageIn.
+	languages []string
+	// compat holds the naming styles of other packages to generate compat_<style>.go aliases for.
+	compat []string
+}
+
+// generate returns the files of the package without writing them.
+// cs holds the constants of the previous generation, which are kept as deprecated aliases.
+func (g *goGenerator) generate(cat *parser.Catalog, cs *constants) ([]*outputFile, error) {
+	if !g.allowRenumber {
+		if rs := diffConstants(cs, cat.Errors).Renumbered; len(rs) > 0 {
+			var msgs []string
+			for _, r := range rs {
+				msgs = append(msgs, fmt.Sprintf("%s %d -> %d", r.Name, r.From, r.To))
+			}
+			return nil, fmt.Errorf("constants would be renumbered (use -allow-renumber to proceed): %s", strings.Join(msgs, ", "))
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+	g.prov.write(&buf)
+	writeHeader(&buf, g.header)
+	fmt.Fprintln(&buf, "package", g.pkg)
+	value := func(e parser.Error) string { return strconv.Itoa(e.Code) }
+	if g.alias != nil {
+		fmt.Fprintf(&buf, "import %q\n", g.alias.importPath)
+		value = func(e parser.Error) string { return g.alias.name + "." + e.Name }
+	}
+	if g.prov.version != "" {
+		fmt.Fprintln(&buf, "// GeneratedFromVersion is the MySQL version the constants were generated from.")
+		fmt.Fprintf(&buf, "const GeneratedFromVersion = %q\n", g.prov.version)
+	}
+	typ := ""
+	if g.constType != "" {
+		if max, ok := constTypeMax[g.constType]; ok {
+			for _, e := range cat.Errors {
+				if int64(e.Code) > max {
+					return nil, fmt.Errorf("%s (%d) overflows -const-type %s", e.Name, e.Code, g.constType)
+				}
+			}
+		}
+		typ = " " + g.constType
+	}
+	for _, group := range constGroups(cat.Errors) {
+		first, last := group[0].Code, group[len(group)-1].Code
+		if first == last {
+			fmt.Fprintf(&buf, "// Code %d.\n", first)
+		} else {
+			fmt.Fprintf(&buf, "// Codes %d to %d.\n", first, last)
+		}
+		fmt.Fprintln(&buf, "const (")
+		for _, mysqlErr := range group {
+			for _, d := range cs.deprecates(mysqlErr.Name, mysqlErr.Code) {
+				fmt.Fprintln(&buf, "// Deprecated: should not be used")
+				fmt.Fprintf(&buf, "%s%s = %s\n", d.Name, typ, value(d))
+			}
+			fmt.Fprintf(&buf, "%s%s = %s\n", mysqlErr.Name, typ, value(mysqlErr))
+		}
+		fmt.Fprintln(&buf, ")")
+	}
+	constantsFile, err := newGoFile(g.out, buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	files := []*outputFile{constantsFile}
+
+	if g.lookup.write != nil {
+		buf.Reset()
+		fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+		writeBuildConstraint(&buf, g.nodataTag, false)
+		fmt.Fprintln(&buf, "package", g.pkg)
+		imports := g.lookup.imports
+		if len(g.languages) > 0 {
+			imports = translationImports(g.lookup)
+		}
+		if len(imports) > 0 {
+			fmt.Fprintln(&buf, "import (")
+			for _, spec := range imports {
+				if i := strings.Index(spec, " "); i >= 0 {
+					fmt.Fprintf(&buf, "%s %q\n", spec[:i], spec[i+1:])
+				} else {
+					fmt.Fprintf(&buf, "%q\n", spec)
+				}
+			}
+			fmt.Fprintln(&buf, ")")
+		}
+		if err := g.lookup.write(&buf, cat); err != nil {
+			return nil, fmt.Errorf("write lookup: %w", err)
+		}
+		if len(g.languages) > 0 {
+			writeTranslations(&buf, cat, g.languages, g.lookup.packed)
+		}
+		lookupFile, err := newGoFile(filepath.Join(g.dir, "lookup.go"), buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, lookupFile)
+		if g.verify {
+			if err := typeCheck(constantsFile, lookupFile); err != nil {
+				return nil, fmt.Errorf("verify: %w", err)
+			}
+		}
+
+		if g.nodataTag != "" {
+			buf.Reset()
+			fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+			writeBuildConstraint(&buf, g.nodataTag, true)
+			fmt.Fprintln(&buf, "package", g.pkg)
+			fmt.Fprintln(&buf, nodataLookup)
+			if len(g.languages) > 0 {
+				fmt.Fprintln(&buf, nodataTranslations)
+			}
+			nodataFile, err := newGoFile(filepath.Join(g.dir, "lookup_nodata.go"), buf.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			if g.verify {
+				if err := typeCheck(constantsFile, nodataFile); err != nil {
+					return nil, fmt.Errorf("verify with %s: %w", g.nodataTag, err)
+				}
+			}
+			files = append(files, nodataFile)
+		}
+	} else if g.verify {
+		if err := typeCheck(constantsFile); err != nil {
+			return nil, fmt.Errorf("verify: %w", err)
+		}
+	}
+	if g.lookup.data != nil {
+		name, b, err := g.lookup.data(cat)
+		if err != nil {
+			return nil, fmt.Errorf("lookup data: %w", err)
+		}
+		files = append(files, &outputFile{name: filepath.Join(g.dir, name), src: b})
+	}
+	if len(g.history) > 0 {
+		buf.Reset()
+		fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+		fmt.Fprintln(&buf, "package", g.pkg)
+		writeHistory(&buf, g.prov.version, cat, g.history)
+		historyFile, err := newGoFile(filepath.Join(g.dir, "history.go"), buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		if g.verify {
+			if err := typeCheck(constantsFile, historyFile); err != nil {
+				return nil, fmt.Errorf("verify: %w", err)
+			}
+		}
+		files = append(files, historyFile)
+	}
+	for _, style := range g.compat {
+		buf.Reset()
+		fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+		fmt.Fprintln(&buf, "package", g.pkg)
+		writeCompat(&buf, style, cat, cs)
+		compatFile, err := newGoFile(filepath.Join(g.dir, "compat_"+style+".go"), buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		if g.verify {
+			if err := typeCheck(constantsFile, compatFile); err != nil {
+				return nil, fmt.Errorf("verify compat %s: %w", style, err)
+			}
+		}
+		files = append(files, compatFile)
+	}
+	if g.test {
+		buf.Reset()
+		fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+		writeConstantsTest(&buf, g.pkg, cat, g.constType != "")
+		testFile, err := newGoFile(filepath.Join(g.dir, "constants_test.go"), buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, testFile)
+		if g.lookup.write != nil && len(g.languages) > 0 {
+			buf.Reset()
+			fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+			writeBuildConstraint(&buf, g.nodataTag, false)
+			writeMessageInTest(&buf, g.pkg, cat, g.languages)
+			lookupTestFile, err := newGoFile(filepath.Join(g.dir, "lookup_test.go"), buf.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, lookupTestFile)
+		}
+	}
+	if g.out == "-" && len(files) > 1 {
+		return nil, fmt.Errorf("cannot write %s to stdout with the constants", files[1].name)
+	}
+	if g.report != "" && len(cs.byName) > 0 {
+		b, err := diffConstants(cs, cat.Errors).marshal()
+		if err != nil {
+			return nil, fmt.Errorf("change report: %w", err)
+		}
+		files = append(files, &outputFile{name: g.report, src: b})
+	}
+	return files, nil
+}
+
+// aliasTarget is a generated package in the same module.
+type aliasTarget struct {
+	importPath string
+	name       string
+}
+
+func newAliasTarget(dir string) (*aliasTarget, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	// Find the enclosing module to turn the directory into an import path.
+	for root := abs; ; root = filepath.Dir(root) {
+		b, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			rel, err := filepath.Rel(root, abs)
+			if err != nil {
+				return nil, err
+			}
+			mod := modulePath(b)
+			if mod == "" {
+				return nil, fmt.Errorf("no module path in %s", filepath.Join(root, "go.mod"))
+			}
+			return &aliasTarget{importPath: path.Join(mod, filepath.ToSlash(rel)), name: filepath.Base(abs)}, nil
+		}
+		if filepath.Dir(root) == root {
+			return nil, fmt.Errorf("%s is not in a module", dir)
+		}
+	}
+}
+
+func modulePath(gomod []byte) string {
+	for _, line := range strings.Split(string(gomod), "\n") {
+		if f := strings.Fields(line); len(f) >= 2 && f[0] == "module" {
+			return strings.Trim(f[1], `"`)
+		}
+	}
+	return ""
+}
+
+type outputFile struct {
+	name string
+	src  []byte
+}
+
+func newGoFile(name string, src []byte) (*outputFile, error) {
+	out, err := format.Source(src)
+	if err != nil {
+		return nil, fmt.Errorf("format %s: %w", filepath.Base(name), err)
+	}
+	return &outputFile{name: name, src: out}, nil
+}
+
+func typeCheck(files ...*outputFile) error {
+	fset := token.NewFileSet()
+	var parsed []*ast.File
+	for _, f := range files {
+		file, err := goparser.ParseFile(fset, f.name, f.src, 0)
+		if err != nil {
+			return err
+		}
+		parsed = append(parsed, file)
+	}
+	conf := types.Config{Importer: importer.Default()}
+	_, err := conf.Check(parsed[0].Name.Name, fset, parsed, nil)
+	return err
+}
+
+func writeBuildConstraint(w io.Writer, tag string, set bool) {
+	if tag == "" {
+		return
+	}
+	if !set {
+		tag = "!" + tag
+	}
+	fmt.Fprintln(w, "//go:build", tag)
+	fmt.Fprintln(w, "// +build", tag)
+	fmt.Fprintln(w)
+}
+
+func writeHeader(w io.Writer, header string) {
+	header = strings.TrimRight(header, "\r\n")
+	if header == "" {
+		return
+	}
+	for _, line := range strings.Split(header, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line == "" {
+			fmt.Fprintln(w, "//")
+		} else {
+			fmt.Fprintln(w, "//", line)
+		}
+	}
+}
+
+const license = `Copyright 2021-2023 Nao Yonashiro 
+
+Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated documentation files (the “Software”), to deal in the Software without restriction, including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.`
+
+type constants struct {
+	byName map[string]int
+	byCode map[int][]string
+}
+
+func (c *constants) add(name string, code int) {
+	if c.byName == nil {
+		c.byName = map[string]int{}
+	}
+	c.byName[name] = code
+	if c.byCode == nil {
+		c.byCode = map[int][]string{}
+	}
+	c.byCode[code] = append(c.byCode[code], name)
+}
+
+func (c *constants) deprecates(name string, code int) []parser.Error {
+	var ds []parser.Error
+	names := c.byCode[code]
+	for _, n := range names {
+		if n == name {
+			continue
+		}
+		ds = append(ds, parser.Error{Name: n, Code: code})
+	}
+	sort.Slice(ds, func(i, j int) bool {
+		return ds[i].Name < ds[j].Name
+	})
+	return ds
+}
+
+// constGroups splits the errors into the const blocks of constants.go: the sections of a message file,
+// each started by a start-error-number directive, or the thousands of the codes for the other sources.
+func constGroups(errs []parser.Error) [][]parser.Error {
+	sectioned := false
+	for _, e := range errs {
+		if e.Section > 0 {
+			sectioned = true
+			break
+		}
+	}
+	key := func(e parser.Error) int {
+		if sectioned {
+			return e.Section
+		}
+		return e.Code / 1000
+	}
+	var groups [][]parser.Error
+	for i, e := range errs {
+		if i == 0 || key(e) != key(errs[i-1]) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], e)
+	}
+	return groups
+}
+
+// constTypeMax is the largest code the integer types of -const-type hold.
+var constTypeMax = map[string]int64{
+	"int8":   1<<7 - 1,
+	"int16":  1<<15 - 1,
+	"int32":  1<<31 - 1,
+	"uint8":  1<<8 - 1,
+	"uint16": 1<<16 - 1,
+	"uint32": 1<<32 - 1,
+}
+
+func parseConstantsGo(name string) (*constants, error) {
+	f, err := goparser.ParseFile(token.NewFileSet(), name, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var c constants
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, n := range vs.Names {
+				if i >= len(vs.Values) {
+					break
+				}
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.INT {
+					continue
+				}
+				val, err := strconv.Atoi(lit.Value)
+				if err != nil {
+					continue
+				}
+				c.add(n.Name, val)
+			}
+		}
+	}
+	return &c, nil
+}
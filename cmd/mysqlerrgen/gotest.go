@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// wellKnownCodes are codes applications commonly depend on.
+// They have never changed and regenerating must not change them.
+var wellKnownCodes = []struct {
+	name string
+	code int
+}{
+	{"ER_CON_COUNT_ERROR", 1040},
+	{"ER_ACCESS_DENIED_ERROR", 1045},
+	{"ER_BAD_DB_ERROR", 1049},
+	{"ER_TABLE_EXISTS_ERROR", 1050},
+	{"ER_BAD_FIELD_ERROR", 1054},
+	{"ER_DUP_KEYNAME", 1061},
+	{"ER_DUP_ENTRY", 1062},
+	{"ER_PARSE_ERROR", 1064},
+	{"ER_NO_SUCH_TABLE", 1146},
+	{"ER_LOCK_WAIT_TIMEOUT", 1205},
+	{"ER_LOCK_DEADLOCK", 1213},
+	{"ER_DATA_TOO_LONG", 1406},
+	{"ER_ROW_IS_REFERENCED_2", 1451},
+	{"ER_NO_REFERENCED_ROW_2", 1452},
+}
+
+// writeConstantsTest writes constants_test.go; typed converts the constants, which have a -const-type, to int.
+func writeConstantsTest(w io.Writer, pkg string, cat *parser.Catalog, typed bool) {
+	fmt.Fprintln(w, "package", pkg)
+	fmt.Fprintln(w, `import "testing"`)
+
+	names := map[string]bool{}
+	for _, e := range cat.Errors {
+		names[e.Name] = true
+	}
+	fmt.Fprintln(w, "func TestWellKnownCodes(t *testing.T) {")
+	fmt.Fprintln(w, "for _, tt := range []struct{ name string; got, want int }{")
+	for _, c := range wellKnownCodes {
+		if names[c.name] {
+			fmt.Fprintf(w, "{%q, %s, %d},\n", c.name, intConst(c.name, typed), c.code)
+		}
+	}
+	io.WriteString(w, `} {
+		if tt.got != tt.want {
+			t.Errorf("%s = %d, want %d", tt.name, tt.got, tt.want)
+		}
+	}
+}
+`)
+
+	// The codes increase within the sections of a message file, with gaps where -include, -exclude
+	// or -deny-prefix left errors out. Headers and TiDB sources have no sections and no order.
+	fmt.Fprintln(w, "var generatedErrors = []struct{ name string; code, section int }{")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(w, "{%q, %s, %d},\n", e.Name, intConst(e.Name, typed), e.Section)
+	}
+	fmt.Fprintln(w, "}")
+	io.WriteString(w, `func TestNoDuplicates(t *testing.T) {
+	names := map[string]bool{}
+	codes := map[int]string{}
+	for _, e := range generatedErrors {
+		if names[e.name] {
+			t.Errorf("duplicate name: %s", e.name)
+		}
+		names[e.name] = true
+		if name, ok := codes[e.code]; ok {
+			t.Errorf("duplicate code %d: %s and %s", e.code, name, e.name)
+		}
+		codes[e.code] = e.name
+	}
+}
+func TestCodesMonotonic(t *testing.T) {
+	for i := 1; i < len(generatedErrors); i++ {
+		prev, cur := generatedErrors[i-1], generatedErrors[i]
+		if cur.section > 0 && cur.section == prev.section && cur.code <= prev.code {
+			t.Errorf("%s = %d, want more than %d (after %s)", cur.name, cur.code, prev.code, prev.name)
+		}
+	}
+}
+`)
+}
+
+// writeMessageInTest writes lookup_test.go, checking that MessageIn finds the messages of every language,
+// the default one through Message and the others through the translation tables, on a sample of the errors:
+// the first ones with a message in the language and the first one without.
+func writeMessageInTest(w io.Writer, pkg string, cat *parser.Catalog, langs []string) {
+	fmt.Fprintln(w, "package", pkg)
+	fmt.Fprintln(w, `import "testing"`)
+	fmt.Fprintln(w, "func TestMessageIn(t *testing.T) {")
+	fmt.Fprintln(w, "for _, tt := range []struct{ code int; lang, want string; ok bool }{")
+	for _, l := range langs {
+		with, without := 0, 0
+		for i := range cat.Errors {
+			e := &cat.Errors[i]
+			msg := e.Message(l)
+			if msg != "" && with < 3 {
+				with++
+			} else if msg == "" && without < 1 {
+				without++
+			} else {
+				continue
+			}
+			fmt.Fprintf(w, "{%d, %q, %q, %t}, // %s\n", e.Code, l, msg, msg != "", e.Name)
+		}
+	}
+	io.WriteString(w, `} {
+		got, ok := MessageIn(tt.code, tt.lang)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("MessageIn(%d, %q) = %q, %t, want %q, %t", tt.code, tt.lang, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+`)
+}
+
+func intConst(name string, typed bool) string {
+	if typed {
+		return "int(" + name + ")"
+	}
+	return name
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+type historySource struct {
+	version string
+	cat     *parser.Catalog
+}
+
+type historyFlag []struct{ version, url string }
+
+func (f *historyFlag) String() string {
+	var s []string
+	for _, h := range *f {
+		s = append(s, h.version+"="+h.url)
+	}
+	return strings.Join(s, ",")
+}
+
+func (f *historyFlag) Set(v string) error {
+	i := strings.Index(v, "=")
+	if i <= 0 {
+		return fmt.Errorf("want version=url: %q", v)
+	}
+	*f = append(*f, struct{ version, url string }{v[:i], v[i+1:]})
+	return nil
+}
+
+func (f historyFlag) urls() []string {
+	var us []string
+	for _, h := range f {
+		us = append(us, h.url)
+	}
+	return us
+}
+
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// writeHistory writes the versions in which each code was introduced and removed.
+// An obsolete error counts as removed, since the server no longer raises it.
+func writeHistory(w io.Writer, version string, cat *parser.Catalog, history []historySource) {
+	sources := append([]historySource{}, history...)
+	if version != "" {
+		sources = append(sources, historySource{version: version, cat: cat})
+	}
+	sort.SliceStable(sources, func(i, j int) bool {
+		return compareVersions(sources[i].version, sources[j].version) < 0
+	})
+
+	type versionRange struct{ introduced, removed string }
+	ranges := map[int]*versionRange{}
+	var codes []int
+	for i, src := range sources {
+		live := map[int]bool{}
+		for _, e := range src.cat.Errors {
+			if !e.Obsolete {
+				live[e.Code] = true
+			}
+		}
+		for code := range live {
+			r, ok := ranges[code]
+			if !ok {
+				r = &versionRange{introduced: src.version}
+				ranges[code] = r
+				codes = append(codes, code)
+			}
+			r.removed = ""
+		}
+		if i == 0 {
+			continue
+		}
+		for code, r := range ranges {
+			if !live[code] && r.removed == "" {
+				r.removed = src.version
+			}
+		}
+	}
+	sort.Ints(codes)
+
+	fmt.Fprintf(w, "var historyVersions = %#v\n", versionsOf(sources))
+	fmt.Fprintln(w, "var errorHistory = map[int]struct{ introduced, removed string }{")
+	for _, code := range codes {
+		r := ranges[code]
+		fmt.Fprintf(w, "%d: {%q, %q},\n", code, r.introduced, r.removed)
+	}
+	fmt.Fprintln(w, "}")
+	io.WriteString(w, historyFuncs)
+}
+
+func versionsOf(sources []historySource) []string {
+	vs := make([]string, len(sources))
+	for i, src := range sources {
+		vs[i] = src.version
+	}
+	return vs
+}
+
+const historyFuncs = `// IntroducedIn returns the first known version that raises the error code.
+// Codes that exist in the oldest known version report that version.
+func IntroducedIn(code int) (string, bool) {
+	h, ok := errorHistory[code]
+	return h.introduced, ok
+}
+// RemovedIn returns the first known version that no longer raises the error code.
+func RemovedIn(code int) (string, bool) {
+	h, ok := errorHistory[code]
+	return h.removed, ok && h.removed != ""
+}
+// ExistsIn reports whether a server of the version can raise the error code.
+// Versions between the known versions are assumed to behave like the preceding known version.
+func ExistsIn(code int, version string) bool {
+	h, ok := errorHistory[code]
+	if !ok {
+		return false
+	}
+	if compareVersions(version, h.introduced) < 0 && h.introduced != historyVersions[0] {
+		return false
+	}
+	return h.removed == "" || compareVersions(version, h.removed) < 0
+}
+func compareVersions(a, b string) int {
+	for a != "" || b != "" {
+		var x, y int
+		x, a = leadingNumber(a)
+		y, b = leadingNumber(b)
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+func leadingNumber(s string) (int, string) {
+	n := 0
+	i := 0
+	for ; i < len(s) && '0' <= s[i] && s[i] <= '9'; i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+	} else if i < len(s) {
+		i = len(s)
+	}
+	return n, s[i:]
+}
+`
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+const jvmClassName = "MySQLErrorCodes"
+
+func writeJava(w io.Writer, cat *parser.Catalog, opts *exportOptions) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "// Code generated mysqlerrgen DO NOT EDIT.")
+	if opts.pkg != "" {
+		fmt.Fprintf(bw, "package %s;\n", opts.pkg)
+	}
+	fmt.Fprintln(bw)
+	fmt.Fprintf(bw, "public final class %s {\n", jvmClassName)
+	fmt.Fprintf(bw, "    private %s() {}\n", jvmClassName)
+	fmt.Fprintln(bw)
+	for _, e := range cat.Errors {
+		if e.Obsolete {
+			fmt.Fprintln(bw, "    @Deprecated")
+		}
+		fmt.Fprintf(bw, "    public static final int %s = %d;\n", e.Name, e.Code)
+	}
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "    public static String name(int code) {")
+	fmt.Fprintln(bw, "        switch (code) {")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(bw, "            case %d: return %s;\n", e.Code, jvmString(e.Name, false))
+	}
+	fmt.Fprintln(bw, "            default: return null;")
+	fmt.Fprintln(bw, "        }")
+	fmt.Fprintln(bw, "    }")
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "    public static String message(int code) {")
+	fmt.Fprintln(bw, "        switch (code) {")
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		if msg := e.Message(cat.DefaultLanguage); msg != "" {
+			fmt.Fprintf(bw, "            case %d: return %s;\n", e.Code, jvmString(msg, false))
+		}
+	}
+	fmt.Fprintln(bw, "            default: return null;")
+	fmt.Fprintln(bw, "        }")
+	fmt.Fprintln(bw, "    }")
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+func writeKotlin(w io.Writer, cat *parser.Catalog, opts *exportOptions) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "// Code generated mysqlerrgen DO NOT EDIT.")
+	if opts.pkg != "" {
+		fmt.Fprintf(bw, "package %s\n", opts.pkg)
+	}
+	fmt.Fprintln(bw)
+	fmt.Fprintf(bw, "object %s {\n", jvmClassName)
+	for _, e := range cat.Errors {
+		if e.Obsolete {
+			fmt.Fprintln(bw, `    @Deprecated("obsolete")`)
+		}
+		fmt.Fprintf(bw, "    const val %s = %d\n", e.Name, e.Code)
+	}
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "    fun name(code: Int): String? = when (code) {")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(bw, "        %d -> %s\n", e.Code, jvmString(e.Name, true))
+	}
+	fmt.Fprintln(bw, "        else -> null")
+	fmt.Fprintln(bw, "    }")
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "    fun message(code: Int): String? = when (code) {")
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		if msg := e.Message(cat.DefaultLanguage); msg != "" {
+			fmt.Fprintf(bw, "        %d -> %s\n", e.Code, jvmString(msg, true))
+		}
+	}
+	fmt.Fprintln(bw, "        else -> null")
+	fmt.Fprintln(bw, "    }")
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// jvmString returns s as a Java string literal, or a Kotlin one when kotlin is set.
+// Kotlin has no \f escape and treats $ as the start of a string template.
+func jvmString(s string, kotlin bool) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"':
+			b.WriteString(`\"`)
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '$' && kotlin:
+			b.WriteString(`\$`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
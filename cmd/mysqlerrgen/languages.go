@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// selectLanguages drops the messages and languages of cat not in langs.
+// The default language of the source stays the default if it is selected, and the first of langs becomes it otherwise.
+func selectLanguages(cat *parser.Catalog, langs []string) error {
+	want := map[string]bool{}
+	for _, l := range langs {
+		want[l] = true
+	}
+	if len(cat.Languages) > 0 {
+		declared := map[string]bool{}
+		for _, l := range cat.Languages {
+			declared[l.ShortName] = true
+		}
+		for _, l := range langs {
+			if !declared[l] {
+				return fmt.Errorf("language %q is not declared by the source", l)
+			}
+		}
+		ls := cat.Languages[:0]
+		for _, l := range cat.Languages {
+			if want[l.ShortName] {
+				ls = append(ls, l)
+			}
+		}
+		cat.Languages = ls
+	}
+	if !want[cat.DefaultLanguage] {
+		cat.DefaultLanguage = langs[0]
+	}
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		msgs := e.Messages[:0]
+		for _, m := range e.Messages {
+			if want[m.Lang] {
+				msgs = append(msgs, m)
+			}
+		}
+		e.Messages = msgs
+	}
+	return nil
+}
+
+// writeTranslations writes MessageIn, looking up the messages of the languages other than the default one
+// in maps built on the first call, or in packed strings indexed like the codes of the packed lookup.
+// The maps need sync, which translationImports adds.
+func writeTranslations(w io.Writer, cat *parser.Catalog, langs []string, packed bool) {
+	var others []string
+	for _, l := range langs {
+		if l != cat.DefaultLanguage {
+			others = append(others, l)
+		}
+	}
+	sort.Strings(others)
+	if packed {
+		for i, l := range others {
+			msgs := make([]string, len(cat.Errors))
+			for j := range cat.Errors {
+				msgs[j] = cat.Errors[j].Message(l)
+			}
+			writePackedStrings(w, fmt.Sprintf("translation%d", i), msgs)
+		}
+		fmt.Fprintln(w, "var translatedMessages = map[string]struct {\ndata string\noffsets []uint32\n}{")
+		for i, l := range others {
+			fmt.Fprintf(w, "%q: {translation%dData, translation%dOffsets[:]},\n", l, i, i)
+		}
+		fmt.Fprintln(w, "}")
+	} else {
+		fmt.Fprintln(w, "var translations = [...]struct {\nlang string\ncodes []int32\nmessages []string\n}{")
+		for _, l := range others {
+			var codes, msgs []string
+			for i := range cat.Errors {
+				e := &cat.Errors[i]
+				if msg := e.Message(l); msg != "" {
+					codes = append(codes, strconv.Itoa(e.Code))
+					msgs = append(msgs, strconv.Quote(msg))
+				}
+			}
+			if len(codes) == 0 {
+				fmt.Fprintf(w, "{%q, nil, nil},\n", l)
+				continue
+			}
+			fmt.Fprintf(w, "{%q, []int32{%s}, []string{\n%s,\n}},\n", l, strings.Join(codes, ", "), strings.Join(msgs, ",\n"))
+		}
+		fmt.Fprintln(w, "}")
+		fmt.Fprintln(w, `var (
+	translatedMessagesOnce sync.Once
+	translatedMessages     map[string]map[int]string
+)
+func loadTranslatedMessages() {
+	translatedMessages = make(map[string]map[int]string, len(translations))
+	for _, t := range translations {
+		m := make(map[int]string, len(t.codes))
+		for i, code := range t.codes {
+			m[int(code)] = t.messages[i]
+		}
+		translatedMessages[t.lang] = m
+	}
+}`)
+	}
+	example := cat.DefaultLanguage
+	if len(others) > 0 {
+		example = others[0]
+	}
+	fmt.Fprintf(w, `// MessageIn returns the message template of the error code in the language, such as %q.
+// It reports false if the message has no translation in the language.
+func MessageIn(code int, lang string) (string, bool) {
+	if lang == %q {
+		return Message(code)
+	}
+`, example, cat.DefaultLanguage)
+	if packed {
+		fmt.Fprintln(w, `	t, ok := translatedMessages[lang]
+	i := searchErrorCode(code)
+	if !ok || i < 0 || t.offsets[i] == t.offsets[i+1] {
+		return "", false
+	}
+	return t.data[t.offsets[i]:t.offsets[i+1]], true
+}`)
+	} else {
+		fmt.Fprintln(w, `	translatedMessagesOnce.Do(loadTranslatedMessages)
+	s, ok := translatedMessages[lang][code]
+	return s, ok
+}`)
+	}
+}
+
+// translationImports returns the imports of the lookup file when it has the translations.
+func translationImports(lw lookupWriter) []string {
+	if lw.packed {
+		return lw.imports
+	}
+	for _, spec := range lw.imports {
+		if spec == "sync" {
+			return lw.imports
+		}
+	}
+	return append(append([]string(nil), lw.imports...), "sync")
+}
+
+const nodataTranslations = `// MessageIn returns the message template of the error code in the language.
+// The lookup tables are excluded from this build, so it always reports false.
+func MessageIn(code int, lang string) (string, bool) {
+	return "", false
+}`
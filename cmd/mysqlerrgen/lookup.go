@@ -0,0 +1,374 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"strconv"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+type lookupWriter struct {
+	// imports are import paths, optionally preceded by a name and a space.
+	imports []string
+	write   func(io.Writer, *parser.Catalog) error
+	// data returns the name and content of a data file written next to constants.go.
+	data func(*parser.Catalog) (string, []byte, error)
+	// packed makes MessageIn keep the translations as packed strings too.
+	packed bool
+}
+
+var lookupWriters = map[string]lookupWriter{
+	"map":        {imports: []string{"sync"}, write: writeMapLookup},
+	"sorted":     {write: writeSortedLookup},
+	"switch":     {write: writeSwitchLookup},
+	"compressed": {imports: []string{"compress/gzip", "encoding/binary", "io", "strings", "sync"}, write: writeCompressedLookup},
+	"embed":      {imports: []string{"_ embed", "encoding/json", "sync"}, write: writeEmbedLookup, data: embedData},
+	"packed":     {write: writePackedLookup, packed: true},
+}
+
+const nodataLookup = `// Name returns the name of the error code.
+// The lookup tables are excluded from this build, so it always reports false.
+func Name(code int) (string, bool) {
+	return "", false
+}
+// Message returns the message template of the error code.
+// The lookup tables are excluded from this build, so it always reports false.
+func Message(code int) (string, bool) {
+	return "", false
+}`
+
+// writeMapLookup writes the tables as arrays, which need no initialization, and builds maps of them
+// with sync.Once on the first lookup, so that importing the package costs nothing at program start.
+func writeMapLookup(w io.Writer, cat *parser.Catalog) error {
+	fmt.Fprintln(w, "var errorCodes = [...]int32{")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(w, "%d,\n", e.Code)
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, "var errorNames = [...]string{")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(w, "%q,\n", e.Name)
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, "var errorMessages = [...]string{")
+	for i := range cat.Errors {
+		fmt.Fprintf(w, "%s,\n", strconv.Quote(cat.Errors[i].Message(cat.DefaultLanguage)))
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, `var (
+	errorMapsOnce   sync.Once
+	errorNameMap    map[int]string
+	errorMessageMap map[int]string
+)
+func loadErrorMaps() {
+	errorNameMap = make(map[int]string, len(errorCodes))
+	errorMessageMap = make(map[int]string, len(errorCodes))
+	for i, code := range errorCodes {
+		errorNameMap[int(code)] = errorNames[i]
+		if errorMessages[i] != "" {
+			errorMessageMap[int(code)] = errorMessages[i]
+		}
+	}
+}
+// Name returns the name of the error code.
+func Name(code int) (string, bool) {
+	errorMapsOnce.Do(loadErrorMaps)
+	s, ok := errorNameMap[code]
+	return s, ok
+}
+// Message returns the message template of the error code.
+func Message(code int) (string, bool) {
+	errorMapsOnce.Do(loadErrorMaps)
+	s, ok := errorMessageMap[code]
+	return s, ok
+}`)
+	return nil
+}
+
+// writeSortedLookup writes the tables as arrays sorted by code, searched with binary search.
+// Unlike map literals, they need no initialization at program start.
+func writeSortedLookup(w io.Writer, cat *parser.Catalog) error {
+	writeSortedNames(w, cat, "Name")
+	fmt.Fprintln(w, "var errorMessages = [...]string{")
+	for i := range cat.Errors {
+		fmt.Fprintf(w, "%s,\n", strconv.Quote(cat.Errors[i].Message(cat.DefaultLanguage)))
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, `// Message returns the message template of the error code.
+func Message(code int) (string, bool) {
+	if i := searchErrorCode(code); i >= 0 && errorMessages[i] != "" {
+		return errorMessages[i], true
+	}
+	return "", false
+}`)
+	return nil
+}
+
+const searchErrorCodeFunc = `func searchErrorCode(code int) int {
+	lo, hi := 0, len(errorCodes)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if int(errorCodes[m]) < code {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(errorCodes) && int(errorCodes[lo]) == code {
+		return lo
+	}
+	return -1
+}`
+
+// writeSortedNames writes the sorted tables of the codes and names and the lookup function named fn.
+func writeSortedNames(w io.Writer, cat *parser.Catalog, fn string) {
+	fmt.Fprintln(w, "var errorCodes = [...]int32{")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(w, "%d,\n", e.Code)
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, "var errorNames = [...]string{")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(w, "%q,\n", e.Name)
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, searchErrorCodeFunc)
+	fmt.Fprintf(w, "// %s returns the name of the error code.\n", fn)
+	fmt.Fprintf(w, "func %s(code int) (string, bool) {\n", fn)
+	fmt.Fprintln(w, `if i := searchErrorCode(code); i >= 0 {
+		return errorNames[i], true
+	}
+	return "", false
+}`)
+}
+
+// writeCompressedLookup writes the names like writeSortedLookup, but the messages as a gzip blob
+// that is decoded on the first call to Message.
+func writeCompressedLookup(w io.Writer, cat *parser.Catalog) error {
+	var blob bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&blob, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	for i := range cat.Errors {
+		msg := cat.Errors[i].Message(cat.DefaultLanguage)
+		var n [binary.MaxVarintLen64]byte
+		zw.Write(n[:binary.PutUvarint(n[:], uint64(len(msg)))])
+		io.WriteString(zw, msg)
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	writeSortedNames(w, cat, "Name")
+	fmt.Fprintf(w, "const errorMessagesGzip = %q\n", blob.String())
+	fmt.Fprintln(w, `var (
+	errorMessagesOnce sync.Once
+	errorMessages     []string
+)
+func loadErrorMessages() {
+	r, err := gzip.NewReader(strings.NewReader(errorMessagesGzip))
+	if err != nil {
+		panic(err)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		panic(err)
+	}
+	s := string(b)
+	errorMessages = make([]string, len(errorCodes))
+	for i := range errorMessages {
+		n, k := binary.Uvarint(b)
+		errorMessages[i] = s[k : k+int(n)]
+		b, s = b[k+int(n):], s[k+int(n):]
+	}
+}
+// Message returns the message template of the error code.
+// The messages are decompressed on the first call.
+func Message(code int) (string, bool) {
+	i := searchErrorCode(code)
+	if i < 0 {
+		return "", false
+	}
+	errorMessagesOnce.Do(loadErrorMessages)
+	if errorMessages[i] == "" {
+		return "", false
+	}
+	return errorMessages[i], true
+}`)
+	return nil
+}
+
+// writePackedLookup writes the names and the messages each as one string constant indexed by offsets,
+// in the order of the sorted codes. Unlike arrays of thousands of strings, they need no relocations
+// and add a single string to the binary.
+func writePackedLookup(w io.Writer, cat *parser.Catalog) error {
+	fmt.Fprintln(w, "var errorCodes = [...]int32{")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(w, "%d,\n", e.Code)
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, searchErrorCodeFunc)
+	names := make([]string, len(cat.Errors))
+	msgs := make([]string, len(cat.Errors))
+	for i := range cat.Errors {
+		names[i] = cat.Errors[i].Name
+		msgs[i] = cat.Errors[i].Message(cat.DefaultLanguage)
+	}
+	writePackedStrings(w, "errorNames", names)
+	writePackedStrings(w, "errorMessages", msgs)
+	fmt.Fprintln(w, `// Name returns the name of the error code.
+func Name(code int) (string, bool) {
+	if i := searchErrorCode(code); i >= 0 {
+		return errorNamesData[errorNamesOffsets[i]:errorNamesOffsets[i+1]], true
+	}
+	return "", false
+}
+// Message returns the message template of the error code.
+func Message(code int) (string, bool) {
+	if i := searchErrorCode(code); i >= 0 && errorMessagesOffsets[i] != errorMessagesOffsets[i+1] {
+		return errorMessagesData[errorMessagesOffsets[i]:errorMessagesOffsets[i+1]], true
+	}
+	return "", false
+}`)
+	return nil
+}
+
+// writePackedStrings writes ss as the constant <name>Data and the offsets <name>Offsets,
+// ss[i] being <name>Data[<name>Offsets[i]:<name>Offsets[i+1]].
+func writePackedStrings(w io.Writer, name string, ss []string) {
+	var data bytes.Buffer
+	offsets := make([]int, 0, len(ss)+1)
+	for _, s := range ss {
+		offsets = append(offsets, data.Len())
+		data.WriteString(s)
+	}
+	offsets = append(offsets, data.Len())
+	fmt.Fprintf(w, "const %sData = %s\n", name, strconv.Quote(data.String()))
+	fmt.Fprintf(w, "var %sOffsets = [...]uint32{", name)
+	for i, o := range offsets {
+		if i%16 == 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%d, ", o)
+	}
+	fmt.Fprintln(w, "\n}")
+}
+
+// writeSwitchLookup writes the lookups as switch statements, which the compiler turns into jump tables.
+// They need neither tables nor initialization.
+func writeSwitchLookup(w io.Writer, cat *parser.Catalog) error {
+	fmt.Fprintln(w, "// Name returns the name of the error code.")
+	fmt.Fprintln(w, "func Name(code int) (string, bool) {")
+	fmt.Fprintln(w, "switch code {")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(w, "case %d:\nreturn %q, true\n", e.Code, e.Name)
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, `return "", false`)
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, "// Message returns the message template of the error code.")
+	fmt.Fprintln(w, "func Message(code int) (string, bool) {")
+	fmt.Fprintln(w, "switch code {")
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		if msg := e.Message(cat.DefaultLanguage); msg != "" {
+			fmt.Fprintf(w, "case %d:\nreturn %s, true\n", e.Code, strconv.Quote(msg))
+		}
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w, `return "", false`)
+	fmt.Fprintln(w, "}")
+	return nil
+}
+
+const embedDataFile = "errors.json"
+
+type embedEntry struct {
+	Code    int    `json:"code"`
+	Name    string `json:"name"`
+	Message string `json:"message,omitempty"`
+}
+
+func embedData(cat *parser.Catalog) (string, []byte, error) {
+	entries := make([]embedEntry, len(cat.Errors))
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		entries[i] = embedEntry{Code: e.Code, Name: e.Name, Message: e.Message(cat.DefaultLanguage)}
+	}
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return "", nil, err
+	}
+	return embedDataFile, append(b, '\n'), nil
+}
+
+// writeEmbedLookup writes a loader for the data file produced by embedData.
+// The loader does not depend on the data, so regenerating only changes the data file and the constants.
+func writeEmbedLookup(w io.Writer, _ *parser.Catalog) error {
+	fmt.Fprintf(w, "//go:embed %s\n", embedDataFile)
+	fmt.Fprintln(w, `var errorsJSON []byte
+var (
+	errorsOnce    sync.Once
+	errorNames    map[int]string
+	errorMessages map[int]string
+)
+func loadErrors() {
+	var entries []struct {
+		Code    int    `+"`json:\"code\"`"+`
+		Name    string `+"`json:\"name\"`"+`
+		Message string `+"`json:\"message\"`"+`
+	}
+	if err := json.Unmarshal(errorsJSON, &entries); err != nil {
+		panic(err)
+	}
+	errorNames = make(map[int]string, len(entries))
+	errorMessages = make(map[int]string, len(entries))
+	for _, e := range entries {
+		errorNames[e.Code] = e.Name
+		if e.Message != "" {
+			errorMessages[e.Code] = e.Message
+		}
+	}
+}
+// Name returns the name of the error code.
+func Name(code int) (string, bool) {
+	errorsOnce.Do(loadErrors)
+	s, ok := errorNames[code]
+	return s, ok
+}
+// Message returns the message template of the error code.
+func Message(code int) (string, bool) {
+	errorsOnce.Do(loadErrors)
+	s, ok := errorMessages[code]
+	return s, ok
+}`)
+	return nil
+}
+
+// writeNames writes a Go file with only the name lookup, for packages whose messages come from elsewhere.
+// The lookup is the unexported catalogName so that the package can put its own names in front of it.
+func writeNames(w io.Writer, cat *parser.Catalog, opts *exportOptions) error {
+	pkg := opts.pkg
+	if pkg == "" {
+		pkg = "mysqlerr"
+	}
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package", pkg)
+	fmt.Fprintln(&buf)
+	writeSortedNames(&buf, cat, "catalogName")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
@@ -1,7 +1,8 @@
 package main
 
 import (
-	"bufio"
+	"bytes"
+	"crypto/sha256"
 	"flag"
 	"fmt"
 	"io"
@@ -9,31 +10,21 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
-)
-
-type language struct {
-	longName  string
-	shortName string
-	charset   string
-}
-
-type message struct {
-	langShortName string
-	text          string
-}
+	"time"
 
-type mysqlError struct {
-	name      string
-	code      int
-	sqlState  string
-	odbcState string
-	messages  []message
-	obsolete  bool
-}
+	"github.com/orisano/mysqlerr/parser"
+)
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "update" {
+		if err := runUpdate(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	if err := run(); err != nil {
 		log.Fatal(err)
 	}
@@ -41,256 +32,562 @@ func main() {
 
 func run() error {
 	pkg := flag.String("pkg", "", "package name")
-	url := flag.String("url", "", "source url")
+	dir := flag.String("dir", "", "directory of the generated Go package (default: the package name)")
+	alias := flag.String("alias", "", "generate constants aliasing the already generated package in `dir` instead of defining them")
+	url := flag.String("url", "", "source url or file, or a tar.gz or zip archive of the server sources (default: stdin)")
+	archiveMember := flag.String("archive-member", "", "message file extracted from the share directory of a source archive, such as messages_to_error_log.txt (default: messages_to_clients.txt, or errmsg-utf8.txt)")
+	include := flag.String("include", "", "only generate the errors whose name matches the regexp")
+	exclude := flag.String("exclude", "", "do not generate the errors whose name matches the regexp")
+	allowPrefix := flag.String("allow-prefix", "", "comma-separated prefixes the symbols of a message file must have (default: any upper case identifier)")
+	denyPrefix := flag.String("deny-prefix", "", "comma-separated prefixes of the symbols of a message file to leave out")
+	input := flag.String("input", "", "source format (mysql: messages_to_clients.txt or errmsg-utf8.txt, header: a C header such as mysqld_error.h or errmsg.h, tidb: TiDB's pkg/errno/errcode.go; default: header for a .h url, mysql otherwise)")
+	var history historyFlag
+	flag.Var(&history, "history", "`version=url` of an older source for IntroducedIn/RemovedIn metadata (repeatable)")
+	var compat compatFlag
+	flag.Var(&compat, "compat", "also generate aliases named like another package (vividcortex, tidb; comma separated or repeatable)")
+	validate := flag.String("validate-against", "", "C header of the same version, such as include/mysqld_error.h, whose defines every error must match")
+	headerFile := flag.String("header-file", "", "file containing the header comment (empty file for none)")
+	verifyBuild := flag.Bool("verify-build", false, "type-check the generated code before writing")
+	version := flag.String("version", "", "MySQL version of the source (default: guessed from url)")
+	tmpl := flag.String("template", "", "render the parsed errors with the text/template file instead of generating Go code")
+	outFormat := flag.String("format", "go", "output format (go, json, yaml, csv, tsv, csv-wide, tsv-wide, proto, sql, markdown, typescript, python, rust, c, java, kotlin, registry, subsystem, names, advice, messages)")
+	adviceFile := flag.String("advice", "", "curated advice file (JSON) merged by the advice format")
+	dialect := flag.String("dialect", "", "dialect of the source for the registry format (mysql, errorlog, mariadb, tidb, client)")
+	languages := flag.String("languages", "", "comma-separated languages of the generated messages, such as eng,jpn; the go format looks up the others with MessageIn (default: the default-language of the source, or all of them for the exports)")
+	lookup := flag.String("lookup", "", "generate Name and Message lookup functions (map, sorted, switch, compressed, embed, packed)")
+	constType := flag.String("const-type", "", "type of the generated constants, such as uint16 for the wire format and the Number of go-sql-driver (default: untyped)")
+	genTest := flag.Bool("test", false, "also generate constants_test.go asserting well-known codes and consistency")
+	nodataTag := flag.String("nodata-tag", "mysqlerr_nodata", "build tag that excludes the lookup tables (empty to always include them)")
+	allowRenumber := flag.Bool("allow-renumber", false, "allow existing constants to change their values")
+	report := flag.String("report", "", "write a JSON report of the constants changed since the previous generation")
+	checkReproducible := flag.Bool("check-reproducible", false, "generate twice and fail if the outputs differ")
+	check := flag.Bool("check", false, "compare with the existing files instead of writing, and fail with a diff if they are stale")
+	dryRun := flag.Bool("dry-run", false, "print a diff against the existing files instead of writing them")
+	watch := flag.Bool("watch", false, "regenerate whenever the source, template, header or history files change")
+	watchInterval := flag.Duration("watch-interval", time.Second, "how often -watch polls the files")
+	output := flag.String("o", "", "output file, - for stdout (default: stdout, or constants.go in -dir for the go format)")
+	var outs outFlag
+	flag.Var(&outs, "out", "`format[=file]` to generate, with the formats of -format and template (repeatable, replaces -format and -o; go writes the package to -dir)")
+	httpTimeout := flag.Duration("http-timeout", time.Minute, "timeout of a download, 0 for none")
+	httpRetries := flag.Int("http-retries", 3, "how many times a download failing with a network error, 429 or 5xx is retried")
+	httpHeader := headerFlag{}
+	flag.Var(httpHeader, "http-header", "`Name: value` header sent with the downloads (repeatable)")
+	httpToken := flag.String("http-token", os.Getenv("MYSQLERR_HTTP_TOKEN"), "bearer token sent with the downloads, for private mirrors (default: $MYSQLERR_HTTP_TOKEN)")
+	snapshots := flag.String("snapshots", os.Getenv("MYSQLERR_SNAPSHOTS"), "directory of verified source snapshots read instead of downloading, as written by mysqlerrgen update (default: $MYSQLERR_SNAPSHOTS)")
+	config := flag.String("config", "", "YAML file setting any of the other flags by name; flags on the command line take precedence")
 	flag.Parse()
+	if *config != "" {
+		if err := applyConfig(flag.CommandLine, *config); err != nil {
+			return fmt.Errorf("config: %w", err)
+		}
+	}
 
-	var r io.Reader
-	if *url != "" {
-		resp, err := http.Get(*url)
+	dirGiven := *dir != ""
+	if !dirGiven {
+		*dir = *pkg
+	}
+	if *alias != "" && *verifyBuild {
+		return fmt.Errorf("-verify-build cannot type-check an -alias package")
+	}
+	fetch := newFetcher(*httpTimeout, *httpRetries, http.Header(httpHeader), *httpToken)
+	fetch.member = *archiveMember
+	fetch.snapshots = *snapshots
+	if *input == "" {
+		*input = "mysql"
+		if strings.HasSuffix(*url, ".h") {
+			*input = "header"
+		}
+	}
+	parse, ok := parsers[*input]
+	if !ok {
+		return fmt.Errorf("unknown input: %q", *input)
+	}
+	if *allowPrefix != "" || *denyPrefix != "" {
+		if *input != "mysql" {
+			return fmt.Errorf("-allow-prefix and -deny-prefix need the mysql input")
+		}
+		popts := &parser.Options{AllowPrefixes: splitList(*allowPrefix), DenyPrefixes: splitList(*denyPrefix)}
+		parse = func(r io.Reader) (*parser.Catalog, error) {
+			return parser.ParseWithOptions(r, popts)
+		}
+	}
+	opts := &emitOptions{parse: parse, reproducible: *checkReproducible, check: *check, dryRun: *dryRun, languages: splitList(*languages)}
+	if *include != "" {
+		re, err := regexp.Compile(*include)
 		if err != nil {
-			return fmt.Errorf("get: %w", err)
+			return fmt.Errorf("invalid -include: %w", err)
 		}
-		defer resp.Body.Close()
-		r = resp.Body
-	} else {
-		r = os.Stdin
+		opts.include = re
+	}
+	if *exclude != "" {
+		re, err := regexp.Compile(*exclude)
+		if err != nil {
+			return fmt.Errorf("invalid -exclude: %w", err)
+		}
+		opts.exclude = re
+	}
+	if *validate != "" {
+		b, err := fetch.readSource(*validate)
+		if err != nil {
+			return fmt.Errorf("read -validate-against: %w", err)
+		}
+		opts.validate, err = parser.ParseHeader(bytes.NewReader(b))
+		if err != nil {
+			return fmt.Errorf("parse -validate-against: %w", err)
+		}
+	}
+	lw, ok := lookupWriters[*lookup]
+	if *lookup != "" && !ok {
+		return fmt.Errorf("unknown lookup: %q", *lookup)
 	}
 
-	s := bufio.NewScanner(r)
-	defaultLanguage := "eng"
-	errorCodeOffset := 1000
-	rCount := 0
-	var languages []language
-	var errs []mysqlError
-	for s.Scan() {
-		line := s.Text()
-		switch {
-		case strings.HasPrefix(line, "language"):
-			languages = parseLanguage(line)
-		case strings.HasPrefix(line, "start-error-number"):
-			_, line = consumeWord(line)
-			line = trimDelimiters(line)
-			offsetStr, rest := consumeWord(line)
-			if rest != "" {
-				return fmt.Errorf("invalid format: %q", s.Text())
+	pass := func() error {
+		header := license
+		if *headerFile != "" {
+			b, err := os.ReadFile(*headerFile)
+			if err != nil {
+				return fmt.Errorf("read header file: %w", err)
 			}
-			errorCodeOffset, _ = strconv.Atoi(offsetStr)
-			rCount = 0
-		case strings.HasPrefix(line, "default-language"):
-			_, line = consumeWord(line)
-			line = trimDelimiters(line)
-			shortName, rest := consumeWord(line)
-			if rest != "" {
-				return fmt.Errorf("invalid format: %q", s.Text())
+			header = string(b)
+		}
+
+		source := "stdin"
+		if *url != "" {
+			source = *url
+		}
+		src, err := fetch.readSource(*url)
+		if err != nil {
+			return fmt.Errorf("read source: %w", err)
+		}
+		if *version == "" {
+			*version = guessVersion(source)
+		}
+		prov := provenance{
+			source:      source,
+			version:     *version,
+			sha256:      fmt.Sprintf("%x", sha256.Sum256(src)),
+			generatedAt: generatedAt(),
+		}
+
+		// goConstantsPath returns the constants file of the go format written to out.
+		// The other files of the package go next to it unless -dir is given.
+		goConstantsPath := func(out string) (string, string) {
+			if out == "" {
+				return filepath.Join(*dir, "constants.go"), *dir
 			}
-			defaultLanguage = shortName
-		case strings.HasPrefix(line, "\t"), strings.HasPrefix(line, " "):
-			line = strings.TrimLeft(line, " \t")
-			langShortName := ""
-			if i := strings.IndexAny(line, " \t"); i >= 0 {
-				langShortName, line = line[:i], line[i:]
-			} else {
-				langShortName, line = line, ""
+			if out == "-" || dirGiven {
+				return out, *dir
 			}
-			line = strings.TrimLeft(line, " \t")
-			if !strings.HasPrefix(line, `"`) {
-				return fmt.Errorf("unexpected EOL: %q", s.Text())
+			return out, filepath.Dir(out)
+		}
+		newGoTarget := func(out string) (func(*parser.Catalog) ([]*outputFile, error), error) {
+			constantsPath, pkgDir := goConstantsPath(out)
+			if !*dryRun && constantsPath != "-" {
+				if err := os.MkdirAll(pkgDir, 0777); err != nil {
+					return nil, fmt.Errorf("make package dir: %w", err)
+				}
 			}
-			text, err := parseQuoted(line[1:])
-			if err != nil {
-				return fmt.Errorf("parse quote(%q): %w", s.Text(), err)
+			out = constantsPath
+			cs := &constants{}
+			var target *aliasTarget
+			if *alias != "" {
+				var err error
+				target, err = newAliasTarget(*alias)
+				if err != nil {
+					return nil, fmt.Errorf("alias: %w", err)
+				}
+				// The aliases follow the constants of the target, deprecated ones included.
+				constantsPath = filepath.Join(*alias, "constants.go")
+			}
+			if _, err := os.Stat(constantsPath); err == nil {
+				cs, err = parseConstantsGo(constantsPath)
+				if err != nil {
+					return nil, fmt.Errorf("parse constants.go: %w", err)
+				}
+			}
+			var hs []historySource
+			for _, h := range history {
+				b, err := fetch.readSource(h.url)
+				if err != nil {
+					return nil, fmt.Errorf("read history source %s: %w", h.version, err)
+				}
+				hcat, err := parse(bytes.NewReader(b))
+				if err != nil {
+					return nil, fmt.Errorf("parse history source %s: %w", h.version, err)
+				}
+				hs = append(hs, historySource{version: h.version, cat: hcat})
 			}
-			curErr := &errs[len(errs)-1]
-			curErr.messages = append(curErr.messages, message{
-				langShortName: langShortName,
-				text:          text,
-			})
-		case strings.HasPrefix(line, "ER_"), strings.HasPrefix(line, "WARN_"), strings.HasPrefix(line, "OBSOLETE_ER_"), strings.HasPrefix(line, "OBSOLETE_WARN_"):
-			var errorName, sqlState, odbcState string
-			errorName, line = consumeWord(line)
-			line = trimDelimiters(line)
-			sqlState, line = consumeWord(line)
-			line = trimDelimiters(line)
-			odbcState, line = consumeWord(line)
-			errorCode := errorCodeOffset + rCount
-			rCount++
-			errs = append(errs, mysqlError{
-				name:      errorName,
-				code:      errorCode,
-				sqlState:  sqlState,
-				odbcState: odbcState,
-				obsolete:  strings.HasPrefix(errorName, "OBSOLETE_"),
-			})
-		case strings.HasPrefix(line, "#"), line == "":
-			// comment
-		case strings.HasPrefix(line, "reserved-error-section"):
-		default:
-			// unknown format
-			return fmt.Errorf("unknown format: %q", line)
-		}
-	}
-	_ = defaultLanguage
-	_ = languages
+			g := &goGenerator{
+				pkg:           *pkg,
+				dir:           pkgDir,
+				out:           out,
+				alias:         target,
+				header:        header,
+				prov:          &prov,
+				lookup:        lw,
+				nodataTag:     *nodataTag,
+				test:          *genTest,
+				verify:        *verifyBuild,
+				report:        *report,
+				allowRenumber: *allowRenumber,
+				history:       hs,
+				compat:        compat,
+				languages:     opts.languages,
+				constType:     *constType,
+			}
+			return func(cat *parser.Catalog) ([]*outputFile, error) {
+				return g.generate(cat, cs)
+			}, nil
+		}
+
+		targets := outs
+		if len(targets) == 0 {
+			t := outTarget{format: *outFormat, file: *output}
+			if *tmpl != "" {
+				t.format = "template"
+			}
+			targets = []outTarget{t}
+		}
+		if *check || *dryRun {
+			existing := targets[0].file
+			for _, t := range targets {
+				if t.format == "go" {
+					existing, _ = goConstantsPath(t.file)
+				}
+			}
+			// Keep the timestamp of the existing generation so that it alone does not count as drift.
+			if t, ok := readGeneratedAt(existing); ok {
+				prov.generatedAt = t
+			}
+		}
 
-	if err := os.MkdirAll(*pkg, 0777); err != nil {
-		return fmt.Errorf("make package dir: %w", err)
+		stdout := 0
+		var gens []func(*parser.Catalog) ([]*outputFile, error)
+		for _, t := range targets {
+			if t.file == "-" || t.file == "" && t.format != "go" {
+				if stdout++; stdout > 1 {
+					return fmt.Errorf("only one output can go to stdout")
+				}
+			}
+			t := t
+			switch t.format {
+			case "template":
+				if *tmpl == "" {
+					return fmt.Errorf("the template output needs -template")
+				}
+				gens = append(gens, func(cat *parser.Catalog) ([]*outputFile, error) {
+					out, err := executeTemplate(*tmpl, *pkg, &prov, cat)
+					if err != nil {
+						return nil, fmt.Errorf("template: %w", err)
+					}
+					return []*outputFile{{name: t.file, src: out}}, nil
+				})
+			case "go":
+				gen, err := newGoTarget(t.file)
+				if err != nil {
+					return err
+				}
+				gens = append(gens, gen)
+			default:
+				export, ok := exporters[t.format]
+				if !ok {
+					return fmt.Errorf("unknown format: %q", t.format)
+				}
+				gens = append(gens, func(cat *parser.Catalog) ([]*outputFile, error) {
+					var buf bytes.Buffer
+					if err := export(&buf, cat, &exportOptions{pkg: *pkg, version: prov.version, dialect: *dialect, advice: *adviceFile}); err != nil {
+						return nil, fmt.Errorf("export %s: %w", t.format, err)
+					}
+					return []*outputFile{{name: t.file, src: buf.Bytes()}}, nil
+				})
+			}
+		}
+		// All the targets are generated from the same parse of the same source.
+		return emit(src, opts, func(cat *parser.Catalog) ([]*outputFile, error) {
+			var files []*outputFile
+			for _, gen := range gens {
+				fs, err := gen(cat)
+				if err != nil {
+					return nil, err
+				}
+				files = append(files, fs...)
+			}
+			return files, nil
+		})
+	}
+	if !*watch {
+		return pass()
 	}
+	var watched []string
+	for _, name := range append([]string{*url, *tmpl, *headerFile}, history.urls()...) {
+		if name != "" && !strings.HasPrefix(name, "http://") && !strings.HasPrefix(name, "https://") {
+			watched = append(watched, name)
+		}
+	}
+	if len(watched) == 0 || watched[0] != *url {
+		return fmt.Errorf("-watch needs -url to be a local file")
+	}
+	return watchFiles(watched, *watchInterval, pass)
+}
 
-	cs := &constants{}
-	constantsPath := filepath.Join(*pkg, "constants.go")
-	if _, err := os.Stat(constantsPath); err == nil {
-		cs, err = parseConstantsGo(constantsPath)
-		if err != nil {
-			return fmt.Errorf("parse constants.go: %w", err)
+// outTarget is an output of a run: a format and the file it goes to, stdout if empty.
+type outTarget struct {
+	format string
+	file   string
+}
+
+type outFlag []outTarget
+
+func (f *outFlag) String() string {
+	var s []string
+	for _, t := range *f {
+		if t.file == "" {
+			s = append(s, t.format)
+		} else {
+			s = append(s, t.format+"="+t.file)
 		}
 	}
+	return strings.Join(s, ",")
+}
 
-	f, err := os.Create(constantsPath)
-	if err != nil {
-		return fmt.Errorf("create constants.go: %w", err)
+func (f *outFlag) Set(v string) error {
+	t := outTarget{format: v}
+	if i := strings.Index(v, "="); i >= 0 {
+		t = outTarget{format: v[:i], file: v[i+1:]}
 	}
-	defer f.Close()
+	if t.format == "" {
+		return fmt.Errorf("want format[=file]: %q", v)
+	}
+	*f = append(*f, t)
+	return nil
+}
 
-	fmt.Fprintln(f, "// Code generated mysqlerrgen DO NOT EDIT.")
-	writeLicense(f)
-	fmt.Fprintln(f, "package", *pkg)
-	for _, mysqlErr := range errs {
-		for _, d := range cs.deprecates(mysqlErr.name, mysqlErr.code) {
-			fmt.Fprintln(f, "// Deprecated: should not be used")
-			fmt.Fprintln(f, "const", d.name, "=", d.code)
+// watchFiles runs fn, then runs it again each time one of the files changes.
+// Errors of fn are logged so that the next change can fix them.
+func watchFiles(names []string, interval time.Duration, fn func() error) error {
+	stamps := func() string {
+		var ss []string
+		for _, name := range names {
+			fi, err := os.Stat(name)
+			if err != nil {
+				ss = append(ss, err.Error())
+				continue
+			}
+			ss = append(ss, fmt.Sprint(fi.ModTime().UnixNano(), fi.Size()))
 		}
-		fmt.Fprintln(f, "const", mysqlErr.name, "=", mysqlErr.code)
+		return strings.Join(ss, "\n")
 	}
-	if err := f.Close(); err != nil {
-		return fmt.Errorf("close constants.go: %w", err)
+	last := stamps()
+	for {
+		if err := fn(); err != nil {
+			log.Print(err)
+		} else {
+			log.Print("generated")
+		}
+		for {
+			time.Sleep(interval)
+			if now := stamps(); now != last {
+				last = now
+				break
+			}
+		}
 	}
-	return nil
 }
 
-func consumeWord(s string) (string, string) {
-	i := strings.IndexAny(s, " ,\t\r\n=")
-	if i < 0 {
-		return s, ""
+// splitList splits a comma-separated flag value, dropping the empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
 	}
-	return s[:i], s[i:]
+	return items
 }
 
-func trimDelimiters(s string) string {
-	return strings.TrimLeft(s, " ,\t=")
+var parsers = map[string]func(io.Reader) (*parser.Catalog, error){
+	"mysql":  parser.Parse,
+	"tidb":   parser.ParseTiDB,
+	"header": parser.ParseHeader,
 }
 
-// <keyword> <lang>[, <lang>]* ;
-// keyword := language[s]
-// lang := <long_name>=<short_name> <charset>
-func parseLanguage(s string) []language {
-	_, s = consumeWord(s) // skip keyword
-	s = trimDelimiters(s)
+type emitOptions struct {
+	parse func(io.Reader) (*parser.Catalog, error)
+	// include and exclude filter the errors by name when set.
+	include, exclude *regexp.Regexp
+	// reproducible generates twice and fails unless both results are identical.
+	reproducible bool
+	// check compares the files with the existing ones instead of writing them.
+	check bool
+	// dryRun prints the differences with the existing files instead of writing them.
+	dryRun bool
+	// languages are the languages the messages are kept in, all of them if empty.
+	languages []string
+	// validate is the header the errors are cross-checked with before filtering, if any.
+	validate *parser.Catalog
+}
 
-	var languages []language
-	for !(strings.HasPrefix(s, ";") || s == "") {
-		longName, x := consumeWord(s)
-		x = trimDelimiters(x)
-		shortName, x := consumeWord(x)
-		x = trimDelimiters(x)
-		charset, x := consumeWord(x)
-		s = trimDelimiters(x)
-		languages = append(languages, language{
-			longName:  longName,
-			shortName: shortName,
-			charset:   charset,
-		})
+func (o *emitOptions) catalog(src []byte) (*parser.Catalog, error) {
+	cat, err := o.parse(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+	if o.validate != nil {
+		if err := validateAgainst(cat, o.validate); err != nil {
+			return nil, err
+		}
+	}
+	if len(o.languages) > 0 {
+		if err := selectLanguages(cat, o.languages); err != nil {
+			return nil, err
+		}
+	}
+	if o.include == nil && o.exclude == nil {
+		return cat, nil
 	}
-	return languages
+	errs := cat.Errors[:0]
+	for _, e := range cat.Errors {
+		if o.include != nil && !o.include.MatchString(e.Name) || o.exclude != nil && o.exclude.MatchString(e.Name) {
+			continue
+		}
+		errs = append(errs, e)
+	}
+	cat.Errors = errs
+	return cat, nil
 }
 
-func parseQuoted(s string) (string, error) {
-	var b strings.Builder
-	r := []rune(s)
-	for i := 0; i < len(r); i++ {
-		if r[i] == '"' {
-			return b.String(), nil
-		}
-		if r[i] == '\\' && i+1 < len(r) {
-			i++
-			switch r[i] {
-			case 'n':
-				b.WriteByte('\n')
-			case '0', '1', '2', '3', '4', '5', '6', '7':
-				n := 0
-				for j := 0; j < 3 && i < len(r); i, j = i+1, j+1 {
-					if r[i] < '0' && '7' < r[i] {
-						i--
-						break
-					}
-					n = n*8 + int(r[i]-'0')
-				}
-				b.WriteByte(byte(n))
-			default:
-				b.WriteRune(r[i])
-			}
-		} else {
-			b.WriteRune(r[i])
+// emit parses src, generates the files and writes them.
+func emit(src []byte, opts *emitOptions, generate func(*parser.Catalog) ([]*outputFile, error)) error {
+	cat, err := opts.catalog(src)
+	if err != nil {
+		return err
+	}
+	files, err := generate(cat)
+	if err != nil {
+		return err
+	}
+	if opts.reproducible {
+		cat, err := opts.catalog(src)
+		if err != nil {
+			return err
+		}
+		again, err := generate(cat)
+		if err != nil {
+			return err
+		}
+		if err := compareOutputs(files, again); err != nil {
+			return fmt.Errorf("output is not reproducible: %w", err)
+		}
+	}
+	if opts.check {
+		return checkOutputs(os.Stdout, files)
+	}
+	if opts.dryRun {
+		_, err := diffOutputs(os.Stdout, files)
+		return err
+	}
+	for _, f := range files {
+		if err := writeOutput(f.name, f.src); err != nil {
+			return fmt.Errorf("write %s: %w", f.name, err)
 		}
 	}
-	return "", fmt.Errorf("unexpected EOL")
+	return nil
 }
 
-func writeLicense(w io.Writer) {
-	fmt.Fprintln(w, `// Copyright 2021-2023 Nao Yonashiro 
-// 
-// Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated documentation files (the “Software”), to deal in the Software without restriction, including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so, subject to the following conditions:
-// 
-// The above copyright notice and this permission notice shall be included in all copies or substantial portions of the Software.
-//
-// THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.`)
+func compareOutputs(a, b []*outputFile) error {
+	if len(a) != len(b) {
+		return fmt.Errorf("generated %d files, then %d", len(a), len(b))
+	}
+	for i := range a {
+		if a[i].name != b[i].name {
+			return fmt.Errorf("generated %s, then %s", a[i].name, b[i].name)
+		}
+		if !bytes.Equal(a[i].src, b[i].src) {
+			return fmt.Errorf("%s differs", a[i].name)
+		}
+	}
+	return nil
 }
 
-type constants struct {
-	byName map[string]int
-	byCode map[int][]string
+// checkOutputs prints a diff of every file that differs from the one on disk
+// and fails if there was any.
+func checkOutputs(w io.Writer, files []*outputFile) error {
+	stale, err := diffOutputs(w, files)
+	if err != nil {
+		return err
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("generated files are stale: %s", strings.Join(stale, ", "))
+	}
+	return nil
 }
 
-func (c *constants) add(name string, code int) {
-	if c.byName == nil {
-		c.byName = map[string]int{}
+// diffOutputs prints a diff of every file that differs from the one on disk and returns their names.
+func diffOutputs(w io.Writer, files []*outputFile) ([]string, error) {
+	var stale []string
+	for _, f := range files {
+		if f.name == "" || f.name == "-" {
+			return nil, fmt.Errorf("cannot compare stdout with an existing file: use an output file")
+		}
+		old, err := os.ReadFile(f.name)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("read %s: %w", f.name, err)
+		}
+		if d := unifiedDiff(filepath.ToSlash(f.name), old, f.src); d != "" {
+			io.WriteString(w, d)
+			stale = append(stale, f.name)
+		}
 	}
-	c.byName[name] = code
-	if c.byCode == nil {
-		c.byCode = map[int][]string{}
+	return stale, nil
+}
+
+type provenance struct {
+	source      string
+	version     string
+	sha256      string
+	generatedAt time.Time
+}
+
+func (p *provenance) write(w io.Writer) {
+	fmt.Fprintln(w, "// Source:", p.source)
+	if p.version != "" {
+		fmt.Fprintln(w, "// MySQL version:", p.version)
 	}
-	c.byCode[code] = append(c.byCode[code], name)
+	fmt.Fprintln(w, "// SHA256:", p.sha256)
+	fmt.Fprintln(w, "// Generated at:", p.generatedAt.Format(time.RFC3339))
 }
 
-func (c *constants) deprecates(name string, code int) []mysqlError {
-	var ds []mysqlError
-	names := c.byCode[code]
-	for _, n := range names {
-		if n == name {
-			continue
+// readGeneratedAt returns the generation time recorded in the provenance of an existing file.
+func readGeneratedAt(name string) (time.Time, bool) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return time.Time{}, false
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		if v := strings.TrimPrefix(line, "// Generated at: "); v != line {
+			t, err := time.Parse(time.RFC3339, v)
+			return t, err == nil
 		}
-		ds = append(ds, mysqlError{name: n, code: code})
 	}
-	return ds
+	return time.Time{}, false
 }
 
-func parseConstantsGo(name string) (*constants, error) {
-	f, err := os.Open(name)
-	if err != nil {
-		return nil, err
+var versionPattern = regexp.MustCompile(`(?:mysql|mariadb)-(\d+\.\d+\.\d+)`)
+
+func guessVersion(source string) string {
+	m := versionPattern.FindStringSubmatch(source)
+	if m == nil {
+		return ""
 	}
-	defer f.Close()
+	return m[1]
+}
 
-	var c constants
-	s := bufio.NewScanner(f)
-	for s.Scan() {
-		line := s.Text()
-		if !strings.HasPrefix(line, "const ") {
-			continue
+// generatedAt honors SOURCE_DATE_EPOCH so that generation can be reproduced byte for byte.
+func generatedAt() time.Time {
+	if epoch := os.Getenv("SOURCE_DATE_EPOCH"); epoch != "" {
+		if sec, err := strconv.ParseInt(epoch, 10, 64); err == nil {
+			return time.Unix(sec, 0).UTC()
 		}
-		tokens := strings.Split(line, " ")
-		key := tokens[1]
-		val, _ := strconv.Atoi(tokens[3])
-		c.add(key, val)
 	}
-	return &c, nil
+	return time.Now().UTC()
 }
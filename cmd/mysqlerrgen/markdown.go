@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+func writeMarkdown(w io.Writer, cat *parser.Catalog, opts *exportOptions) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "<!-- Code generated mysqlerrgen DO NOT EDIT. -->")
+	if opts.version != "" {
+		fmt.Fprintf(bw, "# MySQL %s error reference\n", opts.version)
+	} else {
+		fmt.Fprintln(bw, "# MySQL error reference")
+	}
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		fmt.Fprintln(bw)
+		fmt.Fprintf(bw, "## %s\n", e.Name)
+		fmt.Fprintln(bw)
+		fmt.Fprintf(bw, "- Code: %d\n", e.Code)
+		if e.SQLState != "" {
+			fmt.Fprintf(bw, "- SQLSTATE: %s\n", e.SQLState)
+		}
+		if e.ODBCState != "" {
+			fmt.Fprintf(bw, "- ODBC state: %s\n", e.ODBCState)
+		}
+		if e.Severity != "" {
+			fmt.Fprintf(bw, "- Severity: %s\n", e.Severity)
+		}
+		if msg := e.Message(cat.DefaultLanguage); msg != "" {
+			fmt.Fprintf(bw, "- Message: %s\n", markdownCode(msg))
+		}
+		if e.Obsolete {
+			fmt.Fprintln(bw, "- Obsolete: yes")
+		}
+	}
+	return bw.Flush()
+}
+
+// markdownCode returns s as an inline code span, using a longer fence when s contains backticks.
+func markdownCode(s string) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	fence := "`"
+	for strings.Contains(s, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		s = " " + s + " "
+	}
+	return fence + s + fence
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// writeMessages writes the table behind Message, SQLStateOf and NewError of the root package:
+// the SQLSTATE and the message template in the default language of every error.
+// The errors without a declared SQLSTATE get HY000, as the server sends them with.
+func writeMessages(w io.Writer, cat *parser.Catalog, opts *exportOptions) error {
+	pkg := opts.pkg
+	if pkg == "" {
+		pkg = "mysqlerr"
+	}
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package", pkg)
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var messages = map[int]messageInfo{")
+	seen := map[int]bool{}
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		if seen[e.Code] {
+			continue
+		}
+		seen[e.Code] = true
+		state := e.SQLState
+		if state == "" {
+			state = "HY000"
+		}
+		fmt.Fprintf(&buf, "%d: {%q, %q}, // %s\n", e.Code, state, e.Message(cat.DefaultLanguage), e.Name)
+	}
+	fmt.Fprintln(&buf, "}")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+func writeProto(w io.Writer, cat *parser.Catalog, opts *exportOptions) error {
+	pkg := opts.pkg
+	if pkg == "" {
+		pkg = "mysqlerr"
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "// Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(bw, `syntax = "proto3";`)
+	fmt.Fprintln(bw)
+	fmt.Fprintf(bw, "package %s;\n", pkg)
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "enum MySQLErrorCode {")
+	fmt.Fprintln(bw, "  MYSQL_ERROR_CODE_UNSPECIFIED = 0;")
+	for _, e := range cat.Errors {
+		if e.Obsolete {
+			fmt.Fprintf(bw, "  %s = %d [deprecated = true];\n", e.Name, e.Code)
+		} else {
+			fmt.Fprintf(bw, "  %s = %d;\n", e.Name, e.Code)
+		}
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
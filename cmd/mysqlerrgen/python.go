@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+func writePython(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "# Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(bw, "from enum import IntEnum")
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "class MySQLErrorCode(IntEnum):")
+	if len(cat.Errors) == 0 {
+		fmt.Fprintln(bw, "    pass")
+	}
+	for _, e := range cat.Errors {
+		if e.Obsolete {
+			fmt.Fprintf(bw, "    %s = %d  # obsolete\n", e.Name, e.Code)
+		} else {
+			fmt.Fprintf(bw, "    %s = %d\n", e.Name, e.Code)
+		}
+	}
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "MESSAGES = {")
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		if msg := e.Message(cat.DefaultLanguage); msg != "" {
+			// JSON string literals are valid Python string literals.
+			fmt.Fprintf(bw, "    %d: %s,\n", e.Code, jsString(msg))
+		}
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
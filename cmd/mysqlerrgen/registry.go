@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// writeRegistry writes a file of the registry package adding the errors of one dialect.
+func writeRegistry(w io.Writer, cat *parser.Catalog, opts *exportOptions) error {
+	if opts.dialect == "" {
+		return fmt.Errorf("-dialect is required")
+	}
+	pkg := opts.pkg
+	if pkg == "" {
+		pkg = "registry"
+	}
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package", pkg)
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "func init() {")
+	fmt.Fprintf(&buf, "register(%q, %q, []entry{\n", opts.dialect, opts.version)
+	for _, e := range cat.Errors {
+		fmt.Fprintf(&buf, "{%d, %q},\n", e.Code, e.Name)
+	}
+	fmt.Fprintln(&buf, "})")
+	var severities []parser.Error
+	for _, e := range cat.Errors {
+		if e.Severity != "" {
+			severities = append(severities, e)
+		}
+	}
+	if len(severities) > 0 {
+		fmt.Fprintf(&buf, "registerSeverities(%q, map[int]string{\n", opts.dialect)
+		for _, e := range severities {
+			fmt.Fprintf(&buf, "%d: %q,\n", e.Code, e.Severity)
+		}
+		fmt.Fprintln(&buf, "})")
+	}
+	fmt.Fprintln(&buf, "}")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
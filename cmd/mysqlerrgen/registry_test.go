@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+const errorLogSource = `languages english=eng default-language eng
+
+start-error-number 10000
+
+ER_PARSER_TRACE
+  eng "Parser saw: %s"
+
+ER_BOOTSTRAP_CANT_THREAD severity=ERROR
+  eng "Can't create thread to handle bootstrap (errno: %d)"
+
+ER_TRIGGER_INVALID_VALUE severity WARNING
+  eng "Trigger for table '%s'.'%s': invalid %s value (%s)."
+`
+
+func TestWriteRegistrySeverities(t *testing.T) {
+	cat, err := parser.Parse(strings.NewReader(errorLogSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := writeRegistry(&buf, cat, &exportOptions{dialect: "errorlog", version: "8.4.2"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`register("errorlog", "8.4.2", []entry{`,
+		`{10001, "ER_BOOTSTRAP_CANT_THREAD"},`,
+		`registerSeverities("errorlog", map[int]string{`,
+		`10001: "ERROR",`,
+		`10002: "WARNING",`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("registry does not contain %q:\n%s", want, buf.String())
+		}
+	}
+	if strings.Contains(buf.String(), "10000:") {
+		t.Errorf("registry has a severity for ER_PARSER_TRACE:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+type changeReport struct {
+	Added      []reportEntry   `json:"added"`
+	Removed    []reportEntry   `json:"removed"`
+	Renamed    []renameEntry   `json:"renamed"`
+	Renumbered []renumberEntry `json:"renumbered"`
+}
+
+type reportEntry struct {
+	Name string `json:"name"`
+	Code int    `json:"code"`
+}
+
+type renameEntry struct {
+	Code int      `json:"code"`
+	From []string `json:"from"`
+	To   string   `json:"to"`
+}
+
+type renumberEntry struct {
+	Name string `json:"name"`
+	From int    `json:"from"`
+	To   int    `json:"to"`
+}
+
+// diffConstants compares the constants of the previous generation with the parsed errors.
+// Names that only survive as deprecated aliases are reported as renamed, not removed.
+func diffConstants(old *constants, errs []parser.Error) *changeReport {
+	r := &changeReport{
+		Added:      []reportEntry{},
+		Removed:    []reportEntry{},
+		Renamed:    []renameEntry{},
+		Renumbered: []renumberEntry{},
+	}
+	names := map[string]bool{}
+	codes := map[int]bool{}
+	for _, e := range errs {
+		names[e.Name] = true
+		codes[e.Code] = true
+	}
+	for _, e := range errs {
+		oldCode, ok := old.byName[e.Name]
+		switch {
+		case ok && oldCode != e.Code:
+			r.Renumbered = append(r.Renumbered, renumberEntry{Name: e.Name, From: oldCode, To: e.Code})
+		case ok:
+		case len(old.byCode[e.Code]) > 0:
+			var from []string
+			for _, n := range old.byCode[e.Code] {
+				if !names[n] {
+					from = append(from, n)
+				}
+			}
+			if len(from) > 0 {
+				sort.Strings(from)
+				r.Renamed = append(r.Renamed, renameEntry{Code: e.Code, From: from, To: e.Name})
+			}
+		default:
+			r.Added = append(r.Added, reportEntry{Name: e.Name, Code: e.Code})
+		}
+	}
+	for name, code := range old.byName {
+		if !names[name] && !codes[code] {
+			r.Removed = append(r.Removed, reportEntry{Name: name, Code: code})
+		}
+	}
+	sort.Slice(r.Removed, func(i, j int) bool {
+		if r.Removed[i].Code != r.Removed[j].Code {
+			return r.Removed[i].Code < r.Removed[j].Code
+		}
+		return r.Removed[i].Name < r.Removed[j].Name
+	})
+	return r
+}
+
+func (r *changeReport) marshal() ([]byte, error) {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
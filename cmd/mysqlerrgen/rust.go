@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+func writeRust(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "// Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(bw)
+	for _, e := range cat.Errors {
+		if e.Obsolete {
+			fmt.Fprintln(bw, "#[deprecated]")
+		}
+		fmt.Fprintf(bw, "pub const %s: u16 = %d;\n", e.Name, e.Code)
+	}
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "/// Returns the symbolic name of the error code.")
+	fmt.Fprintln(bw, "pub fn name(code: u16) -> Option<&'static str> {")
+	fmt.Fprintln(bw, "    match code {")
+	for _, e := range cat.Errors {
+		fmt.Fprintf(bw, "        %d => Some(%s),\n", e.Code, rustString(e.Name))
+	}
+	fmt.Fprintln(bw, "        _ => None,")
+	fmt.Fprintln(bw, "    }")
+	fmt.Fprintln(bw, "}")
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "/// Returns the message template of the error code.")
+	fmt.Fprintln(bw, "pub fn message(code: u16) -> Option<&'static str> {")
+	fmt.Fprintln(bw, "    match code {")
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		if msg := e.Message(cat.DefaultLanguage); msg != "" {
+			fmt.Fprintf(bw, "        %d => Some(%s),\n", e.Code, rustString(msg))
+		}
+	}
+	fmt.Fprintln(bw, "        _ => None,")
+	fmt.Fprintln(bw, "    }")
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+func rustString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if unicode.IsPrint(r) {
+				b.WriteRune(r)
+			} else {
+				fmt.Fprintf(&b, `\u{%x}`, r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
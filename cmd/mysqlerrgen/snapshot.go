@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	neturl "net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// The snapshots are copies of the downloaded sources, stored under the path of their url
+// (snapshots/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt) with their digests in SHA256SUMS,
+// so that generation works without network access and fails if a snapshot changed.
+// Go sources are stored with goSnapshotExt appended, so that they are not compiled into the module.
+const (
+	snapshotSums  = "SHA256SUMS"
+	goSnapshotExt = ".txt"
+)
+
+// snapshotPath returns the path of the snapshot of url relative to the snapshot directory.
+func snapshotPath(url string) (string, error) {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return "", err
+	}
+	p := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(u.Path, "/")))
+	if p == "." || strings.HasPrefix(p, "..") {
+		return "", fmt.Errorf("no snapshot path for %s", url)
+	}
+	if strings.HasSuffix(p, ".go") {
+		p += goSnapshotExt
+	}
+	return p, nil
+}
+
+// readSnapshot reads the snapshot of url from dir, and reports false if there is none.
+func readSnapshot(dir, url string) ([]byte, bool, error) {
+	p, err := snapshotPath(url)
+	if err != nil {
+		return nil, false, err
+	}
+	b, err := os.ReadFile(filepath.Join(dir, p))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	sums, err := readSums(dir)
+	if err != nil {
+		return nil, false, err
+	}
+	want, ok := sums[filepath.ToSlash(p)]
+	if !ok {
+		return nil, false, fmt.Errorf("snapshot %s is not in %s", p, snapshotSums)
+	}
+	if got := fmt.Sprintf("%x", sha256.Sum256(b)); got != want {
+		return nil, false, fmt.Errorf("snapshot %s: sha256 %s, want %s", p, got, want)
+	}
+	return b, true, nil
+}
+
+// readSums reads the SHA256SUMS of dir, in the format of sha256sum.
+func readSums(dir string) (map[string]string, error) {
+	sums := map[string]string{}
+	b, err := os.ReadFile(filepath.Join(dir, snapshotSums))
+	if os.IsNotExist(err) {
+		return sums, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for n := 1; s.Scan(); n++ {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: want sha256 path", snapshotSums, n)
+		}
+		sums[strings.TrimPrefix(fields[1], "*")] = fields[0]
+	}
+	return sums, s.Err()
+}
+
+func writeSums(dir string, sums map[string]string) error {
+	var paths []string
+	for p := range sums {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	var buf bytes.Buffer
+	for _, p := range paths {
+		fmt.Fprintf(&buf, "%s  %s\n", sums[p], p)
+	}
+	return os.WriteFile(filepath.Join(dir, snapshotSums), buf.Bytes(), 0666)
+}
+
+// snapshotFiles are the files of a mysql-server release the generator reads, by major version.
+func snapshotFiles(version string) []string {
+	if strings.HasPrefix(version, "5.") {
+		return []string{"sql/share/errmsg-utf8.txt", "include/errmsg.h"}
+	}
+	return []string{"share/messages_to_clients.txt", "share/messages_to_error_log.txt", "include/errmsg.h"}
+}
+
+// updateSource is an upstream repository mysqlerrgen update downloads from.
+type updateSource struct {
+	// repo is the url of the raw files of the repository, followed by the git ref and the path of a file.
+	repo string
+	// tag is the prefix of the release tags, followed by the version.
+	tag   string
+	files func(version string) []string
+}
+
+var updateSources = map[string]updateSource{
+	"mysql":   {"https://raw.githubusercontent.com/mysql/mysql-server/", "mysql-", snapshotFiles},
+	"mariadb": {"https://raw.githubusercontent.com/MariaDB/server/", "mariadb-", func(string) []string { return []string{"sql/share/errmsg-utf8.txt"} }},
+	"tidb":    {"https://raw.githubusercontent.com/pingcap/tidb/", "v", func(string) []string { return []string{"pkg/errno/errcode.go"} }},
+}
+
+// parserOf returns the parser of a file of the update sources.
+func parserOf(file string) func(io.Reader) (*parser.Catalog, error) {
+	switch {
+	case strings.HasSuffix(file, ".h"):
+		return parser.ParseHeader
+	case strings.HasSuffix(file, ".go"):
+		return parser.ParseTiDB
+	}
+	return parser.Parse
+}
+
+var (
+	releasePattern = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+	seriesPattern  = regexp.MustCompile(`^\d+\.\d+(\.\d+)?$`)
+)
+
+// series returns the major.minor of a version.
+func series(version string) string {
+	if i := strings.IndexByte(version, '.'); i >= 0 {
+		if j := strings.IndexByte(version[i+1:], '.'); j >= 0 {
+			return version[:i+1+j]
+		}
+	}
+	return version
+}
+
+var generateVersion = regexp.MustCompile(`-version (\S+)`)
+
+// rewriteGenerate points the -url of the go:generate lines of src reading the release series of version from us at ref,
+// and sets their -version to version. It returns the rewritten source and how many lines read the series.
+func rewriteGenerate(src []byte, us updateSource, ref, version string) ([]byte, int) {
+	url := regexp.MustCompile(`-url ` + regexp.QuoteMeta(us.repo) + `([^/\s]+)/`)
+	lines := strings.SplitAfter(string(src), "\n")
+	n := 0
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "//go:generate ") {
+			continue
+		}
+		m := url.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		v := strings.TrimPrefix(m[1], us.tag)
+		if vm := generateVersion.FindStringSubmatch(line); vm != nil {
+			v = vm[1]
+		}
+		if series(v) != series(version) {
+			continue
+		}
+		line = url.ReplaceAllLiteralString(line, "-url "+us.repo+ref+"/")
+		lines[i] = generateVersion.ReplaceAllLiteralString(line, "-version "+version)
+		n++
+	}
+	return []byte(strings.Join(lines, "")), n
+}
+
+// runUpdate implements mysqlerrgen update: it downloads the sources of a MySQL, MariaDB or TiDB release into the snapshots,
+// checks that they parse and that the ones already recorded did not change, points the go:generate lines
+// of the same release series in generate.go at the release, and runs go generate.
+func runUpdate(args []string) error {
+	fs := flag.NewFlagSet("update", flag.ExitOnError)
+	source := fs.String("source", "mysql", "upstream of the release (mysql, mariadb, tidb)")
+	version := fs.String("version", "", "release, such as 8.4.2")
+	ref := fs.String("ref", "", "git ref to download, such as a commit of a release branch, for which -version may be major.minor (default: the tag of -version)")
+	dir := fs.String("snapshots", "snapshots", "snapshot directory")
+	generateFile := fs.String("generate-file", "generate.go", "file of the go:generate lines to point at the release, empty to leave them")
+	force := fs.Bool("force", false, "replace snapshots whose content changed upstream")
+	generate := fs.Bool("generate", true, "run go generate with the refreshed snapshots")
+	httpTimeout := fs.Duration("http-timeout", time.Minute, "timeout of a download, 0 for none")
+	httpRetries := fs.Int("http-retries", 3, "how many times a download failing with a network error, 429 or 5xx is retried")
+	httpToken := fs.String("http-token", os.Getenv("MYSQLERR_HTTP_TOKEN"), "bearer token sent with the downloads (default: $MYSQLERR_HTTP_TOKEN)")
+	fs.Parse(args)
+	us, ok := updateSources[*source]
+	if !ok {
+		return fmt.Errorf("update: unknown source: %q", *source)
+	}
+	if *ref == "" {
+		if !releasePattern.MatchString(*version) {
+			return fmt.Errorf("update: want -version major.minor.patch, got %q", *version)
+		}
+		*ref = us.tag + *version
+	} else if !seriesPattern.MatchString(*version) {
+		return fmt.Errorf("update: want -version major.minor or major.minor.patch, got %q", *version)
+	}
+	fetch := newFetcher(*httpTimeout, *httpRetries, http.Header{}, *httpToken)
+	sums, err := readSums(*dir)
+	if err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+	for _, file := range us.files(*version) {
+		url := us.repo + *ref + "/" + file
+		b, err := fetch.readSource(url)
+		if err != nil {
+			return fmt.Errorf("update: %w", err)
+		}
+		if _, err := parserOf(file)(bytes.NewReader(b)); err != nil {
+			return fmt.Errorf("update: parse %s: %w", url, err)
+		}
+		p, err := snapshotPath(url)
+		if err != nil {
+			return fmt.Errorf("update: %w", err)
+		}
+		key := filepath.ToSlash(p)
+		sum := fmt.Sprintf("%x", sha256.Sum256(b))
+		if old, ok := sums[key]; ok && old != sum && !*force {
+			return fmt.Errorf("update: %s changed upstream (sha256 %s, recorded %s); use -force to replace it", key, sum, old)
+		}
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(*dir, p)), 0777); err != nil {
+			return fmt.Errorf("update: %w", err)
+		}
+		if err := os.WriteFile(filepath.Join(*dir, p), b, 0666); err != nil {
+			return fmt.Errorf("update: write %s: %w", p, err)
+		}
+		sums[key] = sum
+		log.Printf("%s %s", sum, key)
+	}
+	if err := writeSums(*dir, sums); err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+	if *generateFile != "" {
+		src, err := os.ReadFile(*generateFile)
+		if err != nil {
+			return fmt.Errorf("update: %w", err)
+		}
+		rewritten, n := rewriteGenerate(src, us, *ref, *version)
+		if n == 0 {
+			log.Printf("%s has no go:generate line reading %s %s; add them to generate the release", *generateFile, *source, series(*version))
+		} else if !bytes.Equal(rewritten, src) {
+			if err := os.WriteFile(*generateFile, rewritten, 0666); err != nil {
+				return fmt.Errorf("update: %w", err)
+			}
+		}
+	}
+	if !*generate {
+		return nil
+	}
+	abs, err := filepath.Abs(*dir)
+	if err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+	cmd := exec.Command("go", "generate", ".")
+	cmd.Env = append(os.Environ(), "MYSQLERR_SNAPSHOTS="+abs)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("update: go generate: %w", err)
+	}
+	return nil
+}
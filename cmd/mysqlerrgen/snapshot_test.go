@@ -0,0 +1,118 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const generateSource = `package mysqlerr
+
+//go:generate go run ./cmd/mysqlerrgen -pkg mysqlerr84 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt
+//go:generate go run ./cmd/mysqlerrgen -pkg mysqlerr80 -history 8.4.2=https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.0.39/share/messages_to_clients.txt
+//go:generate go run ./cmd/mysqlerrgen -pkg client -input header -version 8.4.2 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/include/errmsg.h
+//go:generate go run ./cmd/mysqlerrgen -pkg tidb -input tidb -version 8.1 -url https://raw.githubusercontent.com/pingcap/tidb/986af29c533eaa836df0561fe8e81633e4644416/pkg/errno/errcode.go
+// https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt
+`
+
+func TestRewriteGenerate(t *testing.T) {
+	got, n := rewriteGenerate([]byte(generateSource), updateSources["mysql"], "mysql-8.4.3", "8.4.3")
+	if n != 2 {
+		t.Errorf("rewrote %d lines, want 2", n)
+	}
+	want := strings.NewReplacer(
+		"-pkg mysqlerr84 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/", "-pkg mysqlerr84 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.3/",
+		"-version 8.4.2 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/", "-version 8.4.3 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.3/",
+	).Replace(generateSource)
+	if string(got) != want {
+		t.Errorf("rewrote\n%s\nwant\n%s", got, want)
+	}
+
+	got, n = rewriteGenerate([]byte(generateSource), updateSources["tidb"], "0123456789abcdef0123456789abcdef01234567", "8.1.2")
+	if n != 1 {
+		t.Errorf("rewrote %d tidb lines, want 1", n)
+	}
+	want = strings.Replace(generateSource,
+		"-version 8.1 -url https://raw.githubusercontent.com/pingcap/tidb/986af29c533eaa836df0561fe8e81633e4644416/",
+		"-version 8.1.2 -url https://raw.githubusercontent.com/pingcap/tidb/0123456789abcdef0123456789abcdef01234567/", 1)
+	if string(got) != want {
+		t.Errorf("rewrote\n%s\nwant\n%s", got, want)
+	}
+
+	if _, n := rewriteGenerate([]byte(generateSource), updateSources["mysql"], "mysql-9.0.1", "9.0.1"); n != 0 {
+		t.Errorf("rewrote %d lines of another series", n)
+	}
+}
+
+func TestReadSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	url := "https://raw.githubusercontent.com/MariaDB/server/mariadb-11.4.3/sql/share/errmsg-utf8.txt"
+	p := filepath.Join(dir, "MariaDB", "server", "mariadb-11.4.3", "sql", "share", "errmsg-utf8.txt")
+	if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := readSnapshot(dir, url); ok || err != nil {
+		t.Errorf("missing snapshot: %v, %v", ok, err)
+	}
+	src := []byte("ER_A\n  eng \"a\"\n")
+	if err := os.WriteFile(p, src, 0666); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := readSnapshot(dir, url); err == nil {
+		t.Error("read a snapshot missing from SHA256SUMS")
+	}
+	key := "MariaDB/server/mariadb-11.4.3/sql/share/errmsg-utf8.txt"
+	if err := writeSums(dir, map[string]string{key: "0000"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := readSnapshot(dir, url); err == nil {
+		t.Error("read a snapshot whose sha256 changed")
+	}
+	if err := writeSums(dir, map[string]string{key: fmt.Sprintf("%x", sha256.Sum256(src))}); err != nil {
+		t.Fatal(err)
+	}
+	b, ok, err := readSnapshot(dir, url)
+	if !ok || err != nil || string(b) != string(src) {
+		t.Errorf("read %q, %v, %v", b, ok, err)
+	}
+}
+
+func TestSnapshotPath(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/include/errmsg.h", "mysql/mysql-server/mysql-8.4.2/include/errmsg.h"},
+		{"https://raw.githubusercontent.com/pingcap/tidb/v8.1.0/pkg/errno/errcode.go", "pingcap/tidb/v8.1.0/pkg/errno/errcode.go.txt"},
+	}
+	for _, tt := range tests {
+		got, err := snapshotPath(tt.url)
+		if err != nil || filepath.ToSlash(got) != tt.want {
+			t.Errorf("snapshotPath(%q) = %q, %v, want %q", tt.url, got, err, tt.want)
+		}
+	}
+	if _, err := snapshotPath("https://example.com/"); err == nil {
+		t.Error("snapshotPath of a url without path succeeded")
+	}
+}
+
+// TestCommittedSnapshots checks the snapshots of the repository against their SHA256SUMS.
+func TestCommittedSnapshots(t *testing.T) {
+	dir := filepath.Join("..", "..", "snapshots")
+	sums, err := readSums(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for p := range sums {
+		url := "https://raw.githubusercontent.com/" + p
+		if strings.HasSuffix(p, ".go"+goSnapshotExt) {
+			url = strings.TrimSuffix(url, goSnapshotExt)
+		}
+		if _, ok, err := readSnapshot(dir, url); !ok || err != nil {
+			t.Errorf("%s: %v, %v", p, ok, err)
+		}
+	}
+}
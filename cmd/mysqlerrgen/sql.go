@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+const sqlBatchSize = 100
+
+func writeSQL(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "-- Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(bw, "DROP TABLE IF EXISTS mysql_error_messages;")
+	fmt.Fprintln(bw, "DROP TABLE IF EXISTS mysql_errors;")
+	fmt.Fprintln(bw, `CREATE TABLE mysql_errors (
+  code INT UNSIGNED NOT NULL PRIMARY KEY,
+  name VARCHAR(128) NOT NULL,
+  sqlstate CHAR(5) NULL,
+  odbc_state CHAR(5) NULL,
+  obsolete BOOLEAN NOT NULL,
+  UNIQUE KEY (name)
+) DEFAULT CHARSET=utf8mb4;`)
+	fmt.Fprintln(bw, `CREATE TABLE mysql_error_messages (
+  code INT UNSIGNED NOT NULL,
+  lang VARCHAR(8) NOT NULL,
+  message TEXT NOT NULL,
+  PRIMARY KEY (code, lang),
+  FOREIGN KEY (code) REFERENCES mysql_errors (code)
+) DEFAULT CHARSET=utf8mb4;`)
+
+	var rows []string
+	for _, e := range cat.Errors {
+		rows = append(rows, fmt.Sprintf("(%d, %s, %s, %s, %t)", e.Code, sqlQuote(e.Name), sqlNullable(e.SQLState), sqlNullable(e.ODBCState), e.Obsolete))
+	}
+	writeInserts(bw, "mysql_errors (code, name, sqlstate, odbc_state, obsolete)", rows)
+
+	rows = rows[:0]
+	for _, e := range cat.Errors {
+		for _, m := range e.Messages {
+			rows = append(rows, fmt.Sprintf("(%d, %s, %s)", e.Code, sqlQuote(m.Lang), sqlQuote(m.Text)))
+		}
+	}
+	writeInserts(bw, "mysql_error_messages (code, lang, message)", rows)
+	return bw.Flush()
+}
+
+func writeInserts(w io.Writer, table string, rows []string) {
+	for len(rows) > 0 {
+		n := len(rows)
+		if n > sqlBatchSize {
+			n = sqlBatchSize
+		}
+		fmt.Fprintf(w, "INSERT INTO %s VALUES\n  %s;\n", table, strings.Join(rows[:n], ",\n  "))
+		rows = rows[n:]
+	}
+}
+
+var sqlEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `\'`,
+	"\x00", `\0`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\x1a", `\Z`,
+)
+
+func sqlQuote(s string) string {
+	return "'" + sqlEscaper.Replace(s) + "'"
+}
+
+func sqlNullable(s string) string {
+	if s == "" {
+		return "NULL"
+	}
+	return sqlQuote(s)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// subsystemRule assigns the errors whose name matches pattern, or whose code is in [min, max], to a server subsystem.
+// The first matching rule wins, so the more specific rules come first.
+type subsystemRule struct {
+	subsystem string
+	pattern   *regexp.Regexp
+	min, max  int
+}
+
+var subsystemRules = []subsystemRule{
+	{subsystem: "X Plugin", pattern: regexp.MustCompile(`^ER_X_`), min: 5000, max: 5999},
+	{subsystem: "Group Replication", pattern: regexp.MustCompile(`^ER_(GRP_|GROUP_REPLICATION_)`)},
+	{subsystem: "NDB", pattern: regexp.MustCompile(`NDB`)},
+	{subsystem: "InnoDB", pattern: regexp.MustCompile(`^ER_(IB_|INNODB_)|_INNODB_`)},
+	{subsystem: "Partitioning", pattern: regexp.MustCompile(`PARTITION|_IN_PF`)},
+	{subsystem: "Replication", pattern: regexp.MustCompile(`^ER_(SLAVE|REPLICA|MASTER|SOURCE|RPL|GTID|BINLOG|RELAY_LOG|CHANGE_MASTER|CHANGE_SOURCE|CHANGE_REPLICATION_SOURCE)_|_GTID|^ER_BAD_(SLAVE|REPLICA)`)},
+	{subsystem: "Keyring", pattern: regexp.MustCompile(`KEYRING`)},
+	{subsystem: "Clone", pattern: regexp.MustCompile(`^ER_CLONE_`)},
+	{subsystem: "Audit", pattern: regexp.MustCompile(`^ER_AUDIT_`)},
+	{subsystem: "Privileges", pattern: regexp.MustCompile(`ACCESS_DENIED|GRANT|PRIVILEGE|PASSWORD|^ER_(ROLE|CANT_CREATE_USER|CANNOT_USER)`)},
+	{subsystem: "Optimizer", pattern: regexp.MustCompile(`^ER_(OPTIMIZER|HINT|CTE|WINDOW|SUBQUERY|TOO_BIG_SELECT|TOO_HIGH_LEVEL_OF_NESTING_FOR_SELECT)`)},
+	{subsystem: "Stored Programs", pattern: regexp.MustCompile(`^ER_(SP|TRG|EVENT|VIEW)_`)},
+	{subsystem: "Full-Text Search", pattern: regexp.MustCompile(`^ER_FT_|FULLTEXT`)},
+	{subsystem: "JSON", pattern: regexp.MustCompile(`JSON`)},
+	{subsystem: "GIS", pattern: regexp.MustCompile(`^ER_(GIS|SRS)_|GEOMETRY`)},
+}
+
+func subsystemOf(e *parser.Error) string {
+	name := strings.TrimPrefix(e.Name, "OBSOLETE_")
+	for _, r := range subsystemRules {
+		if r.pattern.MatchString(name) || (r.max != 0 && r.min <= e.Code && e.Code <= r.max) {
+			return r.subsystem
+		}
+	}
+	return ""
+}
+
+// writeSubsystems writes the table of mysqlerr.Subsystem.
+func writeSubsystems(w io.Writer, cat *parser.Catalog, opts *exportOptions) error {
+	pkg := opts.pkg
+	if pkg == "" {
+		pkg = "mysqlerr"
+	}
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package", pkg)
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var subsystems = map[int]string{")
+	seen := map[int]bool{}
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		s := subsystemOf(e)
+		if s == "" || seen[e.Code] {
+			continue
+		}
+		seen[e.Code] = true
+		fmt.Fprintf(&buf, "%d: %q, // %s\n", e.Code, s, e.Name)
+	}
+	fmt.Fprintln(&buf, "}")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+type templateData struct {
+	Package         string
+	Source          string
+	Version         string
+	SHA256          string
+	GeneratedAt     string
+	Languages       []parser.Language
+	DefaultLanguage string
+	Errors          []parser.Error
+}
+
+var templateFuncs = template.FuncMap{
+	"quote": strconv.Quote,
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"join":  strings.Join,
+}
+
+func executeTemplate(name, pkg string, prov *provenance, cat *parser.Catalog) ([]byte, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	t, err := template.New(name).Funcs(templateFuncs).Parse(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+	var buf bytes.Buffer
+	err = t.Execute(&buf, &templateData{
+		Package:         pkg,
+		Source:          prov.source,
+		Version:         prov.version,
+		SHA256:          prov.sha256,
+		GeneratedAt:     prov.generatedAt.Format(time.RFC3339),
+		Languages:       cat.Languages,
+		DefaultLanguage: cat.DefaultLanguage,
+		Errors:          cat.Errors,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("execute: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func writeOutput(name string, b []byte) error {
+	if name == "" || name == "-" {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+	return os.WriteFile(name, b, 0666)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+func writeTypeScript(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "// Code generated mysqlerrgen DO NOT EDIT.")
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "export const enum MySQLErrorCode {")
+	for _, e := range cat.Errors {
+		if e.Obsolete {
+			fmt.Fprintln(bw, "  /** @deprecated */")
+		}
+		fmt.Fprintf(bw, "  %s = %d,\n", e.Name, e.Code)
+	}
+	fmt.Fprintln(bw, "}")
+	fmt.Fprintln(bw)
+	fmt.Fprintln(bw, "export const messages: Readonly<Record<number, string>> = {")
+	for i := range cat.Errors {
+		e := &cat.Errors[i]
+		if msg := e.Message(cat.DefaultLanguage); msg != "" {
+			fmt.Fprintf(bw, "  %d: %s,\n", e.Code, jsString(msg))
+		}
+	}
+	fmt.Fprintln(bw, "};")
+	return bw.Flush()
+}
+
+// jsString returns s as a string literal valid in JavaScript and its relatives.
+func jsString(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// maxMismatches is how many mismatches validateAgainst reports before eliding the rest.
+const maxMismatches = 20
+
+// validateAgainst cross-checks the symbols and numbers of the source with the defines of the header
+// the server was built with, such as include/mysqld_error.h, to catch a source whose numbering drifted.
+// Obsolete errors may be missing from the header, which comments them out, and the header defines outside
+// of the codes of the source, such as those of the error log, are ignored.
+func validateAgainst(cat, header *parser.Catalog) error {
+	byName := map[string]int{}
+	byCode := map[int]string{}
+	for _, e := range header.Errors {
+		byName[e.Name] = e.Code
+		byCode[e.Code] = e.Name
+	}
+	var mismatches []string
+	codes := map[int]bool{}
+	min, max := -1, -1
+	for _, e := range cat.Errors {
+		codes[e.Code] = true
+		if min < 0 || e.Code < min {
+			min = e.Code
+		}
+		if e.Code > max {
+			max = e.Code
+		}
+		if code, ok := byName[e.Name]; ok {
+			if code != e.Code {
+				mismatches = append(mismatches, fmt.Sprintf("%s is %d in the source, %d in the header", e.Name, e.Code, code))
+			}
+			continue
+		}
+		if name, ok := byCode[e.Code]; ok {
+			mismatches = append(mismatches, fmt.Sprintf("%d is %s in the source, %s in the header", e.Code, e.Name, name))
+			continue
+		}
+		if !e.Obsolete {
+			mismatches = append(mismatches, fmt.Sprintf("%s (%d) is not in the header", e.Name, e.Code))
+		}
+	}
+	for _, e := range header.Errors {
+		if min <= e.Code && e.Code <= max && !codes[e.Code] {
+			mismatches = append(mismatches, fmt.Sprintf("%s (%d) is only in the header", e.Name, e.Code))
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	n := len(mismatches)
+	if n > maxMismatches {
+		mismatches = append(mismatches[:maxMismatches], fmt.Sprintf("and %d more", n-maxMismatches))
+	}
+	return fmt.Errorf("%d mismatches with the header:\n\t%s", n, strings.Join(mismatches, "\n\t"))
+}
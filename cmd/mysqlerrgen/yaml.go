@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/orisano/mysqlerr/parser"
+)
+
+// writeYAML writes the catalog in the same shape as writeJSON.
+// Strings are always double-quoted; strconv.Quote escapes are valid in YAML double-quoted scalars.
+func writeYAML(w io.Writer, cat *parser.Catalog, _ *exportOptions) error {
+	bw := bufio.NewWriter(w)
+	if len(cat.Languages) == 0 {
+		fmt.Fprintln(bw, "languages: []")
+	} else {
+		fmt.Fprintln(bw, "languages:")
+	}
+	for _, l := range cat.Languages {
+		fmt.Fprintf(bw, "  - long_name: %s\n", strconv.Quote(l.LongName))
+		fmt.Fprintf(bw, "    short_name: %s\n", strconv.Quote(l.ShortName))
+		fmt.Fprintf(bw, "    charset: %s\n", strconv.Quote(l.Charset))
+	}
+	fmt.Fprintf(bw, "default_language: %s\n", strconv.Quote(cat.DefaultLanguage))
+	if len(cat.Errors) == 0 {
+		fmt.Fprintln(bw, "errors: []")
+	} else {
+		fmt.Fprintln(bw, "errors:")
+	}
+	for _, e := range cat.Errors {
+		fmt.Fprintf(bw, "  - name: %s\n", strconv.Quote(e.Name))
+		fmt.Fprintf(bw, "    code: %d\n", e.Code)
+		if e.SQLState != "" {
+			fmt.Fprintf(bw, "    sqlstate: %s\n", strconv.Quote(e.SQLState))
+		}
+		if e.ODBCState != "" {
+			fmt.Fprintf(bw, "    odbc_state: %s\n", strconv.Quote(e.ODBCState))
+		}
+		fmt.Fprintf(bw, "    obsolete: %t\n", e.Obsolete)
+		if e.Severity != "" {
+			fmt.Fprintf(bw, "    severity: %s\n", strconv.Quote(e.Severity))
+		}
+		if len(e.Messages) == 0 {
+			fmt.Fprintln(bw, "    messages: []")
+			continue
+		}
+		fmt.Fprintln(bw, "    messages:")
+		for _, m := range e.Messages {
+			fmt.Fprintf(bw, "      - lang: %s\n", strconv.Quote(m.Lang))
+			fmt.Fprintf(bw, "        text: %s\n", strconv.Quote(m.Text))
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,87 @@
+package mysqlerr
+
+var (
+	cantAggregate2Collations = MustCompileTemplate("Illegal mix of collations (%s,%s) and (%s,%s) for operation '%s'")
+	cantAggregate3Collations = MustCompileTemplate("Illegal mix of collations (%s,%s), (%s,%s), (%s,%s) for operation '%s'")
+	cantAggregateNCollations = MustCompileTemplate("Illegal mix of collations for operation '%s'")
+	unknownCharacterSet      = MustCompileTemplate("Unknown character set: '%-.64s'")
+	unknownCollation         = MustCompileTemplate("Unknown collation: '%-.64s'")
+	collationCharsetMismatch = MustCompileTemplate("COLLATION '%s' is not valid for CHARACTER SET '%s'")
+)
+
+// IsCollationMismatch reports whether a statement combined strings whose collations cannot be reconciled,
+// as happens when columns converted to utf8mb4 are compared with utf8mb3 ones or with constants of the connection charset,
+// or named a collation of another character set.
+func IsCollationMismatch(err error) bool {
+	switch Code(err) {
+	case ER_CANT_AGGREGATE_2COLLATIONS,
+		ER_CANT_AGGREGATE_3COLLATIONS,
+		ER_CANT_AGGREGATE_NCOLLATIONS,
+		ER_COLLATION_CHARSET_MISMATCH:
+		return true
+	}
+	return false
+}
+
+// CollationOperand is an operand of an illegal mix of collations, such as (utf8mb3_general_ci,IMPLICIT).
+type CollationOperand struct {
+	Collation string
+	// Coercibility is how strongly the operand holds its collation: EXPLICIT, IMPLICIT, COERCIBLE, SYSCONST, NUMERIC or IGNORABLE.
+	Coercibility string
+}
+
+// CollationDetail is the detail of a character set or collation error.
+type CollationDetail struct {
+	// Charset is the character set of ER_UNKNOWN_CHARACTER_SET and ER_COLLATION_CHARSET_MISMATCH.
+	Charset string
+	// Collation is the collation of ER_UNKNOWN_COLLATION and ER_COLLATION_CHARSET_MISMATCH.
+	Collation string
+	// Operands are the operands of ER_CANT_AGGREGATE_2COLLATIONS and ER_CANT_AGGREGATE_3COLLATIONS.
+	Operands []CollationOperand
+	// Operation is the operation of the ER_CANT_AGGREGATE_ errors, such as = or concat.
+	Operation string
+}
+
+// CollationDetailOf returns the detail of a collation mismatch, ER_UNKNOWN_CHARACTER_SET or ER_UNKNOWN_COLLATION.
+// It reports false if err is not one of them or its message is not understood.
+func CollationDetailOf(err error) (CollationDetail, bool) {
+	e, ok := FromError(err)
+	if !ok {
+		return CollationDetail{}, false
+	}
+	switch e.Number {
+	case ER_CANT_AGGREGATE_2COLLATIONS:
+		if v, ok := cantAggregate2Collations.Match(e.Message); ok {
+			return CollationDetail{Operands: collationOperands(v[:4]), Operation: v[4]}, true
+		}
+	case ER_CANT_AGGREGATE_3COLLATIONS:
+		if v, ok := cantAggregate3Collations.Match(e.Message); ok {
+			return CollationDetail{Operands: collationOperands(v[:6]), Operation: v[6]}, true
+		}
+	case ER_CANT_AGGREGATE_NCOLLATIONS:
+		if v, ok := cantAggregateNCollations.Match(e.Message); ok {
+			return CollationDetail{Operation: v[0]}, true
+		}
+	case ER_UNKNOWN_CHARACTER_SET:
+		if v, ok := unknownCharacterSet.Match(e.Message); ok {
+			return CollationDetail{Charset: v[0]}, true
+		}
+	case ER_UNKNOWN_COLLATION:
+		if v, ok := unknownCollation.Match(e.Message); ok {
+			return CollationDetail{Collation: v[0]}, true
+		}
+	case ER_COLLATION_CHARSET_MISMATCH:
+		if v, ok := collationCharsetMismatch.Match(e.Message); ok {
+			return CollationDetail{Collation: v[0], Charset: v[1]}, true
+		}
+	}
+	return CollationDetail{}, false
+}
+
+func collationOperands(v []string) []CollationOperand {
+	ops := make([]CollationOperand, 0, len(v)/2)
+	for i := 0; i+1 < len(v); i += 2 {
+		ops = append(ops, CollationOperand{Collation: v[i], Coercibility: v[i+1]})
+	}
+	return ops
+}
@@ -0,0 +1,51 @@
+package mysqlerr
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCollationDetailOf(t *testing.T) {
+	mix := &Error{Number: ER_CANT_AGGREGATE_2COLLATIONS, Message: "Illegal mix of collations (utf8mb4_0900_ai_ci,IMPLICIT) and (utf8mb3_general_ci,IMPLICIT) for operation '='"}
+	mixDetail := CollationDetail{
+		Operands:  []CollationOperand{{"utf8mb4_0900_ai_ci", "IMPLICIT"}, {"utf8mb3_general_ci", "IMPLICIT"}},
+		Operation: "=",
+	}
+	tests := []struct {
+		name     string
+		err      error
+		mismatch bool
+		want     *CollationDetail
+	}{
+		{"2 collations", mix, true, &mixDetail},
+		{"3 collations", &Error{Number: ER_CANT_AGGREGATE_3COLLATIONS, Message: "Illegal mix of collations (latin1_swedish_ci,IMPLICIT), (utf8mb4_bin,COERCIBLE), (utf8mb4_general_ci,EXPLICIT) for operation 'replace'"}, true,
+			&CollationDetail{Operands: []CollationOperand{{"latin1_swedish_ci", "IMPLICIT"}, {"utf8mb4_bin", "COERCIBLE"}, {"utf8mb4_general_ci", "EXPLICIT"}}, Operation: "replace"}},
+		{"n collations", &Error{Number: ER_CANT_AGGREGATE_NCOLLATIONS, Message: "Illegal mix of collations for operation 'IN'"}, true, &CollationDetail{Operation: "IN"}},
+		{"charset mismatch", &Error{Number: ER_COLLATION_CHARSET_MISMATCH, Message: "COLLATION 'latin1_bin' is not valid for CHARACTER SET 'utf8mb4'"}, true,
+			&CollationDetail{Collation: "latin1_bin", Charset: "utf8mb4"}},
+		{"unknown charset", &Error{Number: ER_UNKNOWN_CHARACTER_SET, Message: "Unknown character set: 'utf9'"}, false, &CollationDetail{Charset: "utf9"}},
+		{"unknown collation", &Error{Number: ER_UNKNOWN_COLLATION, Message: "Unknown collation: 'utf8mb4_nope'"}, false, &CollationDetail{Collation: "utf8mb4_nope"}},
+		{"MariaDB", &driverError{Number: 1267, Message: "Illegal mix of collations (utf8mb4_uca1400_ai_ci,IMPLICIT) and (latin1_swedish_ci,IMPLICIT) for operation '='"}, true,
+			&CollationDetail{Operands: []CollationOperand{{"utf8mb4_uca1400_ai_ci", "IMPLICIT"}, {"latin1_swedish_ci", "IMPLICIT"}}, Operation: "="}},
+		{"wrapped", fmt.Errorf("search: %w", mix), true, &mixDetail},
+		{"joined", joinError{errors.New("report"), mix}, true, &mixDetail},
+		{"unknown message", &Error{Number: ER_CANT_AGGREGATE_2COLLATIONS, Message: "collations"}, true, nil},
+		{"duplicate entry", &Error{Number: ER_DUP_ENTRY}, false, nil},
+		{"nil", nil, false, nil},
+	}
+	for _, tt := range tests {
+		if got := IsCollationMismatch(tt.err); got != tt.mismatch {
+			t.Errorf("%s: IsCollationMismatch = %v, want %v", tt.name, got, tt.mismatch)
+		}
+		got, ok := CollationDetailOf(tt.err)
+		if ok != (tt.want != nil) {
+			t.Errorf("%s: CollationDetailOf reported %v", tt.name, ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, *tt.want) {
+			t.Errorf("%s: CollationDetailOf = %+v, want %+v", tt.name, got, *tt.want)
+		}
+	}
+}
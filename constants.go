@@ -0,0 +1,2612 @@
+// Code generated mysqlerrgen DO NOT EDIT.
+// Copyright 2021-2023 Nao Yonashiro
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated documentation files (the “Software”), to deal in the Software without restriction, including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+package mysqlerr
+
+import "github.com/orisano/mysqlerr/mysqlerr84"
+
+// Deprecated: should not be used
+const ER_HASHCHK = mysqlerr84.ER_HASHCHK
+const OBSOLETE_ER_HASHCHK = mysqlerr84.OBSOLETE_ER_HASHCHK
+
+// Deprecated: should not be used
+const ER_NISAMCHK = mysqlerr84.ER_NISAMCHK
+const OBSOLETE_ER_NISAMCHK = mysqlerr84.OBSOLETE_ER_NISAMCHK
+const ER_NO = mysqlerr84.ER_NO
+const ER_YES = mysqlerr84.ER_YES
+const ER_CANT_CREATE_FILE = mysqlerr84.ER_CANT_CREATE_FILE
+const ER_CANT_CREATE_TABLE = mysqlerr84.ER_CANT_CREATE_TABLE
+const ER_CANT_CREATE_DB = mysqlerr84.ER_CANT_CREATE_DB
+const ER_DB_CREATE_EXISTS = mysqlerr84.ER_DB_CREATE_EXISTS
+const ER_DB_DROP_EXISTS = mysqlerr84.ER_DB_DROP_EXISTS
+
+// Deprecated: should not be used
+const ER_DB_DROP_DELETE = mysqlerr84.ER_DB_DROP_DELETE
+const OBSOLETE_ER_DB_DROP_DELETE = mysqlerr84.OBSOLETE_ER_DB_DROP_DELETE
+const ER_DB_DROP_RMDIR = mysqlerr84.ER_DB_DROP_RMDIR
+
+// Deprecated: should not be used
+const ER_CANT_DELETE_FILE = mysqlerr84.ER_CANT_DELETE_FILE
+const OBSOLETE_ER_CANT_DELETE_FILE = mysqlerr84.OBSOLETE_ER_CANT_DELETE_FILE
+const ER_CANT_FIND_SYSTEM_REC = mysqlerr84.ER_CANT_FIND_SYSTEM_REC
+const ER_CANT_GET_STAT = mysqlerr84.ER_CANT_GET_STAT
+
+// Deprecated: should not be used
+const ER_CANT_GET_WD = mysqlerr84.ER_CANT_GET_WD
+const OBSOLETE_ER_CANT_GET_WD = mysqlerr84.OBSOLETE_ER_CANT_GET_WD
+const ER_CANT_LOCK = mysqlerr84.ER_CANT_LOCK
+const ER_CANT_OPEN_FILE = mysqlerr84.ER_CANT_OPEN_FILE
+const ER_FILE_NOT_FOUND = mysqlerr84.ER_FILE_NOT_FOUND
+const ER_CANT_READ_DIR = mysqlerr84.ER_CANT_READ_DIR
+
+// Deprecated: should not be used
+const ER_CANT_SET_WD = mysqlerr84.ER_CANT_SET_WD
+const OBSOLETE_ER_CANT_SET_WD = mysqlerr84.OBSOLETE_ER_CANT_SET_WD
+const ER_CHECKREAD = mysqlerr84.ER_CHECKREAD
+
+// Deprecated: should not be used
+const ER_DISK_FULL = mysqlerr84.ER_DISK_FULL
+const OBSOLETE_ER_DISK_FULL = mysqlerr84.OBSOLETE_ER_DISK_FULL
+const ER_DUP_KEY = mysqlerr84.ER_DUP_KEY
+
+// Deprecated: should not be used
+const ER_ERROR_ON_CLOSE = mysqlerr84.ER_ERROR_ON_CLOSE
+const OBSOLETE_ER_ERROR_ON_CLOSE = mysqlerr84.OBSOLETE_ER_ERROR_ON_CLOSE
+const ER_ERROR_ON_READ = mysqlerr84.ER_ERROR_ON_READ
+const ER_ERROR_ON_RENAME = mysqlerr84.ER_ERROR_ON_RENAME
+const ER_ERROR_ON_WRITE = mysqlerr84.ER_ERROR_ON_WRITE
+const ER_FILE_USED = mysqlerr84.ER_FILE_USED
+
+// Deprecated: should not be used
+const ER_FILSORT_ABORT = mysqlerr84.ER_FILSORT_ABORT
+const OBSOLETE_ER_FILSORT_ABORT = mysqlerr84.OBSOLETE_ER_FILSORT_ABORT
+
+// Deprecated: should not be used
+const ER_FORM_NOT_FOUND = mysqlerr84.ER_FORM_NOT_FOUND
+const OBSOLETE_ER_FORM_NOT_FOUND = mysqlerr84.OBSOLETE_ER_FORM_NOT_FOUND
+const ER_GET_ERRNO = mysqlerr84.ER_GET_ERRNO
+const ER_ILLEGAL_HA = mysqlerr84.ER_ILLEGAL_HA
+const ER_KEY_NOT_FOUND = mysqlerr84.ER_KEY_NOT_FOUND
+const ER_NOT_FORM_FILE = mysqlerr84.ER_NOT_FORM_FILE
+const ER_NOT_KEYFILE = mysqlerr84.ER_NOT_KEYFILE
+const ER_OLD_KEYFILE = mysqlerr84.ER_OLD_KEYFILE
+const ER_OPEN_AS_READONLY = mysqlerr84.ER_OPEN_AS_READONLY
+const ER_OUTOFMEMORY = mysqlerr84.ER_OUTOFMEMORY
+const ER_OUT_OF_SORTMEMORY = mysqlerr84.ER_OUT_OF_SORTMEMORY
+
+// Deprecated: should not be used
+const ER_UNEXPECTED_EOF = mysqlerr84.ER_UNEXPECTED_EOF
+const OBSOLETE_ER_UNEXPECTED_EOF = mysqlerr84.OBSOLETE_ER_UNEXPECTED_EOF
+const ER_CON_COUNT_ERROR = mysqlerr84.ER_CON_COUNT_ERROR
+const ER_OUT_OF_RESOURCES = mysqlerr84.ER_OUT_OF_RESOURCES
+const ER_BAD_HOST_ERROR = mysqlerr84.ER_BAD_HOST_ERROR
+const ER_HANDSHAKE_ERROR = mysqlerr84.ER_HANDSHAKE_ERROR
+const ER_DBACCESS_DENIED_ERROR = mysqlerr84.ER_DBACCESS_DENIED_ERROR
+const ER_ACCESS_DENIED_ERROR = mysqlerr84.ER_ACCESS_DENIED_ERROR
+const ER_NO_DB_ERROR = mysqlerr84.ER_NO_DB_ERROR
+const ER_UNKNOWN_COM_ERROR = mysqlerr84.ER_UNKNOWN_COM_ERROR
+const ER_BAD_NULL_ERROR = mysqlerr84.ER_BAD_NULL_ERROR
+const ER_BAD_DB_ERROR = mysqlerr84.ER_BAD_DB_ERROR
+const ER_TABLE_EXISTS_ERROR = mysqlerr84.ER_TABLE_EXISTS_ERROR
+const ER_BAD_TABLE_ERROR = mysqlerr84.ER_BAD_TABLE_ERROR
+const ER_NON_UNIQ_ERROR = mysqlerr84.ER_NON_UNIQ_ERROR
+const ER_SERVER_SHUTDOWN = mysqlerr84.ER_SERVER_SHUTDOWN
+const ER_BAD_FIELD_ERROR = mysqlerr84.ER_BAD_FIELD_ERROR
+const ER_WRONG_FIELD_WITH_GROUP = mysqlerr84.ER_WRONG_FIELD_WITH_GROUP
+const ER_WRONG_GROUP_FIELD = mysqlerr84.ER_WRONG_GROUP_FIELD
+const ER_WRONG_SUM_SELECT = mysqlerr84.ER_WRONG_SUM_SELECT
+const ER_WRONG_VALUE_COUNT = mysqlerr84.ER_WRONG_VALUE_COUNT
+const ER_TOO_LONG_IDENT = mysqlerr84.ER_TOO_LONG_IDENT
+const ER_DUP_FIELDNAME = mysqlerr84.ER_DUP_FIELDNAME
+const ER_DUP_KEYNAME = mysqlerr84.ER_DUP_KEYNAME
+const ER_DUP_ENTRY = mysqlerr84.ER_DUP_ENTRY
+const ER_WRONG_FIELD_SPEC = mysqlerr84.ER_WRONG_FIELD_SPEC
+const ER_PARSE_ERROR = mysqlerr84.ER_PARSE_ERROR
+const ER_EMPTY_QUERY = mysqlerr84.ER_EMPTY_QUERY
+const ER_NONUNIQ_TABLE = mysqlerr84.ER_NONUNIQ_TABLE
+const ER_INVALID_DEFAULT = mysqlerr84.ER_INVALID_DEFAULT
+const ER_MULTIPLE_PRI_KEY = mysqlerr84.ER_MULTIPLE_PRI_KEY
+const ER_TOO_MANY_KEYS = mysqlerr84.ER_TOO_MANY_KEYS
+const ER_TOO_MANY_KEY_PARTS = mysqlerr84.ER_TOO_MANY_KEY_PARTS
+const ER_TOO_LONG_KEY = mysqlerr84.ER_TOO_LONG_KEY
+const ER_KEY_COLUMN_DOES_NOT_EXITS = mysqlerr84.ER_KEY_COLUMN_DOES_NOT_EXITS
+const ER_BLOB_USED_AS_KEY = mysqlerr84.ER_BLOB_USED_AS_KEY
+const ER_TOO_BIG_FIELDLENGTH = mysqlerr84.ER_TOO_BIG_FIELDLENGTH
+const ER_WRONG_AUTO_KEY = mysqlerr84.ER_WRONG_AUTO_KEY
+const ER_READY = mysqlerr84.ER_READY
+
+// Deprecated: should not be used
+const ER_NORMAL_SHUTDOWN = mysqlerr84.ER_NORMAL_SHUTDOWN
+const OBSOLETE_ER_NORMAL_SHUTDOWN = mysqlerr84.OBSOLETE_ER_NORMAL_SHUTDOWN
+
+// Deprecated: should not be used
+const ER_GOT_SIGNAL = mysqlerr84.ER_GOT_SIGNAL
+const OBSOLETE_ER_GOT_SIGNAL = mysqlerr84.OBSOLETE_ER_GOT_SIGNAL
+const ER_SHUTDOWN_COMPLETE = mysqlerr84.ER_SHUTDOWN_COMPLETE
+const ER_FORCING_CLOSE = mysqlerr84.ER_FORCING_CLOSE
+const ER_IPSOCK_ERROR = mysqlerr84.ER_IPSOCK_ERROR
+const ER_NO_SUCH_INDEX = mysqlerr84.ER_NO_SUCH_INDEX
+const ER_WRONG_FIELD_TERMINATORS = mysqlerr84.ER_WRONG_FIELD_TERMINATORS
+const ER_BLOBS_AND_NO_TERMINATED = mysqlerr84.ER_BLOBS_AND_NO_TERMINATED
+const ER_TEXTFILE_NOT_READABLE = mysqlerr84.ER_TEXTFILE_NOT_READABLE
+const ER_FILE_EXISTS_ERROR = mysqlerr84.ER_FILE_EXISTS_ERROR
+const ER_LOAD_INFO = mysqlerr84.ER_LOAD_INFO
+const ER_ALTER_INFO = mysqlerr84.ER_ALTER_INFO
+const ER_WRONG_SUB_KEY = mysqlerr84.ER_WRONG_SUB_KEY
+const ER_CANT_REMOVE_ALL_FIELDS = mysqlerr84.ER_CANT_REMOVE_ALL_FIELDS
+const ER_CANT_DROP_FIELD_OR_KEY = mysqlerr84.ER_CANT_DROP_FIELD_OR_KEY
+const ER_INSERT_INFO = mysqlerr84.ER_INSERT_INFO
+const ER_UPDATE_TABLE_USED = mysqlerr84.ER_UPDATE_TABLE_USED
+const ER_NO_SUCH_THREAD = mysqlerr84.ER_NO_SUCH_THREAD
+const ER_KILL_DENIED_ERROR = mysqlerr84.ER_KILL_DENIED_ERROR
+const ER_NO_TABLES_USED = mysqlerr84.ER_NO_TABLES_USED
+const ER_TOO_BIG_SET = mysqlerr84.ER_TOO_BIG_SET
+const ER_NO_UNIQUE_LOGFILE = mysqlerr84.ER_NO_UNIQUE_LOGFILE
+const ER_TABLE_NOT_LOCKED_FOR_WRITE = mysqlerr84.ER_TABLE_NOT_LOCKED_FOR_WRITE
+const ER_TABLE_NOT_LOCKED = mysqlerr84.ER_TABLE_NOT_LOCKED
+const ER_BLOB_CANT_HAVE_DEFAULT = mysqlerr84.ER_BLOB_CANT_HAVE_DEFAULT
+const ER_WRONG_DB_NAME = mysqlerr84.ER_WRONG_DB_NAME
+const ER_WRONG_TABLE_NAME = mysqlerr84.ER_WRONG_TABLE_NAME
+const ER_TOO_BIG_SELECT = mysqlerr84.ER_TOO_BIG_SELECT
+const ER_UNKNOWN_ERROR = mysqlerr84.ER_UNKNOWN_ERROR
+const ER_UNKNOWN_PROCEDURE = mysqlerr84.ER_UNKNOWN_PROCEDURE
+const ER_WRONG_PARAMCOUNT_TO_PROCEDURE = mysqlerr84.ER_WRONG_PARAMCOUNT_TO_PROCEDURE
+const ER_WRONG_PARAMETERS_TO_PROCEDURE = mysqlerr84.ER_WRONG_PARAMETERS_TO_PROCEDURE
+const ER_UNKNOWN_TABLE = mysqlerr84.ER_UNKNOWN_TABLE
+const ER_FIELD_SPECIFIED_TWICE = mysqlerr84.ER_FIELD_SPECIFIED_TWICE
+const ER_INVALID_GROUP_FUNC_USE = mysqlerr84.ER_INVALID_GROUP_FUNC_USE
+const ER_UNSUPPORTED_EXTENSION = mysqlerr84.ER_UNSUPPORTED_EXTENSION
+const ER_TABLE_MUST_HAVE_COLUMNS = mysqlerr84.ER_TABLE_MUST_HAVE_COLUMNS
+const ER_RECORD_FILE_FULL = mysqlerr84.ER_RECORD_FILE_FULL
+const ER_UNKNOWN_CHARACTER_SET = mysqlerr84.ER_UNKNOWN_CHARACTER_SET
+const ER_TOO_MANY_TABLES = mysqlerr84.ER_TOO_MANY_TABLES
+const ER_TOO_MANY_FIELDS = mysqlerr84.ER_TOO_MANY_FIELDS
+const ER_TOO_BIG_ROWSIZE = mysqlerr84.ER_TOO_BIG_ROWSIZE
+const ER_STACK_OVERRUN = mysqlerr84.ER_STACK_OVERRUN
+const ER_WRONG_OUTER_JOIN_UNUSED = mysqlerr84.ER_WRONG_OUTER_JOIN_UNUSED
+const ER_NULL_COLUMN_IN_INDEX = mysqlerr84.ER_NULL_COLUMN_IN_INDEX
+const ER_CANT_FIND_UDF = mysqlerr84.ER_CANT_FIND_UDF
+const ER_CANT_INITIALIZE_UDF = mysqlerr84.ER_CANT_INITIALIZE_UDF
+const ER_UDF_NO_PATHS = mysqlerr84.ER_UDF_NO_PATHS
+const ER_UDF_EXISTS = mysqlerr84.ER_UDF_EXISTS
+const ER_CANT_OPEN_LIBRARY = mysqlerr84.ER_CANT_OPEN_LIBRARY
+const ER_CANT_FIND_DL_ENTRY = mysqlerr84.ER_CANT_FIND_DL_ENTRY
+const ER_FUNCTION_NOT_DEFINED = mysqlerr84.ER_FUNCTION_NOT_DEFINED
+const ER_HOST_IS_BLOCKED = mysqlerr84.ER_HOST_IS_BLOCKED
+const ER_HOST_NOT_PRIVILEGED = mysqlerr84.ER_HOST_NOT_PRIVILEGED
+const ER_PASSWORD_ANONYMOUS_USER = mysqlerr84.ER_PASSWORD_ANONYMOUS_USER
+const ER_PASSWORD_NOT_ALLOWED = mysqlerr84.ER_PASSWORD_NOT_ALLOWED
+const ER_PASSWORD_NO_MATCH = mysqlerr84.ER_PASSWORD_NO_MATCH
+const ER_UPDATE_INFO = mysqlerr84.ER_UPDATE_INFO
+const ER_CANT_CREATE_THREAD = mysqlerr84.ER_CANT_CREATE_THREAD
+const ER_WRONG_VALUE_COUNT_ON_ROW = mysqlerr84.ER_WRONG_VALUE_COUNT_ON_ROW
+const ER_CANT_REOPEN_TABLE = mysqlerr84.ER_CANT_REOPEN_TABLE
+const ER_INVALID_USE_OF_NULL = mysqlerr84.ER_INVALID_USE_OF_NULL
+const ER_REGEXP_ERROR = mysqlerr84.ER_REGEXP_ERROR
+const ER_MIX_OF_GROUP_FUNC_AND_FIELDS = mysqlerr84.ER_MIX_OF_GROUP_FUNC_AND_FIELDS
+const ER_NONEXISTING_GRANT = mysqlerr84.ER_NONEXISTING_GRANT
+const ER_TABLEACCESS_DENIED_ERROR = mysqlerr84.ER_TABLEACCESS_DENIED_ERROR
+const ER_COLUMNACCESS_DENIED_ERROR = mysqlerr84.ER_COLUMNACCESS_DENIED_ERROR
+const ER_ILLEGAL_GRANT_FOR_TABLE = mysqlerr84.ER_ILLEGAL_GRANT_FOR_TABLE
+const ER_GRANT_WRONG_HOST_OR_USER = mysqlerr84.ER_GRANT_WRONG_HOST_OR_USER
+const ER_NO_SUCH_TABLE = mysqlerr84.ER_NO_SUCH_TABLE
+const ER_NONEXISTING_TABLE_GRANT = mysqlerr84.ER_NONEXISTING_TABLE_GRANT
+const ER_NOT_ALLOWED_COMMAND = mysqlerr84.ER_NOT_ALLOWED_COMMAND
+const ER_SYNTAX_ERROR = mysqlerr84.ER_SYNTAX_ERROR
+
+// Deprecated: should not be used
+const ER_UNUSED1 = mysqlerr84.ER_UNUSED1
+const OBSOLETE_ER_UNUSED1 = mysqlerr84.OBSOLETE_ER_UNUSED1
+
+// Deprecated: should not be used
+const ER_UNUSED2 = mysqlerr84.ER_UNUSED2
+const OBSOLETE_ER_UNUSED2 = mysqlerr84.OBSOLETE_ER_UNUSED2
+const ER_ABORTING_CONNECTION = mysqlerr84.ER_ABORTING_CONNECTION
+const ER_NET_PACKET_TOO_LARGE = mysqlerr84.ER_NET_PACKET_TOO_LARGE
+const ER_NET_READ_ERROR_FROM_PIPE = mysqlerr84.ER_NET_READ_ERROR_FROM_PIPE
+const ER_NET_FCNTL_ERROR = mysqlerr84.ER_NET_FCNTL_ERROR
+const ER_NET_PACKETS_OUT_OF_ORDER = mysqlerr84.ER_NET_PACKETS_OUT_OF_ORDER
+const ER_NET_UNCOMPRESS_ERROR = mysqlerr84.ER_NET_UNCOMPRESS_ERROR
+const ER_NET_READ_ERROR = mysqlerr84.ER_NET_READ_ERROR
+const ER_NET_READ_INTERRUPTED = mysqlerr84.ER_NET_READ_INTERRUPTED
+const ER_NET_ERROR_ON_WRITE = mysqlerr84.ER_NET_ERROR_ON_WRITE
+const ER_NET_WRITE_INTERRUPTED = mysqlerr84.ER_NET_WRITE_INTERRUPTED
+const ER_TOO_LONG_STRING = mysqlerr84.ER_TOO_LONG_STRING
+const ER_TABLE_CANT_HANDLE_BLOB = mysqlerr84.ER_TABLE_CANT_HANDLE_BLOB
+const ER_TABLE_CANT_HANDLE_AUTO_INCREMENT = mysqlerr84.ER_TABLE_CANT_HANDLE_AUTO_INCREMENT
+
+// Deprecated: should not be used
+const ER_UNUSED3 = mysqlerr84.ER_UNUSED3
+const OBSOLETE_ER_UNUSED3 = mysqlerr84.OBSOLETE_ER_UNUSED3
+const ER_WRONG_COLUMN_NAME = mysqlerr84.ER_WRONG_COLUMN_NAME
+const ER_WRONG_KEY_COLUMN = mysqlerr84.ER_WRONG_KEY_COLUMN
+const ER_WRONG_MRG_TABLE = mysqlerr84.ER_WRONG_MRG_TABLE
+const ER_DUP_UNIQUE = mysqlerr84.ER_DUP_UNIQUE
+const ER_BLOB_KEY_WITHOUT_LENGTH = mysqlerr84.ER_BLOB_KEY_WITHOUT_LENGTH
+const ER_PRIMARY_CANT_HAVE_NULL = mysqlerr84.ER_PRIMARY_CANT_HAVE_NULL
+const ER_TOO_MANY_ROWS = mysqlerr84.ER_TOO_MANY_ROWS
+const ER_REQUIRES_PRIMARY_KEY = mysqlerr84.ER_REQUIRES_PRIMARY_KEY
+
+// Deprecated: should not be used
+const ER_NO_RAID_COMPILED = mysqlerr84.ER_NO_RAID_COMPILED
+const OBSOLETE_ER_NO_RAID_COMPILED = mysqlerr84.OBSOLETE_ER_NO_RAID_COMPILED
+const ER_UPDATE_WITHOUT_KEY_IN_SAFE_MODE = mysqlerr84.ER_UPDATE_WITHOUT_KEY_IN_SAFE_MODE
+const ER_KEY_DOES_NOT_EXITS = mysqlerr84.ER_KEY_DOES_NOT_EXITS
+const ER_CHECK_NO_SUCH_TABLE = mysqlerr84.ER_CHECK_NO_SUCH_TABLE
+const ER_CHECK_NOT_IMPLEMENTED = mysqlerr84.ER_CHECK_NOT_IMPLEMENTED
+const ER_CANT_DO_THIS_DURING_AN_TRANSACTION = mysqlerr84.ER_CANT_DO_THIS_DURING_AN_TRANSACTION
+const ER_ERROR_DURING_COMMIT = mysqlerr84.ER_ERROR_DURING_COMMIT
+const ER_ERROR_DURING_ROLLBACK = mysqlerr84.ER_ERROR_DURING_ROLLBACK
+const ER_ERROR_DURING_FLUSH_LOGS = mysqlerr84.ER_ERROR_DURING_FLUSH_LOGS
+
+// Deprecated: should not be used
+const ER_ERROR_DURING_CHECKPOINT = mysqlerr84.ER_ERROR_DURING_CHECKPOINT
+const OBSOLETE_ER_ERROR_DURING_CHECKPOINT = mysqlerr84.OBSOLETE_ER_ERROR_DURING_CHECKPOINT
+const ER_NEW_ABORTING_CONNECTION = mysqlerr84.ER_NEW_ABORTING_CONNECTION
+
+// Deprecated: should not be used
+const ER_DUMP_NOT_IMPLEMENTED = mysqlerr84.ER_DUMP_NOT_IMPLEMENTED
+const OBSOLETE_ER_DUMP_NOT_IMPLEMENTED = mysqlerr84.OBSOLETE_ER_DUMP_NOT_IMPLEMENTED
+
+// Deprecated: should not be used
+const ER_FLUSH_MASTER_BINLOG_CLOSED = mysqlerr84.ER_FLUSH_MASTER_BINLOG_CLOSED
+const OBSOLETE_ER_FLUSH_MASTER_BINLOG_CLOSED = mysqlerr84.OBSOLETE_ER_FLUSH_MASTER_BINLOG_CLOSED
+
+// Deprecated: should not be used
+const ER_INDEX_REBUILD = mysqlerr84.ER_INDEX_REBUILD
+const OBSOLETE_ER_INDEX_REBUILD = mysqlerr84.OBSOLETE_ER_INDEX_REBUILD
+
+// Deprecated: should not be used
+const ER_MASTER = mysqlerr84.ER_MASTER
+const ER_SOURCE = mysqlerr84.ER_SOURCE
+
+// Deprecated: should not be used
+const ER_MASTER_NET_READ = mysqlerr84.ER_MASTER_NET_READ
+const ER_SOURCE_NET_READ = mysqlerr84.ER_SOURCE_NET_READ
+
+// Deprecated: should not be used
+const ER_MASTER_NET_WRITE = mysqlerr84.ER_MASTER_NET_WRITE
+const ER_SOURCE_NET_WRITE = mysqlerr84.ER_SOURCE_NET_WRITE
+const ER_FT_MATCHING_KEY_NOT_FOUND = mysqlerr84.ER_FT_MATCHING_KEY_NOT_FOUND
+const ER_LOCK_OR_ACTIVE_TRANSACTION = mysqlerr84.ER_LOCK_OR_ACTIVE_TRANSACTION
+const ER_UNKNOWN_SYSTEM_VARIABLE = mysqlerr84.ER_UNKNOWN_SYSTEM_VARIABLE
+const ER_CRASHED_ON_USAGE = mysqlerr84.ER_CRASHED_ON_USAGE
+const ER_CRASHED_ON_REPAIR = mysqlerr84.ER_CRASHED_ON_REPAIR
+const ER_WARNING_NOT_COMPLETE_ROLLBACK = mysqlerr84.ER_WARNING_NOT_COMPLETE_ROLLBACK
+const ER_TRANS_CACHE_FULL = mysqlerr84.ER_TRANS_CACHE_FULL
+
+// Deprecated: should not be used
+const ER_SLAVE_MUST_STOP = mysqlerr84.ER_SLAVE_MUST_STOP
+const OBSOLETE_ER_SLAVE_MUST_STOP = mysqlerr84.OBSOLETE_ER_SLAVE_MUST_STOP
+
+// Deprecated: should not be used
+const ER_SLAVE_NOT_RUNNING = mysqlerr84.ER_SLAVE_NOT_RUNNING
+const ER_REPLICA_NOT_RUNNING = mysqlerr84.ER_REPLICA_NOT_RUNNING
+
+// Deprecated: should not be used
+const ER_BAD_SLAVE = mysqlerr84.ER_BAD_SLAVE
+const ER_BAD_REPLICA = mysqlerr84.ER_BAD_REPLICA
+
+// Deprecated: should not be used
+const ER_MASTER_INFO = mysqlerr84.ER_MASTER_INFO
+const ER_CONNECTION_METADATA = mysqlerr84.ER_CONNECTION_METADATA
+
+// Deprecated: should not be used
+const ER_SLAVE_THREAD = mysqlerr84.ER_SLAVE_THREAD
+const ER_REPLICA_THREAD = mysqlerr84.ER_REPLICA_THREAD
+const ER_TOO_MANY_USER_CONNECTIONS = mysqlerr84.ER_TOO_MANY_USER_CONNECTIONS
+const ER_SET_CONSTANTS_ONLY = mysqlerr84.ER_SET_CONSTANTS_ONLY
+const ER_LOCK_WAIT_TIMEOUT = mysqlerr84.ER_LOCK_WAIT_TIMEOUT
+const ER_LOCK_TABLE_FULL = mysqlerr84.ER_LOCK_TABLE_FULL
+const ER_READ_ONLY_TRANSACTION = mysqlerr84.ER_READ_ONLY_TRANSACTION
+
+// Deprecated: should not be used
+const ER_DROP_DB_WITH_READ_LOCK = mysqlerr84.ER_DROP_DB_WITH_READ_LOCK
+const OBSOLETE_ER_DROP_DB_WITH_READ_LOCK = mysqlerr84.OBSOLETE_ER_DROP_DB_WITH_READ_LOCK
+
+// Deprecated: should not be used
+const ER_CREATE_DB_WITH_READ_LOCK = mysqlerr84.ER_CREATE_DB_WITH_READ_LOCK
+const OBSOLETE_ER_CREATE_DB_WITH_READ_LOCK = mysqlerr84.OBSOLETE_ER_CREATE_DB_WITH_READ_LOCK
+const ER_WRONG_ARGUMENTS = mysqlerr84.ER_WRONG_ARGUMENTS
+const ER_NO_PERMISSION_TO_CREATE_USER = mysqlerr84.ER_NO_PERMISSION_TO_CREATE_USER
+
+// Deprecated: should not be used
+const ER_UNION_TABLES_IN_DIFFERENT_DIR = mysqlerr84.ER_UNION_TABLES_IN_DIFFERENT_DIR
+const OBSOLETE_ER_UNION_TABLES_IN_DIFFERENT_DIR = mysqlerr84.OBSOLETE_ER_UNION_TABLES_IN_DIFFERENT_DIR
+const ER_LOCK_DEADLOCK = mysqlerr84.ER_LOCK_DEADLOCK
+const ER_TABLE_CANT_HANDLE_FT = mysqlerr84.ER_TABLE_CANT_HANDLE_FT
+const ER_CANNOT_ADD_FOREIGN = mysqlerr84.ER_CANNOT_ADD_FOREIGN
+const ER_NO_REFERENCED_ROW = mysqlerr84.ER_NO_REFERENCED_ROW
+const ER_ROW_IS_REFERENCED = mysqlerr84.ER_ROW_IS_REFERENCED
+
+// Deprecated: should not be used
+const ER_CONNECT_TO_MASTER = mysqlerr84.ER_CONNECT_TO_MASTER
+const ER_CONNECT_TO_SOURCE = mysqlerr84.ER_CONNECT_TO_SOURCE
+
+// Deprecated: should not be used
+const ER_QUERY_ON_MASTER = mysqlerr84.ER_QUERY_ON_MASTER
+const OBSOLETE_ER_QUERY_ON_MASTER = mysqlerr84.OBSOLETE_ER_QUERY_ON_MASTER
+const ER_ERROR_WHEN_EXECUTING_COMMAND = mysqlerr84.ER_ERROR_WHEN_EXECUTING_COMMAND
+const ER_WRONG_USAGE = mysqlerr84.ER_WRONG_USAGE
+const ER_WRONG_NUMBER_OF_COLUMNS_IN_SELECT = mysqlerr84.ER_WRONG_NUMBER_OF_COLUMNS_IN_SELECT
+const ER_CANT_UPDATE_WITH_READLOCK = mysqlerr84.ER_CANT_UPDATE_WITH_READLOCK
+const ER_MIXING_NOT_ALLOWED = mysqlerr84.ER_MIXING_NOT_ALLOWED
+const ER_DUP_ARGUMENT = mysqlerr84.ER_DUP_ARGUMENT
+const ER_USER_LIMIT_REACHED = mysqlerr84.ER_USER_LIMIT_REACHED
+const ER_SPECIFIC_ACCESS_DENIED_ERROR = mysqlerr84.ER_SPECIFIC_ACCESS_DENIED_ERROR
+const ER_LOCAL_VARIABLE = mysqlerr84.ER_LOCAL_VARIABLE
+const ER_GLOBAL_VARIABLE = mysqlerr84.ER_GLOBAL_VARIABLE
+const ER_NO_DEFAULT = mysqlerr84.ER_NO_DEFAULT
+const ER_WRONG_VALUE_FOR_VAR = mysqlerr84.ER_WRONG_VALUE_FOR_VAR
+const ER_WRONG_TYPE_FOR_VAR = mysqlerr84.ER_WRONG_TYPE_FOR_VAR
+const ER_VAR_CANT_BE_READ = mysqlerr84.ER_VAR_CANT_BE_READ
+const ER_CANT_USE_OPTION_HERE = mysqlerr84.ER_CANT_USE_OPTION_HERE
+const ER_NOT_SUPPORTED_YET = mysqlerr84.ER_NOT_SUPPORTED_YET
+
+// Deprecated: should not be used
+const ER_MASTER_FATAL_ERROR_READING_BINLOG = mysqlerr84.ER_MASTER_FATAL_ERROR_READING_BINLOG
+const ER_SOURCE_FATAL_ERROR_READING_BINLOG = mysqlerr84.ER_SOURCE_FATAL_ERROR_READING_BINLOG
+
+// Deprecated: should not be used
+const ER_SLAVE_IGNORED_TABLE = mysqlerr84.ER_SLAVE_IGNORED_TABLE
+const ER_REPLICA_IGNORED_TABLE = mysqlerr84.ER_REPLICA_IGNORED_TABLE
+const ER_INCORRECT_GLOBAL_LOCAL_VAR = mysqlerr84.ER_INCORRECT_GLOBAL_LOCAL_VAR
+const ER_WRONG_FK_DEF = mysqlerr84.ER_WRONG_FK_DEF
+const ER_KEY_REF_DO_NOT_MATCH_TABLE_REF = mysqlerr84.ER_KEY_REF_DO_NOT_MATCH_TABLE_REF
+const ER_OPERAND_COLUMNS = mysqlerr84.ER_OPERAND_COLUMNS
+const ER_SUBQUERY_NO_1_ROW = mysqlerr84.ER_SUBQUERY_NO_1_ROW
+const ER_UNKNOWN_STMT_HANDLER = mysqlerr84.ER_UNKNOWN_STMT_HANDLER
+const ER_CORRUPT_HELP_DB = mysqlerr84.ER_CORRUPT_HELP_DB
+
+// Deprecated: should not be used
+const ER_CYCLIC_REFERENCE = mysqlerr84.ER_CYCLIC_REFERENCE
+const OBSOLETE_ER_CYCLIC_REFERENCE = mysqlerr84.OBSOLETE_ER_CYCLIC_REFERENCE
+const ER_AUTO_CONVERT = mysqlerr84.ER_AUTO_CONVERT
+const ER_ILLEGAL_REFERENCE = mysqlerr84.ER_ILLEGAL_REFERENCE
+const ER_DERIVED_MUST_HAVE_ALIAS = mysqlerr84.ER_DERIVED_MUST_HAVE_ALIAS
+const ER_SELECT_REDUCED = mysqlerr84.ER_SELECT_REDUCED
+const ER_TABLENAME_NOT_ALLOWED_HERE = mysqlerr84.ER_TABLENAME_NOT_ALLOWED_HERE
+const ER_NOT_SUPPORTED_AUTH_MODE = mysqlerr84.ER_NOT_SUPPORTED_AUTH_MODE
+const ER_SPATIAL_CANT_HAVE_NULL = mysqlerr84.ER_SPATIAL_CANT_HAVE_NULL
+const ER_COLLATION_CHARSET_MISMATCH = mysqlerr84.ER_COLLATION_CHARSET_MISMATCH
+
+// Deprecated: should not be used
+const ER_SLAVE_WAS_RUNNING = mysqlerr84.ER_SLAVE_WAS_RUNNING
+const OBSOLETE_ER_SLAVE_WAS_RUNNING = mysqlerr84.OBSOLETE_ER_SLAVE_WAS_RUNNING
+
+// Deprecated: should not be used
+const ER_SLAVE_WAS_NOT_RUNNING = mysqlerr84.ER_SLAVE_WAS_NOT_RUNNING
+const OBSOLETE_ER_SLAVE_WAS_NOT_RUNNING = mysqlerr84.OBSOLETE_ER_SLAVE_WAS_NOT_RUNNING
+const ER_TOO_BIG_FOR_UNCOMPRESS = mysqlerr84.ER_TOO_BIG_FOR_UNCOMPRESS
+const ER_ZLIB_Z_MEM_ERROR = mysqlerr84.ER_ZLIB_Z_MEM_ERROR
+const ER_ZLIB_Z_BUF_ERROR = mysqlerr84.ER_ZLIB_Z_BUF_ERROR
+const ER_ZLIB_Z_DATA_ERROR = mysqlerr84.ER_ZLIB_Z_DATA_ERROR
+const ER_CUT_VALUE_GROUP_CONCAT = mysqlerr84.ER_CUT_VALUE_GROUP_CONCAT
+const ER_WARN_TOO_FEW_RECORDS = mysqlerr84.ER_WARN_TOO_FEW_RECORDS
+const ER_WARN_TOO_MANY_RECORDS = mysqlerr84.ER_WARN_TOO_MANY_RECORDS
+const ER_WARN_NULL_TO_NOTNULL = mysqlerr84.ER_WARN_NULL_TO_NOTNULL
+const ER_WARN_DATA_OUT_OF_RANGE = mysqlerr84.ER_WARN_DATA_OUT_OF_RANGE
+const WARN_DATA_TRUNCATED = mysqlerr84.WARN_DATA_TRUNCATED
+const ER_WARN_USING_OTHER_HANDLER = mysqlerr84.ER_WARN_USING_OTHER_HANDLER
+const ER_CANT_AGGREGATE_2COLLATIONS = mysqlerr84.ER_CANT_AGGREGATE_2COLLATIONS
+
+// Deprecated: should not be used
+const ER_DROP_USER = mysqlerr84.ER_DROP_USER
+const OBSOLETE_ER_DROP_USER = mysqlerr84.OBSOLETE_ER_DROP_USER
+const ER_REVOKE_GRANTS = mysqlerr84.ER_REVOKE_GRANTS
+const ER_CANT_AGGREGATE_3COLLATIONS = mysqlerr84.ER_CANT_AGGREGATE_3COLLATIONS
+const ER_CANT_AGGREGATE_NCOLLATIONS = mysqlerr84.ER_CANT_AGGREGATE_NCOLLATIONS
+const ER_VARIABLE_IS_NOT_STRUCT = mysqlerr84.ER_VARIABLE_IS_NOT_STRUCT
+const ER_UNKNOWN_COLLATION = mysqlerr84.ER_UNKNOWN_COLLATION
+
+// Deprecated: should not be used
+const ER_SLAVE_IGNORED_SSL_PARAMS = mysqlerr84.ER_SLAVE_IGNORED_SSL_PARAMS
+const ER_REPLICA_IGNORED_SSL_PARAMS = mysqlerr84.ER_REPLICA_IGNORED_SSL_PARAMS
+
+// Deprecated: should not be used
+const ER_SERVER_IS_IN_SECURE_AUTH_MODE = mysqlerr84.ER_SERVER_IS_IN_SECURE_AUTH_MODE
+const OBSOLETE_ER_SERVER_IS_IN_SECURE_AUTH_MODE = mysqlerr84.OBSOLETE_ER_SERVER_IS_IN_SECURE_AUTH_MODE
+const ER_WARN_FIELD_RESOLVED = mysqlerr84.ER_WARN_FIELD_RESOLVED
+
+// Deprecated: should not be used
+const ER_BAD_SLAVE_UNTIL_COND = mysqlerr84.ER_BAD_SLAVE_UNTIL_COND
+const ER_BAD_REPLICA_UNTIL_COND = mysqlerr84.ER_BAD_REPLICA_UNTIL_COND
+
+// Deprecated: should not be used
+const ER_MISSING_SKIP_SLAVE = mysqlerr84.ER_MISSING_SKIP_SLAVE
+const ER_MISSING_SKIP_REPLICA = mysqlerr84.ER_MISSING_SKIP_REPLICA
+const ER_UNTIL_COND_IGNORED = mysqlerr84.ER_UNTIL_COND_IGNORED
+const ER_WRONG_NAME_FOR_INDEX = mysqlerr84.ER_WRONG_NAME_FOR_INDEX
+const ER_WRONG_NAME_FOR_CATALOG = mysqlerr84.ER_WRONG_NAME_FOR_CATALOG
+
+// Deprecated: should not be used
+const ER_WARN_QC_RESIZE = mysqlerr84.ER_WARN_QC_RESIZE
+const OBSOLETE_ER_WARN_QC_RESIZE = mysqlerr84.OBSOLETE_ER_WARN_QC_RESIZE
+const ER_BAD_FT_COLUMN = mysqlerr84.ER_BAD_FT_COLUMN
+const ER_UNKNOWN_KEY_CACHE = mysqlerr84.ER_UNKNOWN_KEY_CACHE
+const ER_WARN_HOSTNAME_WONT_WORK = mysqlerr84.ER_WARN_HOSTNAME_WONT_WORK
+const ER_UNKNOWN_STORAGE_ENGINE = mysqlerr84.ER_UNKNOWN_STORAGE_ENGINE
+const ER_WARN_DEPRECATED_SYNTAX = mysqlerr84.ER_WARN_DEPRECATED_SYNTAX
+const ER_NON_UPDATABLE_TABLE = mysqlerr84.ER_NON_UPDATABLE_TABLE
+const ER_FEATURE_DISABLED = mysqlerr84.ER_FEATURE_DISABLED
+const ER_OPTION_PREVENTS_STATEMENT = mysqlerr84.ER_OPTION_PREVENTS_STATEMENT
+const ER_DUPLICATED_VALUE_IN_TYPE = mysqlerr84.ER_DUPLICATED_VALUE_IN_TYPE
+const ER_TRUNCATED_WRONG_VALUE = mysqlerr84.ER_TRUNCATED_WRONG_VALUE
+
+// Deprecated: should not be used
+const ER_TOO_MUCH_AUTO_TIMESTAMP_COLS = mysqlerr84.ER_TOO_MUCH_AUTO_TIMESTAMP_COLS
+const OBSOLETE_ER_TOO_MUCH_AUTO_TIMESTAMP_COLS = mysqlerr84.OBSOLETE_ER_TOO_MUCH_AUTO_TIMESTAMP_COLS
+const ER_INVALID_ON_UPDATE = mysqlerr84.ER_INVALID_ON_UPDATE
+const ER_UNSUPPORTED_PS = mysqlerr84.ER_UNSUPPORTED_PS
+const ER_GET_ERRMSG = mysqlerr84.ER_GET_ERRMSG
+const ER_GET_TEMPORARY_ERRMSG = mysqlerr84.ER_GET_TEMPORARY_ERRMSG
+const ER_UNKNOWN_TIME_ZONE = mysqlerr84.ER_UNKNOWN_TIME_ZONE
+const ER_WARN_INVALID_TIMESTAMP = mysqlerr84.ER_WARN_INVALID_TIMESTAMP
+const ER_INVALID_CHARACTER_STRING = mysqlerr84.ER_INVALID_CHARACTER_STRING
+const ER_WARN_ALLOWED_PACKET_OVERFLOWED = mysqlerr84.ER_WARN_ALLOWED_PACKET_OVERFLOWED
+const ER_CONFLICTING_DECLARATIONS = mysqlerr84.ER_CONFLICTING_DECLARATIONS
+const ER_SP_NO_RECURSIVE_CREATE = mysqlerr84.ER_SP_NO_RECURSIVE_CREATE
+const ER_SP_ALREADY_EXISTS = mysqlerr84.ER_SP_ALREADY_EXISTS
+const ER_SP_DOES_NOT_EXIST = mysqlerr84.ER_SP_DOES_NOT_EXIST
+const ER_SP_DROP_FAILED = mysqlerr84.ER_SP_DROP_FAILED
+const ER_SP_STORE_FAILED = mysqlerr84.ER_SP_STORE_FAILED
+const ER_SP_LILABEL_MISMATCH = mysqlerr84.ER_SP_LILABEL_MISMATCH
+const ER_SP_LABEL_REDEFINE = mysqlerr84.ER_SP_LABEL_REDEFINE
+const ER_SP_LABEL_MISMATCH = mysqlerr84.ER_SP_LABEL_MISMATCH
+const ER_SP_UNINIT_VAR = mysqlerr84.ER_SP_UNINIT_VAR
+const ER_SP_BADSELECT = mysqlerr84.ER_SP_BADSELECT
+const ER_SP_BADRETURN = mysqlerr84.ER_SP_BADRETURN
+const ER_SP_BADSTATEMENT = mysqlerr84.ER_SP_BADSTATEMENT
+const ER_UPDATE_LOG_DEPRECATED_IGNORED = mysqlerr84.ER_UPDATE_LOG_DEPRECATED_IGNORED
+const ER_UPDATE_LOG_DEPRECATED_TRANSLATED = mysqlerr84.ER_UPDATE_LOG_DEPRECATED_TRANSLATED
+const ER_QUERY_INTERRUPTED = mysqlerr84.ER_QUERY_INTERRUPTED
+const ER_SP_WRONG_NO_OF_ARGS = mysqlerr84.ER_SP_WRONG_NO_OF_ARGS
+const ER_SP_COND_MISMATCH = mysqlerr84.ER_SP_COND_MISMATCH
+const ER_SP_NORETURN = mysqlerr84.ER_SP_NORETURN
+const ER_SP_NORETURNEND = mysqlerr84.ER_SP_NORETURNEND
+const ER_SP_BAD_CURSOR_QUERY = mysqlerr84.ER_SP_BAD_CURSOR_QUERY
+const ER_SP_BAD_CURSOR_SELECT = mysqlerr84.ER_SP_BAD_CURSOR_SELECT
+const ER_SP_CURSOR_MISMATCH = mysqlerr84.ER_SP_CURSOR_MISMATCH
+const ER_SP_CURSOR_ALREADY_OPEN = mysqlerr84.ER_SP_CURSOR_ALREADY_OPEN
+const ER_SP_CURSOR_NOT_OPEN = mysqlerr84.ER_SP_CURSOR_NOT_OPEN
+const ER_SP_UNDECLARED_VAR = mysqlerr84.ER_SP_UNDECLARED_VAR
+const ER_SP_WRONG_NO_OF_FETCH_ARGS = mysqlerr84.ER_SP_WRONG_NO_OF_FETCH_ARGS
+const ER_SP_FETCH_NO_DATA = mysqlerr84.ER_SP_FETCH_NO_DATA
+const ER_SP_DUP_PARAM = mysqlerr84.ER_SP_DUP_PARAM
+const ER_SP_DUP_VAR = mysqlerr84.ER_SP_DUP_VAR
+const ER_SP_DUP_COND = mysqlerr84.ER_SP_DUP_COND
+const ER_SP_DUP_CURS = mysqlerr84.ER_SP_DUP_CURS
+const ER_SP_CANT_ALTER = mysqlerr84.ER_SP_CANT_ALTER
+const ER_SP_SUBSELECT_NYI = mysqlerr84.ER_SP_SUBSELECT_NYI
+const ER_STMT_NOT_ALLOWED_IN_SF_OR_TRG = mysqlerr84.ER_STMT_NOT_ALLOWED_IN_SF_OR_TRG
+const ER_SP_VARCOND_AFTER_CURSHNDLR = mysqlerr84.ER_SP_VARCOND_AFTER_CURSHNDLR
+const ER_SP_CURSOR_AFTER_HANDLER = mysqlerr84.ER_SP_CURSOR_AFTER_HANDLER
+const ER_SP_CASE_NOT_FOUND = mysqlerr84.ER_SP_CASE_NOT_FOUND
+const ER_FPARSER_TOO_BIG_FILE = mysqlerr84.ER_FPARSER_TOO_BIG_FILE
+const ER_FPARSER_BAD_HEADER = mysqlerr84.ER_FPARSER_BAD_HEADER
+const ER_FPARSER_EOF_IN_COMMENT = mysqlerr84.ER_FPARSER_EOF_IN_COMMENT
+const ER_FPARSER_ERROR_IN_PARAMETER = mysqlerr84.ER_FPARSER_ERROR_IN_PARAMETER
+const ER_FPARSER_EOF_IN_UNKNOWN_PARAMETER = mysqlerr84.ER_FPARSER_EOF_IN_UNKNOWN_PARAMETER
+const ER_VIEW_NO_EXPLAIN = mysqlerr84.ER_VIEW_NO_EXPLAIN
+
+// Deprecated: should not be used
+const ER_FRM_UNKNOWN_TYPE = mysqlerr84.ER_FRM_UNKNOWN_TYPE
+const OBSOLETE_ER_FRM_UNKNOWN_TYPE = mysqlerr84.OBSOLETE_ER_FRM_UNKNOWN_TYPE
+const ER_WRONG_OBJECT = mysqlerr84.ER_WRONG_OBJECT
+const ER_NONUPDATEABLE_COLUMN = mysqlerr84.ER_NONUPDATEABLE_COLUMN
+
+// Deprecated: should not be used
+const ER_VIEW_SELECT_DERIVED_UNUSED = mysqlerr84.ER_VIEW_SELECT_DERIVED_UNUSED
+const OBSOLETE_ER_VIEW_SELECT_DERIVED_UNUSED = mysqlerr84.OBSOLETE_ER_VIEW_SELECT_DERIVED_UNUSED
+const ER_VIEW_SELECT_CLAUSE = mysqlerr84.ER_VIEW_SELECT_CLAUSE
+const ER_VIEW_SELECT_VARIABLE = mysqlerr84.ER_VIEW_SELECT_VARIABLE
+const ER_VIEW_SELECT_TMPTABLE = mysqlerr84.ER_VIEW_SELECT_TMPTABLE
+const ER_VIEW_WRONG_LIST = mysqlerr84.ER_VIEW_WRONG_LIST
+const ER_WARN_VIEW_MERGE = mysqlerr84.ER_WARN_VIEW_MERGE
+const ER_WARN_VIEW_WITHOUT_KEY = mysqlerr84.ER_WARN_VIEW_WITHOUT_KEY
+const ER_VIEW_INVALID = mysqlerr84.ER_VIEW_INVALID
+const ER_SP_NO_DROP_SP = mysqlerr84.ER_SP_NO_DROP_SP
+
+// Deprecated: should not be used
+const ER_SP_GOTO_IN_HNDLR = mysqlerr84.ER_SP_GOTO_IN_HNDLR
+const OBSOLETE_ER_SP_GOTO_IN_HNDLR = mysqlerr84.OBSOLETE_ER_SP_GOTO_IN_HNDLR
+const ER_TRG_ALREADY_EXISTS = mysqlerr84.ER_TRG_ALREADY_EXISTS
+const ER_TRG_DOES_NOT_EXIST = mysqlerr84.ER_TRG_DOES_NOT_EXIST
+const ER_TRG_ON_VIEW_OR_TEMP_TABLE = mysqlerr84.ER_TRG_ON_VIEW_OR_TEMP_TABLE
+const ER_TRG_CANT_CHANGE_ROW = mysqlerr84.ER_TRG_CANT_CHANGE_ROW
+const ER_TRG_NO_SUCH_ROW_IN_TRG = mysqlerr84.ER_TRG_NO_SUCH_ROW_IN_TRG
+const ER_NO_DEFAULT_FOR_FIELD = mysqlerr84.ER_NO_DEFAULT_FOR_FIELD
+const ER_DIVISION_BY_ZERO = mysqlerr84.ER_DIVISION_BY_ZERO
+const ER_TRUNCATED_WRONG_VALUE_FOR_FIELD = mysqlerr84.ER_TRUNCATED_WRONG_VALUE_FOR_FIELD
+const ER_ILLEGAL_VALUE_FOR_TYPE = mysqlerr84.ER_ILLEGAL_VALUE_FOR_TYPE
+const ER_VIEW_NONUPD_CHECK = mysqlerr84.ER_VIEW_NONUPD_CHECK
+const ER_VIEW_CHECK_FAILED = mysqlerr84.ER_VIEW_CHECK_FAILED
+const ER_PROCACCESS_DENIED_ERROR = mysqlerr84.ER_PROCACCESS_DENIED_ERROR
+const ER_RELAY_LOG_FAIL = mysqlerr84.ER_RELAY_LOG_FAIL
+
+// Deprecated: should not be used
+const ER_PASSWD_LENGTH = mysqlerr84.ER_PASSWD_LENGTH
+const OBSOLETE_ER_PASSWD_LENGTH = mysqlerr84.OBSOLETE_ER_PASSWD_LENGTH
+const ER_UNKNOWN_TARGET_BINLOG = mysqlerr84.ER_UNKNOWN_TARGET_BINLOG
+const ER_IO_ERR_LOG_INDEX_READ = mysqlerr84.ER_IO_ERR_LOG_INDEX_READ
+const ER_BINLOG_PURGE_PROHIBITED = mysqlerr84.ER_BINLOG_PURGE_PROHIBITED
+const ER_FSEEK_FAIL = mysqlerr84.ER_FSEEK_FAIL
+const ER_BINLOG_PURGE_FATAL_ERR = mysqlerr84.ER_BINLOG_PURGE_FATAL_ERR
+const ER_LOG_IN_USE = mysqlerr84.ER_LOG_IN_USE
+const ER_LOG_PURGE_UNKNOWN_ERR = mysqlerr84.ER_LOG_PURGE_UNKNOWN_ERR
+const ER_RELAY_LOG_INIT = mysqlerr84.ER_RELAY_LOG_INIT
+const ER_NO_BINARY_LOGGING = mysqlerr84.ER_NO_BINARY_LOGGING
+const ER_RESERVED_SYNTAX = mysqlerr84.ER_RESERVED_SYNTAX
+
+// Deprecated: should not be used
+const ER_WSAS_FAILED = mysqlerr84.ER_WSAS_FAILED
+const OBSOLETE_ER_WSAS_FAILED = mysqlerr84.OBSOLETE_ER_WSAS_FAILED
+
+// Deprecated: should not be used
+const ER_DIFF_GROUPS_PROC = mysqlerr84.ER_DIFF_GROUPS_PROC
+const OBSOLETE_ER_DIFF_GROUPS_PROC = mysqlerr84.OBSOLETE_ER_DIFF_GROUPS_PROC
+
+// Deprecated: should not be used
+const ER_NO_GROUP_FOR_PROC = mysqlerr84.ER_NO_GROUP_FOR_PROC
+const OBSOLETE_ER_NO_GROUP_FOR_PROC = mysqlerr84.OBSOLETE_ER_NO_GROUP_FOR_PROC
+
+// Deprecated: should not be used
+const ER_ORDER_WITH_PROC = mysqlerr84.ER_ORDER_WITH_PROC
+const OBSOLETE_ER_ORDER_WITH_PROC = mysqlerr84.OBSOLETE_ER_ORDER_WITH_PROC
+
+// Deprecated: should not be used
+const ER_LOGGING_PROHIBIT_CHANGING_OF = mysqlerr84.ER_LOGGING_PROHIBIT_CHANGING_OF
+const OBSOLETE_ER_LOGGING_PROHIBIT_CHANGING_OF = mysqlerr84.OBSOLETE_ER_LOGGING_PROHIBIT_CHANGING_OF
+
+// Deprecated: should not be used
+const ER_NO_FILE_MAPPING = mysqlerr84.ER_NO_FILE_MAPPING
+const OBSOLETE_ER_NO_FILE_MAPPING = mysqlerr84.OBSOLETE_ER_NO_FILE_MAPPING
+
+// Deprecated: should not be used
+const ER_WRONG_MAGIC = mysqlerr84.ER_WRONG_MAGIC
+const OBSOLETE_ER_WRONG_MAGIC = mysqlerr84.OBSOLETE_ER_WRONG_MAGIC
+const ER_PS_MANY_PARAM = mysqlerr84.ER_PS_MANY_PARAM
+const ER_KEY_PART_0 = mysqlerr84.ER_KEY_PART_0
+const ER_VIEW_CHECKSUM = mysqlerr84.ER_VIEW_CHECKSUM
+const ER_VIEW_MULTIUPDATE = mysqlerr84.ER_VIEW_MULTIUPDATE
+const ER_VIEW_NO_INSERT_FIELD_LIST = mysqlerr84.ER_VIEW_NO_INSERT_FIELD_LIST
+const ER_VIEW_DELETE_MERGE_VIEW = mysqlerr84.ER_VIEW_DELETE_MERGE_VIEW
+const ER_CANNOT_USER = mysqlerr84.ER_CANNOT_USER
+const ER_XAER_NOTA = mysqlerr84.ER_XAER_NOTA
+const ER_XAER_INVAL = mysqlerr84.ER_XAER_INVAL
+const ER_XAER_RMFAIL = mysqlerr84.ER_XAER_RMFAIL
+const ER_XAER_OUTSIDE = mysqlerr84.ER_XAER_OUTSIDE
+const ER_XAER_RMERR = mysqlerr84.ER_XAER_RMERR
+const ER_XA_RBROLLBACK = mysqlerr84.ER_XA_RBROLLBACK
+const ER_NONEXISTING_PROC_GRANT = mysqlerr84.ER_NONEXISTING_PROC_GRANT
+const ER_PROC_AUTO_GRANT_FAIL = mysqlerr84.ER_PROC_AUTO_GRANT_FAIL
+const ER_PROC_AUTO_REVOKE_FAIL = mysqlerr84.ER_PROC_AUTO_REVOKE_FAIL
+const ER_DATA_TOO_LONG = mysqlerr84.ER_DATA_TOO_LONG
+const ER_SP_BAD_SQLSTATE = mysqlerr84.ER_SP_BAD_SQLSTATE
+const ER_STARTUP = mysqlerr84.ER_STARTUP
+const ER_LOAD_FROM_FIXED_SIZE_ROWS_TO_VAR = mysqlerr84.ER_LOAD_FROM_FIXED_SIZE_ROWS_TO_VAR
+const ER_CANT_CREATE_USER_WITH_GRANT = mysqlerr84.ER_CANT_CREATE_USER_WITH_GRANT
+const ER_WRONG_VALUE_FOR_TYPE = mysqlerr84.ER_WRONG_VALUE_FOR_TYPE
+const ER_TABLE_DEF_CHANGED = mysqlerr84.ER_TABLE_DEF_CHANGED
+const ER_SP_DUP_HANDLER = mysqlerr84.ER_SP_DUP_HANDLER
+const ER_SP_NOT_VAR_ARG = mysqlerr84.ER_SP_NOT_VAR_ARG
+const ER_SP_NO_RETSET = mysqlerr84.ER_SP_NO_RETSET
+const ER_CANT_CREATE_GEOMETRY_OBJECT = mysqlerr84.ER_CANT_CREATE_GEOMETRY_OBJECT
+
+// Deprecated: should not be used
+const ER_FAILED_ROUTINE_BREAK_BINLOG = mysqlerr84.ER_FAILED_ROUTINE_BREAK_BINLOG
+const OBSOLETE_ER_FAILED_ROUTINE_BREAK_BINLOG = mysqlerr84.OBSOLETE_ER_FAILED_ROUTINE_BREAK_BINLOG
+const ER_BINLOG_UNSAFE_ROUTINE = mysqlerr84.ER_BINLOG_UNSAFE_ROUTINE
+const ER_BINLOG_CREATE_ROUTINE_NEED_SUPER = mysqlerr84.ER_BINLOG_CREATE_ROUTINE_NEED_SUPER
+
+// Deprecated: should not be used
+const ER_EXEC_STMT_WITH_OPEN_CURSOR = mysqlerr84.ER_EXEC_STMT_WITH_OPEN_CURSOR
+const OBSOLETE_ER_EXEC_STMT_WITH_OPEN_CURSOR = mysqlerr84.OBSOLETE_ER_EXEC_STMT_WITH_OPEN_CURSOR
+const ER_STMT_HAS_NO_OPEN_CURSOR = mysqlerr84.ER_STMT_HAS_NO_OPEN_CURSOR
+const ER_COMMIT_NOT_ALLOWED_IN_SF_OR_TRG = mysqlerr84.ER_COMMIT_NOT_ALLOWED_IN_SF_OR_TRG
+const ER_NO_DEFAULT_FOR_VIEW_FIELD = mysqlerr84.ER_NO_DEFAULT_FOR_VIEW_FIELD
+const ER_SP_NO_RECURSION = mysqlerr84.ER_SP_NO_RECURSION
+const ER_TOO_BIG_SCALE = mysqlerr84.ER_TOO_BIG_SCALE
+const ER_TOO_BIG_PRECISION = mysqlerr84.ER_TOO_BIG_PRECISION
+const ER_M_BIGGER_THAN_D = mysqlerr84.ER_M_BIGGER_THAN_D
+const ER_WRONG_LOCK_OF_SYSTEM_TABLE = mysqlerr84.ER_WRONG_LOCK_OF_SYSTEM_TABLE
+const ER_CONNECT_TO_FOREIGN_DATA_SOURCE = mysqlerr84.ER_CONNECT_TO_FOREIGN_DATA_SOURCE
+const ER_QUERY_ON_FOREIGN_DATA_SOURCE = mysqlerr84.ER_QUERY_ON_FOREIGN_DATA_SOURCE
+const ER_FOREIGN_DATA_SOURCE_DOESNT_EXIST = mysqlerr84.ER_FOREIGN_DATA_SOURCE_DOESNT_EXIST
+const ER_FOREIGN_DATA_STRING_INVALID_CANT_CREATE = mysqlerr84.ER_FOREIGN_DATA_STRING_INVALID_CANT_CREATE
+const ER_FOREIGN_DATA_STRING_INVALID = mysqlerr84.ER_FOREIGN_DATA_STRING_INVALID
+
+// Deprecated: should not be used
+const ER_CANT_CREATE_FEDERATED_TABLE = mysqlerr84.ER_CANT_CREATE_FEDERATED_TABLE
+const OBSOLETE_ER_CANT_CREATE_FEDERATED_TABLE = mysqlerr84.OBSOLETE_ER_CANT_CREATE_FEDERATED_TABLE
+const ER_TRG_IN_WRONG_SCHEMA = mysqlerr84.ER_TRG_IN_WRONG_SCHEMA
+const ER_STACK_OVERRUN_NEED_MORE = mysqlerr84.ER_STACK_OVERRUN_NEED_MORE
+const ER_TOO_LONG_BODY = mysqlerr84.ER_TOO_LONG_BODY
+const ER_WARN_CANT_DROP_DEFAULT_KEYCACHE = mysqlerr84.ER_WARN_CANT_DROP_DEFAULT_KEYCACHE
+const ER_TOO_BIG_DISPLAYWIDTH = mysqlerr84.ER_TOO_BIG_DISPLAYWIDTH
+const ER_XAER_DUPID = mysqlerr84.ER_XAER_DUPID
+const ER_DATETIME_FUNCTION_OVERFLOW = mysqlerr84.ER_DATETIME_FUNCTION_OVERFLOW
+const ER_CANT_UPDATE_USED_TABLE_IN_SF_OR_TRG = mysqlerr84.ER_CANT_UPDATE_USED_TABLE_IN_SF_OR_TRG
+const ER_VIEW_PREVENT_UPDATE = mysqlerr84.ER_VIEW_PREVENT_UPDATE
+const ER_PS_NO_RECURSION = mysqlerr84.ER_PS_NO_RECURSION
+const ER_SP_CANT_SET_AUTOCOMMIT = mysqlerr84.ER_SP_CANT_SET_AUTOCOMMIT
+
+// Deprecated: should not be used
+const ER_MALFORMED_DEFINER = mysqlerr84.ER_MALFORMED_DEFINER
+const OBSOLETE_ER_MALFORMED_DEFINER = mysqlerr84.OBSOLETE_ER_MALFORMED_DEFINER
+const ER_VIEW_FRM_NO_USER = mysqlerr84.ER_VIEW_FRM_NO_USER
+const ER_VIEW_OTHER_USER = mysqlerr84.ER_VIEW_OTHER_USER
+const ER_NO_SUCH_USER = mysqlerr84.ER_NO_SUCH_USER
+const ER_FORBID_SCHEMA_CHANGE = mysqlerr84.ER_FORBID_SCHEMA_CHANGE
+const ER_ROW_IS_REFERENCED_2 = mysqlerr84.ER_ROW_IS_REFERENCED_2
+const ER_NO_REFERENCED_ROW_2 = mysqlerr84.ER_NO_REFERENCED_ROW_2
+const ER_SP_BAD_VAR_SHADOW = mysqlerr84.ER_SP_BAD_VAR_SHADOW
+const ER_TRG_NO_DEFINER = mysqlerr84.ER_TRG_NO_DEFINER
+const ER_OLD_FILE_FORMAT = mysqlerr84.ER_OLD_FILE_FORMAT
+const ER_SP_RECURSION_LIMIT = mysqlerr84.ER_SP_RECURSION_LIMIT
+
+// Deprecated: should not be used
+const ER_SP_PROC_TABLE_CORRUPT = mysqlerr84.ER_SP_PROC_TABLE_CORRUPT
+const OBSOLETE_ER_SP_PROC_TABLE_CORRUPT = mysqlerr84.OBSOLETE_ER_SP_PROC_TABLE_CORRUPT
+const ER_SP_WRONG_NAME = mysqlerr84.ER_SP_WRONG_NAME
+const ER_TABLE_NEEDS_UPGRADE = mysqlerr84.ER_TABLE_NEEDS_UPGRADE
+const ER_SP_NO_AGGREGATE = mysqlerr84.ER_SP_NO_AGGREGATE
+const ER_MAX_PREPARED_STMT_COUNT_REACHED = mysqlerr84.ER_MAX_PREPARED_STMT_COUNT_REACHED
+const ER_VIEW_RECURSIVE = mysqlerr84.ER_VIEW_RECURSIVE
+const ER_NON_GROUPING_FIELD_USED = mysqlerr84.ER_NON_GROUPING_FIELD_USED
+const ER_TABLE_CANT_HANDLE_SPKEYS = mysqlerr84.ER_TABLE_CANT_HANDLE_SPKEYS
+const ER_NO_TRIGGERS_ON_SYSTEM_SCHEMA = mysqlerr84.ER_NO_TRIGGERS_ON_SYSTEM_SCHEMA
+const ER_REMOVED_SPACES = mysqlerr84.ER_REMOVED_SPACES
+const ER_AUTOINC_READ_FAILED = mysqlerr84.ER_AUTOINC_READ_FAILED
+const ER_USERNAME = mysqlerr84.ER_USERNAME
+const ER_HOSTNAME = mysqlerr84.ER_HOSTNAME
+const ER_WRONG_STRING_LENGTH = mysqlerr84.ER_WRONG_STRING_LENGTH
+const ER_NON_INSERTABLE_TABLE = mysqlerr84.ER_NON_INSERTABLE_TABLE
+const ER_ADMIN_WRONG_MRG_TABLE = mysqlerr84.ER_ADMIN_WRONG_MRG_TABLE
+const ER_TOO_HIGH_LEVEL_OF_NESTING_FOR_SELECT = mysqlerr84.ER_TOO_HIGH_LEVEL_OF_NESTING_FOR_SELECT
+const ER_NAME_BECOMES_EMPTY = mysqlerr84.ER_NAME_BECOMES_EMPTY
+const ER_AMBIGUOUS_FIELD_TERM = mysqlerr84.ER_AMBIGUOUS_FIELD_TERM
+const ER_FOREIGN_SERVER_EXISTS = mysqlerr84.ER_FOREIGN_SERVER_EXISTS
+const ER_FOREIGN_SERVER_DOESNT_EXIST = mysqlerr84.ER_FOREIGN_SERVER_DOESNT_EXIST
+const ER_ILLEGAL_HA_CREATE_OPTION = mysqlerr84.ER_ILLEGAL_HA_CREATE_OPTION
+const ER_PARTITION_REQUIRES_VALUES_ERROR = mysqlerr84.ER_PARTITION_REQUIRES_VALUES_ERROR
+const ER_PARTITION_WRONG_VALUES_ERROR = mysqlerr84.ER_PARTITION_WRONG_VALUES_ERROR
+const ER_PARTITION_MAXVALUE_ERROR = mysqlerr84.ER_PARTITION_MAXVALUE_ERROR
+
+// Deprecated: should not be used
+const ER_PARTITION_SUBPARTITION_ERROR = mysqlerr84.ER_PARTITION_SUBPARTITION_ERROR
+const OBSOLETE_ER_PARTITION_SUBPARTITION_ERROR = mysqlerr84.OBSOLETE_ER_PARTITION_SUBPARTITION_ERROR
+
+// Deprecated: should not be used
+const ER_PARTITION_SUBPART_MIX_ERROR = mysqlerr84.ER_PARTITION_SUBPART_MIX_ERROR
+const OBSOLETE_ER_PARTITION_SUBPART_MIX_ERROR = mysqlerr84.OBSOLETE_ER_PARTITION_SUBPART_MIX_ERROR
+const ER_PARTITION_WRONG_NO_PART_ERROR = mysqlerr84.ER_PARTITION_WRONG_NO_PART_ERROR
+const ER_PARTITION_WRONG_NO_SUBPART_ERROR = mysqlerr84.ER_PARTITION_WRONG_NO_SUBPART_ERROR
+const ER_WRONG_EXPR_IN_PARTITION_FUNC_ERROR = mysqlerr84.ER_WRONG_EXPR_IN_PARTITION_FUNC_ERROR
+
+// Deprecated: should not be used
+const ER_NO_CONST_EXPR_IN_RANGE_OR_LIST_ERROR = mysqlerr84.ER_NO_CONST_EXPR_IN_RANGE_OR_LIST_ERROR
+const OBSOLETE_ER_NO_CONST_EXPR_IN_RANGE_OR_LIST_ERROR = mysqlerr84.OBSOLETE_ER_NO_CONST_EXPR_IN_RANGE_OR_LIST_ERROR
+const ER_FIELD_NOT_FOUND_PART_ERROR = mysqlerr84.ER_FIELD_NOT_FOUND_PART_ERROR
+
+// Deprecated: should not be used
+const ER_LIST_OF_FIELDS_ONLY_IN_HASH_ERROR = mysqlerr84.ER_LIST_OF_FIELDS_ONLY_IN_HASH_ERROR
+const OBSOLETE_ER_LIST_OF_FIELDS_ONLY_IN_HASH_ERROR = mysqlerr84.OBSOLETE_ER_LIST_OF_FIELDS_ONLY_IN_HASH_ERROR
+const ER_INCONSISTENT_PARTITION_INFO_ERROR = mysqlerr84.ER_INCONSISTENT_PARTITION_INFO_ERROR
+const ER_PARTITION_FUNC_NOT_ALLOWED_ERROR = mysqlerr84.ER_PARTITION_FUNC_NOT_ALLOWED_ERROR
+const ER_PARTITIONS_MUST_BE_DEFINED_ERROR = mysqlerr84.ER_PARTITIONS_MUST_BE_DEFINED_ERROR
+const ER_RANGE_NOT_INCREASING_ERROR = mysqlerr84.ER_RANGE_NOT_INCREASING_ERROR
+const ER_INCONSISTENT_TYPE_OF_FUNCTIONS_ERROR = mysqlerr84.ER_INCONSISTENT_TYPE_OF_FUNCTIONS_ERROR
+const ER_MULTIPLE_DEF_CONST_IN_LIST_PART_ERROR = mysqlerr84.ER_MULTIPLE_DEF_CONST_IN_LIST_PART_ERROR
+const ER_PARTITION_ENTRY_ERROR = mysqlerr84.ER_PARTITION_ENTRY_ERROR
+const ER_MIX_HANDLER_ERROR = mysqlerr84.ER_MIX_HANDLER_ERROR
+const ER_PARTITION_NOT_DEFINED_ERROR = mysqlerr84.ER_PARTITION_NOT_DEFINED_ERROR
+const ER_TOO_MANY_PARTITIONS_ERROR = mysqlerr84.ER_TOO_MANY_PARTITIONS_ERROR
+const ER_SUBPARTITION_ERROR = mysqlerr84.ER_SUBPARTITION_ERROR
+const ER_CANT_CREATE_HANDLER_FILE = mysqlerr84.ER_CANT_CREATE_HANDLER_FILE
+const ER_BLOB_FIELD_IN_PART_FUNC_ERROR = mysqlerr84.ER_BLOB_FIELD_IN_PART_FUNC_ERROR
+const ER_UNIQUE_KEY_NEED_ALL_FIELDS_IN_PF = mysqlerr84.ER_UNIQUE_KEY_NEED_ALL_FIELDS_IN_PF
+const ER_NO_PARTS_ERROR = mysqlerr84.ER_NO_PARTS_ERROR
+const ER_PARTITION_MGMT_ON_NONPARTITIONED = mysqlerr84.ER_PARTITION_MGMT_ON_NONPARTITIONED
+const ER_FOREIGN_KEY_ON_PARTITIONED = mysqlerr84.ER_FOREIGN_KEY_ON_PARTITIONED
+const ER_DROP_PARTITION_NON_EXISTENT = mysqlerr84.ER_DROP_PARTITION_NON_EXISTENT
+const ER_DROP_LAST_PARTITION = mysqlerr84.ER_DROP_LAST_PARTITION
+const ER_COALESCE_ONLY_ON_HASH_PARTITION = mysqlerr84.ER_COALESCE_ONLY_ON_HASH_PARTITION
+const ER_REORG_HASH_ONLY_ON_SAME_NO = mysqlerr84.ER_REORG_HASH_ONLY_ON_SAME_NO
+const ER_REORG_NO_PARAM_ERROR = mysqlerr84.ER_REORG_NO_PARAM_ERROR
+const ER_ONLY_ON_RANGE_LIST_PARTITION = mysqlerr84.ER_ONLY_ON_RANGE_LIST_PARTITION
+const ER_ADD_PARTITION_SUBPART_ERROR = mysqlerr84.ER_ADD_PARTITION_SUBPART_ERROR
+const ER_ADD_PARTITION_NO_NEW_PARTITION = mysqlerr84.ER_ADD_PARTITION_NO_NEW_PARTITION
+const ER_COALESCE_PARTITION_NO_PARTITION = mysqlerr84.ER_COALESCE_PARTITION_NO_PARTITION
+const ER_REORG_PARTITION_NOT_EXIST = mysqlerr84.ER_REORG_PARTITION_NOT_EXIST
+const ER_SAME_NAME_PARTITION = mysqlerr84.ER_SAME_NAME_PARTITION
+const ER_NO_BINLOG_ERROR = mysqlerr84.ER_NO_BINLOG_ERROR
+const ER_CONSECUTIVE_REORG_PARTITIONS = mysqlerr84.ER_CONSECUTIVE_REORG_PARTITIONS
+const ER_REORG_OUTSIDE_RANGE = mysqlerr84.ER_REORG_OUTSIDE_RANGE
+const ER_PARTITION_FUNCTION_FAILURE = mysqlerr84.ER_PARTITION_FUNCTION_FAILURE
+
+// Deprecated: should not be used
+const ER_PART_STATE_ERROR = mysqlerr84.ER_PART_STATE_ERROR
+const OBSOLETE_ER_PART_STATE_ERROR = mysqlerr84.OBSOLETE_ER_PART_STATE_ERROR
+const ER_LIMITED_PART_RANGE = mysqlerr84.ER_LIMITED_PART_RANGE
+const ER_PLUGIN_IS_NOT_LOADED = mysqlerr84.ER_PLUGIN_IS_NOT_LOADED
+const ER_WRONG_VALUE = mysqlerr84.ER_WRONG_VALUE
+const ER_NO_PARTITION_FOR_GIVEN_VALUE = mysqlerr84.ER_NO_PARTITION_FOR_GIVEN_VALUE
+const ER_FILEGROUP_OPTION_ONLY_ONCE = mysqlerr84.ER_FILEGROUP_OPTION_ONLY_ONCE
+const ER_CREATE_FILEGROUP_FAILED = mysqlerr84.ER_CREATE_FILEGROUP_FAILED
+const ER_DROP_FILEGROUP_FAILED = mysqlerr84.ER_DROP_FILEGROUP_FAILED
+const ER_TABLESPACE_AUTO_EXTEND_ERROR = mysqlerr84.ER_TABLESPACE_AUTO_EXTEND_ERROR
+const ER_WRONG_SIZE_NUMBER = mysqlerr84.ER_WRONG_SIZE_NUMBER
+const ER_SIZE_OVERFLOW_ERROR = mysqlerr84.ER_SIZE_OVERFLOW_ERROR
+const ER_ALTER_FILEGROUP_FAILED = mysqlerr84.ER_ALTER_FILEGROUP_FAILED
+const ER_BINLOG_ROW_LOGGING_FAILED = mysqlerr84.ER_BINLOG_ROW_LOGGING_FAILED
+
+// Deprecated: should not be used
+const ER_BINLOG_ROW_WRONG_TABLE_DEF = mysqlerr84.ER_BINLOG_ROW_WRONG_TABLE_DEF
+const OBSOLETE_ER_BINLOG_ROW_WRONG_TABLE_DEF = mysqlerr84.OBSOLETE_ER_BINLOG_ROW_WRONG_TABLE_DEF
+
+// Deprecated: should not be used
+const ER_BINLOG_ROW_RBR_TO_SBR = mysqlerr84.ER_BINLOG_ROW_RBR_TO_SBR
+const OBSOLETE_ER_BINLOG_ROW_RBR_TO_SBR = mysqlerr84.OBSOLETE_ER_BINLOG_ROW_RBR_TO_SBR
+const ER_EVENT_ALREADY_EXISTS = mysqlerr84.ER_EVENT_ALREADY_EXISTS
+
+// Deprecated: should not be used
+const ER_EVENT_STORE_FAILED = mysqlerr84.ER_EVENT_STORE_FAILED
+const OBSOLETE_ER_EVENT_STORE_FAILED = mysqlerr84.OBSOLETE_ER_EVENT_STORE_FAILED
+const ER_EVENT_DOES_NOT_EXIST = mysqlerr84.ER_EVENT_DOES_NOT_EXIST
+
+// Deprecated: should not be used
+const ER_EVENT_CANT_ALTER = mysqlerr84.ER_EVENT_CANT_ALTER
+const OBSOLETE_ER_EVENT_CANT_ALTER = mysqlerr84.OBSOLETE_ER_EVENT_CANT_ALTER
+
+// Deprecated: should not be used
+const ER_EVENT_DROP_FAILED = mysqlerr84.ER_EVENT_DROP_FAILED
+const OBSOLETE_ER_EVENT_DROP_FAILED = mysqlerr84.OBSOLETE_ER_EVENT_DROP_FAILED
+const ER_EVENT_INTERVAL_NOT_POSITIVE_OR_TOO_BIG = mysqlerr84.ER_EVENT_INTERVAL_NOT_POSITIVE_OR_TOO_BIG
+const ER_EVENT_ENDS_BEFORE_STARTS = mysqlerr84.ER_EVENT_ENDS_BEFORE_STARTS
+const ER_EVENT_EXEC_TIME_IN_THE_PAST = mysqlerr84.ER_EVENT_EXEC_TIME_IN_THE_PAST
+
+// Deprecated: should not be used
+const ER_EVENT_OPEN_TABLE_FAILED = mysqlerr84.ER_EVENT_OPEN_TABLE_FAILED
+const OBSOLETE_ER_EVENT_OPEN_TABLE_FAILED = mysqlerr84.OBSOLETE_ER_EVENT_OPEN_TABLE_FAILED
+
+// Deprecated: should not be used
+const ER_EVENT_NEITHER_M_EXPR_NOR_M_AT = mysqlerr84.ER_EVENT_NEITHER_M_EXPR_NOR_M_AT
+const OBSOLETE_ER_EVENT_NEITHER_M_EXPR_NOR_M_AT = mysqlerr84.OBSOLETE_ER_EVENT_NEITHER_M_EXPR_NOR_M_AT
+
+// Deprecated: should not be used
+const ER_COL_COUNT_DOESNT_MATCH_CORRUPTED = mysqlerr84.ER_COL_COUNT_DOESNT_MATCH_CORRUPTED
+const OBSOLETE_ER_COL_COUNT_DOESNT_MATCH_CORRUPTED = mysqlerr84.OBSOLETE_ER_COL_COUNT_DOESNT_MATCH_CORRUPTED
+
+// Deprecated: should not be used
+const ER_CANNOT_LOAD_FROM_TABLE = mysqlerr84.ER_CANNOT_LOAD_FROM_TABLE
+const OBSOLETE_ER_CANNOT_LOAD_FROM_TABLE = mysqlerr84.OBSOLETE_ER_CANNOT_LOAD_FROM_TABLE
+
+// Deprecated: should not be used
+const ER_EVENT_CANNOT_DELETE = mysqlerr84.ER_EVENT_CANNOT_DELETE
+const OBSOLETE_ER_EVENT_CANNOT_DELETE = mysqlerr84.OBSOLETE_ER_EVENT_CANNOT_DELETE
+
+// Deprecated: should not be used
+const ER_EVENT_COMPILE_ERROR = mysqlerr84.ER_EVENT_COMPILE_ERROR
+const OBSOLETE_ER_EVENT_COMPILE_ERROR = mysqlerr84.OBSOLETE_ER_EVENT_COMPILE_ERROR
+const ER_EVENT_SAME_NAME = mysqlerr84.ER_EVENT_SAME_NAME
+
+// Deprecated: should not be used
+const ER_EVENT_DATA_TOO_LONG = mysqlerr84.ER_EVENT_DATA_TOO_LONG
+const OBSOLETE_ER_EVENT_DATA_TOO_LONG = mysqlerr84.OBSOLETE_ER_EVENT_DATA_TOO_LONG
+const ER_DROP_INDEX_FK = mysqlerr84.ER_DROP_INDEX_FK
+const ER_WARN_DEPRECATED_SYNTAX_WITH_VER = mysqlerr84.ER_WARN_DEPRECATED_SYNTAX_WITH_VER
+
+// Deprecated: should not be used
+const ER_CANT_WRITE_LOCK_LOG_TABLE = mysqlerr84.ER_CANT_WRITE_LOCK_LOG_TABLE
+const OBSOLETE_ER_CANT_WRITE_LOCK_LOG_TABLE = mysqlerr84.OBSOLETE_ER_CANT_WRITE_LOCK_LOG_TABLE
+const ER_CANT_LOCK_LOG_TABLE = mysqlerr84.ER_CANT_LOCK_LOG_TABLE
+const ER_FOREIGN_DUPLICATE_KEY_OLD_UNUSED = mysqlerr84.ER_FOREIGN_DUPLICATE_KEY_OLD_UNUSED
+const ER_COL_COUNT_DOESNT_MATCH_PLEASE_UPDATE = mysqlerr84.ER_COL_COUNT_DOESNT_MATCH_PLEASE_UPDATE
+
+// Deprecated: should not be used
+const ER_TEMP_TABLE_PREVENTS_SWITCH_OUT_OF_RBR = mysqlerr84.ER_TEMP_TABLE_PREVENTS_SWITCH_OUT_OF_RBR
+const OBSOLETE_ER_TEMP_TABLE_PREVENTS_SWITCH_OUT_OF_RBR = mysqlerr84.OBSOLETE_ER_TEMP_TABLE_PREVENTS_SWITCH_OUT_OF_RBR
+const ER_STORED_FUNCTION_PREVENTS_SWITCH_BINLOG_FORMAT = mysqlerr84.ER_STORED_FUNCTION_PREVENTS_SWITCH_BINLOG_FORMAT
+
+// Deprecated: should not be used
+const ER_NDB_CANT_SWITCH_BINLOG_FORMAT = mysqlerr84.ER_NDB_CANT_SWITCH_BINLOG_FORMAT
+const OBSOLETE_ER_NDB_CANT_SWITCH_BINLOG_FORMAT = mysqlerr84.OBSOLETE_ER_NDB_CANT_SWITCH_BINLOG_FORMAT
+const ER_PARTITION_NO_TEMPORARY = mysqlerr84.ER_PARTITION_NO_TEMPORARY
+const ER_PARTITION_CONST_DOMAIN_ERROR = mysqlerr84.ER_PARTITION_CONST_DOMAIN_ERROR
+const ER_PARTITION_FUNCTION_IS_NOT_ALLOWED = mysqlerr84.ER_PARTITION_FUNCTION_IS_NOT_ALLOWED
+
+// Deprecated: should not be used
+const ER_DDL_LOG_ERROR_UNUSED = mysqlerr84.ER_DDL_LOG_ERROR_UNUSED
+const OBSOLETE_ER_DDL_LOG_ERROR_UNUSED = mysqlerr84.OBSOLETE_ER_DDL_LOG_ERROR_UNUSED
+const ER_NULL_IN_VALUES_LESS_THAN = mysqlerr84.ER_NULL_IN_VALUES_LESS_THAN
+const ER_WRONG_PARTITION_NAME = mysqlerr84.ER_WRONG_PARTITION_NAME
+const ER_CANT_CHANGE_TX_CHARACTERISTICS = mysqlerr84.ER_CANT_CHANGE_TX_CHARACTERISTICS
+const ER_DUP_ENTRY_AUTOINCREMENT_CASE = mysqlerr84.ER_DUP_ENTRY_AUTOINCREMENT_CASE
+
+// Deprecated: should not be used
+const ER_EVENT_MODIFY_QUEUE_ERROR = mysqlerr84.ER_EVENT_MODIFY_QUEUE_ERROR
+const OBSOLETE_ER_EVENT_MODIFY_QUEUE_ERROR = mysqlerr84.OBSOLETE_ER_EVENT_MODIFY_QUEUE_ERROR
+const ER_EVENT_SET_VAR_ERROR = mysqlerr84.ER_EVENT_SET_VAR_ERROR
+const ER_PARTITION_MERGE_ERROR = mysqlerr84.ER_PARTITION_MERGE_ERROR
+
+// Deprecated: should not be used
+const ER_CANT_ACTIVATE_LOG = mysqlerr84.ER_CANT_ACTIVATE_LOG
+const OBSOLETE_ER_CANT_ACTIVATE_LOG = mysqlerr84.OBSOLETE_ER_CANT_ACTIVATE_LOG
+
+// Deprecated: should not be used
+const ER_RBR_NOT_AVAILABLE = mysqlerr84.ER_RBR_NOT_AVAILABLE
+const OBSOLETE_ER_RBR_NOT_AVAILABLE = mysqlerr84.OBSOLETE_ER_RBR_NOT_AVAILABLE
+const ER_BASE64_DECODE_ERROR = mysqlerr84.ER_BASE64_DECODE_ERROR
+const ER_EVENT_RECURSION_FORBIDDEN = mysqlerr84.ER_EVENT_RECURSION_FORBIDDEN
+
+// Deprecated: should not be used
+const ER_EVENTS_DB_ERROR = mysqlerr84.ER_EVENTS_DB_ERROR
+const OBSOLETE_ER_EVENTS_DB_ERROR = mysqlerr84.OBSOLETE_ER_EVENTS_DB_ERROR
+const ER_ONLY_INTEGERS_ALLOWED = mysqlerr84.ER_ONLY_INTEGERS_ALLOWED
+const ER_UNSUPORTED_LOG_ENGINE = mysqlerr84.ER_UNSUPORTED_LOG_ENGINE
+const ER_BAD_LOG_STATEMENT = mysqlerr84.ER_BAD_LOG_STATEMENT
+const ER_CANT_RENAME_LOG_TABLE = mysqlerr84.ER_CANT_RENAME_LOG_TABLE
+const ER_WRONG_PARAMCOUNT_TO_NATIVE_FCT = mysqlerr84.ER_WRONG_PARAMCOUNT_TO_NATIVE_FCT
+const ER_WRONG_PARAMETERS_TO_NATIVE_FCT = mysqlerr84.ER_WRONG_PARAMETERS_TO_NATIVE_FCT
+const ER_WRONG_PARAMETERS_TO_STORED_FCT = mysqlerr84.ER_WRONG_PARAMETERS_TO_STORED_FCT
+const ER_NATIVE_FCT_NAME_COLLISION = mysqlerr84.ER_NATIVE_FCT_NAME_COLLISION
+const ER_DUP_ENTRY_WITH_KEY_NAME = mysqlerr84.ER_DUP_ENTRY_WITH_KEY_NAME
+const ER_BINLOG_PURGE_EMFILE = mysqlerr84.ER_BINLOG_PURGE_EMFILE
+const ER_EVENT_CANNOT_CREATE_IN_THE_PAST = mysqlerr84.ER_EVENT_CANNOT_CREATE_IN_THE_PAST
+const ER_EVENT_CANNOT_ALTER_IN_THE_PAST = mysqlerr84.ER_EVENT_CANNOT_ALTER_IN_THE_PAST
+
+// Deprecated: should not be used
+const ER_SLAVE_INCIDENT = mysqlerr84.ER_SLAVE_INCIDENT
+const OBSOLETE_ER_SLAVE_INCIDENT = mysqlerr84.OBSOLETE_ER_SLAVE_INCIDENT
+const ER_NO_PARTITION_FOR_GIVEN_VALUE_SILENT = mysqlerr84.ER_NO_PARTITION_FOR_GIVEN_VALUE_SILENT
+const ER_BINLOG_UNSAFE_STATEMENT = mysqlerr84.ER_BINLOG_UNSAFE_STATEMENT
+const ER_BINLOG_FATAL_ERROR = mysqlerr84.ER_BINLOG_FATAL_ERROR
+
+// Deprecated: should not be used
+const ER_SLAVE_RELAY_LOG_READ_FAILURE = mysqlerr84.ER_SLAVE_RELAY_LOG_READ_FAILURE
+const OBSOLETE_ER_SLAVE_RELAY_LOG_READ_FAILURE = mysqlerr84.OBSOLETE_ER_SLAVE_RELAY_LOG_READ_FAILURE
+
+// Deprecated: should not be used
+const ER_SLAVE_RELAY_LOG_WRITE_FAILURE = mysqlerr84.ER_SLAVE_RELAY_LOG_WRITE_FAILURE
+const OBSOLETE_ER_SLAVE_RELAY_LOG_WRITE_FAILURE = mysqlerr84.OBSOLETE_ER_SLAVE_RELAY_LOG_WRITE_FAILURE
+
+// Deprecated: should not be used
+const ER_SLAVE_CREATE_EVENT_FAILURE = mysqlerr84.ER_SLAVE_CREATE_EVENT_FAILURE
+const OBSOLETE_ER_SLAVE_CREATE_EVENT_FAILURE = mysqlerr84.OBSOLETE_ER_SLAVE_CREATE_EVENT_FAILURE
+
+// Deprecated: should not be used
+const ER_SLAVE_MASTER_COM_FAILURE = mysqlerr84.ER_SLAVE_MASTER_COM_FAILURE
+const OBSOLETE_ER_SLAVE_MASTER_COM_FAILURE = mysqlerr84.OBSOLETE_ER_SLAVE_MASTER_COM_FAILURE
+const ER_BINLOG_LOGGING_IMPOSSIBLE = mysqlerr84.ER_BINLOG_LOGGING_IMPOSSIBLE
+const ER_VIEW_NO_CREATION_CTX = mysqlerr84.ER_VIEW_NO_CREATION_CTX
+const ER_VIEW_INVALID_CREATION_CTX = mysqlerr84.ER_VIEW_INVALID_CREATION_CTX
+
+// Deprecated: should not be used
+const ER_SR_INVALID_CREATION_CTX = mysqlerr84.ER_SR_INVALID_CREATION_CTX
+const OBSOLETE_ER_SR_INVALID_CREATION_CTX = mysqlerr84.OBSOLETE_ER_SR_INVALID_CREATION_CTX
+const ER_TRG_CORRUPTED_FILE = mysqlerr84.ER_TRG_CORRUPTED_FILE
+const ER_TRG_NO_CREATION_CTX = mysqlerr84.ER_TRG_NO_CREATION_CTX
+const ER_TRG_INVALID_CREATION_CTX = mysqlerr84.ER_TRG_INVALID_CREATION_CTX
+const ER_EVENT_INVALID_CREATION_CTX = mysqlerr84.ER_EVENT_INVALID_CREATION_CTX
+const ER_TRG_CANT_OPEN_TABLE = mysqlerr84.ER_TRG_CANT_OPEN_TABLE
+
+// Deprecated: should not be used
+const ER_CANT_CREATE_SROUTINE = mysqlerr84.ER_CANT_CREATE_SROUTINE
+const OBSOLETE_ER_CANT_CREATE_SROUTINE = mysqlerr84.OBSOLETE_ER_CANT_CREATE_SROUTINE
+
+// Deprecated: should not be used
+const ER_NEVER_USED = mysqlerr84.ER_NEVER_USED
+const OBSOLETE_ER_NEVER_USED = mysqlerr84.OBSOLETE_ER_NEVER_USED
+const ER_NO_FORMAT_DESCRIPTION_EVENT_BEFORE_BINLOG_STATEMENT = mysqlerr84.ER_NO_FORMAT_DESCRIPTION_EVENT_BEFORE_BINLOG_STATEMENT
+
+// Deprecated: should not be used
+const ER_SLAVE_CORRUPT_EVENT = mysqlerr84.ER_SLAVE_CORRUPT_EVENT
+const ER_REPLICA_CORRUPT_EVENT = mysqlerr84.ER_REPLICA_CORRUPT_EVENT
+
+// Deprecated: should not be used
+const ER_LOAD_DATA_INVALID_COLUMN_UNUSED = mysqlerr84.ER_LOAD_DATA_INVALID_COLUMN_UNUSED
+const OBSOLETE_ER_LOAD_DATA_INVALID_COLUMN_UNUSED = mysqlerr84.OBSOLETE_ER_LOAD_DATA_INVALID_COLUMN_UNUSED
+const ER_LOG_PURGE_NO_FILE = mysqlerr84.ER_LOG_PURGE_NO_FILE
+const ER_XA_RBTIMEOUT = mysqlerr84.ER_XA_RBTIMEOUT
+const ER_XA_RBDEADLOCK = mysqlerr84.ER_XA_RBDEADLOCK
+const ER_NEED_REPREPARE = mysqlerr84.ER_NEED_REPREPARE
+
+// Deprecated: should not be used
+const ER_DELAYED_NOT_SUPPORTED = mysqlerr84.ER_DELAYED_NOT_SUPPORTED
+const OBSOLETE_ER_DELAYED_NOT_SUPPORTED = mysqlerr84.OBSOLETE_ER_DELAYED_NOT_SUPPORTED
+
+// Deprecated: should not be used
+const WARN_NO_MASTER_INFO = mysqlerr84.WARN_NO_MASTER_INFO
+const WARN_NO_CONNECTION_METADATA = mysqlerr84.WARN_NO_CONNECTION_METADATA
+const WARN_OPTION_IGNORED = mysqlerr84.WARN_OPTION_IGNORED
+const ER_PLUGIN_DELETE_BUILTIN = mysqlerr84.ER_PLUGIN_DELETE_BUILTIN
+const WARN_PLUGIN_BUSY = mysqlerr84.WARN_PLUGIN_BUSY
+const ER_VARIABLE_IS_READONLY = mysqlerr84.ER_VARIABLE_IS_READONLY
+const ER_WARN_ENGINE_TRANSACTION_ROLLBACK = mysqlerr84.ER_WARN_ENGINE_TRANSACTION_ROLLBACK
+
+// Deprecated: should not be used
+const ER_SLAVE_HEARTBEAT_FAILURE = mysqlerr84.ER_SLAVE_HEARTBEAT_FAILURE
+const OBSOLETE_ER_SLAVE_HEARTBEAT_FAILURE = mysqlerr84.OBSOLETE_ER_SLAVE_HEARTBEAT_FAILURE
+
+// Deprecated: should not be used
+const ER_SLAVE_HEARTBEAT_VALUE_OUT_OF_RANGE = mysqlerr84.ER_SLAVE_HEARTBEAT_VALUE_OUT_OF_RANGE
+const ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE = mysqlerr84.ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE
+const ER_NDB_REPLICATION_SCHEMA_ERROR = mysqlerr84.ER_NDB_REPLICATION_SCHEMA_ERROR
+const ER_CONFLICT_FN_PARSE_ERROR = mysqlerr84.ER_CONFLICT_FN_PARSE_ERROR
+const ER_EXCEPTIONS_WRITE_ERROR = mysqlerr84.ER_EXCEPTIONS_WRITE_ERROR
+const ER_TOO_LONG_TABLE_COMMENT = mysqlerr84.ER_TOO_LONG_TABLE_COMMENT
+const ER_TOO_LONG_FIELD_COMMENT = mysqlerr84.ER_TOO_LONG_FIELD_COMMENT
+const ER_FUNC_INEXISTENT_NAME_COLLISION = mysqlerr84.ER_FUNC_INEXISTENT_NAME_COLLISION
+const ER_DATABASE_NAME = mysqlerr84.ER_DATABASE_NAME
+const ER_TABLE_NAME = mysqlerr84.ER_TABLE_NAME
+const ER_PARTITION_NAME = mysqlerr84.ER_PARTITION_NAME
+const ER_SUBPARTITION_NAME = mysqlerr84.ER_SUBPARTITION_NAME
+const ER_TEMPORARY_NAME = mysqlerr84.ER_TEMPORARY_NAME
+const ER_RENAMED_NAME = mysqlerr84.ER_RENAMED_NAME
+const ER_TOO_MANY_CONCURRENT_TRXS = mysqlerr84.ER_TOO_MANY_CONCURRENT_TRXS
+const WARN_NON_ASCII_SEPARATOR_NOT_IMPLEMENTED = mysqlerr84.WARN_NON_ASCII_SEPARATOR_NOT_IMPLEMENTED
+const ER_DEBUG_SYNC_TIMEOUT = mysqlerr84.ER_DEBUG_SYNC_TIMEOUT
+const ER_DEBUG_SYNC_HIT_LIMIT = mysqlerr84.ER_DEBUG_SYNC_HIT_LIMIT
+const ER_DUP_SIGNAL_SET = mysqlerr84.ER_DUP_SIGNAL_SET
+const ER_SIGNAL_WARN = mysqlerr84.ER_SIGNAL_WARN
+const ER_SIGNAL_NOT_FOUND = mysqlerr84.ER_SIGNAL_NOT_FOUND
+const ER_SIGNAL_EXCEPTION = mysqlerr84.ER_SIGNAL_EXCEPTION
+const ER_RESIGNAL_WITHOUT_ACTIVE_HANDLER = mysqlerr84.ER_RESIGNAL_WITHOUT_ACTIVE_HANDLER
+const ER_SIGNAL_BAD_CONDITION_TYPE = mysqlerr84.ER_SIGNAL_BAD_CONDITION_TYPE
+const WARN_COND_ITEM_TRUNCATED = mysqlerr84.WARN_COND_ITEM_TRUNCATED
+const ER_COND_ITEM_TOO_LONG = mysqlerr84.ER_COND_ITEM_TOO_LONG
+const ER_UNKNOWN_LOCALE = mysqlerr84.ER_UNKNOWN_LOCALE
+
+// Deprecated: should not be used
+const ER_SLAVE_IGNORE_SERVER_IDS = mysqlerr84.ER_SLAVE_IGNORE_SERVER_IDS
+const ER_REPLICA_IGNORE_SERVER_IDS = mysqlerr84.ER_REPLICA_IGNORE_SERVER_IDS
+
+// Deprecated: should not be used
+const ER_QUERY_CACHE_DISABLED = mysqlerr84.ER_QUERY_CACHE_DISABLED
+const OBSOLETE_ER_QUERY_CACHE_DISABLED = mysqlerr84.OBSOLETE_ER_QUERY_CACHE_DISABLED
+const ER_SAME_NAME_PARTITION_FIELD = mysqlerr84.ER_SAME_NAME_PARTITION_FIELD
+const ER_PARTITION_COLUMN_LIST_ERROR = mysqlerr84.ER_PARTITION_COLUMN_LIST_ERROR
+const ER_WRONG_TYPE_COLUMN_VALUE_ERROR = mysqlerr84.ER_WRONG_TYPE_COLUMN_VALUE_ERROR
+const ER_TOO_MANY_PARTITION_FUNC_FIELDS_ERROR = mysqlerr84.ER_TOO_MANY_PARTITION_FUNC_FIELDS_ERROR
+const ER_MAXVALUE_IN_VALUES_IN = mysqlerr84.ER_MAXVALUE_IN_VALUES_IN
+const ER_TOO_MANY_VALUES_ERROR = mysqlerr84.ER_TOO_MANY_VALUES_ERROR
+const ER_ROW_SINGLE_PARTITION_FIELD_ERROR = mysqlerr84.ER_ROW_SINGLE_PARTITION_FIELD_ERROR
+const ER_FIELD_TYPE_NOT_ALLOWED_AS_PARTITION_FIELD = mysqlerr84.ER_FIELD_TYPE_NOT_ALLOWED_AS_PARTITION_FIELD
+const ER_PARTITION_FIELDS_TOO_LONG = mysqlerr84.ER_PARTITION_FIELDS_TOO_LONG
+const ER_BINLOG_ROW_ENGINE_AND_STMT_ENGINE = mysqlerr84.ER_BINLOG_ROW_ENGINE_AND_STMT_ENGINE
+const ER_BINLOG_ROW_MODE_AND_STMT_ENGINE = mysqlerr84.ER_BINLOG_ROW_MODE_AND_STMT_ENGINE
+const ER_BINLOG_UNSAFE_AND_STMT_ENGINE = mysqlerr84.ER_BINLOG_UNSAFE_AND_STMT_ENGINE
+const ER_BINLOG_ROW_INJECTION_AND_STMT_ENGINE = mysqlerr84.ER_BINLOG_ROW_INJECTION_AND_STMT_ENGINE
+const ER_BINLOG_STMT_MODE_AND_ROW_ENGINE = mysqlerr84.ER_BINLOG_STMT_MODE_AND_ROW_ENGINE
+const ER_BINLOG_ROW_INJECTION_AND_STMT_MODE = mysqlerr84.ER_BINLOG_ROW_INJECTION_AND_STMT_MODE
+const ER_BINLOG_MULTIPLE_ENGINES_AND_SELF_LOGGING_ENGINE = mysqlerr84.ER_BINLOG_MULTIPLE_ENGINES_AND_SELF_LOGGING_ENGINE
+const ER_BINLOG_UNSAFE_LIMIT = mysqlerr84.ER_BINLOG_UNSAFE_LIMIT
+
+// Deprecated: should not be used
+const ER_UNUSED4 = mysqlerr84.ER_UNUSED4
+const OBSOLETE_ER_UNUSED4 = mysqlerr84.OBSOLETE_ER_UNUSED4
+const ER_BINLOG_UNSAFE_SYSTEM_TABLE = mysqlerr84.ER_BINLOG_UNSAFE_SYSTEM_TABLE
+const ER_BINLOG_UNSAFE_AUTOINC_COLUMNS = mysqlerr84.ER_BINLOG_UNSAFE_AUTOINC_COLUMNS
+const ER_BINLOG_UNSAFE_UDF = mysqlerr84.ER_BINLOG_UNSAFE_UDF
+const ER_BINLOG_UNSAFE_SYSTEM_VARIABLE = mysqlerr84.ER_BINLOG_UNSAFE_SYSTEM_VARIABLE
+const ER_BINLOG_UNSAFE_SYSTEM_FUNCTION = mysqlerr84.ER_BINLOG_UNSAFE_SYSTEM_FUNCTION
+const ER_BINLOG_UNSAFE_NONTRANS_AFTER_TRANS = mysqlerr84.ER_BINLOG_UNSAFE_NONTRANS_AFTER_TRANS
+const ER_MESSAGE_AND_STATEMENT = mysqlerr84.ER_MESSAGE_AND_STATEMENT
+
+// Deprecated: should not be used
+const ER_SLAVE_CONVERSION_FAILED = mysqlerr84.ER_SLAVE_CONVERSION_FAILED
+const OBSOLETE_ER_SLAVE_CONVERSION_FAILED = mysqlerr84.OBSOLETE_ER_SLAVE_CONVERSION_FAILED
+
+// Deprecated: should not be used
+const ER_SLAVE_CANT_CREATE_CONVERSION = mysqlerr84.ER_SLAVE_CANT_CREATE_CONVERSION
+const ER_REPLICA_CANT_CREATE_CONVERSION = mysqlerr84.ER_REPLICA_CANT_CREATE_CONVERSION
+const ER_INSIDE_TRANSACTION_PREVENTS_SWITCH_BINLOG_FORMAT = mysqlerr84.ER_INSIDE_TRANSACTION_PREVENTS_SWITCH_BINLOG_FORMAT
+const ER_PATH_LENGTH = mysqlerr84.ER_PATH_LENGTH
+const ER_WARN_DEPRECATED_SYNTAX_NO_REPLACEMENT = mysqlerr84.ER_WARN_DEPRECATED_SYNTAX_NO_REPLACEMENT
+const ER_WRONG_NATIVE_TABLE_STRUCTURE = mysqlerr84.ER_WRONG_NATIVE_TABLE_STRUCTURE
+const ER_WRONG_PERFSCHEMA_USAGE = mysqlerr84.ER_WRONG_PERFSCHEMA_USAGE
+const ER_WARN_I_S_SKIPPED_TABLE = mysqlerr84.ER_WARN_I_S_SKIPPED_TABLE
+const ER_INSIDE_TRANSACTION_PREVENTS_SWITCH_BINLOG_DIRECT = mysqlerr84.ER_INSIDE_TRANSACTION_PREVENTS_SWITCH_BINLOG_DIRECT
+const ER_STORED_FUNCTION_PREVENTS_SWITCH_BINLOG_DIRECT = mysqlerr84.ER_STORED_FUNCTION_PREVENTS_SWITCH_BINLOG_DIRECT
+const ER_SPATIAL_MUST_HAVE_GEOM_COL = mysqlerr84.ER_SPATIAL_MUST_HAVE_GEOM_COL
+const ER_TOO_LONG_INDEX_COMMENT = mysqlerr84.ER_TOO_LONG_INDEX_COMMENT
+const ER_LOCK_ABORTED = mysqlerr84.ER_LOCK_ABORTED
+const ER_DATA_OUT_OF_RANGE = mysqlerr84.ER_DATA_OUT_OF_RANGE
+
+// Deprecated: should not be used
+const ER_WRONG_SPVAR_TYPE_IN_LIMIT = mysqlerr84.ER_WRONG_SPVAR_TYPE_IN_LIMIT
+const OBSOLETE_ER_WRONG_SPVAR_TYPE_IN_LIMIT = mysqlerr84.OBSOLETE_ER_WRONG_SPVAR_TYPE_IN_LIMIT
+const ER_BINLOG_UNSAFE_MULTIPLE_ENGINES_AND_SELF_LOGGING_ENGINE = mysqlerr84.ER_BINLOG_UNSAFE_MULTIPLE_ENGINES_AND_SELF_LOGGING_ENGINE
+const ER_BINLOG_UNSAFE_MIXED_STATEMENT = mysqlerr84.ER_BINLOG_UNSAFE_MIXED_STATEMENT
+const ER_INSIDE_TRANSACTION_PREVENTS_SWITCH_SQL_LOG_BIN = mysqlerr84.ER_INSIDE_TRANSACTION_PREVENTS_SWITCH_SQL_LOG_BIN
+const ER_STORED_FUNCTION_PREVENTS_SWITCH_SQL_LOG_BIN = mysqlerr84.ER_STORED_FUNCTION_PREVENTS_SWITCH_SQL_LOG_BIN
+const ER_FAILED_READ_FROM_PAR_FILE = mysqlerr84.ER_FAILED_READ_FROM_PAR_FILE
+const ER_VALUES_IS_NOT_INT_TYPE_ERROR = mysqlerr84.ER_VALUES_IS_NOT_INT_TYPE_ERROR
+const ER_ACCESS_DENIED_NO_PASSWORD_ERROR = mysqlerr84.ER_ACCESS_DENIED_NO_PASSWORD_ERROR
+
+// Deprecated: should not be used
+const ER_SET_PASSWORD_AUTH_PLUGIN = mysqlerr84.ER_SET_PASSWORD_AUTH_PLUGIN
+const OBSOLETE_ER_SET_PASSWORD_AUTH_PLUGIN = mysqlerr84.OBSOLETE_ER_SET_PASSWORD_AUTH_PLUGIN
+
+// Deprecated: should not be used
+const ER_GRANT_PLUGIN_USER_EXISTS = mysqlerr84.ER_GRANT_PLUGIN_USER_EXISTS
+const OBSOLETE_ER_GRANT_PLUGIN_USER_EXISTS = mysqlerr84.OBSOLETE_ER_GRANT_PLUGIN_USER_EXISTS
+const ER_TRUNCATE_ILLEGAL_FK = mysqlerr84.ER_TRUNCATE_ILLEGAL_FK
+const ER_PLUGIN_IS_PERMANENT = mysqlerr84.ER_PLUGIN_IS_PERMANENT
+
+// Deprecated: should not be used
+const ER_SLAVE_HEARTBEAT_VALUE_OUT_OF_RANGE_MIN = mysqlerr84.ER_SLAVE_HEARTBEAT_VALUE_OUT_OF_RANGE_MIN
+const ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MIN = mysqlerr84.ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MIN
+
+// Deprecated: should not be used
+const ER_SLAVE_HEARTBEAT_VALUE_OUT_OF_RANGE_MAX = mysqlerr84.ER_SLAVE_HEARTBEAT_VALUE_OUT_OF_RANGE_MAX
+const ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MAX = mysqlerr84.ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MAX
+const ER_STMT_CACHE_FULL = mysqlerr84.ER_STMT_CACHE_FULL
+const ER_MULTI_UPDATE_KEY_CONFLICT = mysqlerr84.ER_MULTI_UPDATE_KEY_CONFLICT
+const ER_TABLE_NEEDS_REBUILD = mysqlerr84.ER_TABLE_NEEDS_REBUILD
+const WARN_OPTION_BELOW_LIMIT = mysqlerr84.WARN_OPTION_BELOW_LIMIT
+const ER_INDEX_COLUMN_TOO_LONG = mysqlerr84.ER_INDEX_COLUMN_TOO_LONG
+const ER_ERROR_IN_TRIGGER_BODY = mysqlerr84.ER_ERROR_IN_TRIGGER_BODY
+const ER_ERROR_IN_UNKNOWN_TRIGGER_BODY = mysqlerr84.ER_ERROR_IN_UNKNOWN_TRIGGER_BODY
+const ER_INDEX_CORRUPT = mysqlerr84.ER_INDEX_CORRUPT
+const ER_UNDO_RECORD_TOO_BIG = mysqlerr84.ER_UNDO_RECORD_TOO_BIG
+const ER_BINLOG_UNSAFE_INSERT_IGNORE_SELECT = mysqlerr84.ER_BINLOG_UNSAFE_INSERT_IGNORE_SELECT
+const ER_BINLOG_UNSAFE_INSERT_SELECT_UPDATE = mysqlerr84.ER_BINLOG_UNSAFE_INSERT_SELECT_UPDATE
+const ER_BINLOG_UNSAFE_REPLACE_SELECT = mysqlerr84.ER_BINLOG_UNSAFE_REPLACE_SELECT
+const ER_BINLOG_UNSAFE_CREATE_IGNORE_SELECT = mysqlerr84.ER_BINLOG_UNSAFE_CREATE_IGNORE_SELECT
+const ER_BINLOG_UNSAFE_CREATE_REPLACE_SELECT = mysqlerr84.ER_BINLOG_UNSAFE_CREATE_REPLACE_SELECT
+const ER_BINLOG_UNSAFE_UPDATE_IGNORE = mysqlerr84.ER_BINLOG_UNSAFE_UPDATE_IGNORE
+const ER_PLUGIN_NO_UNINSTALL = mysqlerr84.ER_PLUGIN_NO_UNINSTALL
+const ER_PLUGIN_NO_INSTALL = mysqlerr84.ER_PLUGIN_NO_INSTALL
+const ER_BINLOG_UNSAFE_WRITE_AUTOINC_SELECT = mysqlerr84.ER_BINLOG_UNSAFE_WRITE_AUTOINC_SELECT
+const ER_BINLOG_UNSAFE_CREATE_SELECT_AUTOINC = mysqlerr84.ER_BINLOG_UNSAFE_CREATE_SELECT_AUTOINC
+const ER_BINLOG_UNSAFE_INSERT_TWO_KEYS = mysqlerr84.ER_BINLOG_UNSAFE_INSERT_TWO_KEYS
+const ER_TABLE_IN_FK_CHECK = mysqlerr84.ER_TABLE_IN_FK_CHECK
+const ER_UNSUPPORTED_ENGINE = mysqlerr84.ER_UNSUPPORTED_ENGINE
+const ER_BINLOG_UNSAFE_AUTOINC_NOT_FIRST = mysqlerr84.ER_BINLOG_UNSAFE_AUTOINC_NOT_FIRST
+const ER_CANNOT_LOAD_FROM_TABLE_V2 = mysqlerr84.ER_CANNOT_LOAD_FROM_TABLE_V2
+
+// Deprecated: should not be used
+const ER_MASTER_DELAY_VALUE_OUT_OF_RANGE = mysqlerr84.ER_MASTER_DELAY_VALUE_OUT_OF_RANGE
+const ER_SOURCE_DELAY_VALUE_OUT_OF_RANGE = mysqlerr84.ER_SOURCE_DELAY_VALUE_OUT_OF_RANGE
+const ER_ONLY_FD_AND_RBR_EVENTS_ALLOWED_IN_BINLOG_STATEMENT = mysqlerr84.ER_ONLY_FD_AND_RBR_EVENTS_ALLOWED_IN_BINLOG_STATEMENT
+const ER_PARTITION_EXCHANGE_DIFFERENT_OPTION = mysqlerr84.ER_PARTITION_EXCHANGE_DIFFERENT_OPTION
+const ER_PARTITION_EXCHANGE_PART_TABLE = mysqlerr84.ER_PARTITION_EXCHANGE_PART_TABLE
+const ER_PARTITION_EXCHANGE_TEMP_TABLE = mysqlerr84.ER_PARTITION_EXCHANGE_TEMP_TABLE
+const ER_PARTITION_INSTEAD_OF_SUBPARTITION = mysqlerr84.ER_PARTITION_INSTEAD_OF_SUBPARTITION
+const ER_UNKNOWN_PARTITION = mysqlerr84.ER_UNKNOWN_PARTITION
+const ER_TABLES_DIFFERENT_METADATA = mysqlerr84.ER_TABLES_DIFFERENT_METADATA
+const ER_ROW_DOES_NOT_MATCH_PARTITION = mysqlerr84.ER_ROW_DOES_NOT_MATCH_PARTITION
+const ER_BINLOG_CACHE_SIZE_GREATER_THAN_MAX = mysqlerr84.ER_BINLOG_CACHE_SIZE_GREATER_THAN_MAX
+const ER_WARN_INDEX_NOT_APPLICABLE = mysqlerr84.ER_WARN_INDEX_NOT_APPLICABLE
+const ER_PARTITION_EXCHANGE_FOREIGN_KEY = mysqlerr84.ER_PARTITION_EXCHANGE_FOREIGN_KEY
+
+// Deprecated: should not be used
+const ER_NO_SUCH_KEY_VALUE = mysqlerr84.ER_NO_SUCH_KEY_VALUE
+const OBSOLETE_ER_NO_SUCH_KEY_VALUE = mysqlerr84.OBSOLETE_ER_NO_SUCH_KEY_VALUE
+const ER_RPL_INFO_DATA_TOO_LONG = mysqlerr84.ER_RPL_INFO_DATA_TOO_LONG
+
+// Deprecated: should not be used
+const ER_NETWORK_READ_EVENT_CHECKSUM_FAILURE = mysqlerr84.ER_NETWORK_READ_EVENT_CHECKSUM_FAILURE
+const OBSOLETE_ER_NETWORK_READ_EVENT_CHECKSUM_FAILURE = mysqlerr84.OBSOLETE_ER_NETWORK_READ_EVENT_CHECKSUM_FAILURE
+
+// Deprecated: should not be used
+const ER_BINLOG_READ_EVENT_CHECKSUM_FAILURE = mysqlerr84.ER_BINLOG_READ_EVENT_CHECKSUM_FAILURE
+const OBSOLETE_ER_BINLOG_READ_EVENT_CHECKSUM_FAILURE = mysqlerr84.OBSOLETE_ER_BINLOG_READ_EVENT_CHECKSUM_FAILURE
+const ER_BINLOG_STMT_CACHE_SIZE_GREATER_THAN_MAX = mysqlerr84.ER_BINLOG_STMT_CACHE_SIZE_GREATER_THAN_MAX
+const ER_CANT_UPDATE_TABLE_IN_CREATE_TABLE_SELECT = mysqlerr84.ER_CANT_UPDATE_TABLE_IN_CREATE_TABLE_SELECT
+const ER_PARTITION_CLAUSE_ON_NONPARTITIONED = mysqlerr84.ER_PARTITION_CLAUSE_ON_NONPARTITIONED
+const ER_ROW_DOES_NOT_MATCH_GIVEN_PARTITION_SET = mysqlerr84.ER_ROW_DOES_NOT_MATCH_GIVEN_PARTITION_SET
+
+// Deprecated: should not be used
+const ER_NO_SUCH_PARTITION__UNUSED = mysqlerr84.ER_NO_SUCH_PARTITION__UNUSED
+const OBSOLETE_ER_NO_SUCH_PARTITION__UNUSED = mysqlerr84.OBSOLETE_ER_NO_SUCH_PARTITION__UNUSED
+const ER_CHANGE_RPL_INFO_REPOSITORY_FAILURE = mysqlerr84.ER_CHANGE_RPL_INFO_REPOSITORY_FAILURE
+const ER_WARNING_NOT_COMPLETE_ROLLBACK_WITH_CREATED_TEMP_TABLE = mysqlerr84.ER_WARNING_NOT_COMPLETE_ROLLBACK_WITH_CREATED_TEMP_TABLE
+const ER_WARNING_NOT_COMPLETE_ROLLBACK_WITH_DROPPED_TEMP_TABLE = mysqlerr84.ER_WARNING_NOT_COMPLETE_ROLLBACK_WITH_DROPPED_TEMP_TABLE
+
+// Deprecated: should not be used
+const ER_MTS_FEATURE_IS_NOT_SUPPORTED = mysqlerr84.ER_MTS_FEATURE_IS_NOT_SUPPORTED
+const ER_MTA_FEATURE_IS_NOT_SUPPORTED = mysqlerr84.ER_MTA_FEATURE_IS_NOT_SUPPORTED
+
+// Deprecated: should not be used
+const ER_MTS_UPDATED_DBS_GREATER_MAX = mysqlerr84.ER_MTS_UPDATED_DBS_GREATER_MAX
+const ER_MTA_UPDATED_DBS_GREATER_MAX = mysqlerr84.ER_MTA_UPDATED_DBS_GREATER_MAX
+
+// Deprecated: should not be used
+const ER_MTS_CANT_PARALLEL = mysqlerr84.ER_MTS_CANT_PARALLEL
+const ER_MTA_CANT_PARALLEL = mysqlerr84.ER_MTA_CANT_PARALLEL
+
+// Deprecated: should not be used
+const ER_MTS_INCONSISTENT_DATA = mysqlerr84.ER_MTS_INCONSISTENT_DATA
+const ER_MTA_INCONSISTENT_DATA = mysqlerr84.ER_MTA_INCONSISTENT_DATA
+const ER_FULLTEXT_NOT_SUPPORTED_WITH_PARTITIONING = mysqlerr84.ER_FULLTEXT_NOT_SUPPORTED_WITH_PARTITIONING
+const ER_DA_INVALID_CONDITION_NUMBER = mysqlerr84.ER_DA_INVALID_CONDITION_NUMBER
+const ER_INSECURE_PLAIN_TEXT = mysqlerr84.ER_INSECURE_PLAIN_TEXT
+
+// Deprecated: should not be used
+const ER_INSECURE_CHANGE_MASTER = mysqlerr84.ER_INSECURE_CHANGE_MASTER
+const ER_INSECURE_CHANGE_SOURCE = mysqlerr84.ER_INSECURE_CHANGE_SOURCE
+const ER_FOREIGN_DUPLICATE_KEY_WITH_CHILD_INFO = mysqlerr84.ER_FOREIGN_DUPLICATE_KEY_WITH_CHILD_INFO
+const ER_FOREIGN_DUPLICATE_KEY_WITHOUT_CHILD_INFO = mysqlerr84.ER_FOREIGN_DUPLICATE_KEY_WITHOUT_CHILD_INFO
+
+// Deprecated: should not be used
+const ER_SQLTHREAD_WITH_SECURE_SLAVE = mysqlerr84.ER_SQLTHREAD_WITH_SECURE_SLAVE
+const ER_SQLTHREAD_WITH_SECURE_REPLICA = mysqlerr84.ER_SQLTHREAD_WITH_SECURE_REPLICA
+const ER_TABLE_HAS_NO_FT = mysqlerr84.ER_TABLE_HAS_NO_FT
+const ER_VARIABLE_NOT_SETTABLE_IN_SF_OR_TRIGGER = mysqlerr84.ER_VARIABLE_NOT_SETTABLE_IN_SF_OR_TRIGGER
+const ER_VARIABLE_NOT_SETTABLE_IN_TRANSACTION = mysqlerr84.ER_VARIABLE_NOT_SETTABLE_IN_TRANSACTION
+
+// Deprecated: should not be used
+const ER_GTID_NEXT_IS_NOT_IN_GTID_NEXT_LIST = mysqlerr84.ER_GTID_NEXT_IS_NOT_IN_GTID_NEXT_LIST
+const OBSOLETE_ER_GTID_NEXT_IS_NOT_IN_GTID_NEXT_LIST = mysqlerr84.OBSOLETE_ER_GTID_NEXT_IS_NOT_IN_GTID_NEXT_LIST
+
+// Deprecated: should not be used
+const ER_CANT_CHANGE_GTID_NEXT_IN_TRANSACTION = mysqlerr84.ER_CANT_CHANGE_GTID_NEXT_IN_TRANSACTION
+const OBSOLETE_ER_CANT_CHANGE_GTID_NEXT_IN_TRANSACTION = mysqlerr84.OBSOLETE_ER_CANT_CHANGE_GTID_NEXT_IN_TRANSACTION
+const ER_SET_STATEMENT_CANNOT_INVOKE_FUNCTION = mysqlerr84.ER_SET_STATEMENT_CANNOT_INVOKE_FUNCTION
+const ER_GTID_NEXT_CANT_BE_AUTOMATIC_IF_GTID_NEXT_LIST_IS_NON_NULL = mysqlerr84.ER_GTID_NEXT_CANT_BE_AUTOMATIC_IF_GTID_NEXT_LIST_IS_NON_NULL
+
+// Deprecated: should not be used
+const ER_SKIPPING_LOGGED_TRANSACTION = mysqlerr84.ER_SKIPPING_LOGGED_TRANSACTION
+const OBSOLETE_ER_SKIPPING_LOGGED_TRANSACTION = mysqlerr84.OBSOLETE_ER_SKIPPING_LOGGED_TRANSACTION
+const ER_MALFORMED_GTID_SET_SPECIFICATION = mysqlerr84.ER_MALFORMED_GTID_SET_SPECIFICATION
+const ER_MALFORMED_GTID_SET_ENCODING = mysqlerr84.ER_MALFORMED_GTID_SET_ENCODING
+const ER_MALFORMED_GTID_SPECIFICATION = mysqlerr84.ER_MALFORMED_GTID_SPECIFICATION
+const ER_GNO_EXHAUSTED = mysqlerr84.ER_GNO_EXHAUSTED
+
+// Deprecated: should not be used
+const ER_BAD_SLAVE_AUTO_POSITION = mysqlerr84.ER_BAD_SLAVE_AUTO_POSITION
+const ER_BAD_REPLICA_AUTO_POSITION = mysqlerr84.ER_BAD_REPLICA_AUTO_POSITION
+const ER_AUTO_POSITION_REQUIRES_GTID_MODE_NOT_OFF = mysqlerr84.ER_AUTO_POSITION_REQUIRES_GTID_MODE_NOT_OFF
+const ER_CANT_DO_IMPLICIT_COMMIT_IN_TRX_WHEN_GTID_NEXT_IS_SET = mysqlerr84.ER_CANT_DO_IMPLICIT_COMMIT_IN_TRX_WHEN_GTID_NEXT_IS_SET
+const ER_GTID_MODE_ON_REQUIRES_ENFORCE_GTID_CONSISTENCY_ON = mysqlerr84.ER_GTID_MODE_ON_REQUIRES_ENFORCE_GTID_CONSISTENCY_ON
+
+// Deprecated: should not be used
+const ER_GTID_MODE_REQUIRES_BINLOG = mysqlerr84.ER_GTID_MODE_REQUIRES_BINLOG
+const OBSOLETE_ER_GTID_MODE_REQUIRES_BINLOG = mysqlerr84.OBSOLETE_ER_GTID_MODE_REQUIRES_BINLOG
+const ER_CANT_SET_GTID_NEXT_TO_GTID_WHEN_GTID_MODE_IS_OFF = mysqlerr84.ER_CANT_SET_GTID_NEXT_TO_GTID_WHEN_GTID_MODE_IS_OFF
+const ER_CANT_SET_GTID_NEXT_TO_ANONYMOUS_WHEN_GTID_MODE_IS_ON = mysqlerr84.ER_CANT_SET_GTID_NEXT_TO_ANONYMOUS_WHEN_GTID_MODE_IS_ON
+const ER_CANT_SET_GTID_NEXT_LIST_TO_NON_NULL_WHEN_GTID_MODE_IS_OFF = mysqlerr84.ER_CANT_SET_GTID_NEXT_LIST_TO_NON_NULL_WHEN_GTID_MODE_IS_OFF
+
+// Deprecated: should not be used
+const ER_FOUND_GTID_EVENT_WHEN_GTID_MODE_IS_OFF__UNUSED = mysqlerr84.ER_FOUND_GTID_EVENT_WHEN_GTID_MODE_IS_OFF__UNUSED
+const OBSOLETE_ER_FOUND_GTID_EVENT_WHEN_GTID_MODE_IS_OFF__UNUSED = mysqlerr84.OBSOLETE_ER_FOUND_GTID_EVENT_WHEN_GTID_MODE_IS_OFF__UNUSED
+const ER_GTID_UNSAFE_NON_TRANSACTIONAL_TABLE = mysqlerr84.ER_GTID_UNSAFE_NON_TRANSACTIONAL_TABLE
+const ER_GTID_UNSAFE_CREATE_SELECT = mysqlerr84.ER_GTID_UNSAFE_CREATE_SELECT
+
+// Deprecated: should not be used
+const ER_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRANSACTION = mysqlerr84.ER_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRANSACTION
+const OBSOLETE_ER_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRANSACTION = mysqlerr84.OBSOLETE_ER_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRANSACTION
+const ER_GTID_MODE_CAN_ONLY_CHANGE_ONE_STEP_AT_A_TIME = mysqlerr84.ER_GTID_MODE_CAN_ONLY_CHANGE_ONE_STEP_AT_A_TIME
+
+// Deprecated: should not be used
+const ER_MASTER_HAS_PURGED_REQUIRED_GTIDS = mysqlerr84.ER_MASTER_HAS_PURGED_REQUIRED_GTIDS
+const ER_SOURCE_HAS_PURGED_REQUIRED_GTIDS = mysqlerr84.ER_SOURCE_HAS_PURGED_REQUIRED_GTIDS
+const ER_CANT_SET_GTID_NEXT_WHEN_OWNING_GTID = mysqlerr84.ER_CANT_SET_GTID_NEXT_WHEN_OWNING_GTID
+const ER_UNKNOWN_EXPLAIN_FORMAT = mysqlerr84.ER_UNKNOWN_EXPLAIN_FORMAT
+const ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION = mysqlerr84.ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION
+const ER_TOO_LONG_TABLE_PARTITION_COMMENT = mysqlerr84.ER_TOO_LONG_TABLE_PARTITION_COMMENT
+
+// Deprecated: should not be used
+const ER_SLAVE_CONFIGURATION = mysqlerr84.ER_SLAVE_CONFIGURATION
+const ER_REPLICA_CONFIGURATION = mysqlerr84.ER_REPLICA_CONFIGURATION
+const ER_INNODB_FT_LIMIT = mysqlerr84.ER_INNODB_FT_LIMIT
+const ER_INNODB_NO_FT_TEMP_TABLE = mysqlerr84.ER_INNODB_NO_FT_TEMP_TABLE
+const ER_INNODB_FT_WRONG_DOCID_COLUMN = mysqlerr84.ER_INNODB_FT_WRONG_DOCID_COLUMN
+const ER_INNODB_FT_WRONG_DOCID_INDEX = mysqlerr84.ER_INNODB_FT_WRONG_DOCID_INDEX
+const ER_INNODB_ONLINE_LOG_TOO_BIG = mysqlerr84.ER_INNODB_ONLINE_LOG_TOO_BIG
+const ER_UNKNOWN_ALTER_ALGORITHM = mysqlerr84.ER_UNKNOWN_ALTER_ALGORITHM
+const ER_UNKNOWN_ALTER_LOCK = mysqlerr84.ER_UNKNOWN_ALTER_LOCK
+
+// Deprecated: should not be used
+const ER_MTS_CHANGE_MASTER_CANT_RUN_WITH_GAPS = mysqlerr84.ER_MTS_CHANGE_MASTER_CANT_RUN_WITH_GAPS
+const ER_MTA_CHANGE_SOURCE_CANT_RUN_WITH_GAPS = mysqlerr84.ER_MTA_CHANGE_SOURCE_CANT_RUN_WITH_GAPS
+
+// Deprecated: should not be used
+const ER_MTS_RECOVERY_FAILURE = mysqlerr84.ER_MTS_RECOVERY_FAILURE
+const ER_MTA_RECOVERY_FAILURE = mysqlerr84.ER_MTA_RECOVERY_FAILURE
+
+// Deprecated: should not be used
+const ER_MTS_RESET_WORKERS = mysqlerr84.ER_MTS_RESET_WORKERS
+const ER_MTA_RESET_WORKERS = mysqlerr84.ER_MTA_RESET_WORKERS
+const ER_COL_COUNT_DOESNT_MATCH_CORRUPTED_V2 = mysqlerr84.ER_COL_COUNT_DOESNT_MATCH_CORRUPTED_V2
+
+// Deprecated: should not be used
+const ER_SLAVE_SILENT_RETRY_TRANSACTION = mysqlerr84.ER_SLAVE_SILENT_RETRY_TRANSACTION
+const ER_REPLICA_SILENT_RETRY_TRANSACTION = mysqlerr84.ER_REPLICA_SILENT_RETRY_TRANSACTION
+const ER_DISCARD_FK_CHECKS_RUNNING = mysqlerr84.ER_DISCARD_FK_CHECKS_RUNNING
+const ER_TABLE_SCHEMA_MISMATCH = mysqlerr84.ER_TABLE_SCHEMA_MISMATCH
+const ER_TABLE_IN_SYSTEM_TABLESPACE = mysqlerr84.ER_TABLE_IN_SYSTEM_TABLESPACE
+const ER_IO_READ_ERROR = mysqlerr84.ER_IO_READ_ERROR
+const ER_IO_WRITE_ERROR = mysqlerr84.ER_IO_WRITE_ERROR
+const ER_TABLESPACE_MISSING = mysqlerr84.ER_TABLESPACE_MISSING
+const ER_TABLESPACE_EXISTS = mysqlerr84.ER_TABLESPACE_EXISTS
+const ER_TABLESPACE_DISCARDED = mysqlerr84.ER_TABLESPACE_DISCARDED
+const ER_INTERNAL_ERROR = mysqlerr84.ER_INTERNAL_ERROR
+const ER_INNODB_IMPORT_ERROR = mysqlerr84.ER_INNODB_IMPORT_ERROR
+const ER_INNODB_INDEX_CORRUPT = mysqlerr84.ER_INNODB_INDEX_CORRUPT
+const ER_INVALID_YEAR_COLUMN_LENGTH = mysqlerr84.ER_INVALID_YEAR_COLUMN_LENGTH
+const ER_NOT_VALID_PASSWORD = mysqlerr84.ER_NOT_VALID_PASSWORD
+const ER_MUST_CHANGE_PASSWORD = mysqlerr84.ER_MUST_CHANGE_PASSWORD
+const ER_FK_NO_INDEX_CHILD = mysqlerr84.ER_FK_NO_INDEX_CHILD
+const ER_FK_NO_INDEX_PARENT = mysqlerr84.ER_FK_NO_INDEX_PARENT
+const ER_FK_FAIL_ADD_SYSTEM = mysqlerr84.ER_FK_FAIL_ADD_SYSTEM
+const ER_FK_CANNOT_OPEN_PARENT = mysqlerr84.ER_FK_CANNOT_OPEN_PARENT
+const ER_FK_INCORRECT_OPTION = mysqlerr84.ER_FK_INCORRECT_OPTION
+const ER_FK_DUP_NAME = mysqlerr84.ER_FK_DUP_NAME
+const ER_PASSWORD_FORMAT = mysqlerr84.ER_PASSWORD_FORMAT
+const ER_FK_COLUMN_CANNOT_DROP = mysqlerr84.ER_FK_COLUMN_CANNOT_DROP
+const ER_FK_COLUMN_CANNOT_DROP_CHILD = mysqlerr84.ER_FK_COLUMN_CANNOT_DROP_CHILD
+const ER_FK_COLUMN_NOT_NULL = mysqlerr84.ER_FK_COLUMN_NOT_NULL
+const ER_DUP_INDEX = mysqlerr84.ER_DUP_INDEX
+const ER_FK_COLUMN_CANNOT_CHANGE = mysqlerr84.ER_FK_COLUMN_CANNOT_CHANGE
+const ER_FK_COLUMN_CANNOT_CHANGE_CHILD = mysqlerr84.ER_FK_COLUMN_CANNOT_CHANGE_CHILD
+
+// Deprecated: should not be used
+const ER_UNUSED5 = mysqlerr84.ER_UNUSED5
+const OBSOLETE_ER_UNUSED5 = mysqlerr84.OBSOLETE_ER_UNUSED5
+const ER_MALFORMED_PACKET = mysqlerr84.ER_MALFORMED_PACKET
+const ER_READ_ONLY_MODE = mysqlerr84.ER_READ_ONLY_MODE
+const ER_GTID_NEXT_TYPE_UNDEFINED_GTID = mysqlerr84.ER_GTID_NEXT_TYPE_UNDEFINED_GTID
+const ER_VARIABLE_NOT_SETTABLE_IN_SP = mysqlerr84.ER_VARIABLE_NOT_SETTABLE_IN_SP
+
+// Deprecated: should not be used
+const ER_CANT_SET_GTID_PURGED_WHEN_GTID_MODE_IS_OFF = mysqlerr84.ER_CANT_SET_GTID_PURGED_WHEN_GTID_MODE_IS_OFF
+const OBSOLETE_ER_CANT_SET_GTID_PURGED_WHEN_GTID_MODE_IS_OFF = mysqlerr84.OBSOLETE_ER_CANT_SET_GTID_PURGED_WHEN_GTID_MODE_IS_OFF
+const ER_CANT_SET_GTID_PURGED_WHEN_GTID_EXECUTED_IS_NOT_EMPTY = mysqlerr84.ER_CANT_SET_GTID_PURGED_WHEN_GTID_EXECUTED_IS_NOT_EMPTY
+const ER_CANT_SET_GTID_PURGED_WHEN_OWNED_GTIDS_IS_NOT_EMPTY = mysqlerr84.ER_CANT_SET_GTID_PURGED_WHEN_OWNED_GTIDS_IS_NOT_EMPTY
+const ER_GTID_PURGED_WAS_CHANGED = mysqlerr84.ER_GTID_PURGED_WAS_CHANGED
+const ER_GTID_EXECUTED_WAS_CHANGED = mysqlerr84.ER_GTID_EXECUTED_WAS_CHANGED
+const ER_BINLOG_STMT_MODE_AND_NO_REPL_TABLES = mysqlerr84.ER_BINLOG_STMT_MODE_AND_NO_REPL_TABLES
+const ER_ALTER_OPERATION_NOT_SUPPORTED = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COPY = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COPY
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_PARTITION = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_PARTITION
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FK_RENAME = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FK_RENAME
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COLUMN_TYPE = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COLUMN_TYPE
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FK_CHECK = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FK_CHECK
+
+// Deprecated: should not be used
+const ER_UNUSED6 = mysqlerr84.ER_UNUSED6
+const OBSOLETE_ER_UNUSED6 = mysqlerr84.OBSOLETE_ER_UNUSED6
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_NOPK = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_NOPK
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_AUTOINC = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_AUTOINC
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_HIDDEN_FTS = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_HIDDEN_FTS
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_CHANGE_FTS = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_CHANGE_FTS
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FTS = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FTS
+
+// Deprecated: should not be used
+const ER_SQL_REPLICA_SKIP_COUNTER_NOT_SETTABLE_IN_GTID_MODE = mysqlerr84.ER_SQL_REPLICA_SKIP_COUNTER_NOT_SETTABLE_IN_GTID_MODE
+const OBSOLETE_ER_SQL_REPLICA_SKIP_COUNTER_NOT_SETTABLE_IN_GTID_MODE = mysqlerr84.OBSOLETE_ER_SQL_REPLICA_SKIP_COUNTER_NOT_SETTABLE_IN_GTID_MODE
+const ER_DUP_UNKNOWN_IN_INDEX = mysqlerr84.ER_DUP_UNKNOWN_IN_INDEX
+const ER_IDENT_CAUSES_TOO_LONG_PATH = mysqlerr84.ER_IDENT_CAUSES_TOO_LONG_PATH
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_NOT_NULL = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_NOT_NULL
+const ER_MUST_CHANGE_PASSWORD_LOGIN = mysqlerr84.ER_MUST_CHANGE_PASSWORD_LOGIN
+const ER_ROW_IN_WRONG_PARTITION = mysqlerr84.ER_ROW_IN_WRONG_PARTITION
+
+// Deprecated: should not be used
+const ER_MTS_EVENT_BIGGER_PENDING_JOBS_SIZE_MAX = mysqlerr84.ER_MTS_EVENT_BIGGER_PENDING_JOBS_SIZE_MAX
+const ER_MTA_EVENT_BIGGER_PENDING_JOBS_SIZE_MAX = mysqlerr84.ER_MTA_EVENT_BIGGER_PENDING_JOBS_SIZE_MAX
+
+// Deprecated: should not be used
+const ER_INNODB_NO_FT_USES_PARSER = mysqlerr84.ER_INNODB_NO_FT_USES_PARSER
+const OBSOLETE_ER_INNODB_NO_FT_USES_PARSER = mysqlerr84.OBSOLETE_ER_INNODB_NO_FT_USES_PARSER
+const ER_BINLOG_LOGICAL_CORRUPTION = mysqlerr84.ER_BINLOG_LOGICAL_CORRUPTION
+const ER_WARN_PURGE_LOG_IN_USE = mysqlerr84.ER_WARN_PURGE_LOG_IN_USE
+const ER_WARN_PURGE_LOG_IS_ACTIVE = mysqlerr84.ER_WARN_PURGE_LOG_IS_ACTIVE
+const ER_AUTO_INCREMENT_CONFLICT = mysqlerr84.ER_AUTO_INCREMENT_CONFLICT
+const WARN_ON_BLOCKHOLE_IN_RBR = mysqlerr84.WARN_ON_BLOCKHOLE_IN_RBR
+
+// Deprecated: should not be used
+const ER_SLAVE_MI_INIT_REPOSITORY = mysqlerr84.ER_SLAVE_MI_INIT_REPOSITORY
+const ER_REPLICA_CM_INIT_REPOSITORY = mysqlerr84.ER_REPLICA_CM_INIT_REPOSITORY
+
+// Deprecated: should not be used
+const ER_SLAVE_RLI_INIT_REPOSITORY = mysqlerr84.ER_SLAVE_RLI_INIT_REPOSITORY
+const ER_REPLICA_AM_INIT_REPOSITORY = mysqlerr84.ER_REPLICA_AM_INIT_REPOSITORY
+const ER_ACCESS_DENIED_CHANGE_USER_ERROR = mysqlerr84.ER_ACCESS_DENIED_CHANGE_USER_ERROR
+const ER_INNODB_READ_ONLY = mysqlerr84.ER_INNODB_READ_ONLY
+
+// Deprecated: should not be used
+const ER_STOP_SLAVE_SQL_THREAD_TIMEOUT = mysqlerr84.ER_STOP_SLAVE_SQL_THREAD_TIMEOUT
+const ER_STOP_REPLICA_SQL_THREAD_TIMEOUT = mysqlerr84.ER_STOP_REPLICA_SQL_THREAD_TIMEOUT
+
+// Deprecated: should not be used
+const ER_STOP_SLAVE_IO_THREAD_TIMEOUT = mysqlerr84.ER_STOP_SLAVE_IO_THREAD_TIMEOUT
+const ER_STOP_REPLICA_IO_THREAD_TIMEOUT = mysqlerr84.ER_STOP_REPLICA_IO_THREAD_TIMEOUT
+const ER_TABLE_CORRUPT = mysqlerr84.ER_TABLE_CORRUPT
+const ER_TEMP_FILE_WRITE_FAILURE = mysqlerr84.ER_TEMP_FILE_WRITE_FAILURE
+const ER_INNODB_FT_AUX_NOT_HEX_ID = mysqlerr84.ER_INNODB_FT_AUX_NOT_HEX_ID
+const ER_OLD_TEMPORALS_UPGRADED = mysqlerr84.ER_OLD_TEMPORALS_UPGRADED
+const ER_INNODB_FORCED_RECOVERY = mysqlerr84.ER_INNODB_FORCED_RECOVERY
+const ER_AES_INVALID_IV = mysqlerr84.ER_AES_INVALID_IV
+const ER_PLUGIN_CANNOT_BE_UNINSTALLED = mysqlerr84.ER_PLUGIN_CANNOT_BE_UNINSTALLED
+const ER_GTID_UNSAFE_BINLOG_SPLITTABLE_STATEMENT_AND_ASSIGNED_GTID = mysqlerr84.ER_GTID_UNSAFE_BINLOG_SPLITTABLE_STATEMENT_AND_ASSIGNED_GTID
+
+// Deprecated: should not be used
+const ER_SLAVE_HAS_MORE_GTIDS_THAN_MASTER = mysqlerr84.ER_SLAVE_HAS_MORE_GTIDS_THAN_MASTER
+const ER_REPLICA_HAS_MORE_GTIDS_THAN_SOURCE = mysqlerr84.ER_REPLICA_HAS_MORE_GTIDS_THAN_SOURCE
+const ER_MISSING_KEY = mysqlerr84.ER_MISSING_KEY
+const WARN_NAMED_PIPE_ACCESS_EVERYONE = mysqlerr84.WARN_NAMED_PIPE_ACCESS_EVERYONE
+const ER_FILE_CORRUPT = mysqlerr84.ER_FILE_CORRUPT
+
+// Deprecated: should not be used
+const ER_ERROR_ON_MASTER = mysqlerr84.ER_ERROR_ON_MASTER
+const ER_ERROR_ON_SOURCE = mysqlerr84.ER_ERROR_ON_SOURCE
+
+// Deprecated: should not be used
+const ER_INCONSISTENT_ERROR = mysqlerr84.ER_INCONSISTENT_ERROR
+const OBSOLETE_ER_INCONSISTENT_ERROR = mysqlerr84.OBSOLETE_ER_INCONSISTENT_ERROR
+const ER_STORAGE_ENGINE_NOT_LOADED = mysqlerr84.ER_STORAGE_ENGINE_NOT_LOADED
+const ER_GET_STACKED_DA_WITHOUT_ACTIVE_HANDLER = mysqlerr84.ER_GET_STACKED_DA_WITHOUT_ACTIVE_HANDLER
+const ER_WARN_LEGACY_SYNTAX_CONVERTED = mysqlerr84.ER_WARN_LEGACY_SYNTAX_CONVERTED
+const ER_BINLOG_UNSAFE_FULLTEXT_PLUGIN = mysqlerr84.ER_BINLOG_UNSAFE_FULLTEXT_PLUGIN
+const ER_CANNOT_DISCARD_TEMPORARY_TABLE = mysqlerr84.ER_CANNOT_DISCARD_TEMPORARY_TABLE
+const ER_FK_DEPTH_EXCEEDED = mysqlerr84.ER_FK_DEPTH_EXCEEDED
+const ER_COL_COUNT_DOESNT_MATCH_PLEASE_UPDATE_V2 = mysqlerr84.ER_COL_COUNT_DOESNT_MATCH_PLEASE_UPDATE_V2
+const ER_WARN_TRIGGER_DOESNT_HAVE_CREATED = mysqlerr84.ER_WARN_TRIGGER_DOESNT_HAVE_CREATED
+const ER_REFERENCED_TRG_DOES_NOT_EXIST = mysqlerr84.ER_REFERENCED_TRG_DOES_NOT_EXIST
+const ER_EXPLAIN_NOT_SUPPORTED = mysqlerr84.ER_EXPLAIN_NOT_SUPPORTED
+const ER_INVALID_FIELD_SIZE = mysqlerr84.ER_INVALID_FIELD_SIZE
+const ER_MISSING_HA_CREATE_OPTION = mysqlerr84.ER_MISSING_HA_CREATE_OPTION
+const ER_ENGINE_OUT_OF_MEMORY = mysqlerr84.ER_ENGINE_OUT_OF_MEMORY
+const ER_PASSWORD_EXPIRE_ANONYMOUS_USER = mysqlerr84.ER_PASSWORD_EXPIRE_ANONYMOUS_USER
+
+// Deprecated: should not be used
+const ER_SLAVE_SQL_THREAD_MUST_STOP = mysqlerr84.ER_SLAVE_SQL_THREAD_MUST_STOP
+const ER_REPLICA_SQL_THREAD_MUST_STOP = mysqlerr84.ER_REPLICA_SQL_THREAD_MUST_STOP
+const ER_NO_FT_MATERIALIZED_SUBQUERY = mysqlerr84.ER_NO_FT_MATERIALIZED_SUBQUERY
+const ER_INNODB_UNDO_LOG_FULL = mysqlerr84.ER_INNODB_UNDO_LOG_FULL
+const ER_INVALID_ARGUMENT_FOR_LOGARITHM = mysqlerr84.ER_INVALID_ARGUMENT_FOR_LOGARITHM
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_IO_THREAD_MUST_STOP = mysqlerr84.ER_SLAVE_CHANNEL_IO_THREAD_MUST_STOP
+const ER_REPLICA_CHANNEL_IO_THREAD_MUST_STOP = mysqlerr84.ER_REPLICA_CHANNEL_IO_THREAD_MUST_STOP
+const ER_WARN_OPEN_TEMP_TABLES_MUST_BE_ZERO = mysqlerr84.ER_WARN_OPEN_TEMP_TABLES_MUST_BE_ZERO
+
+// Deprecated: should not be used
+const ER_WARN_ONLY_MASTER_LOG_FILE_NO_POS = mysqlerr84.ER_WARN_ONLY_MASTER_LOG_FILE_NO_POS
+const ER_WARN_ONLY_SOURCE_LOG_FILE_NO_POS = mysqlerr84.ER_WARN_ONLY_SOURCE_LOG_FILE_NO_POS
+const ER_QUERY_TIMEOUT = mysqlerr84.ER_QUERY_TIMEOUT
+const ER_NON_RO_SELECT_DISABLE_TIMER = mysqlerr84.ER_NON_RO_SELECT_DISABLE_TIMER
+const ER_DUP_LIST_ENTRY = mysqlerr84.ER_DUP_LIST_ENTRY
+
+// Deprecated: should not be used
+const ER_SQL_MODE_NO_EFFECT = mysqlerr84.ER_SQL_MODE_NO_EFFECT
+const OBSOLETE_ER_SQL_MODE_NO_EFFECT = mysqlerr84.OBSOLETE_ER_SQL_MODE_NO_EFFECT
+const ER_AGGREGATE_ORDER_FOR_UNION = mysqlerr84.ER_AGGREGATE_ORDER_FOR_UNION
+const ER_AGGREGATE_ORDER_NON_AGG_QUERY = mysqlerr84.ER_AGGREGATE_ORDER_NON_AGG_QUERY
+
+// Deprecated: should not be used
+const ER_SLAVE_WORKER_STOPPED_PREVIOUS_THD_ERROR = mysqlerr84.ER_SLAVE_WORKER_STOPPED_PREVIOUS_THD_ERROR
+const ER_REPLICA_WORKER_STOPPED_PREVIOUS_THD_ERROR = mysqlerr84.ER_REPLICA_WORKER_STOPPED_PREVIOUS_THD_ERROR
+const ER_DONT_SUPPORT_REPLICA_PRESERVE_COMMIT_ORDER = mysqlerr84.ER_DONT_SUPPORT_REPLICA_PRESERVE_COMMIT_ORDER
+const ER_SERVER_OFFLINE_MODE = mysqlerr84.ER_SERVER_OFFLINE_MODE
+const ER_GIS_DIFFERENT_SRIDS = mysqlerr84.ER_GIS_DIFFERENT_SRIDS
+const ER_GIS_UNSUPPORTED_ARGUMENT = mysqlerr84.ER_GIS_UNSUPPORTED_ARGUMENT
+const ER_GIS_UNKNOWN_ERROR = mysqlerr84.ER_GIS_UNKNOWN_ERROR
+const ER_GIS_UNKNOWN_EXCEPTION = mysqlerr84.ER_GIS_UNKNOWN_EXCEPTION
+const ER_GIS_INVALID_DATA = mysqlerr84.ER_GIS_INVALID_DATA
+const ER_BOOST_GEOMETRY_EMPTY_INPUT_EXCEPTION = mysqlerr84.ER_BOOST_GEOMETRY_EMPTY_INPUT_EXCEPTION
+const ER_BOOST_GEOMETRY_CENTROID_EXCEPTION = mysqlerr84.ER_BOOST_GEOMETRY_CENTROID_EXCEPTION
+const ER_BOOST_GEOMETRY_OVERLAY_INVALID_INPUT_EXCEPTION = mysqlerr84.ER_BOOST_GEOMETRY_OVERLAY_INVALID_INPUT_EXCEPTION
+const ER_BOOST_GEOMETRY_TURN_INFO_EXCEPTION = mysqlerr84.ER_BOOST_GEOMETRY_TURN_INFO_EXCEPTION
+const ER_BOOST_GEOMETRY_SELF_INTERSECTION_POINT_EXCEPTION = mysqlerr84.ER_BOOST_GEOMETRY_SELF_INTERSECTION_POINT_EXCEPTION
+const ER_BOOST_GEOMETRY_UNKNOWN_EXCEPTION = mysqlerr84.ER_BOOST_GEOMETRY_UNKNOWN_EXCEPTION
+const ER_STD_BAD_ALLOC_ERROR = mysqlerr84.ER_STD_BAD_ALLOC_ERROR
+const ER_STD_DOMAIN_ERROR = mysqlerr84.ER_STD_DOMAIN_ERROR
+const ER_STD_LENGTH_ERROR = mysqlerr84.ER_STD_LENGTH_ERROR
+const ER_STD_INVALID_ARGUMENT = mysqlerr84.ER_STD_INVALID_ARGUMENT
+const ER_STD_OUT_OF_RANGE_ERROR = mysqlerr84.ER_STD_OUT_OF_RANGE_ERROR
+const ER_STD_OVERFLOW_ERROR = mysqlerr84.ER_STD_OVERFLOW_ERROR
+const ER_STD_RANGE_ERROR = mysqlerr84.ER_STD_RANGE_ERROR
+const ER_STD_UNDERFLOW_ERROR = mysqlerr84.ER_STD_UNDERFLOW_ERROR
+const ER_STD_LOGIC_ERROR = mysqlerr84.ER_STD_LOGIC_ERROR
+const ER_STD_RUNTIME_ERROR = mysqlerr84.ER_STD_RUNTIME_ERROR
+const ER_STD_UNKNOWN_EXCEPTION = mysqlerr84.ER_STD_UNKNOWN_EXCEPTION
+const ER_GIS_DATA_WRONG_ENDIANESS = mysqlerr84.ER_GIS_DATA_WRONG_ENDIANESS
+
+// Deprecated: should not be used
+const ER_CHANGE_MASTER_PASSWORD_LENGTH = mysqlerr84.ER_CHANGE_MASTER_PASSWORD_LENGTH
+const ER_CHANGE_SOURCE_PASSWORD_LENGTH = mysqlerr84.ER_CHANGE_SOURCE_PASSWORD_LENGTH
+const ER_USER_LOCK_WRONG_NAME = mysqlerr84.ER_USER_LOCK_WRONG_NAME
+const ER_USER_LOCK_DEADLOCK = mysqlerr84.ER_USER_LOCK_DEADLOCK
+const ER_REPLACE_INACCESSIBLE_ROWS = mysqlerr84.ER_REPLACE_INACCESSIBLE_ROWS
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_GIS = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_GIS
+const ER_ILLEGAL_USER_VAR = mysqlerr84.ER_ILLEGAL_USER_VAR
+const ER_GTID_MODE_OFF = mysqlerr84.ER_GTID_MODE_OFF
+
+// Deprecated: should not be used
+const ER_UNSUPPORTED_BY_REPLICATION_THREAD = mysqlerr84.ER_UNSUPPORTED_BY_REPLICATION_THREAD
+const OBSOLETE_ER_UNSUPPORTED_BY_REPLICATION_THREAD = mysqlerr84.OBSOLETE_ER_UNSUPPORTED_BY_REPLICATION_THREAD
+const ER_INCORRECT_TYPE = mysqlerr84.ER_INCORRECT_TYPE
+const ER_FIELD_IN_ORDER_NOT_SELECT = mysqlerr84.ER_FIELD_IN_ORDER_NOT_SELECT
+const ER_AGGREGATE_IN_ORDER_NOT_SELECT = mysqlerr84.ER_AGGREGATE_IN_ORDER_NOT_SELECT
+const ER_INVALID_RPL_WILD_TABLE_FILTER_PATTERN = mysqlerr84.ER_INVALID_RPL_WILD_TABLE_FILTER_PATTERN
+const ER_NET_OK_PACKET_TOO_LARGE = mysqlerr84.ER_NET_OK_PACKET_TOO_LARGE
+const ER_INVALID_JSON_DATA = mysqlerr84.ER_INVALID_JSON_DATA
+const ER_INVALID_GEOJSON_MISSING_MEMBER = mysqlerr84.ER_INVALID_GEOJSON_MISSING_MEMBER
+const ER_INVALID_GEOJSON_WRONG_TYPE = mysqlerr84.ER_INVALID_GEOJSON_WRONG_TYPE
+const ER_INVALID_GEOJSON_UNSPECIFIED = mysqlerr84.ER_INVALID_GEOJSON_UNSPECIFIED
+const ER_DIMENSION_UNSUPPORTED = mysqlerr84.ER_DIMENSION_UNSUPPORTED
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_DOES_NOT_EXIST = mysqlerr84.ER_SLAVE_CHANNEL_DOES_NOT_EXIST
+const ER_REPLICA_CHANNEL_DOES_NOT_EXIST = mysqlerr84.ER_REPLICA_CHANNEL_DOES_NOT_EXIST
+
+// Deprecated: should not be used
+const ER_SLAVE_MULTIPLE_CHANNELS_HOST_PORT = mysqlerr84.ER_SLAVE_MULTIPLE_CHANNELS_HOST_PORT
+const OBSOLETE_ER_SLAVE_MULTIPLE_CHANNELS_HOST_PORT = mysqlerr84.OBSOLETE_ER_SLAVE_MULTIPLE_CHANNELS_HOST_PORT
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_NAME_INVALID_OR_TOO_LONG = mysqlerr84.ER_SLAVE_CHANNEL_NAME_INVALID_OR_TOO_LONG
+const ER_REPLICA_CHANNEL_NAME_INVALID_OR_TOO_LONG = mysqlerr84.ER_REPLICA_CHANNEL_NAME_INVALID_OR_TOO_LONG
+
+// Deprecated: should not be used
+const ER_SLAVE_NEW_CHANNEL_WRONG_REPOSITORY = mysqlerr84.ER_SLAVE_NEW_CHANNEL_WRONG_REPOSITORY
+const ER_REPLICA_NEW_CHANNEL_WRONG_REPOSITORY = mysqlerr84.ER_REPLICA_NEW_CHANNEL_WRONG_REPOSITORY
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_DELETE = mysqlerr84.ER_SLAVE_CHANNEL_DELETE
+const OBSOLETE_ER_SLAVE_CHANNEL_DELETE = mysqlerr84.OBSOLETE_ER_SLAVE_CHANNEL_DELETE
+
+// Deprecated: should not be used
+const ER_SLAVE_MULTIPLE_CHANNELS_CMD = mysqlerr84.ER_SLAVE_MULTIPLE_CHANNELS_CMD
+const ER_REPLICA_MULTIPLE_CHANNELS_CMD = mysqlerr84.ER_REPLICA_MULTIPLE_CHANNELS_CMD
+
+// Deprecated: should not be used
+const ER_SLAVE_MAX_CHANNELS_EXCEEDED = mysqlerr84.ER_SLAVE_MAX_CHANNELS_EXCEEDED
+const ER_REPLICA_MAX_CHANNELS_EXCEEDED = mysqlerr84.ER_REPLICA_MAX_CHANNELS_EXCEEDED
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_MUST_STOP = mysqlerr84.ER_SLAVE_CHANNEL_MUST_STOP
+const ER_REPLICA_CHANNEL_MUST_STOP = mysqlerr84.ER_REPLICA_CHANNEL_MUST_STOP
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_NOT_RUNNING = mysqlerr84.ER_SLAVE_CHANNEL_NOT_RUNNING
+const ER_REPLICA_CHANNEL_NOT_RUNNING = mysqlerr84.ER_REPLICA_CHANNEL_NOT_RUNNING
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_WAS_RUNNING = mysqlerr84.ER_SLAVE_CHANNEL_WAS_RUNNING
+const ER_REPLICA_CHANNEL_WAS_RUNNING = mysqlerr84.ER_REPLICA_CHANNEL_WAS_RUNNING
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_WAS_NOT_RUNNING = mysqlerr84.ER_SLAVE_CHANNEL_WAS_NOT_RUNNING
+const ER_REPLICA_CHANNEL_WAS_NOT_RUNNING = mysqlerr84.ER_REPLICA_CHANNEL_WAS_NOT_RUNNING
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_SQL_THREAD_MUST_STOP = mysqlerr84.ER_SLAVE_CHANNEL_SQL_THREAD_MUST_STOP
+const ER_REPLICA_CHANNEL_SQL_THREAD_MUST_STOP = mysqlerr84.ER_REPLICA_CHANNEL_SQL_THREAD_MUST_STOP
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_SQL_SKIP_COUNTER = mysqlerr84.ER_SLAVE_CHANNEL_SQL_SKIP_COUNTER
+const ER_REPLICA_CHANNEL_SQL_SKIP_COUNTER = mysqlerr84.ER_REPLICA_CHANNEL_SQL_SKIP_COUNTER
+const ER_WRONG_FIELD_WITH_GROUP_V2 = mysqlerr84.ER_WRONG_FIELD_WITH_GROUP_V2
+const ER_MIX_OF_GROUP_FUNC_AND_FIELDS_V2 = mysqlerr84.ER_MIX_OF_GROUP_FUNC_AND_FIELDS_V2
+const ER_WARN_DEPRECATED_SYSVAR_UPDATE = mysqlerr84.ER_WARN_DEPRECATED_SYSVAR_UPDATE
+const ER_WARN_DEPRECATED_SQLMODE = mysqlerr84.ER_WARN_DEPRECATED_SQLMODE
+const ER_CANNOT_LOG_PARTIAL_DROP_DATABASE_WITH_GTID = mysqlerr84.ER_CANNOT_LOG_PARTIAL_DROP_DATABASE_WITH_GTID
+const ER_GROUP_REPLICATION_CONFIGURATION = mysqlerr84.ER_GROUP_REPLICATION_CONFIGURATION
+const ER_GROUP_REPLICATION_RUNNING = mysqlerr84.ER_GROUP_REPLICATION_RUNNING
+const ER_GROUP_REPLICATION_APPLIER_INIT_ERROR = mysqlerr84.ER_GROUP_REPLICATION_APPLIER_INIT_ERROR
+const ER_GROUP_REPLICATION_STOP_APPLIER_THREAD_TIMEOUT = mysqlerr84.ER_GROUP_REPLICATION_STOP_APPLIER_THREAD_TIMEOUT
+const ER_GROUP_REPLICATION_COMMUNICATION_LAYER_SESSION_ERROR = mysqlerr84.ER_GROUP_REPLICATION_COMMUNICATION_LAYER_SESSION_ERROR
+const ER_GROUP_REPLICATION_COMMUNICATION_LAYER_JOIN_ERROR = mysqlerr84.ER_GROUP_REPLICATION_COMMUNICATION_LAYER_JOIN_ERROR
+const ER_BEFORE_DML_VALIDATION_ERROR = mysqlerr84.ER_BEFORE_DML_VALIDATION_ERROR
+const ER_PREVENTS_VARIABLE_WITHOUT_RBR = mysqlerr84.ER_PREVENTS_VARIABLE_WITHOUT_RBR
+const ER_RUN_HOOK_ERROR = mysqlerr84.ER_RUN_HOOK_ERROR
+const ER_TRANSACTION_ROLLBACK_DURING_COMMIT = mysqlerr84.ER_TRANSACTION_ROLLBACK_DURING_COMMIT
+const ER_GENERATED_COLUMN_FUNCTION_IS_NOT_ALLOWED = mysqlerr84.ER_GENERATED_COLUMN_FUNCTION_IS_NOT_ALLOWED
+const ER_UNSUPPORTED_ALTER_INPLACE_ON_VIRTUAL_COLUMN = mysqlerr84.ER_UNSUPPORTED_ALTER_INPLACE_ON_VIRTUAL_COLUMN
+const ER_WRONG_FK_OPTION_FOR_GENERATED_COLUMN = mysqlerr84.ER_WRONG_FK_OPTION_FOR_GENERATED_COLUMN
+const ER_NON_DEFAULT_VALUE_FOR_GENERATED_COLUMN = mysqlerr84.ER_NON_DEFAULT_VALUE_FOR_GENERATED_COLUMN
+const ER_UNSUPPORTED_ACTION_ON_GENERATED_COLUMN = mysqlerr84.ER_UNSUPPORTED_ACTION_ON_GENERATED_COLUMN
+const ER_GENERATED_COLUMN_NON_PRIOR = mysqlerr84.ER_GENERATED_COLUMN_NON_PRIOR
+const ER_DEPENDENT_BY_GENERATED_COLUMN = mysqlerr84.ER_DEPENDENT_BY_GENERATED_COLUMN
+const ER_GENERATED_COLUMN_REF_AUTO_INC = mysqlerr84.ER_GENERATED_COLUMN_REF_AUTO_INC
+const ER_FEATURE_NOT_AVAILABLE = mysqlerr84.ER_FEATURE_NOT_AVAILABLE
+const ER_CANT_SET_GTID_MODE = mysqlerr84.ER_CANT_SET_GTID_MODE
+const ER_CANT_USE_AUTO_POSITION_WITH_GTID_MODE_OFF = mysqlerr84.ER_CANT_USE_AUTO_POSITION_WITH_GTID_MODE_OFF
+
+// Deprecated: should not be used
+const ER_CANT_REPLICATE_ANONYMOUS_WITH_AUTO_POSITION = mysqlerr84.ER_CANT_REPLICATE_ANONYMOUS_WITH_AUTO_POSITION
+const OBSOLETE_ER_CANT_REPLICATE_ANONYMOUS_WITH_AUTO_POSITION = mysqlerr84.OBSOLETE_ER_CANT_REPLICATE_ANONYMOUS_WITH_AUTO_POSITION
+
+// Deprecated: should not be used
+const ER_CANT_REPLICATE_ANONYMOUS_WITH_GTID_MODE_ON = mysqlerr84.ER_CANT_REPLICATE_ANONYMOUS_WITH_GTID_MODE_ON
+const OBSOLETE_ER_CANT_REPLICATE_ANONYMOUS_WITH_GTID_MODE_ON = mysqlerr84.OBSOLETE_ER_CANT_REPLICATE_ANONYMOUS_WITH_GTID_MODE_ON
+
+// Deprecated: should not be used
+const ER_CANT_REPLICATE_GTID_WITH_GTID_MODE_OFF = mysqlerr84.ER_CANT_REPLICATE_GTID_WITH_GTID_MODE_OFF
+const OBSOLETE_ER_CANT_REPLICATE_GTID_WITH_GTID_MODE_OFF = mysqlerr84.OBSOLETE_ER_CANT_REPLICATE_GTID_WITH_GTID_MODE_OFF
+const ER_CANT_ENFORCE_GTID_CONSISTENCY_WITH_ONGOING_GTID_VIOLATING_TX = mysqlerr84.ER_CANT_ENFORCE_GTID_CONSISTENCY_WITH_ONGOING_GTID_VIOLATING_TX
+const ER_ENFORCE_GTID_CONSISTENCY_WARN_WITH_ONGOING_GTID_VIOLATING_TX = mysqlerr84.ER_ENFORCE_GTID_CONSISTENCY_WARN_WITH_ONGOING_GTID_VIOLATING_TX
+const ER_ACCOUNT_HAS_BEEN_LOCKED = mysqlerr84.ER_ACCOUNT_HAS_BEEN_LOCKED
+const ER_WRONG_TABLESPACE_NAME = mysqlerr84.ER_WRONG_TABLESPACE_NAME
+const ER_TABLESPACE_IS_NOT_EMPTY = mysqlerr84.ER_TABLESPACE_IS_NOT_EMPTY
+const ER_WRONG_FILE_NAME = mysqlerr84.ER_WRONG_FILE_NAME
+const ER_BOOST_GEOMETRY_INCONSISTENT_TURNS_EXCEPTION = mysqlerr84.ER_BOOST_GEOMETRY_INCONSISTENT_TURNS_EXCEPTION
+const ER_WARN_OPTIMIZER_HINT_SYNTAX_ERROR = mysqlerr84.ER_WARN_OPTIMIZER_HINT_SYNTAX_ERROR
+const ER_WARN_BAD_MAX_EXECUTION_TIME = mysqlerr84.ER_WARN_BAD_MAX_EXECUTION_TIME
+const ER_WARN_UNSUPPORTED_MAX_EXECUTION_TIME = mysqlerr84.ER_WARN_UNSUPPORTED_MAX_EXECUTION_TIME
+const ER_WARN_CONFLICTING_HINT = mysqlerr84.ER_WARN_CONFLICTING_HINT
+const ER_WARN_UNKNOWN_QB_NAME = mysqlerr84.ER_WARN_UNKNOWN_QB_NAME
+const ER_UNRESOLVED_HINT_NAME = mysqlerr84.ER_UNRESOLVED_HINT_NAME
+const ER_WARN_ON_MODIFYING_GTID_EXECUTED_TABLE = mysqlerr84.ER_WARN_ON_MODIFYING_GTID_EXECUTED_TABLE
+const ER_PLUGGABLE_PROTOCOL_COMMAND_NOT_SUPPORTED = mysqlerr84.ER_PLUGGABLE_PROTOCOL_COMMAND_NOT_SUPPORTED
+const ER_LOCKING_SERVICE_WRONG_NAME = mysqlerr84.ER_LOCKING_SERVICE_WRONG_NAME
+const ER_LOCKING_SERVICE_DEADLOCK = mysqlerr84.ER_LOCKING_SERVICE_DEADLOCK
+const ER_LOCKING_SERVICE_TIMEOUT = mysqlerr84.ER_LOCKING_SERVICE_TIMEOUT
+const ER_GIS_MAX_POINTS_IN_GEOMETRY_OVERFLOWED = mysqlerr84.ER_GIS_MAX_POINTS_IN_GEOMETRY_OVERFLOWED
+const ER_SQL_MODE_MERGED = mysqlerr84.ER_SQL_MODE_MERGED
+const ER_VTOKEN_PLUGIN_TOKEN_MISMATCH = mysqlerr84.ER_VTOKEN_PLUGIN_TOKEN_MISMATCH
+const ER_VTOKEN_PLUGIN_TOKEN_NOT_FOUND = mysqlerr84.ER_VTOKEN_PLUGIN_TOKEN_NOT_FOUND
+const ER_CANT_SET_VARIABLE_WHEN_OWNING_GTID = mysqlerr84.ER_CANT_SET_VARIABLE_WHEN_OWNING_GTID
+
+// Deprecated: should not be used
+const ER_SLAVE_CHANNEL_OPERATION_NOT_ALLOWED = mysqlerr84.ER_SLAVE_CHANNEL_OPERATION_NOT_ALLOWED
+const ER_REPLICA_CHANNEL_OPERATION_NOT_ALLOWED = mysqlerr84.ER_REPLICA_CHANNEL_OPERATION_NOT_ALLOWED
+const ER_INVALID_JSON_TEXT = mysqlerr84.ER_INVALID_JSON_TEXT
+const ER_INVALID_JSON_TEXT_IN_PARAM = mysqlerr84.ER_INVALID_JSON_TEXT_IN_PARAM
+const ER_INVALID_JSON_BINARY_DATA = mysqlerr84.ER_INVALID_JSON_BINARY_DATA
+const ER_INVALID_JSON_PATH = mysqlerr84.ER_INVALID_JSON_PATH
+const ER_INVALID_JSON_CHARSET = mysqlerr84.ER_INVALID_JSON_CHARSET
+const ER_INVALID_JSON_CHARSET_IN_FUNCTION = mysqlerr84.ER_INVALID_JSON_CHARSET_IN_FUNCTION
+const ER_INVALID_TYPE_FOR_JSON = mysqlerr84.ER_INVALID_TYPE_FOR_JSON
+const ER_INVALID_CAST_TO_JSON = mysqlerr84.ER_INVALID_CAST_TO_JSON
+const ER_INVALID_JSON_PATH_CHARSET = mysqlerr84.ER_INVALID_JSON_PATH_CHARSET
+const ER_INVALID_JSON_PATH_WILDCARD = mysqlerr84.ER_INVALID_JSON_PATH_WILDCARD
+const ER_JSON_VALUE_TOO_BIG = mysqlerr84.ER_JSON_VALUE_TOO_BIG
+const ER_JSON_KEY_TOO_BIG = mysqlerr84.ER_JSON_KEY_TOO_BIG
+const ER_JSON_USED_AS_KEY = mysqlerr84.ER_JSON_USED_AS_KEY
+const ER_JSON_VACUOUS_PATH = mysqlerr84.ER_JSON_VACUOUS_PATH
+const ER_JSON_BAD_ONE_OR_ALL_ARG = mysqlerr84.ER_JSON_BAD_ONE_OR_ALL_ARG
+const ER_NUMERIC_JSON_VALUE_OUT_OF_RANGE = mysqlerr84.ER_NUMERIC_JSON_VALUE_OUT_OF_RANGE
+const ER_INVALID_JSON_VALUE_FOR_CAST = mysqlerr84.ER_INVALID_JSON_VALUE_FOR_CAST
+const ER_JSON_DOCUMENT_TOO_DEEP = mysqlerr84.ER_JSON_DOCUMENT_TOO_DEEP
+const ER_JSON_DOCUMENT_NULL_KEY = mysqlerr84.ER_JSON_DOCUMENT_NULL_KEY
+const ER_SECURE_TRANSPORT_REQUIRED = mysqlerr84.ER_SECURE_TRANSPORT_REQUIRED
+const ER_NO_SECURE_TRANSPORTS_CONFIGURED = mysqlerr84.ER_NO_SECURE_TRANSPORTS_CONFIGURED
+const ER_DISABLED_STORAGE_ENGINE = mysqlerr84.ER_DISABLED_STORAGE_ENGINE
+const ER_USER_DOES_NOT_EXIST = mysqlerr84.ER_USER_DOES_NOT_EXIST
+const ER_USER_ALREADY_EXISTS = mysqlerr84.ER_USER_ALREADY_EXISTS
+const ER_AUDIT_API_ABORT = mysqlerr84.ER_AUDIT_API_ABORT
+const ER_INVALID_JSON_PATH_ARRAY_CELL = mysqlerr84.ER_INVALID_JSON_PATH_ARRAY_CELL
+const ER_BUFPOOL_RESIZE_INPROGRESS = mysqlerr84.ER_BUFPOOL_RESIZE_INPROGRESS
+const ER_FEATURE_DISABLED_SEE_DOC = mysqlerr84.ER_FEATURE_DISABLED_SEE_DOC
+const ER_SERVER_ISNT_AVAILABLE = mysqlerr84.ER_SERVER_ISNT_AVAILABLE
+const ER_SESSION_WAS_KILLED = mysqlerr84.ER_SESSION_WAS_KILLED
+const ER_CAPACITY_EXCEEDED = mysqlerr84.ER_CAPACITY_EXCEEDED
+const ER_CAPACITY_EXCEEDED_IN_RANGE_OPTIMIZER = mysqlerr84.ER_CAPACITY_EXCEEDED_IN_RANGE_OPTIMIZER
+
+// Deprecated: should not be used
+const ER_TABLE_NEEDS_UPG_PART = mysqlerr84.ER_TABLE_NEEDS_UPG_PART
+const OBSOLETE_ER_TABLE_NEEDS_UPG_PART = mysqlerr84.OBSOLETE_ER_TABLE_NEEDS_UPG_PART
+const ER_CANT_WAIT_FOR_EXECUTED_GTID_SET_WHILE_OWNING_A_GTID = mysqlerr84.ER_CANT_WAIT_FOR_EXECUTED_GTID_SET_WHILE_OWNING_A_GTID
+const ER_CANNOT_ADD_FOREIGN_BASE_COL_VIRTUAL = mysqlerr84.ER_CANNOT_ADD_FOREIGN_BASE_COL_VIRTUAL
+const ER_CANNOT_CREATE_VIRTUAL_INDEX_CONSTRAINT = mysqlerr84.ER_CANNOT_CREATE_VIRTUAL_INDEX_CONSTRAINT
+const ER_ERROR_ON_MODIFYING_GTID_EXECUTED_TABLE = mysqlerr84.ER_ERROR_ON_MODIFYING_GTID_EXECUTED_TABLE
+const ER_LOCK_REFUSED_BY_ENGINE = mysqlerr84.ER_LOCK_REFUSED_BY_ENGINE
+const ER_UNSUPPORTED_ALTER_ONLINE_ON_VIRTUAL_COLUMN = mysqlerr84.ER_UNSUPPORTED_ALTER_ONLINE_ON_VIRTUAL_COLUMN
+const ER_MASTER_KEY_ROTATION_NOT_SUPPORTED_BY_SE = mysqlerr84.ER_MASTER_KEY_ROTATION_NOT_SUPPORTED_BY_SE
+
+// Deprecated: should not be used
+const ER_MASTER_KEY_ROTATION_ERROR_BY_SE = mysqlerr84.ER_MASTER_KEY_ROTATION_ERROR_BY_SE
+const OBSOLETE_ER_MASTER_KEY_ROTATION_ERROR_BY_SE = mysqlerr84.OBSOLETE_ER_MASTER_KEY_ROTATION_ERROR_BY_SE
+const ER_MASTER_KEY_ROTATION_BINLOG_FAILED = mysqlerr84.ER_MASTER_KEY_ROTATION_BINLOG_FAILED
+const ER_MASTER_KEY_ROTATION_SE_UNAVAILABLE = mysqlerr84.ER_MASTER_KEY_ROTATION_SE_UNAVAILABLE
+const ER_TABLESPACE_CANNOT_ENCRYPT = mysqlerr84.ER_TABLESPACE_CANNOT_ENCRYPT
+const ER_INVALID_ENCRYPTION_OPTION = mysqlerr84.ER_INVALID_ENCRYPTION_OPTION
+const ER_CANNOT_FIND_KEY_IN_KEYRING = mysqlerr84.ER_CANNOT_FIND_KEY_IN_KEYRING
+const ER_CAPACITY_EXCEEDED_IN_PARSER = mysqlerr84.ER_CAPACITY_EXCEEDED_IN_PARSER
+const ER_UNSUPPORTED_ALTER_ENCRYPTION_INPLACE = mysqlerr84.ER_UNSUPPORTED_ALTER_ENCRYPTION_INPLACE
+const ER_KEYRING_UDF_KEYRING_SERVICE_ERROR = mysqlerr84.ER_KEYRING_UDF_KEYRING_SERVICE_ERROR
+const ER_USER_COLUMN_OLD_LENGTH = mysqlerr84.ER_USER_COLUMN_OLD_LENGTH
+
+// Deprecated: should not be used
+const ER_CANT_RESET_MASTER = mysqlerr84.ER_CANT_RESET_MASTER
+const ER_CANT_RESET_SOURCE = mysqlerr84.ER_CANT_RESET_SOURCE
+const ER_GROUP_REPLICATION_MAX_GROUP_SIZE = mysqlerr84.ER_GROUP_REPLICATION_MAX_GROUP_SIZE
+const ER_CANNOT_ADD_FOREIGN_BASE_COL_STORED = mysqlerr84.ER_CANNOT_ADD_FOREIGN_BASE_COL_STORED
+const ER_TABLE_REFERENCED = mysqlerr84.ER_TABLE_REFERENCED
+
+// Deprecated: should not be used
+const ER_PARTITION_ENGINE_DEPRECATED_FOR_TABLE = mysqlerr84.ER_PARTITION_ENGINE_DEPRECATED_FOR_TABLE
+const OBSOLETE_ER_PARTITION_ENGINE_DEPRECATED_FOR_TABLE = mysqlerr84.OBSOLETE_ER_PARTITION_ENGINE_DEPRECATED_FOR_TABLE
+
+// Deprecated: should not be used
+const ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID_ZERO = mysqlerr84.ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID_ZERO
+const OBSOLETE_ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID_ZERO = mysqlerr84.OBSOLETE_ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID_ZERO
+
+// Deprecated: should not be used
+const ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID = mysqlerr84.ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID
+const OBSOLETE_ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID = mysqlerr84.OBSOLETE_ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID
+const ER_XA_RETRY = mysqlerr84.ER_XA_RETRY
+const ER_KEYRING_AWS_UDF_AWS_KMS_ERROR = mysqlerr84.ER_KEYRING_AWS_UDF_AWS_KMS_ERROR
+const ER_BINLOG_UNSAFE_XA = mysqlerr84.ER_BINLOG_UNSAFE_XA
+const ER_UDF_ERROR = mysqlerr84.ER_UDF_ERROR
+const ER_KEYRING_MIGRATION_FAILURE = mysqlerr84.ER_KEYRING_MIGRATION_FAILURE
+const ER_KEYRING_ACCESS_DENIED_ERROR = mysqlerr84.ER_KEYRING_ACCESS_DENIED_ERROR
+const ER_KEYRING_MIGRATION_STATUS = mysqlerr84.ER_KEYRING_MIGRATION_STATUS
+
+// Deprecated: should not be used
+const ER_PLUGIN_FAILED_TO_OPEN_TABLES = mysqlerr84.ER_PLUGIN_FAILED_TO_OPEN_TABLES
+const OBSOLETE_ER_PLUGIN_FAILED_TO_OPEN_TABLES = mysqlerr84.OBSOLETE_ER_PLUGIN_FAILED_TO_OPEN_TABLES
+
+// Deprecated: should not be used
+const ER_PLUGIN_FAILED_TO_OPEN_TABLE = mysqlerr84.ER_PLUGIN_FAILED_TO_OPEN_TABLE
+const OBSOLETE_ER_PLUGIN_FAILED_TO_OPEN_TABLE = mysqlerr84.OBSOLETE_ER_PLUGIN_FAILED_TO_OPEN_TABLE
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_NO_KEYRING_PLUGIN_INSTALLED = mysqlerr84.ER_AUDIT_LOG_NO_KEYRING_PLUGIN_INSTALLED
+const OBSOLETE_ER_AUDIT_LOG_NO_KEYRING_PLUGIN_INSTALLED = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_NO_KEYRING_PLUGIN_INSTALLED
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_ENCRYPTION_PASSWORD_HAS_NOT_BEEN_SET = mysqlerr84.ER_AUDIT_LOG_ENCRYPTION_PASSWORD_HAS_NOT_BEEN_SET
+const OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_HAS_NOT_BEEN_SET = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_HAS_NOT_BEEN_SET
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_COULD_NOT_CREATE_AES_KEY = mysqlerr84.ER_AUDIT_LOG_COULD_NOT_CREATE_AES_KEY
+const OBSOLETE_ER_AUDIT_LOG_COULD_NOT_CREATE_AES_KEY = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_COULD_NOT_CREATE_AES_KEY
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_ENCRYPTION_PASSWORD_CANNOT_BE_FETCHED = mysqlerr84.ER_AUDIT_LOG_ENCRYPTION_PASSWORD_CANNOT_BE_FETCHED
+const OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_CANNOT_BE_FETCHED = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_CANNOT_BE_FETCHED
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_JSON_FILTERING_NOT_ENABLED = mysqlerr84.ER_AUDIT_LOG_JSON_FILTERING_NOT_ENABLED
+const OBSOLETE_ER_AUDIT_LOG_JSON_FILTERING_NOT_ENABLED = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_JSON_FILTERING_NOT_ENABLED
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_UDF_INSUFFICIENT_PRIVILEGE = mysqlerr84.ER_AUDIT_LOG_UDF_INSUFFICIENT_PRIVILEGE
+const OBSOLETE_ER_AUDIT_LOG_UDF_INSUFFICIENT_PRIVILEGE = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_UDF_INSUFFICIENT_PRIVILEGE
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_SUPER_PRIVILEGE_REQUIRED = mysqlerr84.ER_AUDIT_LOG_SUPER_PRIVILEGE_REQUIRED
+const OBSOLETE_ER_AUDIT_LOG_SUPER_PRIVILEGE_REQUIRED = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_SUPER_PRIVILEGE_REQUIRED
+
+// Deprecated: should not be used
+const ER_COULD_NOT_REINITIALIZE_AUDIT_LOG_FILTERS = mysqlerr84.ER_COULD_NOT_REINITIALIZE_AUDIT_LOG_FILTERS
+const OBSOLETE_ER_COULD_NOT_REINITIALIZE_AUDIT_LOG_FILTERS = mysqlerr84.OBSOLETE_ER_COULD_NOT_REINITIALIZE_AUDIT_LOG_FILTERS
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_TYPE = mysqlerr84.ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_TYPE
+const OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_TYPE = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_TYPE
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_COUNT = mysqlerr84.ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_COUNT
+const OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_COUNT = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_COUNT
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_HAS_NOT_BEEN_INSTALLED = mysqlerr84.ER_AUDIT_LOG_HAS_NOT_BEEN_INSTALLED
+const OBSOLETE_ER_AUDIT_LOG_HAS_NOT_BEEN_INSTALLED = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_HAS_NOT_BEEN_INSTALLED
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_TYPE = mysqlerr84.ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_TYPE
+const OBSOLETE_ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_TYPE = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_TYPE
+const ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_VALUE = mysqlerr84.ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_VALUE
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_JSON_FILTER_PARSING_ERROR = mysqlerr84.ER_AUDIT_LOG_JSON_FILTER_PARSING_ERROR
+const OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_PARSING_ERROR = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_PARSING_ERROR
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_JSON_FILTER_NAME_CANNOT_BE_EMPTY = mysqlerr84.ER_AUDIT_LOG_JSON_FILTER_NAME_CANNOT_BE_EMPTY
+const OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_NAME_CANNOT_BE_EMPTY = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_NAME_CANNOT_BE_EMPTY
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_JSON_USER_NAME_CANNOT_BE_EMPTY = mysqlerr84.ER_AUDIT_LOG_JSON_USER_NAME_CANNOT_BE_EMPTY
+const OBSOLETE_ER_AUDIT_LOG_JSON_USER_NAME_CANNOT_BE_EMPTY = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_JSON_USER_NAME_CANNOT_BE_EMPTY
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_JSON_FILTER_DOES_NOT_EXISTS = mysqlerr84.ER_AUDIT_LOG_JSON_FILTER_DOES_NOT_EXISTS
+const OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_DOES_NOT_EXISTS = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_DOES_NOT_EXISTS
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_USER_FIRST_CHARACTER_MUST_BE_ALPHANUMERIC = mysqlerr84.ER_AUDIT_LOG_USER_FIRST_CHARACTER_MUST_BE_ALPHANUMERIC
+const OBSOLETE_ER_AUDIT_LOG_USER_FIRST_CHARACTER_MUST_BE_ALPHANUMERIC = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_USER_FIRST_CHARACTER_MUST_BE_ALPHANUMERIC
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_USER_NAME_INVALID_CHARACTER = mysqlerr84.ER_AUDIT_LOG_USER_NAME_INVALID_CHARACTER
+const OBSOLETE_ER_AUDIT_LOG_USER_NAME_INVALID_CHARACTER = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_USER_NAME_INVALID_CHARACTER
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_HOST_NAME_INVALID_CHARACTER = mysqlerr84.ER_AUDIT_LOG_HOST_NAME_INVALID_CHARACTER
+const OBSOLETE_ER_AUDIT_LOG_HOST_NAME_INVALID_CHARACTER = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_HOST_NAME_INVALID_CHARACTER
+
+// Deprecated: should not be used
+const WARN_DEPRECATED_MAXDB_SQL_MODE_FOR_TIMESTAMP = mysqlerr84.WARN_DEPRECATED_MAXDB_SQL_MODE_FOR_TIMESTAMP
+const OBSOLETE_WARN_DEPRECATED_MAXDB_SQL_MODE_FOR_TIMESTAMP = mysqlerr84.OBSOLETE_WARN_DEPRECATED_MAXDB_SQL_MODE_FOR_TIMESTAMP
+const OBSOLETE_ER_XA_REPLICATION_FILTERS = mysqlerr84.OBSOLETE_ER_XA_REPLICATION_FILTERS
+
+// Deprecated: should not be used
+const ER_CANT_OPEN_ERROR_LOG = mysqlerr84.ER_CANT_OPEN_ERROR_LOG
+const OBSOLETE_ER_CANT_OPEN_ERROR_LOG = mysqlerr84.OBSOLETE_ER_CANT_OPEN_ERROR_LOG
+const OBSOLETE_ER_GROUPING_ON_TIMESTAMP_IN_DST = mysqlerr84.OBSOLETE_ER_GROUPING_ON_TIMESTAMP_IN_DST
+
+// Deprecated: should not be used
+const ER_CANT_START_SERVER_NAMED_PIPE = mysqlerr84.ER_CANT_START_SERVER_NAMED_PIPE
+const OBSOLETE_ER_CANT_START_SERVER_NAMED_PIPE = mysqlerr84.OBSOLETE_ER_CANT_START_SERVER_NAMED_PIPE
+const ER_WRITE_SET_EXCEEDS_LIMIT = mysqlerr84.ER_WRITE_SET_EXCEEDS_LIMIT
+
+// Deprecated: should not be used
+const ER_DEPRECATED_TLS_VERSION_SESSION_57 = mysqlerr84.ER_DEPRECATED_TLS_VERSION_SESSION_57
+const OBSOLETE_ER_DEPRECATED_TLS_VERSION_SESSION_57 = mysqlerr84.OBSOLETE_ER_DEPRECATED_TLS_VERSION_SESSION_57
+
+// Deprecated: should not be used
+const ER_WARN_DEPRECATED_TLS_VERSION_57 = mysqlerr84.ER_WARN_DEPRECATED_TLS_VERSION_57
+const OBSOLETE_ER_WARN_DEPRECATED_TLS_VERSION_57 = mysqlerr84.OBSOLETE_ER_WARN_DEPRECATED_TLS_VERSION_57
+
+// Deprecated: should not be used
+const ER_WARN_WRONG_NATIVE_TABLE_STRUCTURE = mysqlerr84.ER_WARN_WRONG_NATIVE_TABLE_STRUCTURE
+const OBSOLETE_ER_WARN_WRONG_NATIVE_TABLE_STRUCTURE = mysqlerr84.OBSOLETE_ER_WARN_WRONG_NATIVE_TABLE_STRUCTURE
+const ER_AES_INVALID_KDF_NAME = mysqlerr84.ER_AES_INVALID_KDF_NAME
+const ER_AES_INVALID_KDF_ITERATIONS = mysqlerr84.ER_AES_INVALID_KDF_ITERATIONS
+const WARN_AES_KEY_SIZE = mysqlerr84.WARN_AES_KEY_SIZE
+const ER_AES_INVALID_KDF_OPTION_SIZE = mysqlerr84.ER_AES_INVALID_KDF_OPTION_SIZE
+const ER_UNSUPPORT_COMPRESSED_TEMPORARY_TABLE = mysqlerr84.ER_UNSUPPORT_COMPRESSED_TEMPORARY_TABLE
+const ER_ACL_OPERATION_FAILED = mysqlerr84.ER_ACL_OPERATION_FAILED
+const ER_UNSUPPORTED_INDEX_ALGORITHM = mysqlerr84.ER_UNSUPPORTED_INDEX_ALGORITHM
+const ER_NO_SUCH_DB = mysqlerr84.ER_NO_SUCH_DB
+const ER_TOO_BIG_ENUM = mysqlerr84.ER_TOO_BIG_ENUM
+const ER_TOO_LONG_SET_ENUM_VALUE = mysqlerr84.ER_TOO_LONG_SET_ENUM_VALUE
+const ER_INVALID_DD_OBJECT = mysqlerr84.ER_INVALID_DD_OBJECT
+const ER_UPDATING_DD_TABLE = mysqlerr84.ER_UPDATING_DD_TABLE
+const ER_INVALID_DD_OBJECT_ID = mysqlerr84.ER_INVALID_DD_OBJECT_ID
+const ER_INVALID_DD_OBJECT_NAME = mysqlerr84.ER_INVALID_DD_OBJECT_NAME
+const ER_TABLESPACE_MISSING_WITH_NAME = mysqlerr84.ER_TABLESPACE_MISSING_WITH_NAME
+const ER_TOO_LONG_ROUTINE_COMMENT = mysqlerr84.ER_TOO_LONG_ROUTINE_COMMENT
+const ER_SP_LOAD_FAILED = mysqlerr84.ER_SP_LOAD_FAILED
+const ER_INVALID_BITWISE_OPERANDS_SIZE = mysqlerr84.ER_INVALID_BITWISE_OPERANDS_SIZE
+const ER_INVALID_BITWISE_AGGREGATE_OPERANDS_SIZE = mysqlerr84.ER_INVALID_BITWISE_AGGREGATE_OPERANDS_SIZE
+const ER_WARN_UNSUPPORTED_HINT = mysqlerr84.ER_WARN_UNSUPPORTED_HINT
+const ER_UNEXPECTED_GEOMETRY_TYPE = mysqlerr84.ER_UNEXPECTED_GEOMETRY_TYPE
+const ER_SRS_PARSE_ERROR = mysqlerr84.ER_SRS_PARSE_ERROR
+const ER_SRS_PROJ_PARAMETER_MISSING = mysqlerr84.ER_SRS_PROJ_PARAMETER_MISSING
+const ER_WARN_SRS_NOT_FOUND = mysqlerr84.ER_WARN_SRS_NOT_FOUND
+const ER_SRS_NOT_CARTESIAN = mysqlerr84.ER_SRS_NOT_CARTESIAN
+const ER_SRS_NOT_CARTESIAN_UNDEFINED = mysqlerr84.ER_SRS_NOT_CARTESIAN_UNDEFINED
+const ER_PK_INDEX_CANT_BE_INVISIBLE = mysqlerr84.ER_PK_INDEX_CANT_BE_INVISIBLE
+const ER_UNKNOWN_AUTHID = mysqlerr84.ER_UNKNOWN_AUTHID
+const ER_FAILED_ROLE_GRANT = mysqlerr84.ER_FAILED_ROLE_GRANT
+const ER_OPEN_ROLE_TABLES = mysqlerr84.ER_OPEN_ROLE_TABLES
+const ER_FAILED_DEFAULT_ROLES = mysqlerr84.ER_FAILED_DEFAULT_ROLES
+const ER_COMPONENTS_NO_SCHEME = mysqlerr84.ER_COMPONENTS_NO_SCHEME
+const ER_COMPONENTS_NO_SCHEME_SERVICE = mysqlerr84.ER_COMPONENTS_NO_SCHEME_SERVICE
+const ER_COMPONENTS_CANT_LOAD = mysqlerr84.ER_COMPONENTS_CANT_LOAD
+const ER_ROLE_NOT_GRANTED = mysqlerr84.ER_ROLE_NOT_GRANTED
+const ER_FAILED_REVOKE_ROLE = mysqlerr84.ER_FAILED_REVOKE_ROLE
+const ER_RENAME_ROLE = mysqlerr84.ER_RENAME_ROLE
+const ER_COMPONENTS_CANT_ACQUIRE_SERVICE_IMPLEMENTATION = mysqlerr84.ER_COMPONENTS_CANT_ACQUIRE_SERVICE_IMPLEMENTATION
+const ER_COMPONENTS_CANT_SATISFY_DEPENDENCY = mysqlerr84.ER_COMPONENTS_CANT_SATISFY_DEPENDENCY
+const ER_COMPONENTS_LOAD_CANT_REGISTER_SERVICE_IMPLEMENTATION = mysqlerr84.ER_COMPONENTS_LOAD_CANT_REGISTER_SERVICE_IMPLEMENTATION
+const ER_COMPONENTS_LOAD_CANT_INITIALIZE = mysqlerr84.ER_COMPONENTS_LOAD_CANT_INITIALIZE
+const ER_COMPONENTS_UNLOAD_NOT_LOADED = mysqlerr84.ER_COMPONENTS_UNLOAD_NOT_LOADED
+const ER_COMPONENTS_UNLOAD_CANT_DEINITIALIZE = mysqlerr84.ER_COMPONENTS_UNLOAD_CANT_DEINITIALIZE
+const ER_COMPONENTS_CANT_RELEASE_SERVICE = mysqlerr84.ER_COMPONENTS_CANT_RELEASE_SERVICE
+const ER_COMPONENTS_UNLOAD_CANT_UNREGISTER_SERVICE = mysqlerr84.ER_COMPONENTS_UNLOAD_CANT_UNREGISTER_SERVICE
+const ER_COMPONENTS_CANT_UNLOAD = mysqlerr84.ER_COMPONENTS_CANT_UNLOAD
+const ER_WARN_UNLOAD_THE_NOT_PERSISTED = mysqlerr84.ER_WARN_UNLOAD_THE_NOT_PERSISTED
+const ER_COMPONENT_TABLE_INCORRECT = mysqlerr84.ER_COMPONENT_TABLE_INCORRECT
+const ER_COMPONENT_MANIPULATE_ROW_FAILED = mysqlerr84.ER_COMPONENT_MANIPULATE_ROW_FAILED
+const ER_COMPONENTS_UNLOAD_DUPLICATE_IN_GROUP = mysqlerr84.ER_COMPONENTS_UNLOAD_DUPLICATE_IN_GROUP
+const ER_CANT_SET_GTID_PURGED_DUE_SETS_CONSTRAINTS = mysqlerr84.ER_CANT_SET_GTID_PURGED_DUE_SETS_CONSTRAINTS
+const ER_CANNOT_LOCK_USER_MANAGEMENT_CACHES = mysqlerr84.ER_CANNOT_LOCK_USER_MANAGEMENT_CACHES
+const ER_SRS_NOT_FOUND = mysqlerr84.ER_SRS_NOT_FOUND
+const ER_VARIABLE_NOT_PERSISTED = mysqlerr84.ER_VARIABLE_NOT_PERSISTED
+const ER_IS_QUERY_INVALID_CLAUSE = mysqlerr84.ER_IS_QUERY_INVALID_CLAUSE
+const ER_UNABLE_TO_STORE_STATISTICS = mysqlerr84.ER_UNABLE_TO_STORE_STATISTICS
+const ER_NO_SYSTEM_SCHEMA_ACCESS = mysqlerr84.ER_NO_SYSTEM_SCHEMA_ACCESS
+const ER_NO_SYSTEM_TABLESPACE_ACCESS = mysqlerr84.ER_NO_SYSTEM_TABLESPACE_ACCESS
+const ER_NO_SYSTEM_TABLE_ACCESS = mysqlerr84.ER_NO_SYSTEM_TABLE_ACCESS
+const ER_NO_SYSTEM_TABLE_ACCESS_FOR_DICTIONARY_TABLE = mysqlerr84.ER_NO_SYSTEM_TABLE_ACCESS_FOR_DICTIONARY_TABLE
+const ER_NO_SYSTEM_TABLE_ACCESS_FOR_SYSTEM_TABLE = mysqlerr84.ER_NO_SYSTEM_TABLE_ACCESS_FOR_SYSTEM_TABLE
+const ER_NO_SYSTEM_TABLE_ACCESS_FOR_TABLE = mysqlerr84.ER_NO_SYSTEM_TABLE_ACCESS_FOR_TABLE
+const ER_INVALID_OPTION_KEY = mysqlerr84.ER_INVALID_OPTION_KEY
+const ER_INVALID_OPTION_VALUE = mysqlerr84.ER_INVALID_OPTION_VALUE
+const ER_INVALID_OPTION_KEY_VALUE_PAIR = mysqlerr84.ER_INVALID_OPTION_KEY_VALUE_PAIR
+const ER_INVALID_OPTION_START_CHARACTER = mysqlerr84.ER_INVALID_OPTION_START_CHARACTER
+const ER_INVALID_OPTION_END_CHARACTER = mysqlerr84.ER_INVALID_OPTION_END_CHARACTER
+const ER_INVALID_OPTION_CHARACTERS = mysqlerr84.ER_INVALID_OPTION_CHARACTERS
+const ER_DUPLICATE_OPTION_KEY = mysqlerr84.ER_DUPLICATE_OPTION_KEY
+const ER_WARN_SRS_NOT_FOUND_AXIS_ORDER = mysqlerr84.ER_WARN_SRS_NOT_FOUND_AXIS_ORDER
+const ER_NO_ACCESS_TO_NATIVE_FCT = mysqlerr84.ER_NO_ACCESS_TO_NATIVE_FCT
+
+// Deprecated: should not be used
+const ER_RESET_MASTER_TO_VALUE_OUT_OF_RANGE = mysqlerr84.ER_RESET_MASTER_TO_VALUE_OUT_OF_RANGE
+const ER_RESET_SOURCE_TO_VALUE_OUT_OF_RANGE = mysqlerr84.ER_RESET_SOURCE_TO_VALUE_OUT_OF_RANGE
+const ER_UNRESOLVED_TABLE_LOCK = mysqlerr84.ER_UNRESOLVED_TABLE_LOCK
+const ER_DUPLICATE_TABLE_LOCK = mysqlerr84.ER_DUPLICATE_TABLE_LOCK
+const ER_BINLOG_UNSAFE_SKIP_LOCKED = mysqlerr84.ER_BINLOG_UNSAFE_SKIP_LOCKED
+const ER_BINLOG_UNSAFE_NOWAIT = mysqlerr84.ER_BINLOG_UNSAFE_NOWAIT
+const ER_LOCK_NOWAIT = mysqlerr84.ER_LOCK_NOWAIT
+const ER_CTE_RECURSIVE_REQUIRES_UNION = mysqlerr84.ER_CTE_RECURSIVE_REQUIRES_UNION
+const ER_CTE_RECURSIVE_REQUIRES_NONRECURSIVE_FIRST = mysqlerr84.ER_CTE_RECURSIVE_REQUIRES_NONRECURSIVE_FIRST
+const ER_CTE_RECURSIVE_FORBIDS_AGGREGATION = mysqlerr84.ER_CTE_RECURSIVE_FORBIDS_AGGREGATION
+const ER_CTE_RECURSIVE_FORBIDDEN_JOIN_ORDER = mysqlerr84.ER_CTE_RECURSIVE_FORBIDDEN_JOIN_ORDER
+const ER_CTE_RECURSIVE_REQUIRES_SINGLE_REFERENCE = mysqlerr84.ER_CTE_RECURSIVE_REQUIRES_SINGLE_REFERENCE
+const ER_SWITCH_TMP_ENGINE = mysqlerr84.ER_SWITCH_TMP_ENGINE
+const ER_WINDOW_NO_SUCH_WINDOW = mysqlerr84.ER_WINDOW_NO_SUCH_WINDOW
+const ER_WINDOW_CIRCULARITY_IN_WINDOW_GRAPH = mysqlerr84.ER_WINDOW_CIRCULARITY_IN_WINDOW_GRAPH
+const ER_WINDOW_NO_CHILD_PARTITIONING = mysqlerr84.ER_WINDOW_NO_CHILD_PARTITIONING
+const ER_WINDOW_NO_INHERIT_FRAME = mysqlerr84.ER_WINDOW_NO_INHERIT_FRAME
+const ER_WINDOW_NO_REDEFINE_ORDER_BY = mysqlerr84.ER_WINDOW_NO_REDEFINE_ORDER_BY
+const ER_WINDOW_FRAME_START_ILLEGAL = mysqlerr84.ER_WINDOW_FRAME_START_ILLEGAL
+const ER_WINDOW_FRAME_END_ILLEGAL = mysqlerr84.ER_WINDOW_FRAME_END_ILLEGAL
+const ER_WINDOW_FRAME_ILLEGAL = mysqlerr84.ER_WINDOW_FRAME_ILLEGAL
+const ER_WINDOW_RANGE_FRAME_ORDER_TYPE = mysqlerr84.ER_WINDOW_RANGE_FRAME_ORDER_TYPE
+const ER_WINDOW_RANGE_FRAME_TEMPORAL_TYPE = mysqlerr84.ER_WINDOW_RANGE_FRAME_TEMPORAL_TYPE
+const ER_WINDOW_RANGE_FRAME_NUMERIC_TYPE = mysqlerr84.ER_WINDOW_RANGE_FRAME_NUMERIC_TYPE
+const ER_WINDOW_RANGE_BOUND_NOT_CONSTANT = mysqlerr84.ER_WINDOW_RANGE_BOUND_NOT_CONSTANT
+const ER_WINDOW_DUPLICATE_NAME = mysqlerr84.ER_WINDOW_DUPLICATE_NAME
+const ER_WINDOW_ILLEGAL_ORDER_BY = mysqlerr84.ER_WINDOW_ILLEGAL_ORDER_BY
+const ER_WINDOW_INVALID_WINDOW_FUNC_USE = mysqlerr84.ER_WINDOW_INVALID_WINDOW_FUNC_USE
+const ER_WINDOW_INVALID_WINDOW_FUNC_ALIAS_USE = mysqlerr84.ER_WINDOW_INVALID_WINDOW_FUNC_ALIAS_USE
+const ER_WINDOW_NESTED_WINDOW_FUNC_USE_IN_WINDOW_SPEC = mysqlerr84.ER_WINDOW_NESTED_WINDOW_FUNC_USE_IN_WINDOW_SPEC
+const ER_WINDOW_ROWS_INTERVAL_USE = mysqlerr84.ER_WINDOW_ROWS_INTERVAL_USE
+const ER_WINDOW_NO_GROUP_ORDER_UNUSED = mysqlerr84.ER_WINDOW_NO_GROUP_ORDER_UNUSED
+const ER_WINDOW_EXPLAIN_JSON = mysqlerr84.ER_WINDOW_EXPLAIN_JSON
+const ER_WINDOW_FUNCTION_IGNORES_FRAME = mysqlerr84.ER_WINDOW_FUNCTION_IGNORES_FRAME
+const ER_WL9236_NOW_UNUSED = mysqlerr84.ER_WL9236_NOW_UNUSED
+const ER_INVALID_NO_OF_ARGS = mysqlerr84.ER_INVALID_NO_OF_ARGS
+const ER_FIELD_IN_GROUPING_NOT_GROUP_BY = mysqlerr84.ER_FIELD_IN_GROUPING_NOT_GROUP_BY
+const ER_TOO_LONG_TABLESPACE_COMMENT = mysqlerr84.ER_TOO_LONG_TABLESPACE_COMMENT
+const ER_ENGINE_CANT_DROP_TABLE = mysqlerr84.ER_ENGINE_CANT_DROP_TABLE
+const ER_ENGINE_CANT_DROP_MISSING_TABLE = mysqlerr84.ER_ENGINE_CANT_DROP_MISSING_TABLE
+const ER_TABLESPACE_DUP_FILENAME = mysqlerr84.ER_TABLESPACE_DUP_FILENAME
+const ER_DB_DROP_RMDIR2 = mysqlerr84.ER_DB_DROP_RMDIR2
+const ER_IMP_NO_FILES_MATCHED = mysqlerr84.ER_IMP_NO_FILES_MATCHED
+const ER_IMP_SCHEMA_DOES_NOT_EXIST = mysqlerr84.ER_IMP_SCHEMA_DOES_NOT_EXIST
+const ER_IMP_TABLE_ALREADY_EXISTS = mysqlerr84.ER_IMP_TABLE_ALREADY_EXISTS
+const ER_IMP_INCOMPATIBLE_MYSQLD_VERSION = mysqlerr84.ER_IMP_INCOMPATIBLE_MYSQLD_VERSION
+const ER_IMP_INCOMPATIBLE_DD_VERSION = mysqlerr84.ER_IMP_INCOMPATIBLE_DD_VERSION
+const ER_IMP_INCOMPATIBLE_SDI_VERSION = mysqlerr84.ER_IMP_INCOMPATIBLE_SDI_VERSION
+const ER_WARN_INVALID_HINT = mysqlerr84.ER_WARN_INVALID_HINT
+const ER_VAR_DOES_NOT_EXIST = mysqlerr84.ER_VAR_DOES_NOT_EXIST
+const ER_LONGITUDE_OUT_OF_RANGE = mysqlerr84.ER_LONGITUDE_OUT_OF_RANGE
+const ER_LATITUDE_OUT_OF_RANGE = mysqlerr84.ER_LATITUDE_OUT_OF_RANGE
+const ER_NOT_IMPLEMENTED_FOR_GEOGRAPHIC_SRS = mysqlerr84.ER_NOT_IMPLEMENTED_FOR_GEOGRAPHIC_SRS
+const ER_ILLEGAL_PRIVILEGE_LEVEL = mysqlerr84.ER_ILLEGAL_PRIVILEGE_LEVEL
+const ER_NO_SYSTEM_VIEW_ACCESS = mysqlerr84.ER_NO_SYSTEM_VIEW_ACCESS
+const ER_COMPONENT_FILTER_FLABBERGASTED = mysqlerr84.ER_COMPONENT_FILTER_FLABBERGASTED
+const ER_PART_EXPR_TOO_LONG = mysqlerr84.ER_PART_EXPR_TOO_LONG
+const ER_UDF_DROP_DYNAMICALLY_REGISTERED = mysqlerr84.ER_UDF_DROP_DYNAMICALLY_REGISTERED
+const ER_UNABLE_TO_STORE_COLUMN_STATISTICS = mysqlerr84.ER_UNABLE_TO_STORE_COLUMN_STATISTICS
+const ER_UNABLE_TO_UPDATE_COLUMN_STATISTICS = mysqlerr84.ER_UNABLE_TO_UPDATE_COLUMN_STATISTICS
+const ER_UNABLE_TO_DROP_COLUMN_STATISTICS = mysqlerr84.ER_UNABLE_TO_DROP_COLUMN_STATISTICS
+const ER_UNABLE_TO_BUILD_HISTOGRAM = mysqlerr84.ER_UNABLE_TO_BUILD_HISTOGRAM
+const ER_MANDATORY_ROLE = mysqlerr84.ER_MANDATORY_ROLE
+const ER_MISSING_TABLESPACE_FILE = mysqlerr84.ER_MISSING_TABLESPACE_FILE
+const ER_PERSIST_ONLY_ACCESS_DENIED_ERROR = mysqlerr84.ER_PERSIST_ONLY_ACCESS_DENIED_ERROR
+const ER_CMD_NEED_SUPER = mysqlerr84.ER_CMD_NEED_SUPER
+const ER_PATH_IN_DATADIR = mysqlerr84.ER_PATH_IN_DATADIR
+const ER_CLONE_DDL_IN_PROGRESS = mysqlerr84.ER_CLONE_DDL_IN_PROGRESS
+const ER_CLONE_TOO_MANY_CONCURRENT_CLONES = mysqlerr84.ER_CLONE_TOO_MANY_CONCURRENT_CLONES
+const ER_APPLIER_LOG_EVENT_VALIDATION_ERROR = mysqlerr84.ER_APPLIER_LOG_EVENT_VALIDATION_ERROR
+const ER_CTE_MAX_RECURSION_DEPTH = mysqlerr84.ER_CTE_MAX_RECURSION_DEPTH
+const ER_NOT_HINT_UPDATABLE_VARIABLE = mysqlerr84.ER_NOT_HINT_UPDATABLE_VARIABLE
+const ER_CREDENTIALS_CONTRADICT_TO_HISTORY = mysqlerr84.ER_CREDENTIALS_CONTRADICT_TO_HISTORY
+const ER_WARNING_PASSWORD_HISTORY_CLAUSES_VOID = mysqlerr84.ER_WARNING_PASSWORD_HISTORY_CLAUSES_VOID
+const ER_CLIENT_DOES_NOT_SUPPORT = mysqlerr84.ER_CLIENT_DOES_NOT_SUPPORT
+const ER_I_S_SKIPPED_TABLESPACE = mysqlerr84.ER_I_S_SKIPPED_TABLESPACE
+const ER_TABLESPACE_ENGINE_MISMATCH = mysqlerr84.ER_TABLESPACE_ENGINE_MISMATCH
+const ER_WRONG_SRID_FOR_COLUMN = mysqlerr84.ER_WRONG_SRID_FOR_COLUMN
+const ER_CANNOT_ALTER_SRID_DUE_TO_INDEX = mysqlerr84.ER_CANNOT_ALTER_SRID_DUE_TO_INDEX
+const ER_WARN_BINLOG_PARTIAL_UPDATES_DISABLED = mysqlerr84.ER_WARN_BINLOG_PARTIAL_UPDATES_DISABLED
+
+// Deprecated: should not be used
+const ER_WARN_BINLOG_V1_ROW_EVENTS_DISABLED = mysqlerr84.ER_WARN_BINLOG_V1_ROW_EVENTS_DISABLED
+const OBSOLETE_ER_WARN_BINLOG_V1_ROW_EVENTS_DISABLED = mysqlerr84.OBSOLETE_ER_WARN_BINLOG_V1_ROW_EVENTS_DISABLED
+const ER_WARN_BINLOG_PARTIAL_UPDATES_SUGGESTS_PARTIAL_IMAGES = mysqlerr84.ER_WARN_BINLOG_PARTIAL_UPDATES_SUGGESTS_PARTIAL_IMAGES
+const ER_COULD_NOT_APPLY_JSON_DIFF = mysqlerr84.ER_COULD_NOT_APPLY_JSON_DIFF
+const ER_CORRUPTED_JSON_DIFF = mysqlerr84.ER_CORRUPTED_JSON_DIFF
+const ER_RESOURCE_GROUP_EXISTS = mysqlerr84.ER_RESOURCE_GROUP_EXISTS
+const ER_RESOURCE_GROUP_NOT_EXISTS = mysqlerr84.ER_RESOURCE_GROUP_NOT_EXISTS
+const ER_INVALID_VCPU_ID = mysqlerr84.ER_INVALID_VCPU_ID
+const ER_INVALID_VCPU_RANGE = mysqlerr84.ER_INVALID_VCPU_RANGE
+const ER_INVALID_THREAD_PRIORITY = mysqlerr84.ER_INVALID_THREAD_PRIORITY
+const ER_DISALLOWED_OPERATION = mysqlerr84.ER_DISALLOWED_OPERATION
+const ER_RESOURCE_GROUP_BUSY = mysqlerr84.ER_RESOURCE_GROUP_BUSY
+const ER_RESOURCE_GROUP_DISABLED = mysqlerr84.ER_RESOURCE_GROUP_DISABLED
+const ER_FEATURE_UNSUPPORTED = mysqlerr84.ER_FEATURE_UNSUPPORTED
+const ER_ATTRIBUTE_IGNORED = mysqlerr84.ER_ATTRIBUTE_IGNORED
+const ER_INVALID_THREAD_ID = mysqlerr84.ER_INVALID_THREAD_ID
+const ER_RESOURCE_GROUP_BIND_FAILED = mysqlerr84.ER_RESOURCE_GROUP_BIND_FAILED
+const ER_INVALID_USE_OF_FORCE_OPTION = mysqlerr84.ER_INVALID_USE_OF_FORCE_OPTION
+const ER_GROUP_REPLICATION_COMMAND_FAILURE = mysqlerr84.ER_GROUP_REPLICATION_COMMAND_FAILURE
+const ER_SDI_OPERATION_FAILED = mysqlerr84.ER_SDI_OPERATION_FAILED
+const ER_MISSING_JSON_TABLE_VALUE = mysqlerr84.ER_MISSING_JSON_TABLE_VALUE
+const ER_WRONG_JSON_TABLE_VALUE = mysqlerr84.ER_WRONG_JSON_TABLE_VALUE
+const ER_TF_MUST_HAVE_ALIAS = mysqlerr84.ER_TF_MUST_HAVE_ALIAS
+const ER_TF_FORBIDDEN_JOIN_TYPE = mysqlerr84.ER_TF_FORBIDDEN_JOIN_TYPE
+const ER_JT_VALUE_OUT_OF_RANGE = mysqlerr84.ER_JT_VALUE_OUT_OF_RANGE
+const ER_JT_MAX_NESTED_PATH = mysqlerr84.ER_JT_MAX_NESTED_PATH
+const ER_PASSWORD_EXPIRATION_NOT_SUPPORTED_BY_AUTH_METHOD = mysqlerr84.ER_PASSWORD_EXPIRATION_NOT_SUPPORTED_BY_AUTH_METHOD
+const ER_INVALID_GEOJSON_CRS_NOT_TOP_LEVEL = mysqlerr84.ER_INVALID_GEOJSON_CRS_NOT_TOP_LEVEL
+const ER_BAD_NULL_ERROR_NOT_IGNORED = mysqlerr84.ER_BAD_NULL_ERROR_NOT_IGNORED
+const WARN_USELESS_SPATIAL_INDEX = mysqlerr84.WARN_USELESS_SPATIAL_INDEX
+const ER_DISK_FULL_NOWAIT = mysqlerr84.ER_DISK_FULL_NOWAIT
+const ER_PARSE_ERROR_IN_DIGEST_FN = mysqlerr84.ER_PARSE_ERROR_IN_DIGEST_FN
+const ER_UNDISCLOSED_PARSE_ERROR_IN_DIGEST_FN = mysqlerr84.ER_UNDISCLOSED_PARSE_ERROR_IN_DIGEST_FN
+const ER_SCHEMA_DIR_EXISTS = mysqlerr84.ER_SCHEMA_DIR_EXISTS
+const ER_SCHEMA_DIR_MISSING = mysqlerr84.ER_SCHEMA_DIR_MISSING
+const ER_SCHEMA_DIR_CREATE_FAILED = mysqlerr84.ER_SCHEMA_DIR_CREATE_FAILED
+const ER_SCHEMA_DIR_UNKNOWN = mysqlerr84.ER_SCHEMA_DIR_UNKNOWN
+const ER_ONLY_IMPLEMENTED_FOR_SRID_0_AND_4326 = mysqlerr84.ER_ONLY_IMPLEMENTED_FOR_SRID_0_AND_4326
+
+// Deprecated: should not be used
+const ER_BINLOG_EXPIRE_LOG_DAYS_AND_SECS_USED_TOGETHER = mysqlerr84.ER_BINLOG_EXPIRE_LOG_DAYS_AND_SECS_USED_TOGETHER
+const OBSOLETE_ER_BINLOG_EXPIRE_LOG_DAYS_AND_SECS_USED_TOGETHER = mysqlerr84.OBSOLETE_ER_BINLOG_EXPIRE_LOG_DAYS_AND_SECS_USED_TOGETHER
+const ER_REGEXP_BUFFER_OVERFLOW = mysqlerr84.ER_REGEXP_BUFFER_OVERFLOW
+const ER_REGEXP_ILLEGAL_ARGUMENT = mysqlerr84.ER_REGEXP_ILLEGAL_ARGUMENT
+const ER_REGEXP_INDEX_OUTOFBOUNDS_ERROR = mysqlerr84.ER_REGEXP_INDEX_OUTOFBOUNDS_ERROR
+const ER_REGEXP_INTERNAL_ERROR = mysqlerr84.ER_REGEXP_INTERNAL_ERROR
+const ER_REGEXP_RULE_SYNTAX = mysqlerr84.ER_REGEXP_RULE_SYNTAX
+const ER_REGEXP_BAD_ESCAPE_SEQUENCE = mysqlerr84.ER_REGEXP_BAD_ESCAPE_SEQUENCE
+const ER_REGEXP_UNIMPLEMENTED = mysqlerr84.ER_REGEXP_UNIMPLEMENTED
+const ER_REGEXP_MISMATCHED_PAREN = mysqlerr84.ER_REGEXP_MISMATCHED_PAREN
+const ER_REGEXP_BAD_INTERVAL = mysqlerr84.ER_REGEXP_BAD_INTERVAL
+const ER_REGEXP_MAX_LT_MIN = mysqlerr84.ER_REGEXP_MAX_LT_MIN
+const ER_REGEXP_INVALID_BACK_REF = mysqlerr84.ER_REGEXP_INVALID_BACK_REF
+const ER_REGEXP_LOOK_BEHIND_LIMIT = mysqlerr84.ER_REGEXP_LOOK_BEHIND_LIMIT
+const ER_REGEXP_MISSING_CLOSE_BRACKET = mysqlerr84.ER_REGEXP_MISSING_CLOSE_BRACKET
+const ER_REGEXP_INVALID_RANGE = mysqlerr84.ER_REGEXP_INVALID_RANGE
+const ER_REGEXP_STACK_OVERFLOW = mysqlerr84.ER_REGEXP_STACK_OVERFLOW
+const ER_REGEXP_TIME_OUT = mysqlerr84.ER_REGEXP_TIME_OUT
+const ER_REGEXP_PATTERN_TOO_BIG = mysqlerr84.ER_REGEXP_PATTERN_TOO_BIG
+const ER_CANT_SET_ERROR_LOG_SERVICE = mysqlerr84.ER_CANT_SET_ERROR_LOG_SERVICE
+const ER_EMPTY_PIPELINE_FOR_ERROR_LOG_SERVICE = mysqlerr84.ER_EMPTY_PIPELINE_FOR_ERROR_LOG_SERVICE
+const ER_COMPONENT_FILTER_DIAGNOSTICS = mysqlerr84.ER_COMPONENT_FILTER_DIAGNOSTICS
+const ER_NOT_IMPLEMENTED_FOR_CARTESIAN_SRS = mysqlerr84.ER_NOT_IMPLEMENTED_FOR_CARTESIAN_SRS
+const ER_NOT_IMPLEMENTED_FOR_PROJECTED_SRS = mysqlerr84.ER_NOT_IMPLEMENTED_FOR_PROJECTED_SRS
+const ER_NONPOSITIVE_RADIUS = mysqlerr84.ER_NONPOSITIVE_RADIUS
+const ER_RESTART_SERVER_FAILED = mysqlerr84.ER_RESTART_SERVER_FAILED
+const ER_SRS_MISSING_MANDATORY_ATTRIBUTE = mysqlerr84.ER_SRS_MISSING_MANDATORY_ATTRIBUTE
+const ER_SRS_MULTIPLE_ATTRIBUTE_DEFINITIONS = mysqlerr84.ER_SRS_MULTIPLE_ATTRIBUTE_DEFINITIONS
+const ER_SRS_NAME_CANT_BE_EMPTY_OR_WHITESPACE = mysqlerr84.ER_SRS_NAME_CANT_BE_EMPTY_OR_WHITESPACE
+const ER_SRS_ORGANIZATION_CANT_BE_EMPTY_OR_WHITESPACE = mysqlerr84.ER_SRS_ORGANIZATION_CANT_BE_EMPTY_OR_WHITESPACE
+const ER_SRS_ID_ALREADY_EXISTS = mysqlerr84.ER_SRS_ID_ALREADY_EXISTS
+const ER_WARN_SRS_ID_ALREADY_EXISTS = mysqlerr84.ER_WARN_SRS_ID_ALREADY_EXISTS
+const ER_CANT_MODIFY_SRID_0 = mysqlerr84.ER_CANT_MODIFY_SRID_0
+const ER_WARN_RESERVED_SRID_RANGE = mysqlerr84.ER_WARN_RESERVED_SRID_RANGE
+const ER_CANT_MODIFY_SRS_USED_BY_COLUMN = mysqlerr84.ER_CANT_MODIFY_SRS_USED_BY_COLUMN
+const ER_SRS_INVALID_CHARACTER_IN_ATTRIBUTE = mysqlerr84.ER_SRS_INVALID_CHARACTER_IN_ATTRIBUTE
+const ER_SRS_ATTRIBUTE_STRING_TOO_LONG = mysqlerr84.ER_SRS_ATTRIBUTE_STRING_TOO_LONG
+const ER_DEPRECATED_UTF8_ALIAS = mysqlerr84.ER_DEPRECATED_UTF8_ALIAS
+const ER_DEPRECATED_NATIONAL = mysqlerr84.ER_DEPRECATED_NATIONAL
+const ER_INVALID_DEFAULT_UTF8MB4_COLLATION = mysqlerr84.ER_INVALID_DEFAULT_UTF8MB4_COLLATION
+const ER_UNABLE_TO_COLLECT_LOG_STATUS = mysqlerr84.ER_UNABLE_TO_COLLECT_LOG_STATUS
+const ER_RESERVED_TABLESPACE_NAME = mysqlerr84.ER_RESERVED_TABLESPACE_NAME
+const ER_UNABLE_TO_SET_OPTION = mysqlerr84.ER_UNABLE_TO_SET_OPTION
+
+// Deprecated: should not be used
+const ER_SLAVE_POSSIBLY_DIVERGED_AFTER_DDL = mysqlerr84.ER_SLAVE_POSSIBLY_DIVERGED_AFTER_DDL
+const ER_REPLICA_POSSIBLY_DIVERGED_AFTER_DDL = mysqlerr84.ER_REPLICA_POSSIBLY_DIVERGED_AFTER_DDL
+const ER_SRS_NOT_GEOGRAPHIC = mysqlerr84.ER_SRS_NOT_GEOGRAPHIC
+const ER_POLYGON_TOO_LARGE = mysqlerr84.ER_POLYGON_TOO_LARGE
+const ER_SPATIAL_UNIQUE_INDEX = mysqlerr84.ER_SPATIAL_UNIQUE_INDEX
+const ER_INDEX_TYPE_NOT_SUPPORTED_FOR_SPATIAL_INDEX = mysqlerr84.ER_INDEX_TYPE_NOT_SUPPORTED_FOR_SPATIAL_INDEX
+const ER_FK_CANNOT_DROP_PARENT = mysqlerr84.ER_FK_CANNOT_DROP_PARENT
+const ER_GEOMETRY_PARAM_LONGITUDE_OUT_OF_RANGE = mysqlerr84.ER_GEOMETRY_PARAM_LONGITUDE_OUT_OF_RANGE
+const ER_GEOMETRY_PARAM_LATITUDE_OUT_OF_RANGE = mysqlerr84.ER_GEOMETRY_PARAM_LATITUDE_OUT_OF_RANGE
+const ER_FK_CANNOT_USE_VIRTUAL_COLUMN = mysqlerr84.ER_FK_CANNOT_USE_VIRTUAL_COLUMN
+const ER_FK_NO_COLUMN_PARENT = mysqlerr84.ER_FK_NO_COLUMN_PARENT
+const ER_CANT_SET_ERROR_SUPPRESSION_LIST = mysqlerr84.ER_CANT_SET_ERROR_SUPPRESSION_LIST
+const ER_SRS_GEOGCS_INVALID_AXES = mysqlerr84.ER_SRS_GEOGCS_INVALID_AXES
+const ER_SRS_INVALID_SEMI_MAJOR_AXIS = mysqlerr84.ER_SRS_INVALID_SEMI_MAJOR_AXIS
+const ER_SRS_INVALID_INVERSE_FLATTENING = mysqlerr84.ER_SRS_INVALID_INVERSE_FLATTENING
+const ER_SRS_INVALID_ANGULAR_UNIT = mysqlerr84.ER_SRS_INVALID_ANGULAR_UNIT
+const ER_SRS_INVALID_PRIME_MERIDIAN = mysqlerr84.ER_SRS_INVALID_PRIME_MERIDIAN
+const ER_TRANSFORM_SOURCE_SRS_NOT_SUPPORTED = mysqlerr84.ER_TRANSFORM_SOURCE_SRS_NOT_SUPPORTED
+const ER_TRANSFORM_TARGET_SRS_NOT_SUPPORTED = mysqlerr84.ER_TRANSFORM_TARGET_SRS_NOT_SUPPORTED
+const ER_TRANSFORM_SOURCE_SRS_MISSING_TOWGS84 = mysqlerr84.ER_TRANSFORM_SOURCE_SRS_MISSING_TOWGS84
+const ER_TRANSFORM_TARGET_SRS_MISSING_TOWGS84 = mysqlerr84.ER_TRANSFORM_TARGET_SRS_MISSING_TOWGS84
+const ER_TEMP_TABLE_PREVENTS_SWITCH_SESSION_BINLOG_FORMAT = mysqlerr84.ER_TEMP_TABLE_PREVENTS_SWITCH_SESSION_BINLOG_FORMAT
+const ER_TEMP_TABLE_PREVENTS_SWITCH_GLOBAL_BINLOG_FORMAT = mysqlerr84.ER_TEMP_TABLE_PREVENTS_SWITCH_GLOBAL_BINLOG_FORMAT
+const ER_RUNNING_APPLIER_PREVENTS_SWITCH_GLOBAL_BINLOG_FORMAT = mysqlerr84.ER_RUNNING_APPLIER_PREVENTS_SWITCH_GLOBAL_BINLOG_FORMAT
+const ER_CLIENT_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRX_IN_SBR = mysqlerr84.ER_CLIENT_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRX_IN_SBR
+
+// Deprecated: should not be used
+const ER_XA_CANT_CREATE_MDL_BACKUP = mysqlerr84.ER_XA_CANT_CREATE_MDL_BACKUP
+const OBSOLETE_ER_XA_CANT_CREATE_MDL_BACKUP = mysqlerr84.OBSOLETE_ER_XA_CANT_CREATE_MDL_BACKUP
+const ER_TABLE_WITHOUT_PK = mysqlerr84.ER_TABLE_WITHOUT_PK
+const ER_WARN_DATA_TRUNCATED_FUNCTIONAL_INDEX = mysqlerr84.ER_WARN_DATA_TRUNCATED_FUNCTIONAL_INDEX
+const ER_WARN_DATA_OUT_OF_RANGE_FUNCTIONAL_INDEX = mysqlerr84.ER_WARN_DATA_OUT_OF_RANGE_FUNCTIONAL_INDEX
+const ER_FUNCTIONAL_INDEX_ON_JSON_OR_GEOMETRY_FUNCTION = mysqlerr84.ER_FUNCTIONAL_INDEX_ON_JSON_OR_GEOMETRY_FUNCTION
+const ER_FUNCTIONAL_INDEX_REF_AUTO_INCREMENT = mysqlerr84.ER_FUNCTIONAL_INDEX_REF_AUTO_INCREMENT
+const ER_CANNOT_DROP_COLUMN_FUNCTIONAL_INDEX = mysqlerr84.ER_CANNOT_DROP_COLUMN_FUNCTIONAL_INDEX
+const ER_FUNCTIONAL_INDEX_PRIMARY_KEY = mysqlerr84.ER_FUNCTIONAL_INDEX_PRIMARY_KEY
+const ER_FUNCTIONAL_INDEX_ON_LOB = mysqlerr84.ER_FUNCTIONAL_INDEX_ON_LOB
+const ER_FUNCTIONAL_INDEX_FUNCTION_IS_NOT_ALLOWED = mysqlerr84.ER_FUNCTIONAL_INDEX_FUNCTION_IS_NOT_ALLOWED
+const ER_FULLTEXT_FUNCTIONAL_INDEX = mysqlerr84.ER_FULLTEXT_FUNCTIONAL_INDEX
+const ER_SPATIAL_FUNCTIONAL_INDEX = mysqlerr84.ER_SPATIAL_FUNCTIONAL_INDEX
+const ER_WRONG_KEY_COLUMN_FUNCTIONAL_INDEX = mysqlerr84.ER_WRONG_KEY_COLUMN_FUNCTIONAL_INDEX
+const ER_FUNCTIONAL_INDEX_ON_FIELD = mysqlerr84.ER_FUNCTIONAL_INDEX_ON_FIELD
+const ER_GENERATED_COLUMN_NAMED_FUNCTION_IS_NOT_ALLOWED = mysqlerr84.ER_GENERATED_COLUMN_NAMED_FUNCTION_IS_NOT_ALLOWED
+const ER_GENERATED_COLUMN_ROW_VALUE = mysqlerr84.ER_GENERATED_COLUMN_ROW_VALUE
+const ER_GENERATED_COLUMN_VARIABLES = mysqlerr84.ER_GENERATED_COLUMN_VARIABLES
+const ER_DEPENDENT_BY_DEFAULT_GENERATED_VALUE = mysqlerr84.ER_DEPENDENT_BY_DEFAULT_GENERATED_VALUE
+const ER_DEFAULT_VAL_GENERATED_NON_PRIOR = mysqlerr84.ER_DEFAULT_VAL_GENERATED_NON_PRIOR
+const ER_DEFAULT_VAL_GENERATED_REF_AUTO_INC = mysqlerr84.ER_DEFAULT_VAL_GENERATED_REF_AUTO_INC
+const ER_DEFAULT_VAL_GENERATED_FUNCTION_IS_NOT_ALLOWED = mysqlerr84.ER_DEFAULT_VAL_GENERATED_FUNCTION_IS_NOT_ALLOWED
+const ER_DEFAULT_VAL_GENERATED_NAMED_FUNCTION_IS_NOT_ALLOWED = mysqlerr84.ER_DEFAULT_VAL_GENERATED_NAMED_FUNCTION_IS_NOT_ALLOWED
+const ER_DEFAULT_VAL_GENERATED_ROW_VALUE = mysqlerr84.ER_DEFAULT_VAL_GENERATED_ROW_VALUE
+const ER_DEFAULT_VAL_GENERATED_VARIABLES = mysqlerr84.ER_DEFAULT_VAL_GENERATED_VARIABLES
+const ER_DEFAULT_AS_VAL_GENERATED = mysqlerr84.ER_DEFAULT_AS_VAL_GENERATED
+const ER_UNSUPPORTED_ACTION_ON_DEFAULT_VAL_GENERATED = mysqlerr84.ER_UNSUPPORTED_ACTION_ON_DEFAULT_VAL_GENERATED
+const ER_GTID_UNSAFE_ALTER_ADD_COL_WITH_DEFAULT_EXPRESSION = mysqlerr84.ER_GTID_UNSAFE_ALTER_ADD_COL_WITH_DEFAULT_EXPRESSION
+const ER_FK_CANNOT_CHANGE_ENGINE = mysqlerr84.ER_FK_CANNOT_CHANGE_ENGINE
+const ER_WARN_DEPRECATED_USER_SET_EXPR = mysqlerr84.ER_WARN_DEPRECATED_USER_SET_EXPR
+const ER_WARN_DEPRECATED_UTF8MB3_COLLATION = mysqlerr84.ER_WARN_DEPRECATED_UTF8MB3_COLLATION
+const ER_WARN_DEPRECATED_NESTED_COMMENT_SYNTAX = mysqlerr84.ER_WARN_DEPRECATED_NESTED_COMMENT_SYNTAX
+const ER_FK_INCOMPATIBLE_COLUMNS = mysqlerr84.ER_FK_INCOMPATIBLE_COLUMNS
+const ER_GR_HOLD_WAIT_TIMEOUT = mysqlerr84.ER_GR_HOLD_WAIT_TIMEOUT
+const ER_GR_HOLD_KILLED = mysqlerr84.ER_GR_HOLD_KILLED
+const ER_GR_HOLD_MEMBER_STATUS_ERROR = mysqlerr84.ER_GR_HOLD_MEMBER_STATUS_ERROR
+const ER_RPL_ENCRYPTION_FAILED_TO_FETCH_KEY = mysqlerr84.ER_RPL_ENCRYPTION_FAILED_TO_FETCH_KEY
+const ER_RPL_ENCRYPTION_KEY_NOT_FOUND = mysqlerr84.ER_RPL_ENCRYPTION_KEY_NOT_FOUND
+const ER_RPL_ENCRYPTION_KEYRING_INVALID_KEY = mysqlerr84.ER_RPL_ENCRYPTION_KEYRING_INVALID_KEY
+const ER_RPL_ENCRYPTION_HEADER_ERROR = mysqlerr84.ER_RPL_ENCRYPTION_HEADER_ERROR
+const ER_RPL_ENCRYPTION_FAILED_TO_ROTATE_LOGS = mysqlerr84.ER_RPL_ENCRYPTION_FAILED_TO_ROTATE_LOGS
+const ER_RPL_ENCRYPTION_KEY_EXISTS_UNEXPECTED = mysqlerr84.ER_RPL_ENCRYPTION_KEY_EXISTS_UNEXPECTED
+const ER_RPL_ENCRYPTION_FAILED_TO_GENERATE_KEY = mysqlerr84.ER_RPL_ENCRYPTION_FAILED_TO_GENERATE_KEY
+const ER_RPL_ENCRYPTION_FAILED_TO_STORE_KEY = mysqlerr84.ER_RPL_ENCRYPTION_FAILED_TO_STORE_KEY
+const ER_RPL_ENCRYPTION_FAILED_TO_REMOVE_KEY = mysqlerr84.ER_RPL_ENCRYPTION_FAILED_TO_REMOVE_KEY
+const ER_RPL_ENCRYPTION_UNABLE_TO_CHANGE_OPTION = mysqlerr84.ER_RPL_ENCRYPTION_UNABLE_TO_CHANGE_OPTION
+const ER_RPL_ENCRYPTION_MASTER_KEY_RECOVERY_FAILED = mysqlerr84.ER_RPL_ENCRYPTION_MASTER_KEY_RECOVERY_FAILED
+const ER_SLOW_LOG_MODE_IGNORED_WHEN_NOT_LOGGING_TO_FILE = mysqlerr84.ER_SLOW_LOG_MODE_IGNORED_WHEN_NOT_LOGGING_TO_FILE
+const ER_GRP_TRX_CONSISTENCY_NOT_ALLOWED = mysqlerr84.ER_GRP_TRX_CONSISTENCY_NOT_ALLOWED
+const ER_GRP_TRX_CONSISTENCY_BEFORE = mysqlerr84.ER_GRP_TRX_CONSISTENCY_BEFORE
+const ER_GRP_TRX_CONSISTENCY_AFTER_ON_TRX_BEGIN = mysqlerr84.ER_GRP_TRX_CONSISTENCY_AFTER_ON_TRX_BEGIN
+const ER_GRP_TRX_CONSISTENCY_BEGIN_NOT_ALLOWED = mysqlerr84.ER_GRP_TRX_CONSISTENCY_BEGIN_NOT_ALLOWED
+const ER_FUNCTIONAL_INDEX_ROW_VALUE_IS_NOT_ALLOWED = mysqlerr84.ER_FUNCTIONAL_INDEX_ROW_VALUE_IS_NOT_ALLOWED
+const ER_RPL_ENCRYPTION_FAILED_TO_ENCRYPT = mysqlerr84.ER_RPL_ENCRYPTION_FAILED_TO_ENCRYPT
+const ER_PAGE_TRACKING_NOT_STARTED = mysqlerr84.ER_PAGE_TRACKING_NOT_STARTED
+const ER_PAGE_TRACKING_RANGE_NOT_TRACKED = mysqlerr84.ER_PAGE_TRACKING_RANGE_NOT_TRACKED
+const ER_PAGE_TRACKING_CANNOT_PURGE = mysqlerr84.ER_PAGE_TRACKING_CANNOT_PURGE
+const ER_RPL_ENCRYPTION_CANNOT_ROTATE_BINLOG_MASTER_KEY = mysqlerr84.ER_RPL_ENCRYPTION_CANNOT_ROTATE_BINLOG_MASTER_KEY
+const ER_BINLOG_MASTER_KEY_RECOVERY_OUT_OF_COMBINATION = mysqlerr84.ER_BINLOG_MASTER_KEY_RECOVERY_OUT_OF_COMBINATION
+const ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_OPERATE_KEY = mysqlerr84.ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_OPERATE_KEY
+const ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_ROTATE_LOGS = mysqlerr84.ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_ROTATE_LOGS
+const ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_REENCRYPT_LOG = mysqlerr84.ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_REENCRYPT_LOG
+const ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_CLEANUP_UNUSED_KEYS = mysqlerr84.ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_CLEANUP_UNUSED_KEYS
+const ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_CLEANUP_AUX_KEY = mysqlerr84.ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_CLEANUP_AUX_KEY
+const ER_NON_BOOLEAN_EXPR_FOR_CHECK_CONSTRAINT = mysqlerr84.ER_NON_BOOLEAN_EXPR_FOR_CHECK_CONSTRAINT
+const ER_COLUMN_CHECK_CONSTRAINT_REFERENCES_OTHER_COLUMN = mysqlerr84.ER_COLUMN_CHECK_CONSTRAINT_REFERENCES_OTHER_COLUMN
+const ER_CHECK_CONSTRAINT_NAMED_FUNCTION_IS_NOT_ALLOWED = mysqlerr84.ER_CHECK_CONSTRAINT_NAMED_FUNCTION_IS_NOT_ALLOWED
+const ER_CHECK_CONSTRAINT_FUNCTION_IS_NOT_ALLOWED = mysqlerr84.ER_CHECK_CONSTRAINT_FUNCTION_IS_NOT_ALLOWED
+const ER_CHECK_CONSTRAINT_VARIABLES = mysqlerr84.ER_CHECK_CONSTRAINT_VARIABLES
+const ER_CHECK_CONSTRAINT_ROW_VALUE = mysqlerr84.ER_CHECK_CONSTRAINT_ROW_VALUE
+const ER_CHECK_CONSTRAINT_REFERS_AUTO_INCREMENT_COLUMN = mysqlerr84.ER_CHECK_CONSTRAINT_REFERS_AUTO_INCREMENT_COLUMN
+const ER_CHECK_CONSTRAINT_VIOLATED = mysqlerr84.ER_CHECK_CONSTRAINT_VIOLATED
+const ER_CHECK_CONSTRAINT_REFERS_UNKNOWN_COLUMN = mysqlerr84.ER_CHECK_CONSTRAINT_REFERS_UNKNOWN_COLUMN
+const ER_CHECK_CONSTRAINT_NOT_FOUND = mysqlerr84.ER_CHECK_CONSTRAINT_NOT_FOUND
+const ER_CHECK_CONSTRAINT_DUP_NAME = mysqlerr84.ER_CHECK_CONSTRAINT_DUP_NAME
+const ER_CHECK_CONSTRAINT_CLAUSE_USING_FK_REFER_ACTION_COLUMN = mysqlerr84.ER_CHECK_CONSTRAINT_CLAUSE_USING_FK_REFER_ACTION_COLUMN
+const WARN_UNENCRYPTED_TABLE_IN_ENCRYPTED_DB = mysqlerr84.WARN_UNENCRYPTED_TABLE_IN_ENCRYPTED_DB
+const ER_INVALID_ENCRYPTION_REQUEST = mysqlerr84.ER_INVALID_ENCRYPTION_REQUEST
+const ER_CANNOT_SET_TABLE_ENCRYPTION = mysqlerr84.ER_CANNOT_SET_TABLE_ENCRYPTION
+const ER_CANNOT_SET_DATABASE_ENCRYPTION = mysqlerr84.ER_CANNOT_SET_DATABASE_ENCRYPTION
+const ER_CANNOT_SET_TABLESPACE_ENCRYPTION = mysqlerr84.ER_CANNOT_SET_TABLESPACE_ENCRYPTION
+const ER_TABLESPACE_CANNOT_BE_ENCRYPTED = mysqlerr84.ER_TABLESPACE_CANNOT_BE_ENCRYPTED
+const ER_TABLESPACE_CANNOT_BE_DECRYPTED = mysqlerr84.ER_TABLESPACE_CANNOT_BE_DECRYPTED
+const ER_TABLESPACE_TYPE_UNKNOWN = mysqlerr84.ER_TABLESPACE_TYPE_UNKNOWN
+const ER_TARGET_TABLESPACE_UNENCRYPTED = mysqlerr84.ER_TARGET_TABLESPACE_UNENCRYPTED
+const ER_CANNOT_USE_ENCRYPTION_CLAUSE = mysqlerr84.ER_CANNOT_USE_ENCRYPTION_CLAUSE
+const ER_INVALID_MULTIPLE_CLAUSES = mysqlerr84.ER_INVALID_MULTIPLE_CLAUSES
+const ER_UNSUPPORTED_USE_OF_GRANT_AS = mysqlerr84.ER_UNSUPPORTED_USE_OF_GRANT_AS
+const ER_UKNOWN_AUTH_ID_OR_ACCESS_DENIED_FOR_GRANT_AS = mysqlerr84.ER_UKNOWN_AUTH_ID_OR_ACCESS_DENIED_FOR_GRANT_AS
+const ER_DEPENDENT_BY_FUNCTIONAL_INDEX = mysqlerr84.ER_DEPENDENT_BY_FUNCTIONAL_INDEX
+const ER_PLUGIN_NOT_EARLY = mysqlerr84.ER_PLUGIN_NOT_EARLY
+const ER_INNODB_REDO_LOG_ARCHIVE_START_SUBDIR_PATH = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_START_SUBDIR_PATH
+const ER_INNODB_REDO_LOG_ARCHIVE_START_TIMEOUT = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_START_TIMEOUT
+const ER_INNODB_REDO_LOG_ARCHIVE_DIRS_INVALID = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_DIRS_INVALID
+const ER_INNODB_REDO_LOG_ARCHIVE_LABEL_NOT_FOUND = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_LABEL_NOT_FOUND
+const ER_INNODB_REDO_LOG_ARCHIVE_DIR_EMPTY = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_DIR_EMPTY
+const ER_INNODB_REDO_LOG_ARCHIVE_NO_SUCH_DIR = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_NO_SUCH_DIR
+const ER_INNODB_REDO_LOG_ARCHIVE_DIR_CLASH = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_DIR_CLASH
+const ER_INNODB_REDO_LOG_ARCHIVE_DIR_PERMISSIONS = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_DIR_PERMISSIONS
+const ER_INNODB_REDO_LOG_ARCHIVE_FILE_CREATE = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_FILE_CREATE
+const ER_INNODB_REDO_LOG_ARCHIVE_ACTIVE = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_ACTIVE
+const ER_INNODB_REDO_LOG_ARCHIVE_INACTIVE = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_INACTIVE
+const ER_INNODB_REDO_LOG_ARCHIVE_FAILED = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_FAILED
+const ER_INNODB_REDO_LOG_ARCHIVE_SESSION = mysqlerr84.ER_INNODB_REDO_LOG_ARCHIVE_SESSION
+const ER_STD_REGEX_ERROR = mysqlerr84.ER_STD_REGEX_ERROR
+const ER_INVALID_JSON_TYPE = mysqlerr84.ER_INVALID_JSON_TYPE
+const ER_CANNOT_CONVERT_STRING = mysqlerr84.ER_CANNOT_CONVERT_STRING
+const ER_DEPENDENT_BY_PARTITION_FUNC = mysqlerr84.ER_DEPENDENT_BY_PARTITION_FUNC
+const ER_WARN_DEPRECATED_FLOAT_AUTO_INCREMENT = mysqlerr84.ER_WARN_DEPRECATED_FLOAT_AUTO_INCREMENT
+
+// Deprecated: should not be used
+const ER_RPL_CANT_STOP_SLAVE_WHILE_LOCKED_BACKUP = mysqlerr84.ER_RPL_CANT_STOP_SLAVE_WHILE_LOCKED_BACKUP
+const ER_RPL_CANT_STOP_REPLICA_WHILE_LOCKED_BACKUP = mysqlerr84.ER_RPL_CANT_STOP_REPLICA_WHILE_LOCKED_BACKUP
+const ER_WARN_DEPRECATED_FLOAT_DIGITS = mysqlerr84.ER_WARN_DEPRECATED_FLOAT_DIGITS
+const ER_WARN_DEPRECATED_FLOAT_UNSIGNED = mysqlerr84.ER_WARN_DEPRECATED_FLOAT_UNSIGNED
+const ER_WARN_DEPRECATED_INTEGER_DISPLAY_WIDTH = mysqlerr84.ER_WARN_DEPRECATED_INTEGER_DISPLAY_WIDTH
+const ER_WARN_DEPRECATED_ZEROFILL = mysqlerr84.ER_WARN_DEPRECATED_ZEROFILL
+const ER_CLONE_DONOR = mysqlerr84.ER_CLONE_DONOR
+const ER_CLONE_PROTOCOL = mysqlerr84.ER_CLONE_PROTOCOL
+const ER_CLONE_DONOR_VERSION = mysqlerr84.ER_CLONE_DONOR_VERSION
+const ER_CLONE_OS = mysqlerr84.ER_CLONE_OS
+const ER_CLONE_PLATFORM = mysqlerr84.ER_CLONE_PLATFORM
+const ER_CLONE_CHARSET = mysqlerr84.ER_CLONE_CHARSET
+const ER_CLONE_CONFIG = mysqlerr84.ER_CLONE_CONFIG
+const ER_CLONE_SYS_CONFIG = mysqlerr84.ER_CLONE_SYS_CONFIG
+const ER_CLONE_PLUGIN_MATCH = mysqlerr84.ER_CLONE_PLUGIN_MATCH
+const ER_CLONE_LOOPBACK = mysqlerr84.ER_CLONE_LOOPBACK
+const ER_CLONE_ENCRYPTION = mysqlerr84.ER_CLONE_ENCRYPTION
+const ER_CLONE_DISK_SPACE = mysqlerr84.ER_CLONE_DISK_SPACE
+const ER_CLONE_IN_PROGRESS = mysqlerr84.ER_CLONE_IN_PROGRESS
+const ER_CLONE_DISALLOWED = mysqlerr84.ER_CLONE_DISALLOWED
+const ER_CANNOT_GRANT_ROLES_TO_ANONYMOUS_USER = mysqlerr84.ER_CANNOT_GRANT_ROLES_TO_ANONYMOUS_USER
+const ER_SECONDARY_ENGINE_PLUGIN = mysqlerr84.ER_SECONDARY_ENGINE_PLUGIN
+const ER_SECOND_PASSWORD_CANNOT_BE_EMPTY = mysqlerr84.ER_SECOND_PASSWORD_CANNOT_BE_EMPTY
+const ER_DB_ACCESS_DENIED = mysqlerr84.ER_DB_ACCESS_DENIED
+const ER_DA_AUTH_ID_WITH_SYSTEM_USER_PRIV_IN_MANDATORY_ROLES = mysqlerr84.ER_DA_AUTH_ID_WITH_SYSTEM_USER_PRIV_IN_MANDATORY_ROLES
+const ER_DA_RPL_GTID_TABLE_CANNOT_OPEN = mysqlerr84.ER_DA_RPL_GTID_TABLE_CANNOT_OPEN
+const ER_GEOMETRY_IN_UNKNOWN_LENGTH_UNIT = mysqlerr84.ER_GEOMETRY_IN_UNKNOWN_LENGTH_UNIT
+const ER_DA_PLUGIN_INSTALL_ERROR = mysqlerr84.ER_DA_PLUGIN_INSTALL_ERROR
+const ER_NO_SESSION_TEMP = mysqlerr84.ER_NO_SESSION_TEMP
+const ER_DA_UNKNOWN_ERROR_NUMBER = mysqlerr84.ER_DA_UNKNOWN_ERROR_NUMBER
+const ER_COLUMN_CHANGE_SIZE = mysqlerr84.ER_COLUMN_CHANGE_SIZE
+const ER_REGEXP_INVALID_CAPTURE_GROUP_NAME = mysqlerr84.ER_REGEXP_INVALID_CAPTURE_GROUP_NAME
+const ER_DA_SSL_LIBRARY_ERROR = mysqlerr84.ER_DA_SSL_LIBRARY_ERROR
+const ER_SECONDARY_ENGINE = mysqlerr84.ER_SECONDARY_ENGINE
+const ER_SECONDARY_ENGINE_DDL = mysqlerr84.ER_SECONDARY_ENGINE_DDL
+const ER_INCORRECT_CURRENT_PASSWORD = mysqlerr84.ER_INCORRECT_CURRENT_PASSWORD
+const ER_MISSING_CURRENT_PASSWORD = mysqlerr84.ER_MISSING_CURRENT_PASSWORD
+const ER_CURRENT_PASSWORD_NOT_REQUIRED = mysqlerr84.ER_CURRENT_PASSWORD_NOT_REQUIRED
+const ER_PASSWORD_CANNOT_BE_RETAINED_ON_PLUGIN_CHANGE = mysqlerr84.ER_PASSWORD_CANNOT_BE_RETAINED_ON_PLUGIN_CHANGE
+const ER_CURRENT_PASSWORD_CANNOT_BE_RETAINED = mysqlerr84.ER_CURRENT_PASSWORD_CANNOT_BE_RETAINED
+const ER_PARTIAL_REVOKES_EXIST = mysqlerr84.ER_PARTIAL_REVOKES_EXIST
+const ER_CANNOT_GRANT_SYSTEM_PRIV_TO_MANDATORY_ROLE = mysqlerr84.ER_CANNOT_GRANT_SYSTEM_PRIV_TO_MANDATORY_ROLE
+const ER_XA_REPLICATION_FILTERS = mysqlerr84.ER_XA_REPLICATION_FILTERS
+const ER_UNSUPPORTED_SQL_MODE = mysqlerr84.ER_UNSUPPORTED_SQL_MODE
+const ER_REGEXP_INVALID_FLAG = mysqlerr84.ER_REGEXP_INVALID_FLAG
+const ER_PARTIAL_REVOKE_AND_DB_GRANT_BOTH_EXISTS = mysqlerr84.ER_PARTIAL_REVOKE_AND_DB_GRANT_BOTH_EXISTS
+const ER_UNIT_NOT_FOUND = mysqlerr84.ER_UNIT_NOT_FOUND
+const ER_INVALID_JSON_VALUE_FOR_FUNC_INDEX = mysqlerr84.ER_INVALID_JSON_VALUE_FOR_FUNC_INDEX
+const ER_JSON_VALUE_OUT_OF_RANGE_FOR_FUNC_INDEX = mysqlerr84.ER_JSON_VALUE_OUT_OF_RANGE_FOR_FUNC_INDEX
+const ER_EXCEEDED_MV_KEYS_NUM = mysqlerr84.ER_EXCEEDED_MV_KEYS_NUM
+const ER_EXCEEDED_MV_KEYS_SPACE = mysqlerr84.ER_EXCEEDED_MV_KEYS_SPACE
+const ER_FUNCTIONAL_INDEX_DATA_IS_TOO_LONG = mysqlerr84.ER_FUNCTIONAL_INDEX_DATA_IS_TOO_LONG
+const ER_WRONG_MVI_VALUE = mysqlerr84.ER_WRONG_MVI_VALUE
+const ER_WARN_FUNC_INDEX_NOT_APPLICABLE = mysqlerr84.ER_WARN_FUNC_INDEX_NOT_APPLICABLE
+const ER_GRP_RPL_UDF_ERROR = mysqlerr84.ER_GRP_RPL_UDF_ERROR
+const ER_UPDATE_GTID_PURGED_WITH_GR = mysqlerr84.ER_UPDATE_GTID_PURGED_WITH_GR
+const ER_GROUPING_ON_TIMESTAMP_IN_DST = mysqlerr84.ER_GROUPING_ON_TIMESTAMP_IN_DST
+const ER_TABLE_NAME_CAUSES_TOO_LONG_PATH = mysqlerr84.ER_TABLE_NAME_CAUSES_TOO_LONG_PATH
+const ER_AUDIT_LOG_INSUFFICIENT_PRIVILEGE = mysqlerr84.ER_AUDIT_LOG_INSUFFICIENT_PRIVILEGE
+
+// Deprecated: should not be used
+const ER_AUDIT_LOG_PASSWORD_HAS_BEEN_COPIED = mysqlerr84.ER_AUDIT_LOG_PASSWORD_HAS_BEEN_COPIED
+const OBSOLETE_ER_AUDIT_LOG_PASSWORD_HAS_BEEN_COPIED = mysqlerr84.OBSOLETE_ER_AUDIT_LOG_PASSWORD_HAS_BEEN_COPIED
+const ER_DA_GRP_RPL_STARTED_AUTO_REJOIN = mysqlerr84.ER_DA_GRP_RPL_STARTED_AUTO_REJOIN
+const ER_SYSVAR_CHANGE_DURING_QUERY = mysqlerr84.ER_SYSVAR_CHANGE_DURING_QUERY
+const ER_GLOBSTAT_CHANGE_DURING_QUERY = mysqlerr84.ER_GLOBSTAT_CHANGE_DURING_QUERY
+const ER_GRP_RPL_MESSAGE_SERVICE_INIT_FAILURE = mysqlerr84.ER_GRP_RPL_MESSAGE_SERVICE_INIT_FAILURE
+
+// Deprecated: should not be used
+const ER_CHANGE_MASTER_WRONG_COMPRESSION_ALGORITHM_CLIENT = mysqlerr84.ER_CHANGE_MASTER_WRONG_COMPRESSION_ALGORITHM_CLIENT
+const ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_CLIENT = mysqlerr84.ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_CLIENT
+
+// Deprecated: should not be used
+const ER_CHANGE_MASTER_WRONG_COMPRESSION_LEVEL_CLIENT = mysqlerr84.ER_CHANGE_MASTER_WRONG_COMPRESSION_LEVEL_CLIENT
+const ER_CHANGE_SOURCE_WRONG_COMPRESSION_LEVEL_CLIENT = mysqlerr84.ER_CHANGE_SOURCE_WRONG_COMPRESSION_LEVEL_CLIENT
+const ER_WRONG_COMPRESSION_ALGORITHM_CLIENT = mysqlerr84.ER_WRONG_COMPRESSION_ALGORITHM_CLIENT
+const ER_WRONG_COMPRESSION_LEVEL_CLIENT = mysqlerr84.ER_WRONG_COMPRESSION_LEVEL_CLIENT
+
+// Deprecated: should not be used
+const ER_CHANGE_MASTER_WRONG_COMPRESSION_ALGORITHM_LIST_CLIENT = mysqlerr84.ER_CHANGE_MASTER_WRONG_COMPRESSION_ALGORITHM_LIST_CLIENT
+const ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_LIST_CLIENT = mysqlerr84.ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_LIST_CLIENT
+const ER_CLIENT_PRIVILEGE_CHECKS_USER_CANNOT_BE_ANONYMOUS = mysqlerr84.ER_CLIENT_PRIVILEGE_CHECKS_USER_CANNOT_BE_ANONYMOUS
+const ER_CLIENT_PRIVILEGE_CHECKS_USER_DOES_NOT_EXIST = mysqlerr84.ER_CLIENT_PRIVILEGE_CHECKS_USER_DOES_NOT_EXIST
+const ER_CLIENT_PRIVILEGE_CHECKS_USER_CORRUPT = mysqlerr84.ER_CLIENT_PRIVILEGE_CHECKS_USER_CORRUPT
+const ER_CLIENT_PRIVILEGE_CHECKS_USER_NEEDS_RPL_APPLIER_PRIV = mysqlerr84.ER_CLIENT_PRIVILEGE_CHECKS_USER_NEEDS_RPL_APPLIER_PRIV
+const ER_WARN_DA_PRIVILEGE_NOT_REGISTERED = mysqlerr84.ER_WARN_DA_PRIVILEGE_NOT_REGISTERED
+const ER_CLIENT_KEYRING_UDF_KEY_INVALID = mysqlerr84.ER_CLIENT_KEYRING_UDF_KEY_INVALID
+const ER_CLIENT_KEYRING_UDF_KEY_TYPE_INVALID = mysqlerr84.ER_CLIENT_KEYRING_UDF_KEY_TYPE_INVALID
+const ER_CLIENT_KEYRING_UDF_KEY_TOO_LONG = mysqlerr84.ER_CLIENT_KEYRING_UDF_KEY_TOO_LONG
+const ER_CLIENT_KEYRING_UDF_KEY_TYPE_TOO_LONG = mysqlerr84.ER_CLIENT_KEYRING_UDF_KEY_TYPE_TOO_LONG
+const ER_JSON_SCHEMA_VALIDATION_ERROR_WITH_DETAILED_REPORT = mysqlerr84.ER_JSON_SCHEMA_VALIDATION_ERROR_WITH_DETAILED_REPORT
+const ER_DA_UDF_INVALID_CHARSET_SPECIFIED = mysqlerr84.ER_DA_UDF_INVALID_CHARSET_SPECIFIED
+const ER_DA_UDF_INVALID_CHARSET = mysqlerr84.ER_DA_UDF_INVALID_CHARSET
+const ER_DA_UDF_INVALID_COLLATION = mysqlerr84.ER_DA_UDF_INVALID_COLLATION
+const ER_DA_UDF_INVALID_EXTENSION_ARGUMENT_TYPE = mysqlerr84.ER_DA_UDF_INVALID_EXTENSION_ARGUMENT_TYPE
+const ER_MULTIPLE_CONSTRAINTS_WITH_SAME_NAME = mysqlerr84.ER_MULTIPLE_CONSTRAINTS_WITH_SAME_NAME
+const ER_CONSTRAINT_NOT_FOUND = mysqlerr84.ER_CONSTRAINT_NOT_FOUND
+const ER_ALTER_CONSTRAINT_ENFORCEMENT_NOT_SUPPORTED = mysqlerr84.ER_ALTER_CONSTRAINT_ENFORCEMENT_NOT_SUPPORTED
+const ER_TABLE_VALUE_CONSTRUCTOR_MUST_HAVE_COLUMNS = mysqlerr84.ER_TABLE_VALUE_CONSTRUCTOR_MUST_HAVE_COLUMNS
+const ER_TABLE_VALUE_CONSTRUCTOR_CANNOT_HAVE_DEFAULT = mysqlerr84.ER_TABLE_VALUE_CONSTRUCTOR_CANNOT_HAVE_DEFAULT
+const ER_CLIENT_QUERY_FAILURE_INVALID_NON_ROW_FORMAT = mysqlerr84.ER_CLIENT_QUERY_FAILURE_INVALID_NON_ROW_FORMAT
+const ER_REQUIRE_ROW_FORMAT_INVALID_VALUE = mysqlerr84.ER_REQUIRE_ROW_FORMAT_INVALID_VALUE
+const ER_FAILED_TO_DETERMINE_IF_ROLE_IS_MANDATORY = mysqlerr84.ER_FAILED_TO_DETERMINE_IF_ROLE_IS_MANDATORY
+const ER_FAILED_TO_FETCH_MANDATORY_ROLE_LIST = mysqlerr84.ER_FAILED_TO_FETCH_MANDATORY_ROLE_LIST
+const ER_CLIENT_LOCAL_FILES_DISABLED = mysqlerr84.ER_CLIENT_LOCAL_FILES_DISABLED
+const ER_IMP_INCOMPATIBLE_CFG_VERSION = mysqlerr84.ER_IMP_INCOMPATIBLE_CFG_VERSION
+const ER_DA_OOM = mysqlerr84.ER_DA_OOM
+const ER_DA_UDF_INVALID_ARGUMENT_TO_SET_CHARSET = mysqlerr84.ER_DA_UDF_INVALID_ARGUMENT_TO_SET_CHARSET
+const ER_DA_UDF_INVALID_RETURN_TYPE_TO_SET_CHARSET = mysqlerr84.ER_DA_UDF_INVALID_RETURN_TYPE_TO_SET_CHARSET
+const ER_MULTIPLE_INTO_CLAUSES = mysqlerr84.ER_MULTIPLE_INTO_CLAUSES
+const ER_MISPLACED_INTO = mysqlerr84.ER_MISPLACED_INTO
+const ER_USER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK = mysqlerr84.ER_USER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK
+const ER_WARN_DEPRECATED_YEAR_UNSIGNED = mysqlerr84.ER_WARN_DEPRECATED_YEAR_UNSIGNED
+const ER_CLONE_NETWORK_PACKET = mysqlerr84.ER_CLONE_NETWORK_PACKET
+const ER_SDI_OPERATION_FAILED_MISSING_RECORD = mysqlerr84.ER_SDI_OPERATION_FAILED_MISSING_RECORD
+const ER_DEPENDENT_BY_CHECK_CONSTRAINT = mysqlerr84.ER_DEPENDENT_BY_CHECK_CONSTRAINT
+const ER_GRP_OPERATION_NOT_ALLOWED_GR_MUST_STOP = mysqlerr84.ER_GRP_OPERATION_NOT_ALLOWED_GR_MUST_STOP
+const ER_WARN_DEPRECATED_JSON_TABLE_ON_ERROR_ON_EMPTY = mysqlerr84.ER_WARN_DEPRECATED_JSON_TABLE_ON_ERROR_ON_EMPTY
+const ER_WARN_DEPRECATED_INNER_INTO = mysqlerr84.ER_WARN_DEPRECATED_INNER_INTO
+const ER_WARN_DEPRECATED_VALUES_FUNCTION_ALWAYS_NULL = mysqlerr84.ER_WARN_DEPRECATED_VALUES_FUNCTION_ALWAYS_NULL
+const ER_WARN_DEPRECATED_SQL_CALC_FOUND_ROWS = mysqlerr84.ER_WARN_DEPRECATED_SQL_CALC_FOUND_ROWS
+const ER_WARN_DEPRECATED_FOUND_ROWS = mysqlerr84.ER_WARN_DEPRECATED_FOUND_ROWS
+const ER_MISSING_JSON_VALUE = mysqlerr84.ER_MISSING_JSON_VALUE
+const ER_MULTIPLE_JSON_VALUES = mysqlerr84.ER_MULTIPLE_JSON_VALUES
+const ER_HOSTNAME_TOO_LONG = mysqlerr84.ER_HOSTNAME_TOO_LONG
+
+// Deprecated: should not be used
+const ER_WARN_CLIENT_DEPRECATED_PARTITION_PREFIX_KEY = mysqlerr84.ER_WARN_CLIENT_DEPRECATED_PARTITION_PREFIX_KEY
+const OBSOLETE_ER_WARN_CLIENT_DEPRECATED_PARTITION_PREFIX_KEY = mysqlerr84.OBSOLETE_ER_WARN_CLIENT_DEPRECATED_PARTITION_PREFIX_KEY
+const ER_GROUP_REPLICATION_USER_EMPTY_MSG = mysqlerr84.ER_GROUP_REPLICATION_USER_EMPTY_MSG
+const ER_GROUP_REPLICATION_USER_MANDATORY_MSG = mysqlerr84.ER_GROUP_REPLICATION_USER_MANDATORY_MSG
+const ER_GROUP_REPLICATION_PASSWORD_LENGTH = mysqlerr84.ER_GROUP_REPLICATION_PASSWORD_LENGTH
+const ER_SUBQUERY_TRANSFORM_REJECTED = mysqlerr84.ER_SUBQUERY_TRANSFORM_REJECTED
+const ER_DA_GRP_RPL_RECOVERY_ENDPOINT_FORMAT = mysqlerr84.ER_DA_GRP_RPL_RECOVERY_ENDPOINT_FORMAT
+const ER_DA_GRP_RPL_RECOVERY_ENDPOINT_INVALID = mysqlerr84.ER_DA_GRP_RPL_RECOVERY_ENDPOINT_INVALID
+const ER_WRONG_VALUE_FOR_VAR_PLUS_ACTIONABLE_PART = mysqlerr84.ER_WRONG_VALUE_FOR_VAR_PLUS_ACTIONABLE_PART
+const ER_STATEMENT_NOT_ALLOWED_AFTER_START_TRANSACTION = mysqlerr84.ER_STATEMENT_NOT_ALLOWED_AFTER_START_TRANSACTION
+const ER_FOREIGN_KEY_WITH_ATOMIC_CREATE_SELECT = mysqlerr84.ER_FOREIGN_KEY_WITH_ATOMIC_CREATE_SELECT
+const ER_NOT_ALLOWED_WITH_START_TRANSACTION = mysqlerr84.ER_NOT_ALLOWED_WITH_START_TRANSACTION
+const ER_INVALID_JSON_ATTRIBUTE = mysqlerr84.ER_INVALID_JSON_ATTRIBUTE
+const ER_ENGINE_ATTRIBUTE_NOT_SUPPORTED = mysqlerr84.ER_ENGINE_ATTRIBUTE_NOT_SUPPORTED
+const ER_INVALID_USER_ATTRIBUTE_JSON = mysqlerr84.ER_INVALID_USER_ATTRIBUTE_JSON
+const ER_INNODB_REDO_DISABLED = mysqlerr84.ER_INNODB_REDO_DISABLED
+const ER_INNODB_REDO_ARCHIVING_ENABLED = mysqlerr84.ER_INNODB_REDO_ARCHIVING_ENABLED
+const ER_MDL_OUT_OF_RESOURCES = mysqlerr84.ER_MDL_OUT_OF_RESOURCES
+const ER_IMPLICIT_COMPARISON_FOR_JSON = mysqlerr84.ER_IMPLICIT_COMPARISON_FOR_JSON
+const ER_FUNCTION_DOES_NOT_SUPPORT_CHARACTER_SET = mysqlerr84.ER_FUNCTION_DOES_NOT_SUPPORT_CHARACTER_SET
+const ER_IMPOSSIBLE_STRING_CONVERSION = mysqlerr84.ER_IMPOSSIBLE_STRING_CONVERSION
+const ER_SCHEMA_READ_ONLY = mysqlerr84.ER_SCHEMA_READ_ONLY
+const ER_RPL_ASYNC_RECONNECT_GTID_MODE_OFF = mysqlerr84.ER_RPL_ASYNC_RECONNECT_GTID_MODE_OFF
+const ER_RPL_ASYNC_RECONNECT_AUTO_POSITION_OFF = mysqlerr84.ER_RPL_ASYNC_RECONNECT_AUTO_POSITION_OFF
+const ER_DISABLE_GTID_MODE_REQUIRES_ASYNC_RECONNECT_OFF = mysqlerr84.ER_DISABLE_GTID_MODE_REQUIRES_ASYNC_RECONNECT_OFF
+const ER_DISABLE_AUTO_POSITION_REQUIRES_ASYNC_RECONNECT_OFF = mysqlerr84.ER_DISABLE_AUTO_POSITION_REQUIRES_ASYNC_RECONNECT_OFF
+const ER_INVALID_PARAMETER_USE = mysqlerr84.ER_INVALID_PARAMETER_USE
+const ER_CHARACTER_SET_MISMATCH = mysqlerr84.ER_CHARACTER_SET_MISMATCH
+const ER_WARN_VAR_VALUE_CHANGE_NOT_SUPPORTED = mysqlerr84.ER_WARN_VAR_VALUE_CHANGE_NOT_SUPPORTED
+const ER_INVALID_TIME_ZONE_INTERVAL = mysqlerr84.ER_INVALID_TIME_ZONE_INTERVAL
+const ER_INVALID_CAST = mysqlerr84.ER_INVALID_CAST
+const ER_HYPERGRAPH_NOT_SUPPORTED_YET = mysqlerr84.ER_HYPERGRAPH_NOT_SUPPORTED_YET
+const ER_WARN_HYPERGRAPH_EXPERIMENTAL = mysqlerr84.ER_WARN_HYPERGRAPH_EXPERIMENTAL
+const ER_DA_NO_ERROR_LOG_PARSER_CONFIGURED = mysqlerr84.ER_DA_NO_ERROR_LOG_PARSER_CONFIGURED
+const ER_DA_ERROR_LOG_TABLE_DISABLED = mysqlerr84.ER_DA_ERROR_LOG_TABLE_DISABLED
+const ER_DA_ERROR_LOG_MULTIPLE_FILTERS = mysqlerr84.ER_DA_ERROR_LOG_MULTIPLE_FILTERS
+const ER_DA_CANT_OPEN_ERROR_LOG = mysqlerr84.ER_DA_CANT_OPEN_ERROR_LOG
+const ER_USER_REFERENCED_AS_DEFINER = mysqlerr84.ER_USER_REFERENCED_AS_DEFINER
+const ER_CANNOT_USER_REFERENCED_AS_DEFINER = mysqlerr84.ER_CANNOT_USER_REFERENCED_AS_DEFINER
+const ER_REGEX_NUMBER_TOO_BIG = mysqlerr84.ER_REGEX_NUMBER_TOO_BIG
+const ER_SPVAR_NONINTEGER_TYPE = mysqlerr84.ER_SPVAR_NONINTEGER_TYPE
+const WARN_UNSUPPORTED_ACL_TABLES_READ = mysqlerr84.WARN_UNSUPPORTED_ACL_TABLES_READ
+const ER_BINLOG_UNSAFE_ACL_TABLE_READ_IN_DML_DDL = mysqlerr84.ER_BINLOG_UNSAFE_ACL_TABLE_READ_IN_DML_DDL
+const ER_STOP_REPLICA_MONITOR_IO_THREAD_TIMEOUT = mysqlerr84.ER_STOP_REPLICA_MONITOR_IO_THREAD_TIMEOUT
+const ER_STARTING_REPLICA_MONITOR_IO_THREAD = mysqlerr84.ER_STARTING_REPLICA_MONITOR_IO_THREAD
+const ER_CANT_USE_ANONYMOUS_TO_GTID_WITH_GTID_MODE_NOT_ON = mysqlerr84.ER_CANT_USE_ANONYMOUS_TO_GTID_WITH_GTID_MODE_NOT_ON
+const ER_CANT_COMBINE_ANONYMOUS_TO_GTID_AND_AUTOPOSITION = mysqlerr84.ER_CANT_COMBINE_ANONYMOUS_TO_GTID_AND_AUTOPOSITION
+const ER_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_REQUIRES_GTID_MODE_ON = mysqlerr84.ER_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_REQUIRES_GTID_MODE_ON
+const ER_SQL_REPLICA_SKIP_COUNTER_USED_WITH_GTID_MODE_ON = mysqlerr84.ER_SQL_REPLICA_SKIP_COUNTER_USED_WITH_GTID_MODE_ON
+const ER_USING_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_AS_LOCAL_OR_UUID = mysqlerr84.ER_USING_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_AS_LOCAL_OR_UUID
+
+// Deprecated: should not be used
+const ER_CANT_SET_ANONYMOUS_TO_GTID_AND_WAIT_UNTIL_SQL_THD_AFTER_GTIDS = mysqlerr84.ER_CANT_SET_ANONYMOUS_TO_GTID_AND_WAIT_UNTIL_SQL_THD_AFTER_GTIDS
+const OBSOLETE_ER_SET_GTID_TO_ANON_AND_WAIT_UNTIL_SQL_THD_AFTER_GTIDS = mysqlerr84.OBSOLETE_ER_SET_GTID_TO_ANON_AND_WAIT_UNTIL_SQL_THD_AFTER_GTIDS
+const ER_CANT_SET_SQL_AFTER_OR_BEFORE_GTIDS_WITH_ANONYMOUS_TO_GTID = mysqlerr84.ER_CANT_SET_SQL_AFTER_OR_BEFORE_GTIDS_WITH_ANONYMOUS_TO_GTID
+const ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_GROUP_NAME = mysqlerr84.ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_GROUP_NAME
+const ER_CANT_USE_SAME_UUID_AS_GROUP_NAME = mysqlerr84.ER_CANT_USE_SAME_UUID_AS_GROUP_NAME
+const ER_GRP_RPL_RECOVERY_CHANNEL_STILL_RUNNING = mysqlerr84.ER_GRP_RPL_RECOVERY_CHANNEL_STILL_RUNNING
+const ER_INNODB_INVALID_AUTOEXTEND_SIZE_VALUE = mysqlerr84.ER_INNODB_INVALID_AUTOEXTEND_SIZE_VALUE
+const ER_INNODB_INCOMPATIBLE_WITH_TABLESPACE = mysqlerr84.ER_INNODB_INCOMPATIBLE_WITH_TABLESPACE
+const ER_INNODB_AUTOEXTEND_SIZE_OUT_OF_RANGE = mysqlerr84.ER_INNODB_AUTOEXTEND_SIZE_OUT_OF_RANGE
+const ER_CANNOT_USE_AUTOEXTEND_SIZE_CLAUSE = mysqlerr84.ER_CANNOT_USE_AUTOEXTEND_SIZE_CLAUSE
+const ER_ROLE_GRANTED_TO_ITSELF = mysqlerr84.ER_ROLE_GRANTED_TO_ITSELF
+const ER_TABLE_MUST_HAVE_A_VISIBLE_COLUMN = mysqlerr84.ER_TABLE_MUST_HAVE_A_VISIBLE_COLUMN
+const ER_INNODB_COMPRESSION_FAILURE = mysqlerr84.ER_INNODB_COMPRESSION_FAILURE
+const ER_WARN_ASYNC_CONN_FAILOVER_NETWORK_NAMESPACE = mysqlerr84.ER_WARN_ASYNC_CONN_FAILOVER_NETWORK_NAMESPACE
+const ER_CLIENT_INTERACTION_TIMEOUT = mysqlerr84.ER_CLIENT_INTERACTION_TIMEOUT
+const ER_INVALID_CAST_TO_GEOMETRY = mysqlerr84.ER_INVALID_CAST_TO_GEOMETRY
+const ER_INVALID_CAST_POLYGON_RING_DIRECTION = mysqlerr84.ER_INVALID_CAST_POLYGON_RING_DIRECTION
+const ER_GIS_DIFFERENT_SRIDS_AGGREGATION = mysqlerr84.ER_GIS_DIFFERENT_SRIDS_AGGREGATION
+const ER_RELOAD_KEYRING_FAILURE = mysqlerr84.ER_RELOAD_KEYRING_FAILURE
+const ER_SDI_GET_KEYS_INVALID_TABLESPACE = mysqlerr84.ER_SDI_GET_KEYS_INVALID_TABLESPACE
+const ER_CHANGE_RPL_SRC_WRONG_COMPRESSION_ALGORITHM_SIZE = mysqlerr84.ER_CHANGE_RPL_SRC_WRONG_COMPRESSION_ALGORITHM_SIZE
+
+// Deprecated: should not be used
+const ER_WARN_DEPRECATED_TLS_VERSION_FOR_CHANNEL_CLI = mysqlerr84.ER_WARN_DEPRECATED_TLS_VERSION_FOR_CHANNEL_CLI
+const OBSOLETE_ER_WARN_DEPRECATED_TLS_VERSION_FOR_CHANNEL_CLI = mysqlerr84.OBSOLETE_ER_WARN_DEPRECATED_TLS_VERSION_FOR_CHANNEL_CLI
+const ER_CANT_USE_SAME_UUID_AS_VIEW_CHANGE_UUID = mysqlerr84.ER_CANT_USE_SAME_UUID_AS_VIEW_CHANGE_UUID
+const ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_VIEW_CHANGE_UUID = mysqlerr84.ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_VIEW_CHANGE_UUID
+const ER_GRP_RPL_VIEW_CHANGE_UUID_FAIL_GET_VARIABLE = mysqlerr84.ER_GRP_RPL_VIEW_CHANGE_UUID_FAIL_GET_VARIABLE
+const ER_WARN_ADUIT_LOG_MAX_SIZE_AND_PRUNE_SECONDS = mysqlerr84.ER_WARN_ADUIT_LOG_MAX_SIZE_AND_PRUNE_SECONDS
+const ER_WARN_ADUIT_LOG_MAX_SIZE_CLOSE_TO_ROTATE_ON_SIZE = mysqlerr84.ER_WARN_ADUIT_LOG_MAX_SIZE_CLOSE_TO_ROTATE_ON_SIZE
+const ER_KERBEROS_CREATE_USER = mysqlerr84.ER_KERBEROS_CREATE_USER
+const ER_INSTALL_PLUGIN_CONFLICT_CLIENT = mysqlerr84.ER_INSTALL_PLUGIN_CONFLICT_CLIENT
+const ER_DA_ERROR_LOG_COMPONENT_FLUSH_FAILED = mysqlerr84.ER_DA_ERROR_LOG_COMPONENT_FLUSH_FAILED
+const ER_WARN_SQL_AFTER_MTS_GAPS_GAP_NOT_CALCULATED = mysqlerr84.ER_WARN_SQL_AFTER_MTS_GAPS_GAP_NOT_CALCULATED
+const ER_INVALID_ASSIGNMENT_TARGET = mysqlerr84.ER_INVALID_ASSIGNMENT_TARGET
+const ER_OPERATION_NOT_ALLOWED_ON_GR_SECONDARY = mysqlerr84.ER_OPERATION_NOT_ALLOWED_ON_GR_SECONDARY
+const ER_GRP_RPL_FAILOVER_CHANNEL_STATUS_PROPAGATION = mysqlerr84.ER_GRP_RPL_FAILOVER_CHANNEL_STATUS_PROPAGATION
+const ER_WARN_AUDIT_LOG_FORMAT_UNIX_TIMESTAMP_ONLY_WHEN_JSON = mysqlerr84.ER_WARN_AUDIT_LOG_FORMAT_UNIX_TIMESTAMP_ONLY_WHEN_JSON
+const ER_INVALID_MFA_PLUGIN_SPECIFIED = mysqlerr84.ER_INVALID_MFA_PLUGIN_SPECIFIED
+const ER_IDENTIFIED_BY_UNSUPPORTED = mysqlerr84.ER_IDENTIFIED_BY_UNSUPPORTED
+const ER_INVALID_PLUGIN_FOR_REGISTRATION = mysqlerr84.ER_INVALID_PLUGIN_FOR_REGISTRATION
+const ER_PLUGIN_REQUIRES_REGISTRATION = mysqlerr84.ER_PLUGIN_REQUIRES_REGISTRATION
+const ER_MFA_METHOD_EXISTS = mysqlerr84.ER_MFA_METHOD_EXISTS
+const ER_MFA_METHOD_NOT_EXISTS = mysqlerr84.ER_MFA_METHOD_NOT_EXISTS
+const ER_AUTHENTICATION_POLICY_MISMATCH = mysqlerr84.ER_AUTHENTICATION_POLICY_MISMATCH
+const ER_PLUGIN_REGISTRATION_DONE = mysqlerr84.ER_PLUGIN_REGISTRATION_DONE
+const ER_INVALID_USER_FOR_REGISTRATION = mysqlerr84.ER_INVALID_USER_FOR_REGISTRATION
+const ER_USER_REGISTRATION_FAILED = mysqlerr84.ER_USER_REGISTRATION_FAILED
+const ER_MFA_METHODS_INVALID_ORDER = mysqlerr84.ER_MFA_METHODS_INVALID_ORDER
+const ER_MFA_METHODS_IDENTICAL = mysqlerr84.ER_MFA_METHODS_IDENTICAL
+const ER_INVALID_MFA_OPERATIONS_FOR_PASSWORDLESS_USER = mysqlerr84.ER_INVALID_MFA_OPERATIONS_FOR_PASSWORDLESS_USER
+const ER_CHANGE_REPLICATION_SOURCE_NO_OPTIONS_FOR_GTID_ONLY = mysqlerr84.ER_CHANGE_REPLICATION_SOURCE_NO_OPTIONS_FOR_GTID_ONLY
+const ER_CHANGE_REP_SOURCE_CANT_DISABLE_REQ_ROW_FORMAT_WITH_GTID_ONLY = mysqlerr84.ER_CHANGE_REP_SOURCE_CANT_DISABLE_REQ_ROW_FORMAT_WITH_GTID_ONLY
+const ER_CHANGE_REP_SOURCE_CANT_DISABLE_AUTO_POSITION_WITH_GTID_ONLY = mysqlerr84.ER_CHANGE_REP_SOURCE_CANT_DISABLE_AUTO_POSITION_WITH_GTID_ONLY
+const ER_CHANGE_REP_SOURCE_CANT_DISABLE_GTID_ONLY_WITHOUT_POSITIONS = mysqlerr84.ER_CHANGE_REP_SOURCE_CANT_DISABLE_GTID_ONLY_WITHOUT_POSITIONS
+const ER_CHANGE_REP_SOURCE_CANT_DISABLE_AUTO_POS_WITHOUT_POSITIONS = mysqlerr84.ER_CHANGE_REP_SOURCE_CANT_DISABLE_AUTO_POS_WITHOUT_POSITIONS
+const ER_CHANGE_REP_SOURCE_GR_CHANNEL_WITH_GTID_MODE_NOT_ON = mysqlerr84.ER_CHANGE_REP_SOURCE_GR_CHANNEL_WITH_GTID_MODE_NOT_ON
+const ER_CANT_USE_GTID_ONLY_WITH_GTID_MODE_NOT_ON = mysqlerr84.ER_CANT_USE_GTID_ONLY_WITH_GTID_MODE_NOT_ON
+const ER_WARN_C_DISABLE_GTID_ONLY_WITH_SOURCE_AUTO_POS_INVALID_POS = mysqlerr84.ER_WARN_C_DISABLE_GTID_ONLY_WITH_SOURCE_AUTO_POS_INVALID_POS
+const ER_DA_SSL_FIPS_MODE_ERROR = mysqlerr84.ER_DA_SSL_FIPS_MODE_ERROR
+const ER_VALUE_OUT_OF_RANGE = mysqlerr84.ER_VALUE_OUT_OF_RANGE
+const ER_FULLTEXT_WITH_ROLLUP = mysqlerr84.ER_FULLTEXT_WITH_ROLLUP
+const ER_REGEXP_MISSING_RESOURCE = mysqlerr84.ER_REGEXP_MISSING_RESOURCE
+const ER_WARN_REGEXP_USING_DEFAULT = mysqlerr84.ER_WARN_REGEXP_USING_DEFAULT
+const ER_REGEXP_MISSING_FILE = mysqlerr84.ER_REGEXP_MISSING_FILE
+const ER_WARN_DEPRECATED_COLLATION = mysqlerr84.ER_WARN_DEPRECATED_COLLATION
+const ER_CONCURRENT_PROCEDURE_USAGE = mysqlerr84.ER_CONCURRENT_PROCEDURE_USAGE
+const ER_DA_GLOBAL_CONN_LIMIT = mysqlerr84.ER_DA_GLOBAL_CONN_LIMIT
+const ER_DA_CONN_LIMIT = mysqlerr84.ER_DA_CONN_LIMIT
+const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COLUMN_TYPE_INSTANT = mysqlerr84.ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COLUMN_TYPE_INSTANT
+const ER_WARN_SF_UDF_NAME_COLLISION = mysqlerr84.ER_WARN_SF_UDF_NAME_COLLISION
+const ER_CANNOT_PURGE_BINLOG_WITH_BACKUP_LOCK = mysqlerr84.ER_CANNOT_PURGE_BINLOG_WITH_BACKUP_LOCK
+const ER_TOO_MANY_WINDOWS = mysqlerr84.ER_TOO_MANY_WINDOWS
+const ER_MYSQLBACKUP_CLIENT_MSG = mysqlerr84.ER_MYSQLBACKUP_CLIENT_MSG
+const ER_COMMENT_CONTAINS_INVALID_STRING = mysqlerr84.ER_COMMENT_CONTAINS_INVALID_STRING
+const ER_DEFINITION_CONTAINS_INVALID_STRING = mysqlerr84.ER_DEFINITION_CONTAINS_INVALID_STRING
+const ER_CANT_EXECUTE_COMMAND_WITH_ASSIGNED_GTID_NEXT = mysqlerr84.ER_CANT_EXECUTE_COMMAND_WITH_ASSIGNED_GTID_NEXT
+const ER_XA_TEMP_TABLE = mysqlerr84.ER_XA_TEMP_TABLE
+const ER_INNODB_MAX_ROW_VERSION = mysqlerr84.ER_INNODB_MAX_ROW_VERSION
+
+// Deprecated: should not be used
+const ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_SIZE = mysqlerr84.ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_SIZE
+const OBSOLETE_ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_SIZE = mysqlerr84.OBSOLETE_ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_SIZE
+const ER_OPERATION_NOT_ALLOWED_WHILE_PRIMARY_CHANGE_IS_RUNNING = mysqlerr84.ER_OPERATION_NOT_ALLOWED_WHILE_PRIMARY_CHANGE_IS_RUNNING
+const ER_WARN_DEPRECATED_DATETIME_DELIMITER = mysqlerr84.ER_WARN_DEPRECATED_DATETIME_DELIMITER
+const ER_WARN_DEPRECATED_SUPERFLUOUS_DELIMITER = mysqlerr84.ER_WARN_DEPRECATED_SUPERFLUOUS_DELIMITER
+const ER_CANNOT_PERSIST_SENSITIVE_VARIABLES = mysqlerr84.ER_CANNOT_PERSIST_SENSITIVE_VARIABLES
+const ER_WARN_CANNOT_SECURELY_PERSIST_SENSITIVE_VARIABLES = mysqlerr84.ER_WARN_CANNOT_SECURELY_PERSIST_SENSITIVE_VARIABLES
+const ER_WARN_TRG_ALREADY_EXISTS = mysqlerr84.ER_WARN_TRG_ALREADY_EXISTS
+const ER_IF_NOT_EXISTS_UNSUPPORTED_TRG_EXISTS_ON_DIFFERENT_TABLE = mysqlerr84.ER_IF_NOT_EXISTS_UNSUPPORTED_TRG_EXISTS_ON_DIFFERENT_TABLE
+const ER_IF_NOT_EXISTS_UNSUPPORTED_UDF_NATIVE_FCT_NAME_COLLISION = mysqlerr84.ER_IF_NOT_EXISTS_UNSUPPORTED_UDF_NATIVE_FCT_NAME_COLLISION
+const ER_SET_PASSWORD_AUTH_PLUGIN_ERROR = mysqlerr84.ER_SET_PASSWORD_AUTH_PLUGIN_ERROR
+
+// Deprecated: should not be used
+const ER_REDUCED_DBLWR_FILE_CORRUPTED = mysqlerr84.ER_REDUCED_DBLWR_FILE_CORRUPTED
+const OBSOLETE_ER_REDUCED_DBLWR_FILE_CORRUPTED = mysqlerr84.OBSOLETE_ER_REDUCED_DBLWR_FILE_CORRUPTED
+
+// Deprecated: should not be used
+const ER_REDUCED_DBLWR_PAGE_FOUND = mysqlerr84.ER_REDUCED_DBLWR_PAGE_FOUND
+const OBSOLETE_ER_REDUCED_DBLWR_PAGE_FOUND = mysqlerr84.OBSOLETE_ER_REDUCED_DBLWR_PAGE_FOUND
+const ER_SRS_INVALID_LATITUDE_OF_ORIGIN = mysqlerr84.ER_SRS_INVALID_LATITUDE_OF_ORIGIN
+const ER_SRS_INVALID_LONGITUDE_OF_ORIGIN = mysqlerr84.ER_SRS_INVALID_LONGITUDE_OF_ORIGIN
+const ER_SRS_UNUSED_PROJ_PARAMETER_PRESENT = mysqlerr84.ER_SRS_UNUSED_PROJ_PARAMETER_PRESENT
+const ER_GIPK_COLUMN_EXISTS = mysqlerr84.ER_GIPK_COLUMN_EXISTS
+const ER_GIPK_FAILED_AUTOINC_COLUMN_EXISTS = mysqlerr84.ER_GIPK_FAILED_AUTOINC_COLUMN_EXISTS
+const ER_GIPK_COLUMN_ALTER_NOT_ALLOWED = mysqlerr84.ER_GIPK_COLUMN_ALTER_NOT_ALLOWED
+const ER_DROP_PK_COLUMN_TO_DROP_GIPK = mysqlerr84.ER_DROP_PK_COLUMN_TO_DROP_GIPK
+const ER_CREATE_SELECT_WITH_GIPK_DISALLOWED_IN_SBR = mysqlerr84.ER_CREATE_SELECT_WITH_GIPK_DISALLOWED_IN_SBR
+
+// Deprecated: should not be used
+const ER_DA_EXPIRE_LOGS_DAYS_IGNORED = mysqlerr84.ER_DA_EXPIRE_LOGS_DAYS_IGNORED
+const OBSOLETE_ER_DA_EXPIRE_LOGS_DAYS_IGNORED = mysqlerr84.OBSOLETE_ER_DA_EXPIRE_LOGS_DAYS_IGNORED
+const ER_CTE_RECURSIVE_NOT_UNION = mysqlerr84.ER_CTE_RECURSIVE_NOT_UNION
+const ER_COMMAND_BACKEND_FAILED_TO_FETCH_SECURITY_CTX = mysqlerr84.ER_COMMAND_BACKEND_FAILED_TO_FETCH_SECURITY_CTX
+const ER_COMMAND_SERVICE_BACKEND_FAILED = mysqlerr84.ER_COMMAND_SERVICE_BACKEND_FAILED
+const ER_CLIENT_FILE_PRIVILEGE_FOR_REPLICATION_CHECKS = mysqlerr84.ER_CLIENT_FILE_PRIVILEGE_FOR_REPLICATION_CHECKS
+const ER_GROUP_REPLICATION_FORCE_MEMBERS_COMMAND_FAILURE = mysqlerr84.ER_GROUP_REPLICATION_FORCE_MEMBERS_COMMAND_FAILURE
+const ER_WARN_DEPRECATED_IDENT = mysqlerr84.ER_WARN_DEPRECATED_IDENT
+const ER_INTERSECT_ALL_MAX_DUPLICATES_EXCEEDED = mysqlerr84.ER_INTERSECT_ALL_MAX_DUPLICATES_EXCEEDED
+const ER_TP_QUERY_THRS_PER_GRP_EXCEEDS_TXN_THR_LIMIT = mysqlerr84.ER_TP_QUERY_THRS_PER_GRP_EXCEEDS_TXN_THR_LIMIT
+const ER_BAD_TIMESTAMP_FORMAT = mysqlerr84.ER_BAD_TIMESTAMP_FORMAT
+const ER_SHAPE_PRIDICTION_UDF = mysqlerr84.ER_SHAPE_PRIDICTION_UDF
+const ER_SRS_INVALID_HEIGHT = mysqlerr84.ER_SRS_INVALID_HEIGHT
+const ER_SRS_INVALID_SCALING = mysqlerr84.ER_SRS_INVALID_SCALING
+const ER_SRS_INVALID_ZONE_WIDTH = mysqlerr84.ER_SRS_INVALID_ZONE_WIDTH
+const ER_SRS_INVALID_LATITUDE_POLAR_STERE_VAR_A = mysqlerr84.ER_SRS_INVALID_LATITUDE_POLAR_STERE_VAR_A
+const ER_WARN_DEPRECATED_CLIENT_NO_SCHEMA_OPTION = mysqlerr84.ER_WARN_DEPRECATED_CLIENT_NO_SCHEMA_OPTION
+const ER_TABLE_NOT_EMPTY = mysqlerr84.ER_TABLE_NOT_EMPTY
+const ER_TABLE_NO_PRIMARY_KEY = mysqlerr84.ER_TABLE_NO_PRIMARY_KEY
+const ER_TABLE_IN_SHARED_TABLESPACE = mysqlerr84.ER_TABLE_IN_SHARED_TABLESPACE
+const ER_INDEX_OTHER_THAN_PK = mysqlerr84.ER_INDEX_OTHER_THAN_PK
+const ER_LOAD_BULK_DATA_UNSORTED = mysqlerr84.ER_LOAD_BULK_DATA_UNSORTED
+const ER_BULK_EXECUTOR_ERROR = mysqlerr84.ER_BULK_EXECUTOR_ERROR
+const ER_BULK_READER_LIBCURL_INIT_FAILED = mysqlerr84.ER_BULK_READER_LIBCURL_INIT_FAILED
+const ER_BULK_READER_LIBCURL_ERROR = mysqlerr84.ER_BULK_READER_LIBCURL_ERROR
+const ER_BULK_READER_SERVER_ERROR = mysqlerr84.ER_BULK_READER_SERVER_ERROR
+const ER_BULK_READER_COMMUNICATION_ERROR = mysqlerr84.ER_BULK_READER_COMMUNICATION_ERROR
+const ER_BULK_LOAD_DATA_FAILED = mysqlerr84.ER_BULK_LOAD_DATA_FAILED
+const ER_BULK_LOADER_COLUMN_TOO_BIG_FOR_LEFTOVER_BUFFER = mysqlerr84.ER_BULK_LOADER_COLUMN_TOO_BIG_FOR_LEFTOVER_BUFFER
+const ER_BULK_LOADER_COMPONENT_ERROR = mysqlerr84.ER_BULK_LOADER_COMPONENT_ERROR
+const ER_BULK_LOADER_FILE_CONTAINS_LESS_LINES_THAN_IGNORE_CLAUSE = mysqlerr84.ER_BULK_LOADER_FILE_CONTAINS_LESS_LINES_THAN_IGNORE_CLAUSE
+const ER_BULK_PARSER_MISSING_ENCLOSED_BY = mysqlerr84.ER_BULK_PARSER_MISSING_ENCLOSED_BY
+const ER_BULK_PARSER_ROW_BUFFER_MAX_TOTAL_COLS_EXCEEDED = mysqlerr84.ER_BULK_PARSER_ROW_BUFFER_MAX_TOTAL_COLS_EXCEEDED
+const ER_BULK_PARSER_COPY_BUFFER_SIZE_EXCEEDED = mysqlerr84.ER_BULK_PARSER_COPY_BUFFER_SIZE_EXCEEDED
+const ER_BULK_PARSER_UNEXPECTED_END_OF_INPUT = mysqlerr84.ER_BULK_PARSER_UNEXPECTED_END_OF_INPUT
+const ER_BULK_PARSER_UNEXPECTED_ROW_TERMINATOR = mysqlerr84.ER_BULK_PARSER_UNEXPECTED_ROW_TERMINATOR
+const ER_BULK_PARSER_UNEXPECTED_CHAR_AFTER_ENDING_ENCLOSED_BY = mysqlerr84.ER_BULK_PARSER_UNEXPECTED_CHAR_AFTER_ENDING_ENCLOSED_BY
+const ER_BULK_PARSER_UNEXPECTED_CHAR_AFTER_NULL_ESCAPE = mysqlerr84.ER_BULK_PARSER_UNEXPECTED_CHAR_AFTER_NULL_ESCAPE
+const ER_BULK_PARSER_UNEXPECTED_CHAR_AFTER_COLUMN_TERMINATOR = mysqlerr84.ER_BULK_PARSER_UNEXPECTED_CHAR_AFTER_COLUMN_TERMINATOR
+const ER_BULK_PARSER_INCOMPLETE_ESCAPE_SEQUENCE = mysqlerr84.ER_BULK_PARSER_INCOMPLETE_ESCAPE_SEQUENCE
+const ER_LOAD_BULK_DATA_FAILED = mysqlerr84.ER_LOAD_BULK_DATA_FAILED
+const ER_LOAD_BULK_DATA_WRONG_VALUE_FOR_FIELD = mysqlerr84.ER_LOAD_BULK_DATA_WRONG_VALUE_FOR_FIELD
+const ER_LOAD_BULK_DATA_WARN_NULL_TO_NOTNULL = mysqlerr84.ER_LOAD_BULK_DATA_WARN_NULL_TO_NOTNULL
+const ER_REQUIRE_TABLE_PRIMARY_KEY_CHECK_GENERATE_WITH_GR = mysqlerr84.ER_REQUIRE_TABLE_PRIMARY_KEY_CHECK_GENERATE_WITH_GR
+const ER_CANT_CHANGE_SYS_VAR_IN_READ_ONLY_MODE = mysqlerr84.ER_CANT_CHANGE_SYS_VAR_IN_READ_ONLY_MODE
+const ER_INNODB_INSTANT_ADD_DROP_NOT_SUPPORTED_MAX_SIZE = mysqlerr84.ER_INNODB_INSTANT_ADD_DROP_NOT_SUPPORTED_MAX_SIZE
+const ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_FIELDS = mysqlerr84.ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_FIELDS
+const ER_CANT_SET_PERSISTED = mysqlerr84.ER_CANT_SET_PERSISTED
+const ER_INSTALL_COMPONENT_SET_NULL_VALUE = mysqlerr84.ER_INSTALL_COMPONENT_SET_NULL_VALUE
+const ER_INSTALL_COMPONENT_SET_UNUSED_VALUE = mysqlerr84.ER_INSTALL_COMPONENT_SET_UNUSED_VALUE
+const ER_WARN_DEPRECATED_USER_DEFINED_COLLATIONS = mysqlerr84.ER_WARN_DEPRECATED_USER_DEFINED_COLLATIONS
+const ER_USER_LOCK_OVERLONG_NAME = mysqlerr84.ER_USER_LOCK_OVERLONG_NAME
+const ER_WARN_NO_SPACE_VERSION_COMMENT = mysqlerr84.ER_WARN_NO_SPACE_VERSION_COMMENT
+const ER_VALIDATE_PASSWORD_INSUFFICIENT_CHANGED_CHARACTERS = mysqlerr84.ER_VALIDATE_PASSWORD_INSUFFICIENT_CHANGED_CHARACTERS
+const ER_WARN_DEPRECATED_WITH_NOTE = mysqlerr84.ER_WARN_DEPRECATED_WITH_NOTE
+const ER_LANGUAGE_COMPONENT = mysqlerr84.ER_LANGUAGE_COMPONENT
+const ER_LANGUAGE_COMPONENT_NOT_AVAILABLE = mysqlerr84.ER_LANGUAGE_COMPONENT_NOT_AVAILABLE
+const ER_LANGUAGE_COMPONENT_UNSUPPORTED_LANGUAGE = mysqlerr84.ER_LANGUAGE_COMPONENT_UNSUPPORTED_LANGUAGE
+const ER_LANGUAGE_COMPONENT_CANNOT_UNINSTALL = mysqlerr84.ER_LANGUAGE_COMPONENT_CANNOT_UNINSTALL
+const ER_SP_NO_ALTER_LANGUAGE = mysqlerr84.ER_SP_NO_ALTER_LANGUAGE
+const ER_EXPLAIN_INTO_ANALYZE_NOT_SUPPORTED = mysqlerr84.ER_EXPLAIN_INTO_ANALYZE_NOT_SUPPORTED
+const ER_EXPLAIN_INTO_IMPLICIT_FORMAT_NOT_SUPPORTED = mysqlerr84.ER_EXPLAIN_INTO_IMPLICIT_FORMAT_NOT_SUPPORTED
+const ER_EXPLAIN_INTO_FORMAT_NOT_SUPPORTED = mysqlerr84.ER_EXPLAIN_INTO_FORMAT_NOT_SUPPORTED
+const ER_NULL_CANT_BE_PERSISTED_FOR_READONLY = mysqlerr84.ER_NULL_CANT_BE_PERSISTED_FOR_READONLY
+const ER_EXPLAIN_INTO_FOR_CONNECTION_NOT_SUPPORTED = mysqlerr84.ER_EXPLAIN_INTO_FOR_CONNECTION_NOT_SUPPORTED
+const ER_INNODB_IMPORT_WRONG_DROPPED_ENUM_LENGTH = mysqlerr84.ER_INNODB_IMPORT_WRONG_DROPPED_ENUM_LENGTH
+const ER_INNODB_IMPORT_WRONG_NUMBER_OF_INDEXES_ZERO = mysqlerr84.ER_INNODB_IMPORT_WRONG_NUMBER_OF_INDEXES_ZERO
+const ER_INNODB_IMPORT_WRONG_NUMBER_OF_INDEXES_TOO_HIGH = mysqlerr84.ER_INNODB_IMPORT_WRONG_NUMBER_OF_INDEXES_TOO_HIGH
+const ER_INNODB_IMPORT_DROP_COL_METADATA_MISMATCH = mysqlerr84.ER_INNODB_IMPORT_DROP_COL_METADATA_MISMATCH
+const ER_INNODB_IMPORT_ENUM_NULL_TERMINATOR_MISSING = mysqlerr84.ER_INNODB_IMPORT_ENUM_NULL_TERMINATOR_MISSING
+const ER_SIMULATED_INJECTION_ERROR = mysqlerr84.ER_SIMULATED_INJECTION_ERROR
+const ER_WARN_DEPRECATED_DYNAMIC_PRIV_IN_GRANT = mysqlerr84.ER_WARN_DEPRECATED_DYNAMIC_PRIV_IN_GRANT
+const ER_BULK_MULTI_READER_OPEN_FILE_FAILED = mysqlerr84.ER_BULK_MULTI_READER_OPEN_FILE_FAILED
+const ER_BULK_MULTI_READER_READ_FILE_FAILED = mysqlerr84.ER_BULK_MULTI_READER_READ_FILE_FAILED
+const ER_BULK_MERGE_INVALID_CHUNK = mysqlerr84.ER_BULK_MERGE_INVALID_CHUNK
+const ER_BULK_MERGE_NOT_ALL_CHUNKS_CONSUMED = mysqlerr84.ER_BULK_MERGE_NOT_ALL_CHUNKS_CONSUMED
+const ER_BULK_WRITER_LIBCURL_INIT_FAILED = mysqlerr84.ER_BULK_WRITER_LIBCURL_INIT_FAILED
+const ER_BULK_WRITER_LIBCURL_ERROR = mysqlerr84.ER_BULK_WRITER_LIBCURL_ERROR
+const ER_BULK_SORTING_LOADER_WRITE = mysqlerr84.ER_BULK_SORTING_LOADER_WRITE
+const ER_BULK_SORTING_LOADER_WAIT = mysqlerr84.ER_BULK_SORTING_LOADER_WAIT
+const ER_BULK_READER_OPEN_FILE_FAILED = mysqlerr84.ER_BULK_READER_OPEN_FILE_FAILED
+const ER_BULK_LOAD_TABLE_HAS_INSTANT_COLS = mysqlerr84.ER_BULK_LOAD_TABLE_HAS_INSTANT_COLS
+const ER_BULK_LOAD_RESOURCE = mysqlerr84.ER_BULK_LOAD_RESOURCE
+const ER_BULK_LOAD_SECONDARY_ENGINE = mysqlerr84.ER_BULK_LOAD_SECONDARY_ENGINE
+const ER_BULK_READER_ERROR = mysqlerr84.ER_BULK_READER_ERROR
+const ER_BULK_READER_FILE_DOESNT_EXIST = mysqlerr84.ER_BULK_READER_FILE_DOESNT_EXIST
+const ER_BULK_READER_COULDNT_RESOLVE_HOST = mysqlerr84.ER_BULK_READER_COULDNT_RESOLVE_HOST
+const ER_START_REPLICA_CHANNEL_INVALID_CONFIGURATION = mysqlerr84.ER_START_REPLICA_CHANNEL_INVALID_CONFIGURATION
+const ER_CANNOT_EXECUTE_IN_PRIMARY = mysqlerr84.ER_CANNOT_EXECUTE_IN_PRIMARY
+const ER_TOO_MANY_GROUP_BY_MODIFIER_BRANCHES = mysqlerr84.ER_TOO_MANY_GROUP_BY_MODIFIER_BRANCHES
+const ER_WARN_DEPRECATED_ENGINE_SYNTAX_NO_REPLACEMENT = mysqlerr84.ER_WARN_DEPRECATED_ENGINE_SYNTAX_NO_REPLACEMENT
+const ER_QUALIFY_WITHOUT_WINDOW_FUNCTION = mysqlerr84.ER_QUALIFY_WITHOUT_WINDOW_FUNCTION
+const ER_SUPPORTED_ONLY_WITH_HYPERGRAPH = mysqlerr84.ER_SUPPORTED_ONLY_WITH_HYPERGRAPH
+const ER_SPECIFIC_ACCESS_DENIED = mysqlerr84.ER_SPECIFIC_ACCESS_DENIED
+const ER_CANT_SET_GTID_NEXT_TO_AUTOMATIC_TAGGED_WHEN_GTID_MODE_IS_OFF = mysqlerr84.ER_CANT_SET_GTID_NEXT_TO_AUTOMATIC_TAGGED_WHEN_GTID_MODE_IS_OFF
+const ER_GTID_NEXT_TAG_GTID_MODE_OFF = mysqlerr84.ER_GTID_NEXT_TAG_GTID_MODE_OFF
+const ER_LH_COL_NOT_NULLABLE = mysqlerr84.ER_LH_COL_NOT_NULLABLE
+const ER_LH_WARN_COL_MISSING_NOT_NULLABLE = mysqlerr84.ER_LH_WARN_COL_MISSING_NOT_NULLABLE
+const ER_LH_COL_IS_EMPTY = mysqlerr84.ER_LH_COL_IS_EMPTY
+const ER_LH_COL_IS_EMPTY_WARN = mysqlerr84.ER_LH_COL_IS_EMPTY_WARN
+const ER_LH_BAD_VALUE = mysqlerr84.ER_LH_BAD_VALUE
+const ER_LH_DECIMAL_UNKNOWN_ERR = mysqlerr84.ER_LH_DECIMAL_UNKNOWN_ERR
+const ER_LH_DECIMAL_OOM_ERR = mysqlerr84.ER_LH_DECIMAL_OOM_ERR
+const ER_LH_WARN_DECIMAL_ROUNDING = mysqlerr84.ER_LH_WARN_DECIMAL_ROUNDING
+const ER_LH_DECIMAL_PRECISION_EXCEEDS_SCHEMA = mysqlerr84.ER_LH_DECIMAL_PRECISION_EXCEEDS_SCHEMA
+const ER_LH_EXCEEDS_MIN = mysqlerr84.ER_LH_EXCEEDS_MIN
+const ER_LH_EXCEEDS_MAX = mysqlerr84.ER_LH_EXCEEDS_MAX
+const ER_LH_WARN_EXCEEDS_MIN_TRUNCATING = mysqlerr84.ER_LH_WARN_EXCEEDS_MIN_TRUNCATING
+const ER_LH_WARN_EXCEEDS_MAX_TRUNCATING = mysqlerr84.ER_LH_WARN_EXCEEDS_MAX_TRUNCATING
+const ER_LH_REAL_IS_NAN = mysqlerr84.ER_LH_REAL_IS_NAN
+const ER_LH_OUT_OF_RANGE = mysqlerr84.ER_LH_OUT_OF_RANGE
+const ER_LH_DATETIME_FORMAT = mysqlerr84.ER_LH_DATETIME_FORMAT
+const ER_LH_WARN_TRUNCATED = mysqlerr84.ER_LH_WARN_TRUNCATED
+const ER_LH_CANNOT_CONVERT_STRING = mysqlerr84.ER_LH_CANNOT_CONVERT_STRING
+const ER_LH_RESOURCE_PRINCIPAL_ERR = mysqlerr84.ER_LH_RESOURCE_PRINCIPAL_ERR
+const ER_LH_AWS_AUTH_ERR = mysqlerr84.ER_LH_AWS_AUTH_ERR
+const ER_LH_CSV_PARSING_ERR = mysqlerr84.ER_LH_CSV_PARSING_ERR
+const ER_LH_COLUMN_MISMATCH_ERR = mysqlerr84.ER_LH_COLUMN_MISMATCH_ERR
+const ER_LH_COLUMN_MAX_ERR = mysqlerr84.ER_LH_COLUMN_MAX_ERR
+const ER_LH_CHARSET_UNSUPPORTED = mysqlerr84.ER_LH_CHARSET_UNSUPPORTED
+const ER_LH_PARQUET_DECIMAL_CONVERSION_ERR = mysqlerr84.ER_LH_PARQUET_DECIMAL_CONVERSION_ERR
+const ER_LH_STRING_TOO_LONG = mysqlerr84.ER_LH_STRING_TOO_LONG
+const ER_LH_RESOURCE_PRINCIPAL_BUCKET_ERR = mysqlerr84.ER_LH_RESOURCE_PRINCIPAL_BUCKET_ERR
+const ER_LH_NO_FILES_FOUND = mysqlerr84.ER_LH_NO_FILES_FOUND
+const ER_LH_EMPTY_FILE = mysqlerr84.ER_LH_EMPTY_FILE
+const ER_LH_DUPLICATE_FILE = mysqlerr84.ER_LH_DUPLICATE_FILE
+const ER_LH_AVRO_SCHEMA_DEPTH_EXCEEDS_MAX = mysqlerr84.ER_LH_AVRO_SCHEMA_DEPTH_EXCEEDS_MAX
+const ER_LH_AVRO_HEADER_MISMATCH = mysqlerr84.ER_LH_AVRO_HEADER_MISMATCH
+const ER_LH_AVRO_ENUM_CANNOT_CONVERT_CHARSET = mysqlerr84.ER_LH_AVRO_ENUM_CANNOT_CONVERT_CHARSET
+const ER_LH_AVRO_ENUM_MISMATCH = mysqlerr84.ER_LH_AVRO_ENUM_MISMATCH
+const ER_LH_AVRO_TYPE_CANNOT_CONVERT = mysqlerr84.ER_LH_AVRO_TYPE_CANNOT_CONVERT
+const ER_LH_AVRO_FILE_ENDS_UNEXPECTEDLY = mysqlerr84.ER_LH_AVRO_FILE_ENDS_UNEXPECTEDLY
+const ER_LH_AVRO_FILE_DATA_CORRUPT = mysqlerr84.ER_LH_AVRO_FILE_DATA_CORRUPT
+const ER_LH_AVRO_INVALID_UNION = mysqlerr84.ER_LH_AVRO_INVALID_UNION
+const ER_LH_AVRO_INVALID_BLOCK_SIZE = mysqlerr84.ER_LH_AVRO_INVALID_BLOCK_SIZE
+const ER_LH_AVRO_INVALID_BLOCK_RECORD_COUNT = mysqlerr84.ER_LH_AVRO_INVALID_BLOCK_RECORD_COUNT
+const ER_LH_FORMAT_HEADER_NO_MAGIC_BYTES = mysqlerr84.ER_LH_FORMAT_HEADER_NO_MAGIC_BYTES
+const ER_LH_AVRO_HEADER_METADATA_ERR = mysqlerr84.ER_LH_AVRO_HEADER_METADATA_ERR
+const ER_LH_AVRO_HEADER_NO_SCHEMA = mysqlerr84.ER_LH_AVRO_HEADER_NO_SCHEMA
+const ER_LH_AVRO_NO_CODEC_IN_HEADER = mysqlerr84.ER_LH_AVRO_NO_CODEC_IN_HEADER
+const ER_LH_AVRO_INVALID_NAME_IN_SCHEMA = mysqlerr84.ER_LH_AVRO_INVALID_NAME_IN_SCHEMA
+const ER_LH_AVRO_DECODING_ERR = mysqlerr84.ER_LH_AVRO_DECODING_ERR
+const ER_LH_PARQUET_NON_UTF8_FILE_ENC = mysqlerr84.ER_LH_PARQUET_NON_UTF8_FILE_ENC
+const ER_LH_PARQUET_SCHEMA_MISMATCH = mysqlerr84.ER_LH_PARQUET_SCHEMA_MISMATCH
+const ER_LH_PARQUET_ROW_GROUP_SIZE_EXCEEDS_MAX = mysqlerr84.ER_LH_PARQUET_ROW_GROUP_SIZE_EXCEEDS_MAX
+const ER_LH_PARQUET_CANNOT_LOCATE_OFFSET = mysqlerr84.ER_LH_PARQUET_CANNOT_LOCATE_OFFSET
+const ER_LH_PARQUET_TYPE_CANNOT_CONVERT = mysqlerr84.ER_LH_PARQUET_TYPE_CANNOT_CONVERT
+const ER_LH_PARQUET_CANNOT_LOCATE_SCHEMA = mysqlerr84.ER_LH_PARQUET_CANNOT_LOCATE_SCHEMA
+const ER_LH_INFER_SCHEMA_MISMATCH = mysqlerr84.ER_LH_INFER_SCHEMA_MISMATCH
+const ER_LH_OOM = mysqlerr84.ER_LH_OOM
+const ER_LH_WARN_INFER_SKIPPED_LINES = mysqlerr84.ER_LH_WARN_INFER_SKIPPED_LINES
+const ER_LH_WARN_INFER_SKIPPED_FILES = mysqlerr84.ER_LH_WARN_INFER_SKIPPED_FILES
+const ER_LH_INFER_FILE_HAS_NO_DATA = mysqlerr84.ER_LH_INFER_FILE_HAS_NO_DATA
+const ER_LH_INFER_NO_DATA = mysqlerr84.ER_LH_INFER_NO_DATA
+const ER_LH_INFER_NO_FILES = mysqlerr84.ER_LH_INFER_NO_FILES
+const ER_LH_WARN_INFER_USE_DEFAULT_COL_NAMES = mysqlerr84.ER_LH_WARN_INFER_USE_DEFAULT_COL_NAMES
+const ER_LH_PARQUET_CANNOT_READ_HEADER = mysqlerr84.ER_LH_PARQUET_CANNOT_READ_HEADER
+const ER_LH_INFER_WARN_GOT_EXCEPTION = mysqlerr84.ER_LH_INFER_WARN_GOT_EXCEPTION
+const ER_LH_AVRO_CANNOT_PARSE_HEADER = mysqlerr84.ER_LH_AVRO_CANNOT_PARSE_HEADER
+const ER_LH_PARQUET_CANT_OPEN_FILE = mysqlerr84.ER_LH_PARQUET_CANT_OPEN_FILE
+const ER_LH_TOO_LARGE_VALUE_ERR = mysqlerr84.ER_LH_TOO_LARGE_VALUE_ERR
+const ER_LH_TOO_LARGE_ROW_ERR = mysqlerr84.ER_LH_TOO_LARGE_ROW_ERR
+const ER_TABLESAMPLE_PERCENTAGE = mysqlerr84.ER_TABLESAMPLE_PERCENTAGE
+const ER_TABLESAMPLE_ONLY_ON_BASE_TABLES = mysqlerr84.ER_TABLESAMPLE_ONLY_ON_BASE_TABLES
+const OBSOLETE_ER_PARAMETER_INDEX_OUT_OF_RANGE = mysqlerr84.OBSOLETE_ER_PARAMETER_INDEX_OUT_OF_RANGE
+const ER_RESULT_SIZE_LIMIT_EXCEEDED = mysqlerr84.ER_RESULT_SIZE_LIMIT_EXCEEDED
+const ER_LANGUAGE_COMPONENT_INTERNAL = mysqlerr84.ER_LANGUAGE_COMPONENT_INTERNAL
+const ER_LANGUAGE_COMPONENT_CONCURRENCY_LIMIT = mysqlerr84.ER_LANGUAGE_COMPONENT_CONCURRENCY_LIMIT
+const ER_LANGUAGE_COMPONENT_RUNTIME = mysqlerr84.ER_LANGUAGE_COMPONENT_RUNTIME
+const ER_LANGUAGE_COMPONENT_TIMEZONE = mysqlerr84.ER_LANGUAGE_COMPONENT_TIMEZONE
+const ER_LANGUAGE_COMPONENT_KEYWORD = mysqlerr84.ER_LANGUAGE_COMPONENT_KEYWORD
+const ER_LANGUAGE_COMPONENT_SET_SYSTEM_VARIABLE = mysqlerr84.ER_LANGUAGE_COMPONENT_SET_SYSTEM_VARIABLE
+const ER_LANGUAGE_COMPONENT_UNSUPPORTED_TYPE = mysqlerr84.ER_LANGUAGE_COMPONENT_UNSUPPORTED_TYPE
+const ER_LANGUAGE_COMPONENT_CONVERSION = mysqlerr84.ER_LANGUAGE_COMPONENT_CONVERSION
+const ER_WARN_SP_STATEMENT_PARTIALLY_EXECUTED = mysqlerr84.ER_WARN_SP_STATEMENT_PARTIALLY_EXECUTED
+const ER_STMT_EXECUTION_NOT_ALLOWED_WITHIN_SP_OR_TRG_OR_UDF = mysqlerr84.ER_STMT_EXECUTION_NOT_ALLOWED_WITHIN_SP_OR_TRG_OR_UDF
+const ER_LH_JSON_PARSING = mysqlerr84.ER_LH_JSON_PARSING
+const ER_ENGINE_CANNOT_BE_DEFAULT = mysqlerr84.ER_ENGINE_CANNOT_BE_DEFAULT
+const ER_PARTITION_PREFIX_KEY_NOT_SUPPORTED = mysqlerr84.ER_PARTITION_PREFIX_KEY_NOT_SUPPORTED
+const ER_WARN_DEPRECATED_NON_STANDARD_KEY = mysqlerr84.ER_WARN_DEPRECATED_NON_STANDARD_KEY
+const ER_FK_NO_UNIQUE_INDEX_PARENT = mysqlerr84.ER_FK_NO_UNIQUE_INDEX_PARENT
+const ER_ACCESS_DENIED_NO_PROXY_GRANT = mysqlerr84.ER_ACCESS_DENIED_NO_PROXY_GRANT
+const ER_ACCESS_DENIED_NO_PROXY = mysqlerr84.ER_ACCESS_DENIED_NO_PROXY
+const ER_LH_USER_DATA_ACCESS_FAILED = mysqlerr84.ER_LH_USER_DATA_ACCESS_FAILED
+const ER_BULK_READER_ZSTD_ERROR = mysqlerr84.ER_BULK_READER_ZSTD_ERROR
+const ER_BULK_PARSER_ERROR = mysqlerr84.ER_BULK_PARSER_ERROR
+const ER_LH_INVALID_JSON_FILE_FORMAT_SCHEMA = mysqlerr84.ER_LH_INVALID_JSON_FILE_FORMAT_SCHEMA
+const ER_LH_INFER_JSON_INVALID_SCHEMA = mysqlerr84.ER_LH_INFER_JSON_INVALID_SCHEMA
+const ER_LH_JSON_FILE_FORMAT_WARN_INFER_SCHEMA = mysqlerr84.ER_LH_JSON_FILE_FORMAT_WARN_INFER_SCHEMA
+const ER_NON_SCALAR_USED_AS_KEY = mysqlerr84.ER_NON_SCALAR_USED_AS_KEY
+const ER_INCOMPATIBLE_TYPE_AGG = mysqlerr84.ER_INCOMPATIBLE_TYPE_AGG
+const ER_DATA_INCOMPATIBLE_WITH_VECTOR = mysqlerr84.ER_DATA_INCOMPATIBLE_WITH_VECTOR
+const ER_EXCEEDS_VECTOR_MAX_DIMENSIONS = mysqlerr84.ER_EXCEEDS_VECTOR_MAX_DIMENSIONS
+const ER_TO_VECTOR_CONVERSION = mysqlerr84.ER_TO_VECTOR_CONVERSION
+const ER_EXTERNAL_UNSUPPORTED_INDEX_ALGORITHM = mysqlerr84.ER_EXTERNAL_UNSUPPORTED_INDEX_ALGORITHM
+const ER_TP_CANNOT_DISABLE_MTL_WITH_DL = mysqlerr84.ER_TP_CANNOT_DISABLE_MTL_WITH_DL
@@ -0,0 +1,59 @@
+package mysqlerr
+
+import "fmt"
+
+type customError struct {
+	name     string
+	sqlState string
+	message  string
+}
+
+var customErrors = map[int]customError{}
+
+// SignalSQLState is the SQLSTATE of an unhandled user-defined exception,
+// the one SIGNAL statements conventionally raise.
+const SignalSQLState = "45000"
+
+// RegisterCustom adds an application-defined error, such as one stored procedures raise with
+// SIGNAL SQLSTATE '45000' SET MYSQL_ERRNO = code, so that Name, Message, SQLStateOf, NewError
+// and the classification helpers know it like the errors of the server.
+// An empty sqlstate defaults to SignalSQLState, and template is a message in the format of the server's.
+// It panics if the code or the name is already known or the template does not compile.
+// It is not safe for concurrent use and is meant to be called from init functions.
+func RegisterCustom(code uint16, name, sqlstate, template string) {
+	if n, ok := Name(int(code)); ok {
+		panic(fmt.Sprintf("mysqlerr: RegisterCustom of %s: code %d is %s", name, code, n))
+	}
+	for c, e := range customErrors {
+		if e.name == name {
+			panic(fmt.Sprintf("mysqlerr: RegisterCustom of %d: name %s is %d", code, name, c))
+		}
+	}
+	for i, n := range errorNames {
+		if n == name {
+			panic(fmt.Sprintf("mysqlerr: RegisterCustom of %d: name %s is %d", code, name, errorCodes[i]))
+		}
+	}
+	if sqlstate == "" {
+		sqlstate = SignalSQLState
+	}
+	if len(sqlstate) != 5 {
+		panic(fmt.Sprintf("mysqlerr: RegisterCustom of %s: invalid SQLSTATE %q", name, sqlstate))
+	}
+	if _, err := CompileTemplate(template); err != nil {
+		panic(fmt.Sprintf("mysqlerr: RegisterCustom of %s: %v", name, err))
+	}
+	customErrors[int(code)] = customError{name: name, sqlState: sqlstate, message: template}
+}
+
+// Name returns the name of the error code, for the errors of the server and the registered custom ones.
+// For other codes, such as the new errors of a server newer than this package, it returns UnknownName(code) and false.
+func Name(code int) (string, bool) {
+	if e, ok := customErrors[code]; ok {
+		return e.name, true
+	}
+	if name, ok := catalogName(code); ok {
+		return name, true
+	}
+	return UnknownName(code), false
+}
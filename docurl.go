@@ -0,0 +1,48 @@
+package mysqlerr
+
+import (
+	"strings"
+
+	"github.com/orisano/mysqlerr/client"
+)
+
+// DocVersion is the release series of the reference manual DocURL links when no version is given,
+// the one whose error set this package aliases.
+const DocVersion = "8.4"
+
+const docBaseURL = "https://dev.mysql.com/doc/mysql-errors/"
+
+// DocURL returns the address of the error in the MySQL error reference of the release series of version,
+// such as "8.0" or "8.0.39", or of DocVersion if version is empty.
+// It returns "" for the codes the reference does not document, custom ones included.
+func DocURL(code uint16, version string) string {
+	series := DocVersion
+	if version != "" {
+		series = releaseSeries(version)
+	}
+	base := docBaseURL + series + "/en/"
+	c := int(code)
+	page := "server-error-reference.html"
+	name, ok := catalogName(c)
+	if 2000 <= c && c < 3000 {
+		page = "client-error-reference.html"
+		name, ok = client.Name(c)
+	}
+	if !ok || strings.HasPrefix(name, "OBSOLETE_") {
+		return ""
+	}
+	return base + page + "#error_" + strings.ToLower(name)
+}
+
+// releaseSeries returns the major and minor parts of a version, "8.0" for "8.0.39-log".
+func releaseSeries(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	minor := parts[1]
+	if i := strings.IndexFunc(minor, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+		minor = minor[:i]
+	}
+	return parts[0] + "." + minor
+}
@@ -0,0 +1,24 @@
+package mysqlerr
+
+import "testing"
+
+func TestDocURL(t *testing.T) {
+	tests := []struct {
+		code    uint16
+		version string
+		want    string
+	}{
+		{ER_DUP_ENTRY, "", "https://dev.mysql.com/doc/mysql-errors/8.4/en/server-error-reference.html#error_er_dup_entry"},
+		{ER_LOCK_DEADLOCK, "8.0.39-log", "https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html#error_er_lock_deadlock"},
+		{2013, "5.7", "https://dev.mysql.com/doc/mysql-errors/5.7/en/client-error-reference.html#error_cr_server_lost"},
+		{2006, "", "https://dev.mysql.com/doc/mysql-errors/8.4/en/client-error-reference.html#error_cr_server_gone_error"},
+		{2999, "", ""},
+		{999, "", ""},
+		{65535, "", ""},
+	}
+	for _, tt := range tests {
+		if got := DocURL(tt.code, tt.version); got != tt.want {
+			t.Errorf("DocURL(%d, %q) = %q, want %q", tt.code, tt.version, got, tt.want)
+		}
+	}
+}
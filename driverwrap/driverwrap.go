@@ -0,0 +1,344 @@
+// Package driverwrap wraps a database/sql driver so that every MySQL error it returns
+// is a *mysqlerr.Error, whatever driver or proxy produced it:
+//
+//	sql.Register("mysqlerr", driverwrap.Wrap(mysql.MySQLDriver{}))
+//	db, err := sql.Open("mysqlerr", dsn)
+//
+// The errors are converted with mysqlerr.Wrap, so errors.Unwrap still returns the original error of the driver.
+package driverwrap
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// Wrap returns a driver whose connections convert the errors of d.
+func Wrap(d driver.Driver) driver.Driver {
+	return &wrappedDriver{d: d}
+}
+
+// WrapConnector returns a connector whose connections convert the errors of c, for use with sql.OpenDB.
+func WrapConnector(c driver.Connector) driver.Connector {
+	return &connector{c: c, d: &wrappedDriver{d: c.Driver()}}
+}
+
+type wrappedDriver struct {
+	d driver.Driver
+}
+
+func (d *wrappedDriver) Open(name string) (driver.Conn, error) {
+	c, err := d.d.Open(name)
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &conn{c: c}, nil
+}
+
+func (d *wrappedDriver) OpenConnector(name string) (driver.Connector, error) {
+	dc, ok := d.d.(driver.DriverContext)
+	if !ok {
+		return &dsnConnector{name: name, d: d}, nil
+	}
+	c, err := dc.OpenConnector(name)
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &connector{c: c, d: d}, nil
+}
+
+type connector struct {
+	c driver.Connector
+	d *wrappedDriver
+}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	cn, err := c.c.Connect(ctx)
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &conn{c: cn}, nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	return c.d
+}
+
+type dsnConnector struct {
+	name string
+	d    *wrappedDriver
+}
+
+func (c *dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.d.Open(c.name)
+}
+
+func (c *dsnConnector) Driver() driver.Driver {
+	return c.d
+}
+
+// conn implements every optional interface of driver.Conn and falls back to what
+// database/sql does itself when the wrapped connection lacks one.
+type conn struct {
+	c driver.Conn
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	s, err := c.c.Prepare(query)
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &stmt{s: s}, nil
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	pc, ok := c.c.(driver.ConnPrepareContext)
+	if !ok {
+		return c.Prepare(query)
+	}
+	s, err := pc.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &stmt{s: s}, nil
+}
+
+func (c *conn) Close() error {
+	return mysqlerr.Wrap(c.c.Close())
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	t, err := c.c.Begin()
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &tx{t: t}, nil
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	bt, ok := c.c.(driver.ConnBeginTx)
+	if !ok {
+		if opts.Isolation != driver.IsolationLevel(0) {
+			return nil, errors.New("sql: driver does not support non-default isolation level")
+		}
+		if opts.ReadOnly {
+			return nil, errors.New("sql: driver does not support read-only transactions")
+		}
+		return c.Begin()
+	}
+	t, err := bt.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &tx{t: t}, nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	ec, ok := c.c.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	r, err := ec.ExecContext(ctx, query, args)
+	return r, mysqlerr.Wrap(err)
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	qc, ok := c.c.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	r, err := qc.QueryContext(ctx, query, args)
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &rows{r: r}, nil
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	p, ok := c.c.(driver.Pinger)
+	if !ok {
+		return nil
+	}
+	return mysqlerr.Wrap(p.Ping(ctx))
+}
+
+func (c *conn) ResetSession(ctx context.Context) error {
+	sr, ok := c.c.(driver.SessionResetter)
+	if !ok {
+		return nil
+	}
+	return mysqlerr.Wrap(sr.ResetSession(ctx))
+}
+
+func (c *conn) IsValid() bool {
+	v, ok := c.c.(driver.Validator)
+	return !ok || v.IsValid()
+}
+
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	nvc, ok := c.c.(driver.NamedValueChecker)
+	if !ok {
+		return driver.ErrSkip
+	}
+	return nvc.CheckNamedValue(nv)
+}
+
+type stmt struct {
+	s driver.Stmt
+}
+
+func (s *stmt) Close() error {
+	return mysqlerr.Wrap(s.s.Close())
+}
+
+func (s *stmt) NumInput() int {
+	return s.s.NumInput()
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	r, err := s.s.Exec(args)
+	return r, mysqlerr.Wrap(err)
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	r, err := s.s.Query(args)
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &rows{r: r}, nil
+}
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	ec, ok := s.s.(driver.StmtExecContext)
+	if !ok {
+		values, err := namedValuesToValues(args)
+		if err != nil {
+			return nil, err
+		}
+		return s.Exec(values)
+	}
+	r, err := ec.ExecContext(ctx, args)
+	return r, mysqlerr.Wrap(err)
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	qc, ok := s.s.(driver.StmtQueryContext)
+	if !ok {
+		values, err := namedValuesToValues(args)
+		if err != nil {
+			return nil, err
+		}
+		return s.Query(values)
+	}
+	r, err := qc.QueryContext(ctx, args)
+	if err != nil {
+		return nil, mysqlerr.Wrap(err)
+	}
+	return &rows{r: r}, nil
+}
+
+func (s *stmt) CheckNamedValue(nv *driver.NamedValue) error {
+	nvc, ok := s.s.(driver.NamedValueChecker)
+	if !ok {
+		return driver.ErrSkip
+	}
+	return nvc.CheckNamedValue(nv)
+}
+
+func namedValuesToValues(args []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			return nil, errors.New("sql: driver does not support the use of Named Parameters")
+		}
+		values[i] = arg.Value
+	}
+	return values, nil
+}
+
+type tx struct {
+	t driver.Tx
+}
+
+func (t *tx) Commit() error {
+	return mysqlerr.Wrap(t.t.Commit())
+}
+
+func (t *tx) Rollback() error {
+	return mysqlerr.Wrap(t.t.Rollback())
+}
+
+var scanTypeAny = reflect.TypeOf(new(interface{})).Elem()
+
+type rows struct {
+	r driver.Rows
+}
+
+func (r *rows) Columns() []string {
+	return r.r.Columns()
+}
+
+func (r *rows) Close() error {
+	return mysqlerr.Wrap(r.r.Close())
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	return mysqlerr.Wrap(r.r.Next(dest))
+}
+
+func (r *rows) HasNextResultSet() bool {
+	nrs, ok := r.r.(driver.RowsNextResultSet)
+	return ok && nrs.HasNextResultSet()
+}
+
+func (r *rows) NextResultSet() error {
+	nrs, ok := r.r.(driver.RowsNextResultSet)
+	if !ok {
+		return io.EOF
+	}
+	return mysqlerr.Wrap(nrs.NextResultSet())
+}
+
+func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	ct, ok := r.r.(driver.RowsColumnTypeScanType)
+	if !ok {
+		return scanTypeAny
+	}
+	return ct.ColumnTypeScanType(index)
+}
+
+func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
+	ct, ok := r.r.(driver.RowsColumnTypeDatabaseTypeName)
+	if !ok {
+		return ""
+	}
+	return ct.ColumnTypeDatabaseTypeName(index)
+}
+
+func (r *rows) ColumnTypeLength(index int) (int64, bool) {
+	ct, ok := r.r.(driver.RowsColumnTypeLength)
+	if !ok {
+		return 0, false
+	}
+	return ct.ColumnTypeLength(index)
+}
+
+func (r *rows) ColumnTypeNullable(index int) (bool, bool) {
+	ct, ok := r.r.(driver.RowsColumnTypeNullable)
+	if !ok {
+		return false, false
+	}
+	return ct.ColumnTypeNullable(index)
+}
+
+func (r *rows) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	ct, ok := r.r.(driver.RowsColumnTypePrecisionScale)
+	if !ok {
+		return 0, 0, false
+	}
+	return ct.ColumnTypePrecisionScale(index)
+}
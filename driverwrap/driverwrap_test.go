@@ -0,0 +1,165 @@
+package driverwrap
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// driverError has the shape of *mysql.MySQLError.
+type driverError struct {
+	Number   uint16
+	SQLState [5]byte
+	Message  string
+}
+
+func (e *driverError) Error() string {
+	return e.Message
+}
+
+var errDeadlock = &driverError{Number: 1213, SQLState: [5]byte{'4', '0', '0', '0', '1'}, Message: "Deadlock found when trying to get lock; try restarting transaction"}
+
+// fakeDriver implements only the mandatory interfaces, so that database/sql falls back on driver.ErrSkip.
+type fakeDriver struct {
+	execErr error
+	rows    int
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	return &fakeConn{d: d}, nil
+}
+
+type fakeConn struct {
+	d *fakeDriver
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("unsupported")
+}
+
+type fakeStmt struct {
+	d *fakeDriver
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), s.d.execErr
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{n: s.d.rows}, nil
+}
+
+type fakeRows struct {
+	n int
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"n"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.n == 0 {
+		return io.EOF
+	}
+	dest[0] = int64(r.n)
+	r.n--
+	return nil
+}
+
+func TestPassthrough(t *testing.T) {
+	c, err := Wrap(&fakeDriver{}).Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := c.(driver.ExecerContext).ExecContext(ctx, "DO 1", nil); err != driver.ErrSkip {
+		t.Errorf("ExecContext: %v, want driver.ErrSkip", err)
+	}
+	if _, err := c.(driver.QueryerContext).QueryContext(ctx, "SELECT 1", nil); err != driver.ErrSkip {
+		t.Errorf("QueryContext: %v, want driver.ErrSkip", err)
+	}
+	if err := c.(driver.NamedValueChecker).CheckNamedValue(&driver.NamedValue{Value: 1}); err != driver.ErrSkip {
+		t.Errorf("CheckNamedValue: %v, want driver.ErrSkip", err)
+	}
+	s, err := c.Prepare("SELECT 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := s.Query(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Next(make([]driver.Value, 1)); err != io.EOF {
+		t.Errorf("Next: %v, want io.EOF", err)
+	}
+	if err := r.(driver.RowsNextResultSet).NextResultSet(); err != io.EOF {
+		t.Errorf("NextResultSet: %v, want io.EOF", err)
+	}
+}
+
+func TestDB(t *testing.T) {
+	d := &fakeDriver{rows: 2}
+	sql.Register("driverwrap-test", Wrap(d))
+	db, err := sql.Open("driverwrap-test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int
+	for rows.Next() {
+		var n int
+		if err := rows.Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, n)
+	}
+	if err := rows.Err(); err != nil {
+		t.Errorf("rows.Err: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("read %v, want 2 rows", got)
+	}
+
+	d.execErr = errDeadlock
+	_, err = db.Exec("UPDATE t SET n = ?", 1)
+	var e *mysqlerr.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("Exec: %T %v, want a *mysqlerr.Error", err, err)
+	}
+	if e.Number != mysqlerr.ER_LOCK_DEADLOCK || e.SQLState != "40001" {
+		t.Errorf("Exec: %+v", e)
+	}
+	if !errors.Is(err, errDeadlock) {
+		t.Error("Exec: the error of the driver is not wrapped")
+	}
+}
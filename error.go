@@ -0,0 +1,206 @@
+package mysqlerr
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Error is an error sent by a MySQL server.
+type Error struct {
+	Number   uint16
+	SQLState string
+	Message  string
+
+	err error // the error it was converted from, if any
+}
+
+func (e *Error) Error() string {
+	if e.SQLState != "" {
+		return fmt.Sprintf("Error %d (%s): %s", e.Number, e.SQLState, e.Message)
+	}
+	return fmt.Sprintf("Error %d: %s", e.Number, e.Message)
+}
+
+// Unwrap returns the error e was converted from by Wrap, or nil.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// Wrap returns the first MySQL error in err's tree as an *Error wrapping err,
+// so that errors.As finds an *Error while errors.Is and errors.As still reach the original.
+// It returns err itself if it already is an *Error or carries no MySQL error.
+func Wrap(err error) error {
+	if _, ok := err.(*Error); ok {
+		return err
+	}
+	e, ok := FromError(err)
+	if !ok {
+		return err
+	}
+	w := *e
+	w.err = err
+	return &w
+}
+
+var extractors []func(error) (*Error, bool)
+
+// RegisterExtractor adds f to the functions FromError tries on each error of a tree
+// before its own rules, so that errors carrying a MySQL error in another shape,
+// such as the text of a proxy, are understood too.
+// It is not safe for concurrent use and is meant to be called from init functions.
+func RegisterExtractor(f func(error) (*Error, bool)) {
+	extractors = append(extractors, f)
+}
+
+// FromError returns the first MySQL error in err's tree, which it walks depth first as errors.As does,
+// into the errors of errors.Join and of fmt.Errorf with several %w verbs too.
+// Besides *Error, it understands driver errors with a Number field and
+// optional SQLState and Message fields, such as *mysql.MySQLError of github.com/go-sql-driver/mysql,
+// and whatever the registered extractors understand.
+func FromError(err error) (*Error, bool) {
+	var found *Error
+	walk(err, func(err error) bool {
+		found = fromError(err)
+		return found != nil
+	})
+	return found, found != nil
+}
+
+// fromError converts err itself, not the errors it wraps.
+func fromError(err error) *Error {
+	for _, extract := range extractors {
+		if e, ok := extract(err); ok {
+			return e
+		}
+	}
+	if e, ok := err.(*Error); ok {
+		return e
+	}
+	if e, ok := reflectError(err); ok {
+		return e
+	}
+	return nil
+}
+
+// walk calls f on err and the errors it wraps, depth first, until f returns true,
+// and reports whether it did.
+func walk(err error, f func(error) bool) bool {
+	for err != nil {
+		if f(err) {
+			return true
+		}
+		switch u := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, err := range u.Unwrap() {
+				if walk(err, f) {
+					return true
+				}
+			}
+			return false
+		case interface{ Unwrap() error }:
+			err = u.Unwrap()
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// Code returns the number of the first MySQL error in err's tree, or 0 if there is none.
+func Code(err error) int {
+	e, ok := FromError(err)
+	if !ok {
+		return 0
+	}
+	return int(e.Number)
+}
+
+// Codes returns the numbers of all the MySQL errors in err's tree, in the order FromError visits them,
+// such as the failures of the rows of a batch joined with errors.Join. A code occurring several times is repeated.
+// The errors a MySQL error wraps, such as the original of Wrap, are not searched.
+func Codes(err error) []uint16 {
+	var codes []uint16
+	for _, e := range appendErrors(nil, err) {
+		codes = append(codes, e.Number)
+	}
+	return codes
+}
+
+func appendErrors(errs []*Error, err error) []*Error {
+	for err != nil {
+		if e := fromError(err); e != nil {
+			return append(errs, e)
+		}
+		switch u := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, err := range u.Unwrap() {
+				errs = appendErrors(errs, err)
+			}
+			return errs
+		case interface{ Unwrap() error }:
+			err = u.Unwrap()
+		default:
+			return errs
+		}
+	}
+	return errs
+}
+
+func reflectError(err error) (*Error, bool) {
+	v := reflect.ValueOf(err)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	var e Error
+	switch f := exportedField(v, "Number"); f.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		e.Number = uint16(f.Uint())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.Number = uint16(f.Int())
+	default:
+		return nil, false
+	}
+	switch f := exportedField(v, "SQLState"); f.Kind() {
+	case reflect.String:
+		e.SQLState = f.String()
+	case reflect.Array:
+		// go-sql-driver/mysql stores it as [5]byte.
+		if f.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, f.Len())
+			for i := range b {
+				b[i] = byte(f.Index(i).Uint())
+			}
+			if len(b) > 0 && b[0] != 0 {
+				e.SQLState = string(b)
+			}
+		}
+	}
+	if f := exportedField(v, "Message"); f.Kind() == reflect.String {
+		e.Message = f.String()
+	}
+	return &e, true
+}
+
+func exportedField(v reflect.Value, name string) reflect.Value {
+	sf, ok := v.Type().FieldByName(name)
+	if !ok || sf.PkgPath != "" {
+		return reflect.Value{}
+	}
+	// FieldByIndex panics on a nil embedded pointer.
+	for i, x := range sf.Index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
@@ -0,0 +1,143 @@
+package mysqlerr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// joinError is what errors.Join returns.
+type joinError []error
+
+func (e joinError) Error() string {
+	return fmt.Sprint([]error(e))
+}
+
+func (e joinError) Unwrap() []error {
+	return e
+}
+
+// driverError has the shape of *mysql.MySQLError of go-sql-driver/mysql.
+type driverError struct {
+	Number   uint16
+	SQLState [5]byte
+	Message  string
+}
+
+func (e *driverError) Error() string { return e.Message }
+
+type emptyStateError struct {
+	Number   uint16
+	SQLState [0]byte
+}
+
+func (e *emptyStateError) Error() string { return "empty state" }
+
+type stringStateError struct {
+	Number   int
+	SQLState string
+}
+
+func (e stringStateError) Error() string { return "string state" }
+
+// floatError has a Number that is not an error code.
+type floatError struct {
+	Number float64
+}
+
+func (e *floatError) Error() string { return "float" }
+
+type unexportedError struct {
+	number uint16
+}
+
+func (e *unexportedError) Error() string { return "unexported" }
+
+type Inner struct {
+	Number uint16
+}
+
+type embeddedError struct {
+	*Inner
+}
+
+func (e *embeddedError) Error() string { return "embedded" }
+
+func TestFromError(t *testing.T) {
+	deadlock := &Error{Number: ER_LOCK_DEADLOCK, SQLState: "40001", Message: "Deadlock found"}
+	tests := []struct {
+		name string
+		err  error
+		want *Error
+	}{
+		{"nil", nil, nil},
+		{"Error", deadlock, deadlock},
+		{"driver error", &driverError{Number: 1062, SQLState: [5]byte{'2', '3', '0', '0', '0'}, Message: "dup"}, &Error{Number: 1062, SQLState: "23000", Message: "dup"}},
+		{"driver error without state", &driverError{Number: 1105}, &Error{Number: 1105}},
+		{"zero-length state", &emptyStateError{Number: 1105}, &Error{Number: 1105}},
+		{"string state", stringStateError{Number: 1045, SQLState: "28000"}, &Error{Number: 1045, SQLState: "28000"}},
+		{"nil pointer", (*driverError)(nil), nil},
+		{"nil embedded struct", &embeddedError{}, nil},
+		{"embedded struct", &embeddedError{&Inner{Number: 1146}}, &Error{Number: 1146}},
+		{"float Number", &floatError{Number: 1.5}, nil},
+		{"unexported number", &unexportedError{number: 1062}, nil},
+		{"other error", io.EOF, nil},
+		{"wrapped", fmt.Errorf("query: %w", deadlock), deadlock},
+		{"joined", joinError{io.EOF, fmt.Errorf("tx: %w", deadlock)}, deadlock},
+		{"joined nil pointer", joinError{(*driverError)(nil), deadlock}, deadlock},
+	}
+	for _, tt := range tests {
+		got, ok := FromError(tt.err)
+		if ok != (tt.want != nil) {
+			t.Errorf("%s: FromError reported %v", tt.name, ok)
+			continue
+		}
+		if ok && (got.Number != tt.want.Number || got.SQLState != tt.want.SQLState || got.Message != tt.want.Message) {
+			t.Errorf("%s: FromError = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCodes(t *testing.T) {
+	dup := &Error{Number: ER_DUP_ENTRY}
+	deadlock := &Error{Number: ER_LOCK_DEADLOCK}
+	tests := []struct {
+		name string
+		err  error
+		want []uint16
+	}{
+		{"nil", nil, nil},
+		{"single", dup, []uint16{ER_DUP_ENTRY}},
+		{"joined", joinError{dup, io.EOF, fmt.Errorf("row 2: %w", deadlock)}, []uint16{ER_DUP_ENTRY, ER_LOCK_DEADLOCK}},
+		{"nested", fmt.Errorf("batch: %w", joinError{joinError{dup}, dup}), []uint16{ER_DUP_ENTRY, ER_DUP_ENTRY}},
+		{"wrapped by Wrap", Wrap(&driverError{Number: ER_DUP_ENTRY}), []uint16{ER_DUP_ENTRY}},
+	}
+	for _, tt := range tests {
+		if got := Codes(tt.err); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Codes = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if got := Code(joinError{io.EOF, deadlock, dup}); got != ER_LOCK_DEADLOCK {
+		t.Errorf("Code of joined errors = %d, want the first, %d", got, ER_LOCK_DEADLOCK)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	orig := &driverError{Number: ER_DUP_ENTRY, Message: "dup"}
+	err := Wrap(fmt.Errorf("insert: %w", orig))
+	var e *Error
+	if !errors.As(err, &e) || e.Number != ER_DUP_ENTRY {
+		t.Fatalf("Wrap = %v, want an *Error", err)
+	}
+	if !errors.Is(err, orig) {
+		t.Error("Wrap lost the original error")
+	}
+	if err := Wrap(io.EOF); err != io.EOF {
+		t.Errorf("Wrap(io.EOF) = %v, want io.EOF unchanged", err)
+	}
+	if err := Wrap(nil); err != nil {
+		t.Errorf("Wrap(nil) = %v", err)
+	}
+}
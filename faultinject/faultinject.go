@@ -0,0 +1,308 @@
+// Package faultinject wraps a database/sql driver to make some of its calls fail with MySQL errors,
+// for testing how an application copes with deadlocks, failovers and full disks without breaking a server:
+//
+//	sql.Register("chaos", faultinject.New(mysql.MySQLDriver{},
+//		faultinject.Rule{Code: mysqlerr.ER_LOCK_DEADLOCK, Ops: faultinject.Exec, Probability: 0.01},
+//		faultinject.Rule{Code: mysqlerr.ER_OPTION_PREVENTS_STATEMENT, Args: []interface{}{"--read-only"}, Pattern: regexp.MustCompile(`^INSERT`)},
+//	))
+package faultinject
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math/rand"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// Op is a set of driver operations.
+type Op int
+
+// Operations a Rule applies to.
+const (
+	Exec Op = 1 << iota
+	Query
+	Begin
+
+	All = Exec | Query | Begin
+)
+
+// Rule describes the calls to fail and the error to fail them with.
+type Rule struct {
+	// Code and Args render the error with mysqlerr.NewError. Until its message table is generated,
+	// mysqlerr knows the SQLSTATE and message of the common errors only: the others get HY000
+	// and the Args as the message, so set Err to inject one of them exactly.
+	Code int
+	Args []interface{}
+	// Err is returned instead of the rendered error if it is not nil.
+	Err error
+
+	// Ops are the operations the rule applies to (default All).
+	Ops Op
+	// Pattern only lets the rule apply to the queries it matches. Begin has no query and never matches.
+	Pattern *regexp.Regexp
+	// Probability makes the rule fail a matching call with this probability (default 1).
+	Probability float64
+	// Every makes the rule only fail every Every-th matching call.
+	Every int
+	// Times is the number of calls the rule fails at most (default unlimited).
+	Times int
+}
+
+type rule struct {
+	Rule
+	calls    int
+	injected int
+}
+
+// Driver is a driver.Driver injecting errors into the connections of another one.
+type Driver struct {
+	d driver.Driver
+
+	mu    sync.Mutex
+	rules []*rule
+	rand  *rand.Rand
+}
+
+// New returns a driver failing the calls of d the rules match. The first matching rule wins.
+func New(d driver.Driver, rules ...Rule) *Driver {
+	fd := &Driver{d: d, rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+	fd.SetRules(rules...)
+	return fd
+}
+
+// SetRules replaces the rules and resets their counters.
+func (d *Driver) SetRules(rules ...Rule) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.rules = d.rules[:0]
+	for _, r := range rules {
+		if r.Ops == 0 {
+			r.Ops = All
+		}
+		d.rules = append(d.rules, &rule{Rule: r})
+	}
+}
+
+// Seed makes the probabilities deterministic.
+func (d *Driver) Seed(seed int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.rand = rand.New(rand.NewSource(seed))
+}
+
+func (d *Driver) inject(op Op, query string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, r := range d.rules {
+		if r.Ops&op == 0 || (r.Pattern != nil && (op == Begin || !r.Pattern.MatchString(query))) {
+			continue
+		}
+		if r.Times > 0 && r.injected >= r.Times {
+			continue
+		}
+		r.calls++
+		if r.Every > 0 && r.calls%r.Every != 0 {
+			continue
+		}
+		if r.Probability > 0 && d.rand.Float64() >= r.Probability {
+			continue
+		}
+		r.injected++
+		if r.Err != nil {
+			return r.Err
+		}
+		return mysqlerr.NewError(r.Code, r.Args...)
+	}
+	return nil
+}
+
+// Open opens a connection of the wrapped driver.
+func (d *Driver) Open(name string) (driver.Conn, error) {
+	c, err := d.d.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{c: c, d: d}, nil
+}
+
+type conn struct {
+	c driver.Conn
+	d *Driver
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	s, err := c.c.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{s: s, query: query, d: c.d}, nil
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	pc, ok := c.c.(driver.ConnPrepareContext)
+	if !ok {
+		return c.Prepare(query)
+	}
+	s, err := pc.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{s: s, query: query, d: c.d}, nil
+}
+
+func (c *conn) Close() error {
+	return c.c.Close()
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	if err := c.d.inject(Begin, ""); err != nil {
+		return nil, err
+	}
+	return c.c.Begin()
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	bt, ok := c.c.(driver.ConnBeginTx)
+	if !ok {
+		return c.Begin()
+	}
+	if err := c.d.inject(Begin, ""); err != nil {
+		return nil, err
+	}
+	return bt.BeginTx(ctx, opts)
+}
+
+// ExecContext skips before injecting when the wrapped connection lacks it,
+// so that database/sql falls back to a statement and the call is counted once.
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	ec, ok := c.c.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if err := c.d.inject(Exec, query); err != nil {
+		return nil, err
+	}
+	return ec.ExecContext(ctx, query, args)
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	qc, ok := c.c.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if err := c.d.inject(Query, query); err != nil {
+		return nil, err
+	}
+	return qc.QueryContext(ctx, query, args)
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	p, ok := c.c.(driver.Pinger)
+	if !ok {
+		return nil
+	}
+	return p.Ping(ctx)
+}
+
+func (c *conn) ResetSession(ctx context.Context) error {
+	sr, ok := c.c.(driver.SessionResetter)
+	if !ok {
+		return nil
+	}
+	return sr.ResetSession(ctx)
+}
+
+func (c *conn) IsValid() bool {
+	v, ok := c.c.(driver.Validator)
+	return !ok || v.IsValid()
+}
+
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	nvc, ok := c.c.(driver.NamedValueChecker)
+	if !ok {
+		return driver.ErrSkip
+	}
+	return nvc.CheckNamedValue(nv)
+}
+
+type stmt struct {
+	s     driver.Stmt
+	query string
+	d     *Driver
+}
+
+func (s *stmt) Close() error {
+	return s.s.Close()
+}
+
+func (s *stmt) NumInput() int {
+	return s.s.NumInput()
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	if err := s.d.inject(Exec, s.query); err != nil {
+		return nil, err
+	}
+	return s.s.Exec(args)
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	if err := s.d.inject(Query, s.query); err != nil {
+		return nil, err
+	}
+	return s.s.Query(args)
+}
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	ec, ok := s.s.(driver.StmtExecContext)
+	if !ok {
+		values, err := namedValuesToValues(args)
+		if err != nil {
+			return nil, err
+		}
+		return s.Exec(values)
+	}
+	if err := s.d.inject(Exec, s.query); err != nil {
+		return nil, err
+	}
+	return ec.ExecContext(ctx, args)
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	qc, ok := s.s.(driver.StmtQueryContext)
+	if !ok {
+		values, err := namedValuesToValues(args)
+		if err != nil {
+			return nil, err
+		}
+		return s.Query(values)
+	}
+	if err := s.d.inject(Query, s.query); err != nil {
+		return nil, err
+	}
+	return qc.QueryContext(ctx, args)
+}
+
+func (s *stmt) CheckNamedValue(nv *driver.NamedValue) error {
+	nvc, ok := s.s.(driver.NamedValueChecker)
+	if !ok {
+		return driver.ErrSkip
+	}
+	return nvc.CheckNamedValue(nv)
+}
+
+func namedValuesToValues(args []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		if arg.Name != "" {
+			return nil, errors.New("sql: driver does not support the use of Named Parameters")
+		}
+		values[i] = arg.Value
+	}
+	return values, nil
+}
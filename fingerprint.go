@@ -0,0 +1,40 @@
+package mysqlerr
+
+import "regexp"
+
+var (
+	directive     = regexp.MustCompile("%[-+ #0`]*[0-9*]*(?:\\.[0-9*]*)?[hlLqjzt]*[a-zA-Z]")
+	quotedLiteral = regexp.MustCompile(`'(?:[^'\\]|\\.)*'|"(?:[^"\\]|\\.)*"|\b\d+(?:\.\d+)?\b`)
+)
+
+// Fingerprint returns a stable fingerprint of the first MySQL error in err's chain for error trackers,
+// made of its name and its message with the values left out, so that
+// "Duplicate entry 'a@b.com' for key 'email'" and "Duplicate entry 'x@y.org' for key 'email'" are grouped together.
+// It returns nil if err carries no MySQL error.
+func Fingerprint(err error) []string {
+	e, ok := FromError(err)
+	if !ok {
+		return nil
+	}
+	name, _ := Name(int(e.Number))
+	return []string{"mysql", name, normalizeMessage(e)}
+}
+
+// normalizeMessage returns the template of e's message with its directives replaced by "?",
+// or the message with its quoted strings and numbers replaced if the template is unknown or does not match.
+func normalizeMessage(e *Error) string {
+	if tmpl, ok := Message(int(e.Number)); ok {
+		if t, err := CompileTemplate(tmpl); err == nil {
+			if _, ok := t.Match(e.Message); ok {
+				return directive.ReplaceAllString(tmpl, "?")
+			}
+		}
+	}
+	return quotedLiteral.ReplaceAllStringFunc(e.Message, func(s string) string {
+		switch s[0] {
+		case '\'', '"':
+			return s[:1] + "?" + s[:1]
+		}
+		return "?"
+	})
+}
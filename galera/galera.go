@@ -0,0 +1,44 @@
+// Package galera classifies the errors of Galera Cluster, Percona XtraDB Cluster
+// and MariaDB Galera, which reuse MySQL codes for wsrep failures.
+package galera
+
+import (
+	"strings"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// Messages wsrep sends with the reused codes.
+const (
+	// MessageNotReady is sent with ER_UNKNOWN_COM_ERROR while the node is not synced with the cluster.
+	MessageNotReady = "WSREP has not yet prepared node for application use"
+	// MessageConflict is sent with ER_LOCK_DEADLOCK by Percona XtraDB Cluster 8.0 when certification fails.
+	MessageConflict = "WSREP detected deadlock/conflict and aborted the transaction. Try restarting the transaction"
+)
+
+// IsCertificationConflict reports whether the transaction lost a certification conflict
+// against a write set of another node.
+// Galera reports it as ER_LOCK_DEADLOCK, so on a cluster every deadlock is treated as one;
+// either way the transaction has been rolled back and can be restarted.
+func IsCertificationConflict(err error) bool {
+	return mysqlerr.Code(err) == mysqlerr.ER_LOCK_DEADLOCK
+}
+
+// IsNotReady reports whether the node refused the statement because it is not part
+// of the primary component or still syncing; another node should be tried.
+func IsNotReady(err error) bool {
+	e, ok := mysqlerr.FromError(err)
+	return ok && e.Number == mysqlerr.ER_UNKNOWN_COM_ERROR && strings.Contains(e.Message, "WSREP")
+}
+
+// IsCommitFailure reports whether replicating the transaction failed at COMMIT.
+// Unlike a certification conflict it may be caused by the cluster losing quorum.
+func IsCommitFailure(err error) bool {
+	return mysqlerr.Code(err) == mysqlerr.ER_ERROR_DURING_COMMIT
+}
+
+// IsRetryable reports whether the transaction can be retried, on this node for a conflict
+// or on another one when the node is not ready.
+func IsRetryable(err error) bool {
+	return IsCertificationConflict(err) || IsNotReady(err)
+}
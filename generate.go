@@ -1,6 +1,24 @@
+// Package mysqlerr provides helpers for MySQL server errors.
+// The error code constants of the latest release are aliased from mysqlerr84;
+// import mysqlerr57, mysqlerr80 or mysqlerr84 to get exactly the error set of a server version.
 package mysqlerr
 
 //go:generate go run ./cmd/mysqlerrgen -pkg mysqlerr8 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt
+//go:generate go run ./cmd/mysqlerrgen -pkg mysqlerr84 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt
+//go:generate go run ./cmd/mysqlerrgen -pkg mysqlerr -dir . -alias mysqlerr84 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt
+//go:generate go run ./cmd/mysqlerrgen -format subsystem -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt -o subsystems.go
+//go:generate go run ./cmd/mysqlerrgen -format names -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt -o names.go
+//go:generate go run ./cmd/mysqlerrgen -format advice -advice advice.json -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt -o advices.go
+//go:generate go run ./cmd/mysqlerrgen -format messages -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt -o messagetable.go
+//go:generate go run ./cmd/mysqlerrgen -format json -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt -o cmd/mysqlerr/catalog.json
 //go:generate go run ./cmd/mysqlerrgen -pkg mysqlerr80 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.0.39/share/messages_to_clients.txt
 //go:generate go run ./cmd/mysqlerrgen -pkg mysqlerr57 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-5.7.44/sql/share/errmsg-utf8.txt
-
+//go:generate go run ./cmd/mysqlerrgen -pkg client -input header -include ^CR_ -lookup map -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/include/errmsg.h
+//go:generate go run ./cmd/mysqlerrgen -pkg ndb -include NDB|^ER_GET_ERRMSG|^ER_GET_TEMPORARY_ERRMSG -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt
+//go:generate go run ./cmd/mysqlerrgen -pkg tidb -input tidb -version 8.1 -url https://raw.githubusercontent.com/pingcap/tidb/986af29c533eaa836df0561fe8e81633e4644416/pkg/errno/errcode.go
+//go:generate go run ./cmd/mysqlerrgen -pkg mariadb -input header -version 3.3 -url https://raw.githubusercontent.com/MariaDB/mariadb-connector-c/ead038dc8a2b3d7819f87af33e14f105325f8cf9/include/mysqld_error.h
+//go:generate go run ./cmd/mysqlerrgen -format registry -dialect mysql -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt -o registry/mysql.go
+//go:generate go run ./cmd/mysqlerrgen -format registry -dialect errorlog -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_error_log.txt -o registry/errorlog.go
+//go:generate go run ./cmd/mysqlerrgen -format registry -dialect mariadb -input header -version 3.3 -url https://raw.githubusercontent.com/MariaDB/mariadb-connector-c/ead038dc8a2b3d7819f87af33e14f105325f8cf9/include/mysqld_error.h -o registry/mariadb.go
+//go:generate go run ./cmd/mysqlerrgen -format registry -dialect tidb -input tidb -version 8.1 -url https://raw.githubusercontent.com/pingcap/tidb/986af29c533eaa836df0561fe8e81633e4644416/pkg/errno/errcode.go -o registry/tidb.go
+//go:generate go run ./cmd/mysqlerrgen -format registry -dialect client -input header -include ^CR_ -version 8.4.2 -url https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/include/errmsg.h -o registry/client.go
@@ -1,3 +1,8 @@
 module github.com/orisano/mysqlerr
 
 go 1.16
+
+require (
+	github.com/go-sql-driver/mysql v1.8.1
+	golang.org/x/tools v0.24.0
+)
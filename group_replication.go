@@ -0,0 +1,53 @@
+package mysqlerr
+
+// groupReplicationCodes are the errors raised by the Group Replication plugin
+// or by the server on its behalf.
+var groupReplicationCodes = map[int]bool{
+	ER_GROUP_REPLICATION_CONFIGURATION:                     true,
+	ER_GROUP_REPLICATION_RUNNING:                           true,
+	ER_GROUP_REPLICATION_APPLIER_INIT_ERROR:                true,
+	ER_GROUP_REPLICATION_STOP_APPLIER_THREAD_TIMEOUT:       true,
+	ER_GROUP_REPLICATION_COMMUNICATION_LAYER_SESSION_ERROR: true,
+	ER_GROUP_REPLICATION_COMMUNICATION_LAYER_JOIN_ERROR:    true,
+	ER_BEFORE_DML_VALIDATION_ERROR:                         true,
+	ER_RUN_HOOK_ERROR:                                      true,
+	ER_TRANSACTION_ROLLBACK_DURING_COMMIT:                  true,
+	ER_GROUP_REPLICATION_MAX_GROUP_SIZE:                    true,
+	ER_GROUP_REPLICATION_COMMAND_FAILURE:                   true,
+	ER_GRP_TRX_CONSISTENCY_NOT_ALLOWED:                     true,
+	ER_GRP_TRX_CONSISTENCY_BEFORE:                          true,
+	ER_GRP_TRX_CONSISTENCY_AFTER_ON_TRX_BEGIN:              true,
+	ER_GRP_TRX_CONSISTENCY_BEGIN_NOT_ALLOWED:               true,
+	ER_GRP_RPL_UDF_ERROR:                                   true,
+	ER_GRP_RPL_MESSAGE_SERVICE_INIT_FAILURE:                true,
+	ER_GRP_OPERATION_NOT_ALLOWED_GR_MUST_STOP:              true,
+	ER_GROUP_REPLICATION_USER_EMPTY_MSG:                    true,
+	ER_GROUP_REPLICATION_USER_MANDATORY_MSG:                true,
+	ER_GROUP_REPLICATION_PASSWORD_LENGTH:                   true,
+	ER_GRP_RPL_RECOVERY_CHANNEL_STILL_RUNNING:              true,
+	ER_GRP_RPL_VIEW_CHANGE_UUID_FAIL_GET_VARIABLE:          true,
+	ER_GRP_RPL_FAILOVER_CHANNEL_STATUS_PROPAGATION:         true,
+	ER_GROUP_REPLICATION_FORCE_MEMBERS_COMMAND_FAILURE:     true,
+}
+
+// IsGroupReplicationError reports whether err was raised by Group Replication.
+func IsGroupReplicationError(err error) bool {
+	return groupReplicationCodes[Code(err)]
+}
+
+// IsGroupReplicationTransition reports whether a write failed because the member
+// is not, or no longer, a writable primary: during a primary election the old primary
+// turns super_read_only, members leaving the group fail their commit hooks, and
+// consistency guarantees make transactions wait or fail.
+// Such writes should be paused and retried against the new primary.
+func IsGroupReplicationTransition(err error) bool {
+	if option, ok := ReadOnlyOption(err); ok {
+		return option == ReadOnlySuperServer
+	}
+	switch Code(err) {
+	case ER_RUN_HOOK_ERROR, ER_GRP_TRX_CONSISTENCY_NOT_ALLOWED, ER_GRP_TRX_CONSISTENCY_BEFORE,
+		ER_GRP_TRX_CONSISTENCY_AFTER_ON_TRX_BEGIN, ER_GRP_TRX_CONSISTENCY_BEGIN_NOT_ALLOWED:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package mysqlerr
+
+import "github.com/orisano/mysqlerr/mariadb"
+
+// IsQueryKilled reports whether the statement was interrupted rather than failed on its own:
+// killed with KILL QUERY (ER_QUERY_INTERRUPTED), or stopped by max_execution_time (ER_QUERY_TIMEOUT)
+// or by max_statement_time of MariaDB. The connection is still usable.
+func IsQueryKilled(err error) bool {
+	switch Code(err) {
+	case ER_QUERY_INTERRUPTED, ER_QUERY_TIMEOUT, mariadb.ER_STATEMENT_TIMEOUT:
+		return true
+	}
+	return false
+}
+
+// IsSessionKilled reports whether the server ended the session: killed with KILL (ER_SESSION_WAS_KILLED,
+// or ER_CONNECTION_KILLED of MariaDB), or disconnected after wait_timeout of inactivity.
+// The connection is gone and must be discarded.
+func IsSessionKilled(err error) bool {
+	switch Code(err) {
+	case ER_SESSION_WAS_KILLED, ER_CLIENT_INTERACTION_TIMEOUT, mariadb.ER_CONNECTION_KILLED:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package mysqlerr
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/orisano/mysqlerr/mariadb"
+)
+
+func TestIsKilled(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		query   bool
+		session bool
+	}{
+		{"KILL QUERY", &Error{Number: ER_QUERY_INTERRUPTED}, true, false},
+		{"max_execution_time", &Error{Number: ER_QUERY_TIMEOUT}, true, false},
+		{"MariaDB max_statement_time", &Error{Number: mariadb.ER_STATEMENT_TIMEOUT}, true, false},
+		{"KILL", &Error{Number: ER_SESSION_WAS_KILLED}, false, true},
+		{"wait_timeout", &Error{Number: ER_CLIENT_INTERACTION_TIMEOUT}, false, true},
+		{"MariaDB KILL", &Error{Number: mariadb.ER_CONNECTION_KILLED}, false, true},
+		{"driver error", &driverError{Number: ER_QUERY_INTERRUPTED}, true, false},
+		{"wrapped", fmt.Errorf("select: %w", &Error{Number: ER_QUERY_TIMEOUT}), true, false},
+		{"joined", joinError{io.EOF, &Error{Number: ER_SESSION_WAS_KILLED}}, false, true},
+		{"lock wait timeout", &Error{Number: ER_LOCK_WAIT_TIMEOUT}, false, false},
+		{"not a MySQL error", io.EOF, false, false},
+		{"nil", nil, false, false},
+	}
+	for _, tt := range tests {
+		if got := IsQueryKilled(tt.err); got != tt.query {
+			t.Errorf("%s: IsQueryKilled = %v, want %v", tt.name, got, tt.query)
+		}
+		if got := IsSessionKilled(tt.err); got != tt.session {
+			t.Errorf("%s: IsSessionKilled = %v, want %v", tt.name, got, tt.session)
+		}
+	}
+}
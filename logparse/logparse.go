@@ -0,0 +1,150 @@
+// Package logparse parses the lines of a MySQL 8 error log.
+//
+// It understands the default format of log_sink_internal,
+//
+//	2024-07-01T09:12:03.116104Z 0 [System] [MY-010931] [Server] /usr/sbin/mysqld: ready for connections.
+//
+// and the JSON format of log_sink_json.
+//
+// The JSON format names the error of each line. For the default format the name comes from the MySQL
+// and ErrorLog entries of the registry.
+package logparse
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/orisano/mysqlerr"
+	"github.com/orisano/mysqlerr/registry"
+)
+
+// Record is a parsed error log line.
+type Record struct {
+	Time   time.Time
+	Thread uint64
+	// Severity is the label of the priority: System, Error, Warning or Note.
+	Severity string
+	Code     int
+	// Name is the symbol of Code: err_symbol in the JSON format, or the name the registry has for Code.
+	// It is empty if neither knows it.
+	Name      string
+	Subsystem string
+	Message   string
+}
+
+var defaultLine = regexp.MustCompile(`^(\S+) (\d+) \[(\w+)\] \[(MY-\d{6})\](?: \[(\w+)\])? (.*)$`)
+
+// Parse parses a line of either format.
+func Parse(line string) (*Record, error) {
+	line = strings.TrimRight(line, "\r\n")
+	if strings.HasPrefix(line, "{") {
+		return parseJSON(line)
+	}
+	m := defaultLine.FindStringSubmatch(line)
+	if m == nil {
+		return nil, fmt.Errorf("unknown log line: %q", line)
+	}
+	t, err := time.Parse(time.RFC3339Nano, m[1])
+	if err != nil {
+		return nil, fmt.Errorf("parse time: %w", err)
+	}
+	thread, err := strconv.ParseUint(m[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse thread: %w", err)
+	}
+	code, err := mysqlerr.ParseErrorLogSymbol(m[4])
+	if err != nil {
+		return nil, err
+	}
+	return &Record{
+		Time:      t,
+		Thread:    thread,
+		Severity:  m[3],
+		Code:      code,
+		Name:      name(code),
+		Subsystem: m[5],
+		Message:   m[6],
+	}, nil
+}
+
+type jsonLine struct {
+	Time      string `json:"time"`
+	Thread    uint64 `json:"thread"`
+	Label     string `json:"label"`
+	ErrCode   int    `json:"err_code"`
+	ErrSymbol string `json:"err_symbol"`
+	Subsystem string `json:"subsystem"`
+	Msg       string `json:"msg"`
+}
+
+func parseJSON(line string) (*Record, error) {
+	var j jsonLine
+	if err := json.Unmarshal([]byte(line), &j); err != nil {
+		return nil, fmt.Errorf("parse json: %w", err)
+	}
+	t, err := time.Parse(time.RFC3339Nano, j.Time)
+	if err != nil {
+		return nil, fmt.Errorf("parse time: %w", err)
+	}
+	r := &Record{
+		Time:      t,
+		Thread:    j.Thread,
+		Severity:  j.Label,
+		Code:      j.ErrCode,
+		Name:      j.ErrSymbol,
+		Subsystem: j.Subsystem,
+		Message:   j.Msg,
+	}
+	if r.Name == "" {
+		r.Name = name(r.Code)
+	}
+	return r, nil
+}
+
+func name(code int) string {
+	for _, dialect := range []string{registry.MySQL, registry.ErrorLog} {
+		if e, ok := registry.Lookup(dialect, code); ok {
+			return e.Name
+		}
+	}
+	return ""
+}
+
+// Reader reads records from an error log.
+type Reader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+// NewReader returns a Reader reading from r.
+func NewReader(r io.Reader) *Reader {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1024*1024)
+	return &Reader{s: s}
+}
+
+// Read returns the next record, or io.EOF at the end of the log.
+// Blank lines are skipped.
+func (r *Reader) Read() (*Record, error) {
+	for r.s.Scan() {
+		r.line++
+		if strings.TrimSpace(r.s.Text()) == "" {
+			continue
+		}
+		rec, err := Parse(r.s.Text())
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.line, err)
+		}
+		return rec, nil
+	}
+	if err := r.s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
@@ -0,0 +1,90 @@
+package logparse
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func ExampleParse() {
+	r, err := Parse("2024-07-01T09:12:03.116104Z 0 [System] [MY-010931] [Server] /usr/sbin/mysqld: ready for connections.")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%d %q %s %s: %s\n", r.Code, r.Name, r.Severity, r.Subsystem, r.Message)
+	// Output:
+	// 10931 "" System Server: /usr/sbin/mysqld: ready for connections.
+}
+
+func TestParse(t *testing.T) {
+	at := time.Date(2024, 7, 1, 9, 12, 3, 116104000, time.UTC)
+	tests := []struct {
+		line string
+		want Record
+	}{
+		{
+			"2024-07-01T09:12:03.116104Z 12 [Warning] [MY-001213] [InnoDB] Deadlock found when trying to get lock\n",
+			Record{Time: at, Thread: 12, Severity: "Warning", Code: 1213, Name: "ER_LOCK_DEADLOCK", Subsystem: "InnoDB", Message: "Deadlock found when trying to get lock"},
+		},
+		{
+			// Servers before 8.0.14 print no subsystem.
+			"2024-07-01T09:12:03.116104Z 0 [Note] [MY-010931] /usr/sbin/mysqld: ready for connections.",
+			Record{Time: at, Severity: "Note", Code: 10931, Message: "/usr/sbin/mysqld: ready for connections."},
+		},
+		{
+			`{"time": "2024-07-01T09:12:03.116104Z", "thread": 0, "label": "System", "err_code": 10931, "err_symbol": "ER_SERVER_STARTUP_MSG", "subsystem": "Server", "msg": "ready for connections."}`,
+			Record{Time: at, Severity: "System", Code: 10931, Name: "ER_SERVER_STARTUP_MSG", Subsystem: "Server", Message: "ready for connections."},
+		},
+		{
+			`{"time": "2024-07-01T09:12:03.116104Z", "thread": 7, "label": "Error", "err_code": 1045, "subsystem": "Server", "msg": "Access denied"}`,
+			Record{Time: at, Thread: 7, Severity: "Error", Code: 1045, Name: "ER_ACCESS_DENIED_ERROR", Subsystem: "Server", Message: "Access denied"},
+		},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.line)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.line, err)
+			continue
+		}
+		if !reflect.DeepEqual(*got, tt.want) {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.line, *got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, line := range []string{
+		"mysqld: ready for connections.",
+		"2024-07-01T09:12:03Z 0 [System] [MY-10931] [Server] short code",
+		"yesterday 0 [System] [MY-010931] [Server] bad time",
+		`{"time": "2024-07-01T09:12:03Z", "thread": "main"}`,
+		`{"time": "yesterday"}`,
+	} {
+		if r, err := Parse(line); err == nil {
+			t.Errorf("Parse(%q) = %+v, want an error", line, r)
+		}
+	}
+}
+
+func TestReader(t *testing.T) {
+	log := "2024-07-01T09:12:03Z 0 [System] [MY-010931] [Server] ready\n\n2024-07-01T09:12:04Z 1 [Warning] [MY-001213] [InnoDB] deadlock\ngarbage\n"
+	r := NewReader(strings.NewReader(log))
+	for _, want := range []int{10931, 1213} {
+		rec, err := r.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rec.Code != want {
+			t.Errorf("Code = %d, want %d", rec.Code, want)
+		}
+	}
+	if _, err := r.Read(); err == nil || !strings.Contains(err.Error(), "line 4") {
+		t.Errorf("Read of garbage: %v, want an error on line 4", err)
+	}
+	if _, err := NewReader(strings.NewReader("\n")).Read(); err != io.EOF {
+		t.Errorf("Read of an empty log: %v, want io.EOF", err)
+	}
+}
@@ -0,0 +1,58 @@
+package mysqlerr
+
+import (
+	"fmt"
+	"strings"
+)
+
+type messageInfo struct {
+	sqlState string
+	message  string
+}
+
+// DefaultSQLState is the SQLSTATE of the errors that do not define one.
+const DefaultSQLState = "HY000"
+
+// Message returns the English message template of code.
+func Message(code int) (string, bool) {
+	if m, ok := messages[code]; ok {
+		return m.message, true
+	}
+	e, ok := customErrors[code]
+	return e.message, ok
+}
+
+// SQLStateOf returns the SQLSTATE sent with the errors with code,
+// or DefaultSQLState if the catalog does not know it.
+func SQLStateOf(code int) string {
+	if state, ok := catalogSQLState(code); ok {
+		return state
+	}
+	return DefaultSQLState
+}
+
+// catalogSQLState returns the SQLSTATE the catalog or a custom error has for code,
+// and reports false if neither knows the code.
+func catalogSQLState(code int) (string, bool) {
+	if m, ok := messages[code]; ok {
+		return m.sqlState, true
+	}
+	if e, ok := customErrors[code]; ok {
+		return e.sqlState, true
+	}
+	return "", false
+}
+
+// NewError returns the error a server sends for code, with its message rendered from args.
+// If the catalog does not know code, the SQLSTATE is DefaultSQLState and the message is the args separated by spaces.
+func NewError(code int, args ...interface{}) *Error {
+	e := &Error{Number: uint16(code), SQLState: SQLStateOf(code)}
+	if m, ok := Message(code); ok {
+		if t, err := CompileTemplate(m); err == nil {
+			e.Message = t.Format(args...)
+			return e
+		}
+	}
+	e.Message = strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+	return e
+}
@@ -0,0 +1,55 @@
+package mysqlerr
+
+// This file is a hand-written seed of the most common errors until go generate
+// replaces it with the table of messages_to_clients.txt.
+
+var messages = map[int]messageInfo{
+	ER_DISK_FULL:                             {"HY000", "Disk full (%s); waiting for someone to free some space... (errno: %d - %s)"},
+	ER_OUTOFMEMORY:                           {"HY001", "Out of memory; restart server and try again (needed %d bytes)"},
+	ER_CON_COUNT_ERROR:                       {"08004", "Too many connections"},
+	ER_OUT_OF_RESOURCES:                      {"HY000", "Out of memory; check if mysqld or some other process uses all available memory; if not, you may have to use 'ulimit' to allow mysqld to use more memory or you can add more swap space"},
+	ER_DBACCESS_DENIED_ERROR:                 {"42000", "Access denied for user '%-.48s'@'%-.64s' to database '%-.192s'"},
+	ER_ACCESS_DENIED_ERROR:                   {"28000", "Access denied for user '%-.48s'@'%-.64s' (using password: %s)"},
+	ER_NO_DB_ERROR:                           {"3D000", "No database selected"},
+	ER_UNKNOWN_COM_ERROR:                     {"08S01", "Unknown command"},
+	ER_BAD_NULL_ERROR:                        {"23000", "Column '%-.192s' cannot be null"},
+	ER_BAD_DB_ERROR:                          {"42000", "Unknown database '%-.192s'"},
+	ER_TABLE_EXISTS_ERROR:                    {"42S01", "Table '%-.192s' already exists"},
+	ER_BAD_TABLE_ERROR:                       {"42S02", "Unknown table '%-.129s'"},
+	ER_SERVER_SHUTDOWN:                       {"08S01", "Server shutdown in progress"},
+	ER_BAD_FIELD_ERROR:                       {"42S22", "Unknown column '%-.192s' in '%-.192s'"},
+	ER_DUP_FIELDNAME:                         {"42S21", "Duplicate column name '%-.192s'"},
+	ER_DUP_KEYNAME:                           {"42000", "Duplicate key name '%-.192s'"},
+	ER_DUP_ENTRY:                             {"23000", "Duplicate entry '%-.192s' for key '%-.192s'"},
+	ER_PARSE_ERROR:                           {"42000", "%s near '%-.80s' at line %d"},
+	ER_CANT_DROP_FIELD_OR_KEY:                {"42000", "Can't DROP '%-.192s'; check that column/key exists"},
+	ER_UNKNOWN_ERROR:                         {"HY000", "Unknown error"},
+	ER_RECORD_FILE_FULL:                      {"HY000", "The table '%-.192s' is full"},
+	ER_TABLEACCESS_DENIED_ERROR:              {"42000", "%-.32s command denied to user '%-.48s'@'%-.64s' for table '%-.192s'"},
+	ER_COLUMNACCESS_DENIED_ERROR:             {"42000", "%-.32s command denied to user '%-.48s'@'%-.64s' for column '%-.192s' in table '%-.192s'"},
+	ER_NO_SUCH_TABLE:                         {"42S02", "Table '%-.192s.%-.192s' doesn't exist"},
+	ER_SYNTAX_ERROR:                          {"42000", "You have an error in your SQL syntax; check the manual that corresponds to your MySQL server version for the right syntax to use"},
+	ER_NET_PACKET_TOO_LARGE:                  {"08S01", "Got a packet bigger than 'max_allowed_packet' bytes"},
+	ER_NET_READ_ERROR:                        {"08S01", "Got an error reading communication packets"},
+	ER_NET_READ_INTERRUPTED:                  {"08S01", "Got timeout reading communication packets"},
+	ER_TOO_MANY_USER_CONNECTIONS:             {"42000", "User %-.64s already has more than 'max_user_connections' active connections"},
+	ER_LOCK_WAIT_TIMEOUT:                     {"HY000", "Lock wait timeout exceeded; try restarting transaction"},
+	ER_LOCK_DEADLOCK:                         {"40001", "Deadlock found when trying to get lock; try restarting transaction"},
+	ER_SPECIFIC_ACCESS_DENIED_ERROR:          {"42000", "Access denied; you need (at least one of) the %-.128s privilege(s) for this operation"},
+	ER_COLLATION_CHARSET_MISMATCH:            {"42000", "COLLATION '%s' is not valid for CHARACTER SET '%s'"},
+	ER_WARN_DATA_OUT_OF_RANGE:                {"22003", "Out of range value for column '%s' at row %ld"},
+	WARN_DATA_TRUNCATED:                      {"01000", "Data truncated for column '%s' at row %ld"},
+	ER_CANT_AGGREGATE_2COLLATIONS:            {"HY000", "Illegal mix of collations (%s,%s) and (%s,%s) for operation '%s'"},
+	ER_OPTION_PREVENTS_STATEMENT:             {"HY000", "The MySQL server is running with the %s option so it cannot execute this statement"},
+	ER_TRUNCATED_WRONG_VALUE:                 {"22007", "Truncated incorrect %-.32s value: '%-.128s'"},
+	ER_QUERY_INTERRUPTED:                     {"70100", "Query execution was interrupted"},
+	ER_TRUNCATED_WRONG_VALUE_FOR_FIELD:       {"HY000", "Incorrect %-.32s value: '%-.128s' for column '%.192s' at row %ld"},
+	ER_DATA_TOO_LONG:                         {"22001", "Data too long for column '%s' at row %ld"},
+	ER_ROW_IS_REFERENCED_2:                   {"23000", "Cannot delete or update a parent row: a foreign key constraint fails (%.192s)"},
+	ER_NO_REFERENCED_ROW_2:                   {"23000", "Cannot add or update a child row: a foreign key constraint fails (%.192s)"},
+	ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION: {"25006", "Cannot execute statement in a READ ONLY transaction."},
+	ER_READ_ONLY_MODE:                        {"HY000", "Running in read-only mode"},
+	ER_QUERY_TIMEOUT:                         {"HY000", "Query execution was interrupted, maximum statement execution time exceeded"},
+	ER_TRANSACTION_ROLLBACK_DURING_COMMIT:    {"HY000", "Plugin instructed the server to rollback the current transaction."},
+	ER_LOCK_NOWAIT:                           {"HY000", "Statement aborted because lock(s) could not be acquired immediately and NOWAIT is set."},
+}
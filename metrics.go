@@ -0,0 +1,29 @@
+package mysqlerr
+
+import "strconv"
+
+// MetricLabelNames are the label names of MetricLabels, in the order of MetricLabelValues:
+//
+//	errors := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "mysql_errors_total"}, mysqlerr.MetricLabelNames)
+//	errors.WithLabelValues(mysqlerr.MetricLabelValues(err)...).Inc()
+var MetricLabelNames = []string{"code", "category", "retryable"}
+
+// MetricLabels returns the labels describing err for a metric: the name of its MySQL error code,
+// its category and whether it is retryable. The code is empty if err carries no MySQL error.
+func MetricLabels(err error) map[string]string {
+	values := MetricLabelValues(err)
+	labels := make(map[string]string, len(values))
+	for i, name := range MetricLabelNames {
+		labels[name] = values[i]
+	}
+	return labels
+}
+
+// MetricLabelValues returns the values of MetricLabels in the order of MetricLabelNames.
+func MetricLabelValues(err error) []string {
+	var code string
+	if e, ok := FromError(err); ok {
+		code, _ = Name(int(e.Number))
+	}
+	return []string{code, string(CategoryOf(err)), strconv.FormatBool(IsRetryable(err))}
+}
@@ -1,15 +1,17 @@
 // Code generated mysqlerrgen DO NOT EDIT.
-// Copyright 2021-2023 Nao Yonashiro 
-// 
+// Copyright 2021-2023 Nao Yonashiro
+//
 // Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated documentation files (the “Software”), to deal in the Software without restriction, including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so, subject to the following conditions:
-// 
+//
 // The above copyright notice and this permission notice shall be included in all copies or substantial portions of the Software.
 //
 // THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
 package mysqlerr80
+
 // Deprecated: should not be used
 const ER_HASHCHK = 1000
 const OBSOLETE_ER_HASHCHK = 1000
+
 // Deprecated: should not be used
 const ER_NISAMCHK = 1001
 const OBSOLETE_ER_NISAMCHK = 1001
@@ -20,15 +22,18 @@ const ER_CANT_CREATE_TABLE = 1005
 const ER_CANT_CREATE_DB = 1006
 const ER_DB_CREATE_EXISTS = 1007
 const ER_DB_DROP_EXISTS = 1008
+
 // Deprecated: should not be used
 const ER_DB_DROP_DELETE = 1009
 const OBSOLETE_ER_DB_DROP_DELETE = 1009
 const ER_DB_DROP_RMDIR = 1010
+
 // Deprecated: should not be used
 const ER_CANT_DELETE_FILE = 1011
 const OBSOLETE_ER_CANT_DELETE_FILE = 1011
 const ER_CANT_FIND_SYSTEM_REC = 1012
 const ER_CANT_GET_STAT = 1013
+
 // Deprecated: should not be used
 const ER_CANT_GET_WD = 1014
 const OBSOLETE_ER_CANT_GET_WD = 1014
@@ -36,14 +41,17 @@ const ER_CANT_LOCK = 1015
 const ER_CANT_OPEN_FILE = 1016
 const ER_FILE_NOT_FOUND = 1017
 const ER_CANT_READ_DIR = 1018
+
 // Deprecated: should not be used
 const ER_CANT_SET_WD = 1019
 const OBSOLETE_ER_CANT_SET_WD = 1019
 const ER_CHECKREAD = 1020
+
 // Deprecated: should not be used
 const ER_DISK_FULL = 1021
 const OBSOLETE_ER_DISK_FULL = 1021
 const ER_DUP_KEY = 1022
+
 // Deprecated: should not be used
 const ER_ERROR_ON_CLOSE = 1023
 const OBSOLETE_ER_ERROR_ON_CLOSE = 1023
@@ -51,9 +59,11 @@ const ER_ERROR_ON_READ = 1024
 const ER_ERROR_ON_RENAME = 1025
 const ER_ERROR_ON_WRITE = 1026
 const ER_FILE_USED = 1027
+
 // Deprecated: should not be used
 const ER_FILSORT_ABORT = 1028
 const OBSOLETE_ER_FILSORT_ABORT = 1028
+
 // Deprecated: should not be used
 const ER_FORM_NOT_FOUND = 1029
 const OBSOLETE_ER_FORM_NOT_FOUND = 1029
@@ -66,6 +76,7 @@ const ER_OLD_KEYFILE = 1035
 const ER_OPEN_AS_READONLY = 1036
 const ER_OUTOFMEMORY = 1037
 const ER_OUT_OF_SORTMEMORY = 1038
+
 // Deprecated: should not be used
 const ER_UNEXPECTED_EOF = 1039
 const OBSOLETE_ER_UNEXPECTED_EOF = 1039
@@ -106,9 +117,11 @@ const ER_BLOB_USED_AS_KEY = 1073
 const ER_TOO_BIG_FIELDLENGTH = 1074
 const ER_WRONG_AUTO_KEY = 1075
 const ER_READY = 1076
+
 // Deprecated: should not be used
 const ER_NORMAL_SHUTDOWN = 1077
 const OBSOLETE_ER_NORMAL_SHUTDOWN = 1077
+
 // Deprecated: should not be used
 const ER_GOT_SIGNAL = 1078
 const OBSOLETE_ER_GOT_SIGNAL = 1078
@@ -183,9 +196,11 @@ const ER_NO_SUCH_TABLE = 1146
 const ER_NONEXISTING_TABLE_GRANT = 1147
 const ER_NOT_ALLOWED_COMMAND = 1148
 const ER_SYNTAX_ERROR = 1149
+
 // Deprecated: should not be used
 const ER_UNUSED1 = 1150
 const OBSOLETE_ER_UNUSED1 = 1150
+
 // Deprecated: should not be used
 const ER_UNUSED2 = 1151
 const OBSOLETE_ER_UNUSED2 = 1151
@@ -202,6 +217,7 @@ const ER_NET_WRITE_INTERRUPTED = 1161
 const ER_TOO_LONG_STRING = 1162
 const ER_TABLE_CANT_HANDLE_BLOB = 1163
 const ER_TABLE_CANT_HANDLE_AUTO_INCREMENT = 1164
+
 // Deprecated: should not be used
 const ER_UNUSED3 = 1165
 const OBSOLETE_ER_UNUSED3 = 1165
@@ -213,6 +229,7 @@ const ER_BLOB_KEY_WITHOUT_LENGTH = 1170
 const ER_PRIMARY_CANT_HAVE_NULL = 1171
 const ER_TOO_MANY_ROWS = 1172
 const ER_REQUIRES_PRIMARY_KEY = 1173
+
 // Deprecated: should not be used
 const ER_NO_RAID_COMPILED = 1174
 const OBSOLETE_ER_NO_RAID_COMPILED = 1174
@@ -224,25 +241,32 @@ const ER_CANT_DO_THIS_DURING_AN_TRANSACTION = 1179
 const ER_ERROR_DURING_COMMIT = 1180
 const ER_ERROR_DURING_ROLLBACK = 1181
 const ER_ERROR_DURING_FLUSH_LOGS = 1182
+
 // Deprecated: should not be used
 const ER_ERROR_DURING_CHECKPOINT = 1183
 const OBSOLETE_ER_ERROR_DURING_CHECKPOINT = 1183
 const ER_NEW_ABORTING_CONNECTION = 1184
+
 // Deprecated: should not be used
 const ER_DUMP_NOT_IMPLEMENTED = 1185
 const OBSOLETE_ER_DUMP_NOT_IMPLEMENTED = 1185
+
 // Deprecated: should not be used
 const ER_FLUSH_MASTER_BINLOG_CLOSED = 1186
 const OBSOLETE_ER_FLUSH_MASTER_BINLOG_CLOSED = 1186
+
 // Deprecated: should not be used
 const ER_INDEX_REBUILD = 1187
 const OBSOLETE_ER_INDEX_REBUILD = 1187
+
 // Deprecated: should not be used
 const ER_MASTER = 1188
 const ER_SOURCE = 1188
+
 // Deprecated: should not be used
 const ER_MASTER_NET_READ = 1189
 const ER_SOURCE_NET_READ = 1189
+
 // Deprecated: should not be used
 const ER_MASTER_NET_WRITE = 1190
 const ER_SOURCE_NET_WRITE = 1190
@@ -253,18 +277,23 @@ const ER_CRASHED_ON_USAGE = 1194
 const ER_CRASHED_ON_REPAIR = 1195
 const ER_WARNING_NOT_COMPLETE_ROLLBACK = 1196
 const ER_TRANS_CACHE_FULL = 1197
+
 // Deprecated: should not be used
 const ER_SLAVE_MUST_STOP = 1198
 const OBSOLETE_ER_SLAVE_MUST_STOP = 1198
+
 // Deprecated: should not be used
 const ER_SLAVE_NOT_RUNNING = 1199
 const ER_REPLICA_NOT_RUNNING = 1199
+
 // Deprecated: should not be used
 const ER_BAD_SLAVE = 1200
 const ER_BAD_REPLICA = 1200
+
 // Deprecated: should not be used
 const ER_MASTER_INFO = 1201
 const ER_CONNECTION_METADATA = 1201
+
 // Deprecated: should not be used
 const ER_SLAVE_THREAD = 1202
 const ER_REPLICA_THREAD = 1202
@@ -273,14 +302,17 @@ const ER_SET_CONSTANTS_ONLY = 1204
 const ER_LOCK_WAIT_TIMEOUT = 1205
 const ER_LOCK_TABLE_FULL = 1206
 const ER_READ_ONLY_TRANSACTION = 1207
+
 // Deprecated: should not be used
 const ER_DROP_DB_WITH_READ_LOCK = 1208
 const OBSOLETE_ER_DROP_DB_WITH_READ_LOCK = 1208
+
 // Deprecated: should not be used
 const ER_CREATE_DB_WITH_READ_LOCK = 1209
 const OBSOLETE_ER_CREATE_DB_WITH_READ_LOCK = 1209
 const ER_WRONG_ARGUMENTS = 1210
 const ER_NO_PERMISSION_TO_CREATE_USER = 1211
+
 // Deprecated: should not be used
 const ER_UNION_TABLES_IN_DIFFERENT_DIR = 1212
 const OBSOLETE_ER_UNION_TABLES_IN_DIFFERENT_DIR = 1212
@@ -289,9 +321,11 @@ const ER_TABLE_CANT_HANDLE_FT = 1214
 const ER_CANNOT_ADD_FOREIGN = 1215
 const ER_NO_REFERENCED_ROW = 1216
 const ER_ROW_IS_REFERENCED = 1217
+
 // Deprecated: should not be used
 const ER_CONNECT_TO_MASTER = 1218
 const ER_CONNECT_TO_SOURCE = 1218
+
 // Deprecated: should not be used
 const ER_QUERY_ON_MASTER = 1219
 const OBSOLETE_ER_QUERY_ON_MASTER = 1219
@@ -311,9 +345,11 @@ const ER_WRONG_TYPE_FOR_VAR = 1232
 const ER_VAR_CANT_BE_READ = 1233
 const ER_CANT_USE_OPTION_HERE = 1234
 const ER_NOT_SUPPORTED_YET = 1235
+
 // Deprecated: should not be used
 const ER_MASTER_FATAL_ERROR_READING_BINLOG = 1236
 const ER_SOURCE_FATAL_ERROR_READING_BINLOG = 1236
+
 // Deprecated: should not be used
 const ER_SLAVE_IGNORED_TABLE = 1237
 const ER_REPLICA_IGNORED_TABLE = 1237
@@ -324,6 +360,7 @@ const ER_OPERAND_COLUMNS = 1241
 const ER_SUBQUERY_NO_1_ROW = 1242
 const ER_UNKNOWN_STMT_HANDLER = 1243
 const ER_CORRUPT_HELP_DB = 1244
+
 // Deprecated: should not be used
 const ER_CYCLIC_REFERENCE = 1245
 const OBSOLETE_ER_CYCLIC_REFERENCE = 1245
@@ -335,9 +372,11 @@ const ER_TABLENAME_NOT_ALLOWED_HERE = 1250
 const ER_NOT_SUPPORTED_AUTH_MODE = 1251
 const ER_SPATIAL_CANT_HAVE_NULL = 1252
 const ER_COLLATION_CHARSET_MISMATCH = 1253
+
 // Deprecated: should not be used
 const ER_SLAVE_WAS_RUNNING = 1254
 const OBSOLETE_ER_SLAVE_WAS_RUNNING = 1254
+
 // Deprecated: should not be used
 const ER_SLAVE_WAS_NOT_RUNNING = 1255
 const OBSOLETE_ER_SLAVE_WAS_NOT_RUNNING = 1255
@@ -353,6 +392,7 @@ const ER_WARN_DATA_OUT_OF_RANGE = 1264
 const WARN_DATA_TRUNCATED = 1265
 const ER_WARN_USING_OTHER_HANDLER = 1266
 const ER_CANT_AGGREGATE_2COLLATIONS = 1267
+
 // Deprecated: should not be used
 const ER_DROP_USER = 1268
 const OBSOLETE_ER_DROP_USER = 1268
@@ -361,22 +401,27 @@ const ER_CANT_AGGREGATE_3COLLATIONS = 1270
 const ER_CANT_AGGREGATE_NCOLLATIONS = 1271
 const ER_VARIABLE_IS_NOT_STRUCT = 1272
 const ER_UNKNOWN_COLLATION = 1273
+
 // Deprecated: should not be used
 const ER_SLAVE_IGNORED_SSL_PARAMS = 1274
 const ER_REPLICA_IGNORED_SSL_PARAMS = 1274
+
 // Deprecated: should not be used
 const ER_SERVER_IS_IN_SECURE_AUTH_MODE = 1275
 const OBSOLETE_ER_SERVER_IS_IN_SECURE_AUTH_MODE = 1275
 const ER_WARN_FIELD_RESOLVED = 1276
+
 // Deprecated: should not be used
 const ER_BAD_SLAVE_UNTIL_COND = 1277
 const ER_BAD_REPLICA_UNTIL_COND = 1277
+
 // Deprecated: should not be used
 const ER_MISSING_SKIP_SLAVE = 1278
 const ER_MISSING_SKIP_REPLICA = 1278
 const ER_UNTIL_COND_IGNORED = 1279
 const ER_WRONG_NAME_FOR_INDEX = 1280
 const ER_WRONG_NAME_FOR_CATALOG = 1281
+
 // Deprecated: should not be used
 const ER_WARN_QC_RESIZE = 1282
 const OBSOLETE_ER_WARN_QC_RESIZE = 1282
@@ -390,6 +435,7 @@ const ER_FEATURE_DISABLED = 1289
 const ER_OPTION_PREVENTS_STATEMENT = 1290
 const ER_DUPLICATED_VALUE_IN_TYPE = 1291
 const ER_TRUNCATED_WRONG_VALUE = 1292
+
 // Deprecated: should not be used
 const ER_TOO_MUCH_AUTO_TIMESTAMP_COLS = 1293
 const OBSOLETE_ER_TOO_MUCH_AUTO_TIMESTAMP_COLS = 1293
@@ -445,11 +491,13 @@ const ER_FPARSER_EOF_IN_COMMENT = 1342
 const ER_FPARSER_ERROR_IN_PARAMETER = 1343
 const ER_FPARSER_EOF_IN_UNKNOWN_PARAMETER = 1344
 const ER_VIEW_NO_EXPLAIN = 1345
+
 // Deprecated: should not be used
 const ER_FRM_UNKNOWN_TYPE = 1346
 const OBSOLETE_ER_FRM_UNKNOWN_TYPE = 1346
 const ER_WRONG_OBJECT = 1347
 const ER_NONUPDATEABLE_COLUMN = 1348
+
 // Deprecated: should not be used
 const ER_VIEW_SELECT_DERIVED_UNUSED = 1349
 const OBSOLETE_ER_VIEW_SELECT_DERIVED_UNUSED = 1349
@@ -461,6 +509,7 @@ const ER_WARN_VIEW_MERGE = 1354
 const ER_WARN_VIEW_WITHOUT_KEY = 1355
 const ER_VIEW_INVALID = 1356
 const ER_SP_NO_DROP_SP = 1357
+
 // Deprecated: should not be used
 const ER_SP_GOTO_IN_HNDLR = 1358
 const OBSOLETE_ER_SP_GOTO_IN_HNDLR = 1358
@@ -477,6 +526,7 @@ const ER_VIEW_NONUPD_CHECK = 1368
 const ER_VIEW_CHECK_FAILED = 1369
 const ER_PROCACCESS_DENIED_ERROR = 1370
 const ER_RELAY_LOG_FAIL = 1371
+
 // Deprecated: should not be used
 const ER_PASSWD_LENGTH = 1372
 const OBSOLETE_ER_PASSWD_LENGTH = 1372
@@ -490,24 +540,31 @@ const ER_LOG_PURGE_UNKNOWN_ERR = 1379
 const ER_RELAY_LOG_INIT = 1380
 const ER_NO_BINARY_LOGGING = 1381
 const ER_RESERVED_SYNTAX = 1382
+
 // Deprecated: should not be used
 const ER_WSAS_FAILED = 1383
 const OBSOLETE_ER_WSAS_FAILED = 1383
+
 // Deprecated: should not be used
 const ER_DIFF_GROUPS_PROC = 1384
 const OBSOLETE_ER_DIFF_GROUPS_PROC = 1384
+
 // Deprecated: should not be used
 const ER_NO_GROUP_FOR_PROC = 1385
 const OBSOLETE_ER_NO_GROUP_FOR_PROC = 1385
+
 // Deprecated: should not be used
 const ER_ORDER_WITH_PROC = 1386
 const OBSOLETE_ER_ORDER_WITH_PROC = 1386
+
 // Deprecated: should not be used
 const ER_LOGGING_PROHIBIT_CHANGING_OF = 1387
 const OBSOLETE_ER_LOGGING_PROHIBIT_CHANGING_OF = 1387
+
 // Deprecated: should not be used
 const ER_NO_FILE_MAPPING = 1388
 const OBSOLETE_ER_NO_FILE_MAPPING = 1388
+
 // Deprecated: should not be used
 const ER_WRONG_MAGIC = 1389
 const OBSOLETE_ER_WRONG_MAGIC = 1389
@@ -538,11 +595,13 @@ const ER_SP_DUP_HANDLER = 1413
 const ER_SP_NOT_VAR_ARG = 1414
 const ER_SP_NO_RETSET = 1415
 const ER_CANT_CREATE_GEOMETRY_OBJECT = 1416
+
 // Deprecated: should not be used
 const ER_FAILED_ROUTINE_BREAK_BINLOG = 1417
 const OBSOLETE_ER_FAILED_ROUTINE_BREAK_BINLOG = 1417
 const ER_BINLOG_UNSAFE_ROUTINE = 1418
 const ER_BINLOG_CREATE_ROUTINE_NEED_SUPER = 1419
+
 // Deprecated: should not be used
 const ER_EXEC_STMT_WITH_OPEN_CURSOR = 1420
 const OBSOLETE_ER_EXEC_STMT_WITH_OPEN_CURSOR = 1420
@@ -559,6 +618,7 @@ const ER_QUERY_ON_FOREIGN_DATA_SOURCE = 1430
 const ER_FOREIGN_DATA_SOURCE_DOESNT_EXIST = 1431
 const ER_FOREIGN_DATA_STRING_INVALID_CANT_CREATE = 1432
 const ER_FOREIGN_DATA_STRING_INVALID = 1433
+
 // Deprecated: should not be used
 const ER_CANT_CREATE_FEDERATED_TABLE = 1434
 const OBSOLETE_ER_CANT_CREATE_FEDERATED_TABLE = 1434
@@ -573,6 +633,7 @@ const ER_CANT_UPDATE_USED_TABLE_IN_SF_OR_TRG = 1442
 const ER_VIEW_PREVENT_UPDATE = 1443
 const ER_PS_NO_RECURSION = 1444
 const ER_SP_CANT_SET_AUTOCOMMIT = 1445
+
 // Deprecated: should not be used
 const ER_MALFORMED_DEFINER = 1446
 const OBSOLETE_ER_MALFORMED_DEFINER = 1446
@@ -586,6 +647,7 @@ const ER_SP_BAD_VAR_SHADOW = 1453
 const ER_TRG_NO_DEFINER = 1454
 const ER_OLD_FILE_FORMAT = 1455
 const ER_SP_RECURSION_LIMIT = 1456
+
 // Deprecated: should not be used
 const ER_SP_PROC_TABLE_CORRUPT = 1457
 const OBSOLETE_ER_SP_PROC_TABLE_CORRUPT = 1457
@@ -613,19 +675,23 @@ const ER_ILLEGAL_HA_CREATE_OPTION = 1478
 const ER_PARTITION_REQUIRES_VALUES_ERROR = 1479
 const ER_PARTITION_WRONG_VALUES_ERROR = 1480
 const ER_PARTITION_MAXVALUE_ERROR = 1481
+
 // Deprecated: should not be used
 const ER_PARTITION_SUBPARTITION_ERROR = 1482
 const OBSOLETE_ER_PARTITION_SUBPARTITION_ERROR = 1482
+
 // Deprecated: should not be used
 const ER_PARTITION_SUBPART_MIX_ERROR = 1483
 const OBSOLETE_ER_PARTITION_SUBPART_MIX_ERROR = 1483
 const ER_PARTITION_WRONG_NO_PART_ERROR = 1484
 const ER_PARTITION_WRONG_NO_SUBPART_ERROR = 1485
 const ER_WRONG_EXPR_IN_PARTITION_FUNC_ERROR = 1486
+
 // Deprecated: should not be used
 const ER_NO_CONST_EXPR_IN_RANGE_OR_LIST_ERROR = 1487
 const OBSOLETE_ER_NO_CONST_EXPR_IN_RANGE_OR_LIST_ERROR = 1487
 const ER_FIELD_NOT_FOUND_PART_ERROR = 1488
+
 // Deprecated: should not be used
 const ER_LIST_OF_FIELDS_ONLY_IN_HASH_ERROR = 1489
 const OBSOLETE_ER_LIST_OF_FIELDS_ONLY_IN_HASH_ERROR = 1489
@@ -661,6 +727,7 @@ const ER_NO_BINLOG_ERROR = 1518
 const ER_CONSECUTIVE_REORG_PARTITIONS = 1519
 const ER_REORG_OUTSIDE_RANGE = 1520
 const ER_PARTITION_FUNCTION_FAILURE = 1521
+
 // Deprecated: should not be used
 const ER_PART_STATE_ERROR = 1522
 const OBSOLETE_ER_PART_STATE_ERROR = 1522
@@ -676,66 +743,82 @@ const ER_WRONG_SIZE_NUMBER = 1531
 const ER_SIZE_OVERFLOW_ERROR = 1532
 const ER_ALTER_FILEGROUP_FAILED = 1533
 const ER_BINLOG_ROW_LOGGING_FAILED = 1534
+
 // Deprecated: should not be used
 const ER_BINLOG_ROW_WRONG_TABLE_DEF = 1535
 const OBSOLETE_ER_BINLOG_ROW_WRONG_TABLE_DEF = 1535
+
 // Deprecated: should not be used
 const ER_BINLOG_ROW_RBR_TO_SBR = 1536
 const OBSOLETE_ER_BINLOG_ROW_RBR_TO_SBR = 1536
 const ER_EVENT_ALREADY_EXISTS = 1537
+
 // Deprecated: should not be used
 const ER_EVENT_STORE_FAILED = 1538
 const OBSOLETE_ER_EVENT_STORE_FAILED = 1538
 const ER_EVENT_DOES_NOT_EXIST = 1539
+
 // Deprecated: should not be used
 const ER_EVENT_CANT_ALTER = 1540
 const OBSOLETE_ER_EVENT_CANT_ALTER = 1540
+
 // Deprecated: should not be used
 const ER_EVENT_DROP_FAILED = 1541
 const OBSOLETE_ER_EVENT_DROP_FAILED = 1541
 const ER_EVENT_INTERVAL_NOT_POSITIVE_OR_TOO_BIG = 1542
 const ER_EVENT_ENDS_BEFORE_STARTS = 1543
 const ER_EVENT_EXEC_TIME_IN_THE_PAST = 1544
+
 // Deprecated: should not be used
 const ER_EVENT_OPEN_TABLE_FAILED = 1545
 const OBSOLETE_ER_EVENT_OPEN_TABLE_FAILED = 1545
+
 // Deprecated: should not be used
 const ER_EVENT_NEITHER_M_EXPR_NOR_M_AT = 1546
 const OBSOLETE_ER_EVENT_NEITHER_M_EXPR_NOR_M_AT = 1546
+
 // Deprecated: should not be used
 const ER_COL_COUNT_DOESNT_MATCH_CORRUPTED = 1547
 const OBSOLETE_ER_COL_COUNT_DOESNT_MATCH_CORRUPTED = 1547
+
 // Deprecated: should not be used
 const ER_CANNOT_LOAD_FROM_TABLE = 1548
 const OBSOLETE_ER_CANNOT_LOAD_FROM_TABLE = 1548
+
 // Deprecated: should not be used
 const ER_EVENT_CANNOT_DELETE = 1549
 const OBSOLETE_ER_EVENT_CANNOT_DELETE = 1549
+
 // Deprecated: should not be used
 const ER_EVENT_COMPILE_ERROR = 1550
 const OBSOLETE_ER_EVENT_COMPILE_ERROR = 1550
 const ER_EVENT_SAME_NAME = 1551
+
 // Deprecated: should not be used
 const ER_EVENT_DATA_TOO_LONG = 1552
 const OBSOLETE_ER_EVENT_DATA_TOO_LONG = 1552
 const ER_DROP_INDEX_FK = 1553
 const ER_WARN_DEPRECATED_SYNTAX_WITH_VER = 1554
+
 // Deprecated: should not be used
 const ER_CANT_WRITE_LOCK_LOG_TABLE = 1555
 const OBSOLETE_ER_CANT_WRITE_LOCK_LOG_TABLE = 1555
 const ER_CANT_LOCK_LOG_TABLE = 1556
 const ER_FOREIGN_DUPLICATE_KEY_OLD_UNUSED = 1557
 const ER_COL_COUNT_DOESNT_MATCH_PLEASE_UPDATE = 1558
+
 // Deprecated: should not be used
 const ER_TEMP_TABLE_PREVENTS_SWITCH_OUT_OF_RBR = 1559
 const OBSOLETE_ER_TEMP_TABLE_PREVENTS_SWITCH_OUT_OF_RBR = 1559
 const ER_STORED_FUNCTION_PREVENTS_SWITCH_BINLOG_FORMAT = 1560
+
 // Deprecated: should not be used
 const ER_NDB_CANT_SWITCH_BINLOG_FORMAT = 1561
 const OBSOLETE_ER_NDB_CANT_SWITCH_BINLOG_FORMAT = 1561
 const ER_PARTITION_NO_TEMPORARY = 1562
 const ER_PARTITION_CONST_DOMAIN_ERROR = 1563
 const ER_PARTITION_FUNCTION_IS_NOT_ALLOWED = 1564
+
 // Deprecated: should not be used
 const ER_DDL_LOG_ERROR_UNUSED = 1565
 const OBSOLETE_ER_DDL_LOG_ERROR_UNUSED = 1565
@@ -743,19 +826,23 @@ const ER_NULL_IN_VALUES_LESS_THAN = 1566
 const ER_WRONG_PARTITION_NAME = 1567
 const ER_CANT_CHANGE_TX_CHARACTERISTICS = 1568
 const ER_DUP_ENTRY_AUTOINCREMENT_CASE = 1569
+
 // Deprecated: should not be used
 const ER_EVENT_MODIFY_QUEUE_ERROR = 1570
 const OBSOLETE_ER_EVENT_MODIFY_QUEUE_ERROR = 1570
 const ER_EVENT_SET_VAR_ERROR = 1571
 const ER_PARTITION_MERGE_ERROR = 1572
+
 // Deprecated: should not be used
 const ER_CANT_ACTIVATE_LOG = 1573
 const OBSOLETE_ER_CANT_ACTIVATE_LOG = 1573
+
 // Deprecated: should not be used
 const ER_RBR_NOT_AVAILABLE = 1574
 const OBSOLETE_ER_RBR_NOT_AVAILABLE = 1574
 const ER_BASE64_DECODE_ERROR = 1575
 const ER_EVENT_RECURSION_FORBIDDEN = 1576
+
 // Deprecated: should not be used
 const ER_EVENTS_DB_ERROR = 1577
 const OBSOLETE_ER_EVENTS_DB_ERROR = 1577
@@ -771,27 +858,33 @@ const ER_DUP_ENTRY_WITH_KEY_NAME = 1586
 const ER_BINLOG_PURGE_EMFILE = 1587
 const ER_EVENT_CANNOT_CREATE_IN_THE_PAST = 1588
 const ER_EVENT_CANNOT_ALTER_IN_THE_PAST = 1589
+
 // Deprecated: should not be used
 const ER_SLAVE_INCIDENT = 1590
 const OBSOLETE_ER_SLAVE_INCIDENT = 1590
 const ER_NO_PARTITION_FOR_GIVEN_VALUE_SILENT = 1591
 const ER_BINLOG_UNSAFE_STATEMENT = 1592
 const ER_BINLOG_FATAL_ERROR = 1593
+
 // Deprecated: should not be used
 const ER_SLAVE_RELAY_LOG_READ_FAILURE = 1594
 const OBSOLETE_ER_SLAVE_RELAY_LOG_READ_FAILURE = 1594
+
 // Deprecated: should not be used
 const ER_SLAVE_RELAY_LOG_WRITE_FAILURE = 1595
 const OBSOLETE_ER_SLAVE_RELAY_LOG_WRITE_FAILURE = 1595
+
 // Deprecated: should not be used
 const ER_SLAVE_CREATE_EVENT_FAILURE = 1596
 const OBSOLETE_ER_SLAVE_CREATE_EVENT_FAILURE = 1596
+
 // Deprecated: should not be used
 const ER_SLAVE_MASTER_COM_FAILURE = 1597
 const OBSOLETE_ER_SLAVE_MASTER_COM_FAILURE = 1597
 const ER_BINLOG_LOGGING_IMPOSSIBLE = 1598
 const ER_VIEW_NO_CREATION_CTX = 1599
 const ER_VIEW_INVALID_CREATION_CTX = 1600
+
 // Deprecated: should not be used
 const ER_SR_INVALID_CREATION_CTX = 1601
 const OBSOLETE_ER_SR_INVALID_CREATION_CTX = 1601
@@ -800,16 +893,20 @@ const ER_TRG_NO_CREATION_CTX = 1603
 const ER_TRG_INVALID_CREATION_CTX = 1604
 const ER_EVENT_INVALID_CREATION_CTX = 1605
 const ER_TRG_CANT_OPEN_TABLE = 1606
+
 // Deprecated: should not be used
 const ER_CANT_CREATE_SROUTINE = 1607
 const OBSOLETE_ER_CANT_CREATE_SROUTINE = 1607
+
 // Deprecated: should not be used
 const ER_NEVER_USED = 1608
 const OBSOLETE_ER_NEVER_USED = 1608
 const ER_NO_FORMAT_DESCRIPTION_EVENT_BEFORE_BINLOG_STATEMENT = 1609
+
 // Deprecated: should not be used
 const ER_SLAVE_CORRUPT_EVENT = 1610
 const ER_REPLICA_CORRUPT_EVENT = 1610
+
 // Deprecated: should not be used
 const ER_LOAD_DATA_INVALID_COLUMN_UNUSED = 1611
 const OBSOLETE_ER_LOAD_DATA_INVALID_COLUMN_UNUSED = 1611
@@ -817,9 +914,11 @@ const ER_LOG_PURGE_NO_FILE = 1612
 const ER_XA_RBTIMEOUT = 1613
 const ER_XA_RBDEADLOCK = 1614
 const ER_NEED_REPREPARE = 1615
+
 // Deprecated: should not be used
 const ER_DELAYED_NOT_SUPPORTED = 1616
 const OBSOLETE_ER_DELAYED_NOT_SUPPORTED = 1616
+
 // Deprecated: should not be used
 const WARN_NO_MASTER_INFO = 1617
 const WARN_NO_CONNECTION_METADATA = 1617
@@ -828,9 +927,11 @@ const ER_PLUGIN_DELETE_BUILTIN = 1619
 const WARN_PLUGIN_BUSY = 1620
 const ER_VARIABLE_IS_READONLY = 1621
 const ER_WARN_ENGINE_TRANSACTION_ROLLBACK = 1622
+
 // Deprecated: should not be used
 const ER_SLAVE_HEARTBEAT_FAILURE = 1623
 const OBSOLETE_ER_SLAVE_HEARTBEAT_FAILURE = 1623
+
 // Deprecated: should not be used
 const ER_SLAVE_HEARTBEAT_VALUE_OUT_OF_RANGE = 1624
 const ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE = 1624
@@ -859,9 +960,11 @@ const ER_SIGNAL_BAD_CONDITION_TYPE = 1646
 const WARN_COND_ITEM_TRUNCATED = 1647
 const ER_COND_ITEM_TOO_LONG = 1648
 const ER_UNKNOWN_LOCALE = 1649
+
 // Deprecated: should not be used
 const ER_SLAVE_IGNORE_SERVER_IDS = 1650
 const ER_REPLICA_IGNORE_SERVER_IDS = 1650
+
 // Deprecated: should not be used
 const ER_QUERY_CACHE_DISABLED = 1651
 const OBSOLETE_ER_QUERY_CACHE_DISABLED = 1651
@@ -882,6 +985,7 @@ const ER_BINLOG_STMT_MODE_AND_ROW_ENGINE = 1665
 const ER_BINLOG_ROW_INJECTION_AND_STMT_MODE = 1666
 const ER_BINLOG_MULTIPLE_ENGINES_AND_SELF_LOGGING_ENGINE = 1667
 const ER_BINLOG_UNSAFE_LIMIT = 1668
+
 // Deprecated: should not be used
 const ER_UNUSED4 = 1669
 const OBSOLETE_ER_UNUSED4 = 1669
@@ -892,9 +996,11 @@ const ER_BINLOG_UNSAFE_SYSTEM_VARIABLE = 1673
 const ER_BINLOG_UNSAFE_SYSTEM_FUNCTION = 1674
 const ER_BINLOG_UNSAFE_NONTRANS_AFTER_TRANS = 1675
 const ER_MESSAGE_AND_STATEMENT = 1676
+
 // Deprecated: should not be used
 const ER_SLAVE_CONVERSION_FAILED = 1677
 const OBSOLETE_ER_SLAVE_CONVERSION_FAILED = 1677
+
 // Deprecated: should not be used
 const ER_SLAVE_CANT_CREATE_CONVERSION = 1678
 const ER_REPLICA_CANT_CREATE_CONVERSION = 1678
@@ -910,6 +1016,7 @@ const ER_SPATIAL_MUST_HAVE_GEOM_COL = 1687
 const ER_TOO_LONG_INDEX_COMMENT = 1688
 const ER_LOCK_ABORTED = 1689
 const ER_DATA_OUT_OF_RANGE = 1690
+
 // Deprecated: should not be used
 const ER_WRONG_SPVAR_TYPE_IN_LIMIT = 1691
 const OBSOLETE_ER_WRONG_SPVAR_TYPE_IN_LIMIT = 1691
@@ -920,17 +1027,21 @@ const ER_STORED_FUNCTION_PREVENTS_SWITCH_SQL_LOG_BIN = 1695
 const ER_FAILED_READ_FROM_PAR_FILE = 1696
 const ER_VALUES_IS_NOT_INT_TYPE_ERROR = 1697
 const ER_ACCESS_DENIED_NO_PASSWORD_ERROR = 1698
+
 // Deprecated: should not be used
 const ER_SET_PASSWORD_AUTH_PLUGIN = 1699
 const OBSOLETE_ER_SET_PASSWORD_AUTH_PLUGIN = 1699
+
 // Deprecated: should not be used
 const ER_GRANT_PLUGIN_USER_EXISTS = 1700
 const OBSOLETE_ER_GRANT_PLUGIN_USER_EXISTS = 1700
 const ER_TRUNCATE_ILLEGAL_FK = 1701
 const ER_PLUGIN_IS_PERMANENT = 1702
+
 // Deprecated: should not be used
 const ER_SLAVE_HEARTBEAT_VALUE_OUT_OF_RANGE_MIN = 1703
 const ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MIN = 1703
+
 // Deprecated: should not be used
 const ER_SLAVE_HEARTBEAT_VALUE_OUT_OF_RANGE_MAX = 1704
 const ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MAX = 1704
@@ -958,6 +1069,7 @@ const ER_TABLE_IN_FK_CHECK = 1725
 const ER_UNSUPPORTED_ENGINE = 1726
 const ER_BINLOG_UNSAFE_AUTOINC_NOT_FIRST = 1727
 const ER_CANNOT_LOAD_FROM_TABLE_V2 = 1728
+
 // Deprecated: should not be used
 const ER_MASTER_DELAY_VALUE_OUT_OF_RANGE = 1729
 const ER_SOURCE_DELAY_VALUE_OUT_OF_RANGE = 1729
@@ -972,13 +1084,16 @@ const ER_ROW_DOES_NOT_MATCH_PARTITION = 1737
 const ER_BINLOG_CACHE_SIZE_GREATER_THAN_MAX = 1738
 const ER_WARN_INDEX_NOT_APPLICABLE = 1739
 const ER_PARTITION_EXCHANGE_FOREIGN_KEY = 1740
+
 // Deprecated: should not be used
 const ER_NO_SUCH_KEY_VALUE = 1741
 const OBSOLETE_ER_NO_SUCH_KEY_VALUE = 1741
 const ER_RPL_INFO_DATA_TOO_LONG = 1742
+
 // Deprecated: should not be used
 const ER_NETWORK_READ_EVENT_CHECKSUM_FAILURE = 1743
 const OBSOLETE_ER_NETWORK_READ_EVENT_CHECKSUM_FAILURE = 1743
+
 // Deprecated: should not be used
 const ER_BINLOG_READ_EVENT_CHECKSUM_FAILURE = 1744
 const OBSOLETE_ER_BINLOG_READ_EVENT_CHECKSUM_FAILURE = 1744
@@ -986,46 +1101,56 @@ const ER_BINLOG_STMT_CACHE_SIZE_GREATER_THAN_MAX = 1745
 const ER_CANT_UPDATE_TABLE_IN_CREATE_TABLE_SELECT = 1746
 const ER_PARTITION_CLAUSE_ON_NONPARTITIONED = 1747
 const ER_ROW_DOES_NOT_MATCH_GIVEN_PARTITION_SET = 1748
+
 // Deprecated: should not be used
 const ER_NO_SUCH_PARTITION__UNUSED = 1749
 const OBSOLETE_ER_NO_SUCH_PARTITION__UNUSED = 1749
 const ER_CHANGE_RPL_INFO_REPOSITORY_FAILURE = 1750
 const ER_WARNING_NOT_COMPLETE_ROLLBACK_WITH_CREATED_TEMP_TABLE = 1751
 const ER_WARNING_NOT_COMPLETE_ROLLBACK_WITH_DROPPED_TEMP_TABLE = 1752
+
 // Deprecated: should not be used
 const ER_MTS_FEATURE_IS_NOT_SUPPORTED = 1753
 const ER_MTA_FEATURE_IS_NOT_SUPPORTED = 1753
+
 // Deprecated: should not be used
 const ER_MTS_UPDATED_DBS_GREATER_MAX = 1754
 const ER_MTA_UPDATED_DBS_GREATER_MAX = 1754
+
 // Deprecated: should not be used
 const ER_MTS_CANT_PARALLEL = 1755
 const ER_MTA_CANT_PARALLEL = 1755
+
 // Deprecated: should not be used
 const ER_MTS_INCONSISTENT_DATA = 1756
 const ER_MTA_INCONSISTENT_DATA = 1756
 const ER_FULLTEXT_NOT_SUPPORTED_WITH_PARTITIONING = 1757
 const ER_DA_INVALID_CONDITION_NUMBER = 1758
 const ER_INSECURE_PLAIN_TEXT = 1759
+
 // Deprecated: should not be used
 const ER_INSECURE_CHANGE_MASTER = 1760
 const ER_INSECURE_CHANGE_SOURCE = 1760
 const ER_FOREIGN_DUPLICATE_KEY_WITH_CHILD_INFO = 1761
 const ER_FOREIGN_DUPLICATE_KEY_WITHOUT_CHILD_INFO = 1762
+
 // Deprecated: should not be used
 const ER_SQLTHREAD_WITH_SECURE_SLAVE = 1763
 const ER_SQLTHREAD_WITH_SECURE_REPLICA = 1763
 const ER_TABLE_HAS_NO_FT = 1764
 const ER_VARIABLE_NOT_SETTABLE_IN_SF_OR_TRIGGER = 1765
 const ER_VARIABLE_NOT_SETTABLE_IN_TRANSACTION = 1766
+
 // Deprecated: should not be used
 const ER_GTID_NEXT_IS_NOT_IN_GTID_NEXT_LIST = 1767
 const OBSOLETE_ER_GTID_NEXT_IS_NOT_IN_GTID_NEXT_LIST = 1767
+
 // Deprecated: should not be used
 const ER_CANT_CHANGE_GTID_NEXT_IN_TRANSACTION = 1768
 const OBSOLETE_ER_CANT_CHANGE_GTID_NEXT_IN_TRANSACTION = 1768
 const ER_SET_STATEMENT_CANNOT_INVOKE_FUNCTION = 1769
 const ER_GTID_NEXT_CANT_BE_AUTOMATIC_IF_GTID_NEXT_LIST_IS_NON_NULL = 1770
+
 // Deprecated: should not be used
 const ER_SKIPPING_LOGGED_TRANSACTION = 1771
 const OBSOLETE_ER_SKIPPING_LOGGED_TRANSACTION = 1771
@@ -1033,27 +1158,32 @@ const ER_MALFORMED_GTID_SET_SPECIFICATION = 1772
 const ER_MALFORMED_GTID_SET_ENCODING = 1773
 const ER_MALFORMED_GTID_SPECIFICATION = 1774
 const ER_GNO_EXHAUSTED = 1775
+
 // Deprecated: should not be used
 const ER_BAD_SLAVE_AUTO_POSITION = 1776
 const ER_BAD_REPLICA_AUTO_POSITION = 1776
 const ER_AUTO_POSITION_REQUIRES_GTID_MODE_NOT_OFF = 1777
 const ER_CANT_DO_IMPLICIT_COMMIT_IN_TRX_WHEN_GTID_NEXT_IS_SET = 1778
 const ER_GTID_MODE_ON_REQUIRES_ENFORCE_GTID_CONSISTENCY_ON = 1779
+
 // Deprecated: should not be used
 const ER_GTID_MODE_REQUIRES_BINLOG = 1780
 const OBSOLETE_ER_GTID_MODE_REQUIRES_BINLOG = 1780
 const ER_CANT_SET_GTID_NEXT_TO_GTID_WHEN_GTID_MODE_IS_OFF = 1781
 const ER_CANT_SET_GTID_NEXT_TO_ANONYMOUS_WHEN_GTID_MODE_IS_ON = 1782
 const ER_CANT_SET_GTID_NEXT_LIST_TO_NON_NULL_WHEN_GTID_MODE_IS_OFF = 1783
+
 // Deprecated: should not be used
 const ER_FOUND_GTID_EVENT_WHEN_GTID_MODE_IS_OFF__UNUSED = 1784
 const OBSOLETE_ER_FOUND_GTID_EVENT_WHEN_GTID_MODE_IS_OFF__UNUSED = 1784
 const ER_GTID_UNSAFE_NON_TRANSACTIONAL_TABLE = 1785
 const ER_GTID_UNSAFE_CREATE_SELECT = 1786
+
 // Deprecated: should not be used
 const ER_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRANSACTION = 1787
 const OBSOLETE_ER_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRANSACTION = 1787
 const ER_GTID_MODE_CAN_ONLY_CHANGE_ONE_STEP_AT_A_TIME = 1788
+
 // Deprecated: should not be used
 const ER_MASTER_HAS_PURGED_REQUIRED_GTIDS = 1789
 const ER_SOURCE_HAS_PURGED_REQUIRED_GTIDS = 1789
@@ -1061,6 +1191,7 @@ const ER_CANT_SET_GTID_NEXT_WHEN_OWNING_GTID = 1790
 const ER_UNKNOWN_EXPLAIN_FORMAT = 1791
 const ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION = 1792
 const ER_TOO_LONG_TABLE_PARTITION_COMMENT = 1793
+
 // Deprecated: should not be used
 const ER_SLAVE_CONFIGURATION = 1794
 const ER_REPLICA_CONFIGURATION = 1794
@@ -1071,16 +1202,20 @@ const ER_INNODB_FT_WRONG_DOCID_INDEX = 1798
 const ER_INNODB_ONLINE_LOG_TOO_BIG = 1799
 const ER_UNKNOWN_ALTER_ALGORITHM = 1800
 const ER_UNKNOWN_ALTER_LOCK = 1801
+
 // Deprecated: should not be used
 const ER_MTS_CHANGE_MASTER_CANT_RUN_WITH_GAPS = 1802
 const ER_MTA_CHANGE_SOURCE_CANT_RUN_WITH_GAPS = 1802
+
 // Deprecated: should not be used
 const ER_MTS_RECOVERY_FAILURE = 1803
 const ER_MTA_RECOVERY_FAILURE = 1803
+
 // Deprecated: should not be used
 const ER_MTS_RESET_WORKERS = 1804
 const ER_MTA_RESET_WORKERS = 1804
 const ER_COL_COUNT_DOESNT_MATCH_CORRUPTED_V2 = 1805
+
 // Deprecated: should not be used
 const ER_SLAVE_SILENT_RETRY_TRANSACTION = 1806
 const ER_REPLICA_SILENT_RETRY_TRANSACTION = 1806
@@ -1111,6 +1246,7 @@ const ER_FK_COLUMN_NOT_NULL = 1830
 const ER_DUP_INDEX = 1831
 const ER_FK_COLUMN_CANNOT_CHANGE = 1832
 const ER_FK_COLUMN_CANNOT_CHANGE_CHILD = 1833
+
 // Deprecated: should not be used
 const ER_UNUSED5 = 1834
 const OBSOLETE_ER_UNUSED5 = 1834
@@ -1118,6 +1254,7 @@ const ER_MALFORMED_PACKET = 1835
 const ER_READ_ONLY_MODE = 1836
 const ER_GTID_NEXT_TYPE_UNDEFINED_GTID = 1837
 const ER_VARIABLE_NOT_SETTABLE_IN_SP = 1838
+
 // Deprecated: should not be used
 const ER_CANT_SET_GTID_PURGED_WHEN_GTID_MODE_IS_OFF = 1839
 const OBSOLETE_ER_CANT_SET_GTID_PURGED_WHEN_GTID_MODE_IS_OFF = 1839
@@ -1133,6 +1270,7 @@ const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_PARTITION = 1848
 const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FK_RENAME = 1849
 const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COLUMN_TYPE = 1850
 const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FK_CHECK = 1851
+
 // Deprecated: should not be used
 const ER_UNUSED6 = 1852
 const OBSOLETE_ER_UNUSED6 = 1852
@@ -1141,6 +1279,7 @@ const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_AUTOINC = 1854
 const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_HIDDEN_FTS = 1855
 const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_CHANGE_FTS = 1856
 const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FTS = 1857
+
 // Deprecated: should not be used
 const ER_SQL_REPLICA_SKIP_COUNTER_NOT_SETTABLE_IN_GTID_MODE = 1858
 const OBSOLETE_ER_SQL_REPLICA_SKIP_COUNTER_NOT_SETTABLE_IN_GTID_MODE = 1858
@@ -1149,9 +1288,11 @@ const ER_IDENT_CAUSES_TOO_LONG_PATH = 1860
 const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_NOT_NULL = 1861
 const ER_MUST_CHANGE_PASSWORD_LOGIN = 1862
 const ER_ROW_IN_WRONG_PARTITION = 1863
+
 // Deprecated: should not be used
 const ER_MTS_EVENT_BIGGER_PENDING_JOBS_SIZE_MAX = 1864
 const ER_MTA_EVENT_BIGGER_PENDING_JOBS_SIZE_MAX = 1864
+
 // Deprecated: should not be used
 const ER_INNODB_NO_FT_USES_PARSER = 1865
 const OBSOLETE_ER_INNODB_NO_FT_USES_PARSER = 1865
@@ -1160,17 +1301,21 @@ const ER_WARN_PURGE_LOG_IN_USE = 1867
 const ER_WARN_PURGE_LOG_IS_ACTIVE = 1868
 const ER_AUTO_INCREMENT_CONFLICT = 1869
 const WARN_ON_BLOCKHOLE_IN_RBR = 1870
+
 // Deprecated: should not be used
 const ER_SLAVE_MI_INIT_REPOSITORY = 1871
 const ER_REPLICA_CM_INIT_REPOSITORY = 1871
+
 // Deprecated: should not be used
 const ER_SLAVE_RLI_INIT_REPOSITORY = 1872
 const ER_REPLICA_AM_INIT_REPOSITORY = 1872
 const ER_ACCESS_DENIED_CHANGE_USER_ERROR = 1873
 const ER_INNODB_READ_ONLY = 1874
+
 // Deprecated: should not be used
 const ER_STOP_SLAVE_SQL_THREAD_TIMEOUT = 1875
 const ER_STOP_REPLICA_SQL_THREAD_TIMEOUT = 1875
+
 // Deprecated: should not be used
 const ER_STOP_SLAVE_IO_THREAD_TIMEOUT = 1876
 const ER_STOP_REPLICA_IO_THREAD_TIMEOUT = 1876
@@ -1182,15 +1327,18 @@ const ER_INNODB_FORCED_RECOVERY = 1881
 const ER_AES_INVALID_IV = 1882
 const ER_PLUGIN_CANNOT_BE_UNINSTALLED = 1883
 const ER_GTID_UNSAFE_BINLOG_SPLITTABLE_STATEMENT_AND_ASSIGNED_GTID = 1884
+
 // Deprecated: should not be used
 const ER_SLAVE_HAS_MORE_GTIDS_THAN_MASTER = 1885
 const ER_REPLICA_HAS_MORE_GTIDS_THAN_SOURCE = 1885
 const ER_MISSING_KEY = 1886
 const WARN_NAMED_PIPE_ACCESS_EVERYONE = 1887
 const ER_FILE_CORRUPT = 3000
+
 // Deprecated: should not be used
 const ER_ERROR_ON_MASTER = 3001
 const ER_ERROR_ON_SOURCE = 3001
+
 // Deprecated: should not be used
 const ER_INCONSISTENT_ERROR = 3002
 const OBSOLETE_ER_INCONSISTENT_ERROR = 3002
@@ -1208,27 +1356,32 @@ const ER_INVALID_FIELD_SIZE = 3013
 const ER_MISSING_HA_CREATE_OPTION = 3014
 const ER_ENGINE_OUT_OF_MEMORY = 3015
 const ER_PASSWORD_EXPIRE_ANONYMOUS_USER = 3016
+
 // Deprecated: should not be used
 const ER_SLAVE_SQL_THREAD_MUST_STOP = 3017
 const ER_REPLICA_SQL_THREAD_MUST_STOP = 3017
 const ER_NO_FT_MATERIALIZED_SUBQUERY = 3018
 const ER_INNODB_UNDO_LOG_FULL = 3019
 const ER_INVALID_ARGUMENT_FOR_LOGARITHM = 3020
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_IO_THREAD_MUST_STOP = 3021
 const ER_REPLICA_CHANNEL_IO_THREAD_MUST_STOP = 3021
 const ER_WARN_OPEN_TEMP_TABLES_MUST_BE_ZERO = 3022
+
 // Deprecated: should not be used
 const ER_WARN_ONLY_MASTER_LOG_FILE_NO_POS = 3023
 const ER_WARN_ONLY_SOURCE_LOG_FILE_NO_POS = 3023
 const ER_QUERY_TIMEOUT = 3024
 const ER_NON_RO_SELECT_DISABLE_TIMER = 3025
 const ER_DUP_LIST_ENTRY = 3026
+
 // Deprecated: should not be used
 const ER_SQL_MODE_NO_EFFECT = 3027
 const OBSOLETE_ER_SQL_MODE_NO_EFFECT = 3027
 const ER_AGGREGATE_ORDER_FOR_UNION = 3028
 const ER_AGGREGATE_ORDER_NON_AGG_QUERY = 3029
+
 // Deprecated: should not be used
 const ER_SLAVE_WORKER_STOPPED_PREVIOUS_THD_ERROR = 3030
 const ER_REPLICA_WORKER_STOPPED_PREVIOUS_THD_ERROR = 3030
@@ -1257,6 +1410,7 @@ const ER_STD_LOGIC_ERROR = 3052
 const ER_STD_RUNTIME_ERROR = 3053
 const ER_STD_UNKNOWN_EXCEPTION = 3054
 const ER_GIS_DATA_WRONG_ENDIANESS = 3055
+
 // Deprecated: should not be used
 const ER_CHANGE_MASTER_PASSWORD_LENGTH = 3056
 const ER_CHANGE_SOURCE_PASSWORD_LENGTH = 3056
@@ -1266,6 +1420,7 @@ const ER_REPLACE_INACCESSIBLE_ROWS = 3059
 const ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_GIS = 3060
 const ER_ILLEGAL_USER_VAR = 3061
 const ER_GTID_MODE_OFF = 3062
+
 // Deprecated: should not be used
 const ER_UNSUPPORTED_BY_REPLICATION_THREAD = 3063
 const OBSOLETE_ER_UNSUPPORTED_BY_REPLICATION_THREAD = 3063
@@ -1279,42 +1434,55 @@ const ER_INVALID_GEOJSON_MISSING_MEMBER = 3070
 const ER_INVALID_GEOJSON_WRONG_TYPE = 3071
 const ER_INVALID_GEOJSON_UNSPECIFIED = 3072
 const ER_DIMENSION_UNSUPPORTED = 3073
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_DOES_NOT_EXIST = 3074
 const ER_REPLICA_CHANNEL_DOES_NOT_EXIST = 3074
+
 // Deprecated: should not be used
 const ER_SLAVE_MULTIPLE_CHANNELS_HOST_PORT = 3075
 const OBSOLETE_ER_SLAVE_MULTIPLE_CHANNELS_HOST_PORT = 3075
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_NAME_INVALID_OR_TOO_LONG = 3076
 const ER_REPLICA_CHANNEL_NAME_INVALID_OR_TOO_LONG = 3076
+
 // Deprecated: should not be used
 const ER_SLAVE_NEW_CHANNEL_WRONG_REPOSITORY = 3077
 const ER_REPLICA_NEW_CHANNEL_WRONG_REPOSITORY = 3077
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_DELETE = 3078
 const OBSOLETE_ER_SLAVE_CHANNEL_DELETE = 3078
+
 // Deprecated: should not be used
 const ER_SLAVE_MULTIPLE_CHANNELS_CMD = 3079
 const ER_REPLICA_MULTIPLE_CHANNELS_CMD = 3079
+
 // Deprecated: should not be used
 const ER_SLAVE_MAX_CHANNELS_EXCEEDED = 3080
 const ER_REPLICA_MAX_CHANNELS_EXCEEDED = 3080
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_MUST_STOP = 3081
 const ER_REPLICA_CHANNEL_MUST_STOP = 3081
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_NOT_RUNNING = 3082
 const ER_REPLICA_CHANNEL_NOT_RUNNING = 3082
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_WAS_RUNNING = 3083
 const ER_REPLICA_CHANNEL_WAS_RUNNING = 3083
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_WAS_NOT_RUNNING = 3084
 const ER_REPLICA_CHANNEL_WAS_NOT_RUNNING = 3084
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_SQL_THREAD_MUST_STOP = 3085
 const ER_REPLICA_CHANNEL_SQL_THREAD_MUST_STOP = 3085
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_SQL_SKIP_COUNTER = 3086
 const ER_REPLICA_CHANNEL_SQL_SKIP_COUNTER = 3086
@@ -1344,12 +1512,15 @@ const ER_GENERATED_COLUMN_REF_AUTO_INC = 3109
 const ER_FEATURE_NOT_AVAILABLE = 3110
 const ER_CANT_SET_GTID_MODE = 3111
 const ER_CANT_USE_AUTO_POSITION_WITH_GTID_MODE_OFF = 3112
+
 // Deprecated: should not be used
 const ER_CANT_REPLICATE_ANONYMOUS_WITH_AUTO_POSITION = 3113
 const OBSOLETE_ER_CANT_REPLICATE_ANONYMOUS_WITH_AUTO_POSITION = 3113
+
 // Deprecated: should not be used
 const ER_CANT_REPLICATE_ANONYMOUS_WITH_GTID_MODE_ON = 3114
 const OBSOLETE_ER_CANT_REPLICATE_ANONYMOUS_WITH_GTID_MODE_ON = 3114
+
 // Deprecated: should not be used
 const ER_CANT_REPLICATE_GTID_WITH_GTID_MODE_OFF = 3115
 const OBSOLETE_ER_CANT_REPLICATE_GTID_WITH_GTID_MODE_OFF = 3115
@@ -1376,6 +1547,7 @@ const ER_SQL_MODE_MERGED = 3135
 const ER_VTOKEN_PLUGIN_TOKEN_MISMATCH = 3136
 const ER_VTOKEN_PLUGIN_TOKEN_NOT_FOUND = 3137
 const ER_CANT_SET_VARIABLE_WHEN_OWNING_GTID = 3138
+
 // Deprecated: should not be used
 const ER_SLAVE_CHANNEL_OPERATION_NOT_ALLOWED = 3139
 const ER_REPLICA_CHANNEL_OPERATION_NOT_ALLOWED = 3139
@@ -1411,6 +1583,7 @@ const ER_SERVER_ISNT_AVAILABLE = 3168
 const ER_SESSION_WAS_KILLED = 3169
 const ER_CAPACITY_EXCEEDED = 3170
 const ER_CAPACITY_EXCEEDED_IN_RANGE_OPTIMIZER = 3171
+
 // Deprecated: should not be used
 const ER_TABLE_NEEDS_UPG_PART = 3172
 const OBSOLETE_ER_TABLE_NEEDS_UPG_PART = 3172
@@ -1421,6 +1594,7 @@ const ER_ERROR_ON_MODIFYING_GTID_EXECUTED_TABLE = 3176
 const ER_LOCK_REFUSED_BY_ENGINE = 3177
 const ER_UNSUPPORTED_ALTER_ONLINE_ON_VIRTUAL_COLUMN = 3178
 const ER_MASTER_KEY_ROTATION_NOT_SUPPORTED_BY_SE = 3179
+
 // Deprecated: should not be used
 const ER_MASTER_KEY_ROTATION_ERROR_BY_SE = 3180
 const OBSOLETE_ER_MASTER_KEY_ROTATION_ERROR_BY_SE = 3180
@@ -1433,18 +1607,22 @@ const ER_CAPACITY_EXCEEDED_IN_PARSER = 3186
 const ER_UNSUPPORTED_ALTER_ENCRYPTION_INPLACE = 3187
 const ER_KEYRING_UDF_KEYRING_SERVICE_ERROR = 3188
 const ER_USER_COLUMN_OLD_LENGTH = 3189
+
 // Deprecated: should not be used
 const ER_CANT_RESET_MASTER = 3190
 const ER_CANT_RESET_SOURCE = 3190
 const ER_GROUP_REPLICATION_MAX_GROUP_SIZE = 3191
 const ER_CANNOT_ADD_FOREIGN_BASE_COL_STORED = 3192
 const ER_TABLE_REFERENCED = 3193
+
 // Deprecated: should not be used
 const ER_PARTITION_ENGINE_DEPRECATED_FOR_TABLE = 3194
 const OBSOLETE_ER_PARTITION_ENGINE_DEPRECATED_FOR_TABLE = 3194
+
 // Deprecated: should not be used
 const ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID_ZERO = 3195
 const OBSOLETE_ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID_ZERO = 3195
+
 // Deprecated: should not be used
 const ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID = 3196
 const OBSOLETE_ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID = 3196
@@ -1455,88 +1633,115 @@ const ER_UDF_ERROR = 3200
 const ER_KEYRING_MIGRATION_FAILURE = 3201
 const ER_KEYRING_ACCESS_DENIED_ERROR = 3202
 const ER_KEYRING_MIGRATION_STATUS = 3203
+
 // Deprecated: should not be used
 const ER_PLUGIN_FAILED_TO_OPEN_TABLES = 3204
 const OBSOLETE_ER_PLUGIN_FAILED_TO_OPEN_TABLES = 3204
+
 // Deprecated: should not be used
 const ER_PLUGIN_FAILED_TO_OPEN_TABLE = 3205
 const OBSOLETE_ER_PLUGIN_FAILED_TO_OPEN_TABLE = 3205
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_NO_KEYRING_PLUGIN_INSTALLED = 3206
 const OBSOLETE_ER_AUDIT_LOG_NO_KEYRING_PLUGIN_INSTALLED = 3206
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_ENCRYPTION_PASSWORD_HAS_NOT_BEEN_SET = 3207
 const OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_HAS_NOT_BEEN_SET = 3207
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_COULD_NOT_CREATE_AES_KEY = 3208
 const OBSOLETE_ER_AUDIT_LOG_COULD_NOT_CREATE_AES_KEY = 3208
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_ENCRYPTION_PASSWORD_CANNOT_BE_FETCHED = 3209
 const OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_CANNOT_BE_FETCHED = 3209
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_JSON_FILTERING_NOT_ENABLED = 3210
 const OBSOLETE_ER_AUDIT_LOG_JSON_FILTERING_NOT_ENABLED = 3210
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_UDF_INSUFFICIENT_PRIVILEGE = 3211
 const OBSOLETE_ER_AUDIT_LOG_UDF_INSUFFICIENT_PRIVILEGE = 3211
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_SUPER_PRIVILEGE_REQUIRED = 3212
 const OBSOLETE_ER_AUDIT_LOG_SUPER_PRIVILEGE_REQUIRED = 3212
+
 // Deprecated: should not be used
 const ER_COULD_NOT_REINITIALIZE_AUDIT_LOG_FILTERS = 3213
 const OBSOLETE_ER_COULD_NOT_REINITIALIZE_AUDIT_LOG_FILTERS = 3213
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_TYPE = 3214
 const OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_TYPE = 3214
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_COUNT = 3215
 const OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_COUNT = 3215
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_HAS_NOT_BEEN_INSTALLED = 3216
 const OBSOLETE_ER_AUDIT_LOG_HAS_NOT_BEEN_INSTALLED = 3216
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_TYPE = 3217
 const OBSOLETE_ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_TYPE = 3217
 const ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_VALUE = 3218
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_JSON_FILTER_PARSING_ERROR = 3219
 const OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_PARSING_ERROR = 3219
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_JSON_FILTER_NAME_CANNOT_BE_EMPTY = 3220
 const OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_NAME_CANNOT_BE_EMPTY = 3220
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_JSON_USER_NAME_CANNOT_BE_EMPTY = 3221
 const OBSOLETE_ER_AUDIT_LOG_JSON_USER_NAME_CANNOT_BE_EMPTY = 3221
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_JSON_FILTER_DOES_NOT_EXISTS = 3222
 const OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_DOES_NOT_EXISTS = 3222
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_USER_FIRST_CHARACTER_MUST_BE_ALPHANUMERIC = 3223
 const OBSOLETE_ER_AUDIT_LOG_USER_FIRST_CHARACTER_MUST_BE_ALPHANUMERIC = 3223
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_USER_NAME_INVALID_CHARACTER = 3224
 const OBSOLETE_ER_AUDIT_LOG_USER_NAME_INVALID_CHARACTER = 3224
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_HOST_NAME_INVALID_CHARACTER = 3225
 const OBSOLETE_ER_AUDIT_LOG_HOST_NAME_INVALID_CHARACTER = 3225
+
 // Deprecated: should not be used
 const WARN_DEPRECATED_MAXDB_SQL_MODE_FOR_TIMESTAMP = 3226
 const OBSOLETE_WARN_DEPRECATED_MAXDB_SQL_MODE_FOR_TIMESTAMP = 3226
 const OBSOLETE_ER_XA_REPLICATION_FILTERS = 3227
+
 // Deprecated: should not be used
 const ER_CANT_OPEN_ERROR_LOG = 3228
 const OBSOLETE_ER_CANT_OPEN_ERROR_LOG = 3228
 const OBSOLETE_ER_GROUPING_ON_TIMESTAMP_IN_DST = 3229
+
 // Deprecated: should not be used
 const ER_CANT_START_SERVER_NAMED_PIPE = 3230
 const OBSOLETE_ER_CANT_START_SERVER_NAMED_PIPE = 3230
 const ER_WRITE_SET_EXCEEDS_LIMIT = 3231
+
 // Deprecated: should not be used
 const ER_DEPRECATED_TLS_VERSION_SESSION_57 = 3232
 const OBSOLETE_ER_DEPRECATED_TLS_VERSION_SESSION_57 = 3232
+
 // Deprecated: should not be used
 const ER_WARN_DEPRECATED_TLS_VERSION_57 = 3233
 const OBSOLETE_ER_WARN_DEPRECATED_TLS_VERSION_57 = 3233
+
 // Deprecated: should not be used
 const ER_WARN_WRONG_NATIVE_TABLE_STRUCTURE = 3234
 const OBSOLETE_ER_WARN_WRONG_NATIVE_TABLE_STRUCTURE = 3234
@@ -1611,6 +1816,7 @@ const ER_INVALID_OPTION_CHARACTERS = 3563
 const ER_DUPLICATE_OPTION_KEY = 3564
 const ER_WARN_SRS_NOT_FOUND_AXIS_ORDER = 3565
 const ER_NO_ACCESS_TO_NATIVE_FCT = 3566
+
 // Deprecated: should not be used
 const ER_RESET_MASTER_TO_VALUE_OUT_OF_RANGE = 3567
 const ER_RESET_SOURCE_TO_VALUE_OUT_OF_RANGE = 3567
@@ -1771,6 +1977,7 @@ const ER_INVALID_DEFAULT_UTF8MB4_COLLATION = 3721
 const ER_UNABLE_TO_COLLECT_LOG_STATUS = 3722
 const ER_RESERVED_TABLESPACE_NAME = 3723
 const ER_UNABLE_TO_SET_OPTION = 3724
+
 // Deprecated: should not be used
 const ER_SLAVE_POSSIBLY_DIVERGED_AFTER_DDL = 3725
 const ER_REPLICA_POSSIBLY_DIVERGED_AFTER_DDL = 3725
@@ -1797,6 +2004,7 @@ const ER_TEMP_TABLE_PREVENTS_SWITCH_SESSION_BINLOG_FORMAT = 3745
 const ER_TEMP_TABLE_PREVENTS_SWITCH_GLOBAL_BINLOG_FORMAT = 3746
 const ER_RUNNING_APPLIER_PREVENTS_SWITCH_GLOBAL_BINLOG_FORMAT = 3747
 const ER_CLIENT_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRX_IN_SBR = 3748
+
 // Deprecated: should not be used
 const ER_XA_CANT_CREATE_MDL_BACKUP = 3749
 const OBSOLETE_ER_XA_CANT_CREATE_MDL_BACKUP = 3749
@@ -1907,6 +2115,7 @@ const ER_INVALID_JSON_TYPE = 3853
 const ER_CANNOT_CONVERT_STRING = 3854
 const ER_DEPENDENT_BY_PARTITION_FUNC = 3855
 const ER_WARN_DEPRECATED_FLOAT_AUTO_INCREMENT = 3856
+
 // Deprecated: should not be used
 const ER_RPL_CANT_STOP_SLAVE_WHILE_LOCKED_BACKUP = 3857
 const ER_RPL_CANT_STOP_REPLICA_WHILE_LOCKED_BACKUP = 3857
@@ -1967,6 +2176,7 @@ const ER_UPDATE_GTID_PURGED_WITH_GR = 3911
 const ER_GROUPING_ON_TIMESTAMP_IN_DST = 3912
 const ER_TABLE_NAME_CAUSES_TOO_LONG_PATH = 3913
 const ER_AUDIT_LOG_INSUFFICIENT_PRIVILEGE = 3914
+
 // Deprecated: should not be used
 const ER_AUDIT_LOG_PASSWORD_HAS_BEEN_COPIED = 3915
 const OBSOLETE_ER_AUDIT_LOG_PASSWORD_HAS_BEEN_COPIED = 3915
@@ -1974,14 +2184,17 @@ const ER_DA_GRP_RPL_STARTED_AUTO_REJOIN = 3916
 const ER_SYSVAR_CHANGE_DURING_QUERY = 3917
 const ER_GLOBSTAT_CHANGE_DURING_QUERY = 3918
 const ER_GRP_RPL_MESSAGE_SERVICE_INIT_FAILURE = 3919
+
 // Deprecated: should not be used
 const ER_CHANGE_MASTER_WRONG_COMPRESSION_ALGORITHM_CLIENT = 3920
 const ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_CLIENT = 3920
+
 // Deprecated: should not be used
 const ER_CHANGE_MASTER_WRONG_COMPRESSION_LEVEL_CLIENT = 3921
 const ER_CHANGE_SOURCE_WRONG_COMPRESSION_LEVEL_CLIENT = 3921
 const ER_WRONG_COMPRESSION_ALGORITHM_CLIENT = 3922
 const ER_WRONG_COMPRESSION_LEVEL_CLIENT = 3923
+
 // Deprecated: should not be used
 const ER_CHANGE_MASTER_WRONG_COMPRESSION_ALGORITHM_LIST_CLIENT = 3924
 const ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_LIST_CLIENT = 3924
@@ -2098,6 +2311,7 @@ const ER_GIS_DIFFERENT_SRIDS_AGGREGATION = 4034
 const ER_RELOAD_KEYRING_FAILURE = 4035
 const ER_SDI_GET_KEYS_INVALID_TABLESPACE = 4036
 const ER_CHANGE_RPL_SRC_WRONG_COMPRESSION_ALGORITHM_SIZE = 4037
+
 // Deprecated: should not be used
 const ER_WARN_DEPRECATED_TLS_VERSION_FOR_CHANNEL_CLI = 4038
 const OBSOLETE_ER_WARN_DEPRECATED_TLS_VERSION_FOR_CHANNEL_CLI = 4038
@@ -2155,6 +2369,7 @@ const ER_DEFINITION_CONTAINS_INVALID_STRING = 4089
 const ER_CANT_EXECUTE_COMMAND_WITH_ASSIGNED_GTID_NEXT = 4090
 const ER_XA_TEMP_TABLE = 4091
 const ER_INNODB_MAX_ROW_VERSION = 4092
+
 // Deprecated: should not be used
 const ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_SIZE = 4093
 const OBSOLETE_ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_SIZE = 4093
@@ -2167,9 +2382,11 @@ const ER_WARN_TRG_ALREADY_EXISTS = 4099
 const ER_IF_NOT_EXISTS_UNSUPPORTED_TRG_EXISTS_ON_DIFFERENT_TABLE = 4100
 const ER_IF_NOT_EXISTS_UNSUPPORTED_UDF_NATIVE_FCT_NAME_COLLISION = 4101
 const ER_SET_PASSWORD_AUTH_PLUGIN_ERROR = 4102
+
 // Deprecated: should not be used
 const ER_REDUCED_DBLWR_FILE_CORRUPTED = 4103
 const OBSOLETE_ER_REDUCED_DBLWR_FILE_CORRUPTED = 4103
+
 // Deprecated: should not be used
 const ER_REDUCED_DBLWR_PAGE_FOUND = 4104
 const OBSOLETE_ER_REDUCED_DBLWR_PAGE_FOUND = 4104
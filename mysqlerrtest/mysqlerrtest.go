@@ -0,0 +1,21 @@
+// Package mysqlerrtest fabricates the errors of github.com/go-sql-driver/mysql for tests,
+// such as the results of go-sqlmock expectations:
+//
+//	mock.ExpectExec("INSERT INTO users").WillReturnError(mysqlerrtest.NewDriverError(mysqlerr.ER_DUP_ENTRY, "a@example.com", "users.email"))
+package mysqlerrtest
+
+import (
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// NewDriverError returns the error the driver reports when the server fails with code,
+// with the SQLSTATE of code and its message rendered from args, as mysqlerr.NewError returns them
+// from the message table generated from messages_to_clients.txt.
+func NewDriverError(code int, args ...interface{}) *mysql.MySQLError {
+	e := mysqlerr.NewError(code, args...)
+	me := &mysql.MySQLError{Number: e.Number, Message: e.Message}
+	copy(me.SQLState[:], e.SQLState)
+	return me
+}
@@ -0,0 +1,30 @@
+package mysqlerrtest
+
+import (
+	"testing"
+
+	"github.com/orisano/mysqlerr"
+)
+
+func TestNewDriverError(t *testing.T) {
+	tests := []struct {
+		code     int
+		args     []interface{}
+		sqlState string
+		message  string
+	}{
+		{mysqlerr.ER_DUP_ENTRY, []interface{}{"a@example.com", "users.email"}, "23000", "Duplicate entry 'a@example.com' for key 'users.email'"},
+		{mysqlerr.ER_NO_REFERENCED_ROW_2, []interface{}{"`shop`.`orders`, CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)"}, "23000",
+			"Cannot add or update a child row: a foreign key constraint fails (`shop`.`orders`, CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`))"},
+		{mysqlerr.ER_LOCK_DEADLOCK, nil, "40001", "Deadlock found when trying to get lock; try restarting transaction"},
+	}
+	for _, tt := range tests {
+		e := NewDriverError(tt.code, tt.args...)
+		if int(e.Number) != tt.code || string(e.SQLState[:]) != tt.sqlState || e.Message != tt.message {
+			t.Errorf("NewDriverError(%d) = %d %s %q, want %s %q", tt.code, e.Number, e.SQLState[:], e.Message, tt.sqlState, tt.message)
+		}
+		if got := mysqlerr.Code(e); got != tt.code {
+			t.Errorf("Code(NewDriverError(%d)) = %d", tt.code, got)
+		}
+	}
+}
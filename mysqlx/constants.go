@@ -0,0 +1,65 @@
+// Copyright 2021-2023 Nao Yonashiro
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of this software and associated documentation files (the “Software”), to deal in the Software without restriction, including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+package mysqlx
+
+// This file is a hand-written seed until it is regenerated from
+// the mysqlx_error.h of the X Plugin, which has no go:generate line
+// in generate.go until a snapshot of it is recorded.
+const ER_X_BAD_MESSAGE = 5000
+const ER_X_CAPABILITIES_PREPARE_FAILED = 5001
+const ER_X_CAPABILITY_NOT_FOUND = 5002
+const ER_X_INVALID_PROTOCOL_DATA = 5003
+const ER_X_BAD_CONNECTION_SESSION_ATTRIBUTE_VALUE_LENGTH = 5004
+const ER_X_BAD_CONNECTION_SESSION_ATTRIBUTE_KEY_LENGTH = 5005
+const ER_X_BAD_CONNECTION_SESSION_ATTRIBUTE_EMPTY_KEY = 5006
+const ER_X_BAD_CONNECTION_SESSION_ATTRIBUTE_LENGTH = 5007
+const ER_X_BAD_CONNECTION_SESSION_ATTRIBUTE_TYPE = 5008
+const ER_X_CAPABILITY_SET_NOT_ALLOWED = 5009
+const ER_X_SERVICE_ERROR = 5010
+const ER_X_SESSION = 5011
+const ER_X_INVALID_ARGUMENT = 5012
+const ER_X_MISSING_ARGUMENT = 5013
+const ER_X_BAD_INSERT_DATA = 5014
+const ER_X_CMD_NUM_ARGUMENTS = 5015
+const ER_X_CMD_ARGUMENT_TYPE = 5016
+const ER_X_CMD_ARGUMENT_VALUE = 5017
+const ER_X_BAD_UPSERT_DATA = 5018
+const ER_X_DUPLICATED_CAPABILITIES = 5019
+const ER_X_CMD_ARGUMENT_OBJECT_EMPTY = 5020
+const ER_X_CMD_INVALID_ARGUMENT = 5021
+const ER_X_BAD_UPDATE_DATA = 5050
+const ER_X_BAD_TYPE_OF_UPDATE = 5051
+const ER_X_BAD_COLUMN_TO_UPDATE = 5052
+const ER_X_BAD_MEMBER_TO_UPDATE = 5053
+const ER_X_BAD_STATEMENT_ID = 5110
+const ER_X_BAD_CURSOR_ID = 5111
+const ER_X_BAD_SCHEMA = 5112
+const ER_X_BAD_TABLE = 5113
+const ER_X_BAD_PROJECTION = 5114
+const ER_X_DOC_ID_MISSING = 5115
+const ER_X_DUPLICATE_ENTRY = 5116
+const ER_X_DOC_REQUIRED_FIELD_MISSING = 5117
+const ER_X_PROJ_BAD_KEY_NAME = 5120
+const ER_X_BAD_DOC_PATH = 5121
+const ER_X_CURSOR_EXISTS = 5122
+const ER_X_CURSOR_REACHED_EOF = 5123
+const ER_X_EXPR_BAD_OPERATOR = 5150
+const ER_X_EXPR_BAD_NUM_ARGS = 5151
+const ER_X_EXPR_MISSING_ARG = 5152
+const ER_X_EXPR_BAD_TYPE_VALUE = 5153
+const ER_X_EXPR_BAD_VALUE = 5154
+const ER_X_INVALID_COLLECTION = 5156
+const ER_X_INVALID_ADMIN_COMMAND = 5157
+const ER_X_EXPECT_NOT_OPEN = 5158
+const ER_X_EXPECT_NO_ERROR_FAILED = 5159
+const ER_X_EXPECT_BAD_CONDITION = 5160
+const ER_X_EXPECT_BAD_CONDITION_VALUE = 5161
+const ER_X_INVALID_NAMESPACE = 5162
+const ER_X_BAD_NOTICE = 5163
+const ER_X_CANNOT_DISABLE_NOTICE = 5164
+const ER_X_BAD_CONFIGURATION = 5165
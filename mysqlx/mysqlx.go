@@ -0,0 +1,74 @@
+// Package mysqlx provides the error codes of the X Protocol and teaches mysqlerr
+// the errors of X DevAPI clients, so that they are classified like classic protocol errors.
+//
+// Importing this package registers an extractor for errors carrying a Mysqlx.Error message:
+//
+//	import _ "github.com/orisano/mysqlerr/mysqlx"
+package mysqlx
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/orisano/mysqlerr"
+)
+
+func init() {
+	mysqlerr.RegisterExtractor(Extract)
+}
+
+// protocolError is implemented by the Mysqlx.Error message generated by protoc-gen-go,
+// and by client errors that embed it.
+type protocolError interface {
+	GetCode() uint32
+	GetSqlState() string
+	GetMsg() string
+}
+
+// Extract returns the MySQL error of an error carrying a Mysqlx.Error message.
+// It only looks at err itself, not at the errors it wraps.
+func Extract(err error) (*mysqlerr.Error, bool) {
+	x, ok := err.(protocolError)
+	if !ok || x.GetCode() == 0 || x.GetCode() > 0xffff {
+		return nil, false
+	}
+	return &mysqlerr.Error{
+		Number:   uint16(x.GetCode()),
+		SQLState: x.GetSqlState(),
+		Message:  x.GetMsg(),
+	}, true
+}
+
+// severityFatal is Mysqlx.Error.Severity.FATAL.
+const severityFatal = 1
+
+// IsFatal reports whether err's tree, errors.Join included, has an X Protocol error with FATAL severity,
+// after which the server closes the session.
+func IsFatal(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if isFatal(err) {
+			return true
+		}
+		if j, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, err := range j.Unwrap() {
+				if IsFatal(err) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return false
+}
+
+func isFatal(err error) bool {
+	m := reflect.ValueOf(err).MethodByName("GetSeverity")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return false
+	}
+	switch v := m.Call(nil)[0]; v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == severityFatal
+	}
+	return false
+}
@@ -0,0 +1,3902 @@
+// Code generated mysqlerrgen DO NOT EDIT.
+
+package mysqlerr
+
+var errorCodes = [...]int32{
+	1000,
+	1001,
+	1002,
+	1003,
+	1004,
+	1005,
+	1006,
+	1007,
+	1008,
+	1009,
+	1010,
+	1011,
+	1012,
+	1013,
+	1014,
+	1015,
+	1016,
+	1017,
+	1018,
+	1019,
+	1020,
+	1021,
+	1022,
+	1023,
+	1024,
+	1025,
+	1026,
+	1027,
+	1028,
+	1029,
+	1030,
+	1031,
+	1032,
+	1033,
+	1034,
+	1035,
+	1036,
+	1037,
+	1038,
+	1039,
+	1040,
+	1041,
+	1042,
+	1043,
+	1044,
+	1045,
+	1046,
+	1047,
+	1048,
+	1049,
+	1050,
+	1051,
+	1052,
+	1053,
+	1054,
+	1055,
+	1056,
+	1057,
+	1058,
+	1059,
+	1060,
+	1061,
+	1062,
+	1063,
+	1064,
+	1065,
+	1066,
+	1067,
+	1068,
+	1069,
+	1070,
+	1071,
+	1072,
+	1073,
+	1074,
+	1075,
+	1076,
+	1077,
+	1078,
+	1079,
+	1080,
+	1081,
+	1082,
+	1083,
+	1084,
+	1085,
+	1086,
+	1087,
+	1088,
+	1089,
+	1090,
+	1091,
+	1092,
+	1093,
+	1094,
+	1095,
+	1096,
+	1097,
+	1098,
+	1099,
+	1100,
+	1101,
+	1102,
+	1103,
+	1104,
+	1105,
+	1106,
+	1107,
+	1108,
+	1109,
+	1110,
+	1111,
+	1112,
+	1113,
+	1114,
+	1115,
+	1116,
+	1117,
+	1118,
+	1119,
+	1120,
+	1121,
+	1122,
+	1123,
+	1124,
+	1125,
+	1126,
+	1127,
+	1128,
+	1129,
+	1130,
+	1131,
+	1132,
+	1133,
+	1134,
+	1135,
+	1136,
+	1137,
+	1138,
+	1139,
+	1140,
+	1141,
+	1142,
+	1143,
+	1144,
+	1145,
+	1146,
+	1147,
+	1148,
+	1149,
+	1150,
+	1151,
+	1152,
+	1153,
+	1154,
+	1155,
+	1156,
+	1157,
+	1158,
+	1159,
+	1160,
+	1161,
+	1162,
+	1163,
+	1164,
+	1165,
+	1166,
+	1167,
+	1168,
+	1169,
+	1170,
+	1171,
+	1172,
+	1173,
+	1174,
+	1175,
+	1176,
+	1177,
+	1178,
+	1179,
+	1180,
+	1181,
+	1182,
+	1183,
+	1184,
+	1185,
+	1186,
+	1187,
+	1188,
+	1189,
+	1190,
+	1191,
+	1192,
+	1193,
+	1194,
+	1195,
+	1196,
+	1197,
+	1198,
+	1199,
+	1200,
+	1201,
+	1202,
+	1203,
+	1204,
+	1205,
+	1206,
+	1207,
+	1208,
+	1209,
+	1210,
+	1211,
+	1212,
+	1213,
+	1214,
+	1215,
+	1216,
+	1217,
+	1218,
+	1219,
+	1220,
+	1221,
+	1222,
+	1223,
+	1224,
+	1225,
+	1226,
+	1227,
+	1228,
+	1229,
+	1230,
+	1231,
+	1232,
+	1233,
+	1234,
+	1235,
+	1236,
+	1237,
+	1238,
+	1239,
+	1240,
+	1241,
+	1242,
+	1243,
+	1244,
+	1245,
+	1246,
+	1247,
+	1248,
+	1249,
+	1250,
+	1251,
+	1252,
+	1253,
+	1254,
+	1255,
+	1256,
+	1257,
+	1258,
+	1259,
+	1260,
+	1261,
+	1262,
+	1263,
+	1264,
+	1265,
+	1266,
+	1267,
+	1268,
+	1269,
+	1270,
+	1271,
+	1272,
+	1273,
+	1274,
+	1275,
+	1276,
+	1277,
+	1278,
+	1279,
+	1280,
+	1281,
+	1282,
+	1283,
+	1284,
+	1285,
+	1286,
+	1287,
+	1288,
+	1289,
+	1290,
+	1291,
+	1292,
+	1293,
+	1294,
+	1295,
+	1296,
+	1297,
+	1298,
+	1299,
+	1300,
+	1301,
+	1302,
+	1303,
+	1304,
+	1305,
+	1306,
+	1307,
+	1308,
+	1309,
+	1310,
+	1311,
+	1312,
+	1313,
+	1314,
+	1315,
+	1316,
+	1317,
+	1318,
+	1319,
+	1320,
+	1321,
+	1322,
+	1323,
+	1324,
+	1325,
+	1326,
+	1327,
+	1328,
+	1329,
+	1330,
+	1331,
+	1332,
+	1333,
+	1334,
+	1335,
+	1336,
+	1337,
+	1338,
+	1339,
+	1340,
+	1341,
+	1342,
+	1343,
+	1344,
+	1345,
+	1346,
+	1347,
+	1348,
+	1349,
+	1350,
+	1351,
+	1352,
+	1353,
+	1354,
+	1355,
+	1356,
+	1357,
+	1358,
+	1359,
+	1360,
+	1361,
+	1362,
+	1363,
+	1364,
+	1365,
+	1366,
+	1367,
+	1368,
+	1369,
+	1370,
+	1371,
+	1372,
+	1373,
+	1374,
+	1375,
+	1376,
+	1377,
+	1378,
+	1379,
+	1380,
+	1381,
+	1382,
+	1383,
+	1384,
+	1385,
+	1386,
+	1387,
+	1388,
+	1389,
+	1390,
+	1391,
+	1392,
+	1393,
+	1394,
+	1395,
+	1396,
+	1397,
+	1398,
+	1399,
+	1400,
+	1401,
+	1402,
+	1403,
+	1404,
+	1405,
+	1406,
+	1407,
+	1408,
+	1409,
+	1410,
+	1411,
+	1412,
+	1413,
+	1414,
+	1415,
+	1416,
+	1417,
+	1418,
+	1419,
+	1420,
+	1421,
+	1422,
+	1423,
+	1424,
+	1425,
+	1426,
+	1427,
+	1428,
+	1429,
+	1430,
+	1431,
+	1432,
+	1433,
+	1434,
+	1435,
+	1436,
+	1437,
+	1438,
+	1439,
+	1440,
+	1441,
+	1442,
+	1443,
+	1444,
+	1445,
+	1446,
+	1447,
+	1448,
+	1449,
+	1450,
+	1451,
+	1452,
+	1453,
+	1454,
+	1455,
+	1456,
+	1457,
+	1458,
+	1459,
+	1460,
+	1461,
+	1462,
+	1463,
+	1464,
+	1465,
+	1466,
+	1467,
+	1468,
+	1469,
+	1470,
+	1471,
+	1472,
+	1473,
+	1474,
+	1475,
+	1476,
+	1477,
+	1478,
+	1479,
+	1480,
+	1481,
+	1482,
+	1483,
+	1484,
+	1485,
+	1486,
+	1487,
+	1488,
+	1489,
+	1490,
+	1491,
+	1492,
+	1493,
+	1494,
+	1495,
+	1496,
+	1497,
+	1498,
+	1499,
+	1500,
+	1501,
+	1502,
+	1503,
+	1504,
+	1505,
+	1506,
+	1507,
+	1508,
+	1509,
+	1510,
+	1511,
+	1512,
+	1513,
+	1514,
+	1515,
+	1516,
+	1517,
+	1518,
+	1519,
+	1520,
+	1521,
+	1522,
+	1523,
+	1524,
+	1525,
+	1526,
+	1527,
+	1528,
+	1529,
+	1530,
+	1531,
+	1532,
+	1533,
+	1534,
+	1535,
+	1536,
+	1537,
+	1538,
+	1539,
+	1540,
+	1541,
+	1542,
+	1543,
+	1544,
+	1545,
+	1546,
+	1547,
+	1548,
+	1549,
+	1550,
+	1551,
+	1552,
+	1553,
+	1554,
+	1555,
+	1556,
+	1557,
+	1558,
+	1559,
+	1560,
+	1561,
+	1562,
+	1563,
+	1564,
+	1565,
+	1566,
+	1567,
+	1568,
+	1569,
+	1570,
+	1571,
+	1572,
+	1573,
+	1574,
+	1575,
+	1576,
+	1577,
+	1578,
+	1579,
+	1580,
+	1581,
+	1582,
+	1583,
+	1584,
+	1585,
+	1586,
+	1587,
+	1588,
+	1589,
+	1590,
+	1591,
+	1592,
+	1593,
+	1594,
+	1595,
+	1596,
+	1597,
+	1598,
+	1599,
+	1600,
+	1601,
+	1602,
+	1603,
+	1604,
+	1605,
+	1606,
+	1607,
+	1608,
+	1609,
+	1610,
+	1611,
+	1612,
+	1613,
+	1614,
+	1615,
+	1616,
+	1617,
+	1618,
+	1619,
+	1620,
+	1621,
+	1622,
+	1623,
+	1624,
+	1625,
+	1626,
+	1627,
+	1628,
+	1629,
+	1630,
+	1631,
+	1632,
+	1633,
+	1634,
+	1635,
+	1636,
+	1637,
+	1638,
+	1639,
+	1640,
+	1641,
+	1642,
+	1643,
+	1644,
+	1645,
+	1646,
+	1647,
+	1648,
+	1649,
+	1650,
+	1651,
+	1652,
+	1653,
+	1654,
+	1655,
+	1656,
+	1657,
+	1658,
+	1659,
+	1660,
+	1661,
+	1662,
+	1663,
+	1664,
+	1665,
+	1666,
+	1667,
+	1668,
+	1669,
+	1670,
+	1671,
+	1672,
+	1673,
+	1674,
+	1675,
+	1676,
+	1677,
+	1678,
+	1679,
+	1680,
+	1681,
+	1682,
+	1683,
+	1684,
+	1685,
+	1686,
+	1687,
+	1688,
+	1689,
+	1690,
+	1691,
+	1692,
+	1693,
+	1694,
+	1695,
+	1696,
+	1697,
+	1698,
+	1699,
+	1700,
+	1701,
+	1702,
+	1703,
+	1704,
+	1705,
+	1706,
+	1707,
+	1708,
+	1709,
+	1710,
+	1711,
+	1712,
+	1713,
+	1714,
+	1715,
+	1716,
+	1717,
+	1718,
+	1719,
+	1720,
+	1721,
+	1722,
+	1723,
+	1724,
+	1725,
+	1726,
+	1727,
+	1728,
+	1729,
+	1730,
+	1731,
+	1732,
+	1733,
+	1734,
+	1735,
+	1736,
+	1737,
+	1738,
+	1739,
+	1740,
+	1741,
+	1742,
+	1743,
+	1744,
+	1745,
+	1746,
+	1747,
+	1748,
+	1749,
+	1750,
+	1751,
+	1752,
+	1753,
+	1754,
+	1755,
+	1756,
+	1757,
+	1758,
+	1759,
+	1760,
+	1761,
+	1762,
+	1763,
+	1764,
+	1765,
+	1766,
+	1767,
+	1768,
+	1769,
+	1770,
+	1771,
+	1772,
+	1773,
+	1774,
+	1775,
+	1776,
+	1777,
+	1778,
+	1779,
+	1780,
+	1781,
+	1782,
+	1783,
+	1784,
+	1785,
+	1786,
+	1787,
+	1788,
+	1789,
+	1790,
+	1791,
+	1792,
+	1793,
+	1794,
+	1795,
+	1796,
+	1797,
+	1798,
+	1799,
+	1800,
+	1801,
+	1802,
+	1803,
+	1804,
+	1805,
+	1806,
+	1807,
+	1808,
+	1809,
+	1810,
+	1811,
+	1812,
+	1813,
+	1814,
+	1815,
+	1816,
+	1817,
+	1818,
+	1819,
+	1820,
+	1821,
+	1822,
+	1823,
+	1824,
+	1825,
+	1826,
+	1827,
+	1828,
+	1829,
+	1830,
+	1831,
+	1832,
+	1833,
+	1834,
+	1835,
+	1836,
+	1837,
+	1838,
+	1839,
+	1840,
+	1841,
+	1842,
+	1843,
+	1844,
+	1845,
+	1846,
+	1847,
+	1848,
+	1849,
+	1850,
+	1851,
+	1852,
+	1853,
+	1854,
+	1855,
+	1856,
+	1857,
+	1858,
+	1859,
+	1860,
+	1861,
+	1862,
+	1863,
+	1864,
+	1865,
+	1866,
+	1867,
+	1868,
+	1869,
+	1870,
+	1871,
+	1872,
+	1873,
+	1874,
+	1875,
+	1876,
+	1877,
+	1878,
+	1879,
+	1880,
+	1881,
+	1882,
+	1883,
+	1884,
+	1885,
+	1886,
+	1887,
+	3000,
+	3001,
+	3002,
+	3003,
+	3004,
+	3005,
+	3006,
+	3007,
+	3008,
+	3009,
+	3010,
+	3011,
+	3012,
+	3013,
+	3014,
+	3015,
+	3016,
+	3017,
+	3018,
+	3019,
+	3020,
+	3021,
+	3022,
+	3023,
+	3024,
+	3025,
+	3026,
+	3027,
+	3028,
+	3029,
+	3030,
+	3031,
+	3032,
+	3033,
+	3034,
+	3035,
+	3036,
+	3037,
+	3038,
+	3039,
+	3040,
+	3041,
+	3042,
+	3043,
+	3044,
+	3045,
+	3046,
+	3047,
+	3048,
+	3049,
+	3050,
+	3051,
+	3052,
+	3053,
+	3054,
+	3055,
+	3056,
+	3057,
+	3058,
+	3059,
+	3060,
+	3061,
+	3062,
+	3063,
+	3064,
+	3065,
+	3066,
+	3067,
+	3068,
+	3069,
+	3070,
+	3071,
+	3072,
+	3073,
+	3074,
+	3075,
+	3076,
+	3077,
+	3078,
+	3079,
+	3080,
+	3081,
+	3082,
+	3083,
+	3084,
+	3085,
+	3086,
+	3087,
+	3088,
+	3089,
+	3090,
+	3091,
+	3092,
+	3093,
+	3094,
+	3095,
+	3096,
+	3097,
+	3098,
+	3099,
+	3100,
+	3101,
+	3102,
+	3103,
+	3104,
+	3105,
+	3106,
+	3107,
+	3108,
+	3109,
+	3110,
+	3111,
+	3112,
+	3113,
+	3114,
+	3115,
+	3116,
+	3117,
+	3118,
+	3119,
+	3120,
+	3121,
+	3122,
+	3123,
+	3124,
+	3125,
+	3126,
+	3127,
+	3128,
+	3129,
+	3130,
+	3131,
+	3132,
+	3133,
+	3134,
+	3135,
+	3136,
+	3137,
+	3138,
+	3139,
+	3140,
+	3141,
+	3142,
+	3143,
+	3144,
+	3145,
+	3146,
+	3147,
+	3148,
+	3149,
+	3150,
+	3151,
+	3152,
+	3153,
+	3154,
+	3155,
+	3156,
+	3157,
+	3158,
+	3159,
+	3160,
+	3161,
+	3162,
+	3163,
+	3164,
+	3165,
+	3166,
+	3167,
+	3168,
+	3169,
+	3170,
+	3171,
+	3172,
+	3173,
+	3174,
+	3175,
+	3176,
+	3177,
+	3178,
+	3179,
+	3180,
+	3181,
+	3182,
+	3183,
+	3184,
+	3185,
+	3186,
+	3187,
+	3188,
+	3189,
+	3190,
+	3191,
+	3192,
+	3193,
+	3194,
+	3195,
+	3196,
+	3197,
+	3198,
+	3199,
+	3200,
+	3201,
+	3202,
+	3203,
+	3204,
+	3205,
+	3206,
+	3207,
+	3208,
+	3209,
+	3210,
+	3211,
+	3212,
+	3213,
+	3214,
+	3215,
+	3216,
+	3217,
+	3218,
+	3219,
+	3220,
+	3221,
+	3222,
+	3223,
+	3224,
+	3225,
+	3226,
+	3227,
+	3228,
+	3229,
+	3230,
+	3231,
+	3232,
+	3233,
+	3234,
+	3235,
+	3236,
+	3237,
+	3238,
+	3500,
+	3501,
+	3502,
+	3503,
+	3504,
+	3505,
+	3506,
+	3507,
+	3508,
+	3509,
+	3510,
+	3511,
+	3512,
+	3513,
+	3514,
+	3515,
+	3516,
+	3517,
+	3518,
+	3519,
+	3520,
+	3521,
+	3522,
+	3523,
+	3524,
+	3525,
+	3526,
+	3527,
+	3528,
+	3529,
+	3530,
+	3531,
+	3532,
+	3533,
+	3534,
+	3535,
+	3536,
+	3537,
+	3538,
+	3539,
+	3540,
+	3541,
+	3542,
+	3543,
+	3544,
+	3545,
+	3546,
+	3547,
+	3548,
+	3549,
+	3550,
+	3551,
+	3552,
+	3553,
+	3554,
+	3555,
+	3556,
+	3557,
+	3558,
+	3559,
+	3560,
+	3561,
+	3562,
+	3563,
+	3564,
+	3565,
+	3566,
+	3567,
+	3568,
+	3569,
+	3570,
+	3571,
+	3572,
+	3573,
+	3574,
+	3575,
+	3576,
+	3577,
+	3578,
+	3579,
+	3580,
+	3581,
+	3582,
+	3583,
+	3584,
+	3585,
+	3586,
+	3587,
+	3588,
+	3589,
+	3590,
+	3591,
+	3592,
+	3593,
+	3594,
+	3595,
+	3596,
+	3597,
+	3598,
+	3599,
+	3600,
+	3601,
+	3602,
+	3603,
+	3604,
+	3605,
+	3606,
+	3607,
+	3608,
+	3609,
+	3610,
+	3611,
+	3612,
+	3613,
+	3614,
+	3615,
+	3616,
+	3617,
+	3618,
+	3619,
+	3620,
+	3621,
+	3622,
+	3623,
+	3624,
+	3625,
+	3626,
+	3627,
+	3628,
+	3629,
+	3630,
+	3631,
+	3632,
+	3633,
+	3634,
+	3635,
+	3636,
+	3637,
+	3638,
+	3639,
+	3640,
+	3641,
+	3642,
+	3643,
+	3644,
+	3645,
+	3646,
+	3647,
+	3648,
+	3649,
+	3650,
+	3651,
+	3652,
+	3653,
+	3654,
+	3655,
+	3656,
+	3657,
+	3658,
+	3659,
+	3660,
+	3661,
+	3662,
+	3663,
+	3664,
+	3665,
+	3666,
+	3667,
+	3668,
+	3669,
+	3670,
+	3671,
+	3672,
+	3673,
+	3674,
+	3675,
+	3676,
+	3677,
+	3678,
+	3679,
+	3680,
+	3681,
+	3682,
+	3683,
+	3684,
+	3685,
+	3686,
+	3687,
+	3688,
+	3689,
+	3690,
+	3691,
+	3692,
+	3693,
+	3694,
+	3695,
+	3696,
+	3697,
+	3698,
+	3699,
+	3700,
+	3701,
+	3702,
+	3703,
+	3704,
+	3705,
+	3706,
+	3707,
+	3708,
+	3709,
+	3710,
+	3711,
+	3712,
+	3713,
+	3714,
+	3715,
+	3716,
+	3717,
+	3718,
+	3719,
+	3720,
+	3721,
+	3722,
+	3723,
+	3724,
+	3725,
+	3726,
+	3727,
+	3728,
+	3729,
+	3730,
+	3731,
+	3732,
+	3733,
+	3734,
+	3735,
+	3736,
+	3737,
+	3738,
+	3739,
+	3740,
+	3741,
+	3742,
+	3743,
+	3744,
+	3745,
+	3746,
+	3747,
+	3748,
+	3749,
+	3750,
+	3751,
+	3752,
+	3753,
+	3754,
+	3755,
+	3756,
+	3757,
+	3758,
+	3759,
+	3760,
+	3761,
+	3762,
+	3763,
+	3764,
+	3765,
+	3766,
+	3767,
+	3768,
+	3769,
+	3770,
+	3771,
+	3772,
+	3773,
+	3774,
+	3775,
+	3776,
+	3777,
+	3778,
+	3779,
+	3780,
+	3781,
+	3782,
+	3783,
+	3784,
+	3785,
+	3786,
+	3787,
+	3788,
+	3789,
+	3790,
+	3791,
+	3792,
+	3793,
+	3794,
+	3795,
+	3796,
+	3797,
+	3798,
+	3799,
+	3800,
+	3801,
+	3802,
+	3803,
+	3804,
+	3805,
+	3806,
+	3807,
+	3808,
+	3809,
+	3810,
+	3811,
+	3812,
+	3813,
+	3814,
+	3815,
+	3816,
+	3817,
+	3818,
+	3819,
+	3820,
+	3821,
+	3822,
+	3823,
+	3824,
+	3825,
+	3826,
+	3827,
+	3828,
+	3829,
+	3830,
+	3831,
+	3832,
+	3833,
+	3834,
+	3835,
+	3836,
+	3837,
+	3838,
+	3839,
+	3840,
+	3841,
+	3842,
+	3843,
+	3844,
+	3845,
+	3846,
+	3847,
+	3848,
+	3849,
+	3850,
+	3851,
+	3852,
+	3853,
+	3854,
+	3855,
+	3856,
+	3857,
+	3858,
+	3859,
+	3860,
+	3861,
+	3862,
+	3863,
+	3864,
+	3865,
+	3866,
+	3867,
+	3868,
+	3869,
+	3870,
+	3871,
+	3872,
+	3873,
+	3874,
+	3875,
+	3876,
+	3877,
+	3878,
+	3879,
+	3880,
+	3881,
+	3882,
+	3883,
+	3884,
+	3885,
+	3886,
+	3887,
+	3888,
+	3889,
+	3890,
+	3891,
+	3892,
+	3893,
+	3894,
+	3895,
+	3896,
+	3897,
+	3898,
+	3899,
+	3900,
+	3901,
+	3902,
+	3903,
+	3904,
+	3905,
+	3906,
+	3907,
+	3908,
+	3909,
+	3910,
+	3911,
+	3912,
+	3913,
+	3914,
+	3915,
+	3916,
+	3917,
+	3918,
+	3919,
+	3920,
+	3921,
+	3922,
+	3923,
+	3924,
+	3925,
+	3926,
+	3927,
+	3928,
+	3929,
+	3930,
+	3931,
+	3932,
+	3933,
+	3934,
+	3935,
+	3936,
+	3937,
+	3938,
+	3939,
+	3940,
+	3941,
+	3942,
+	3943,
+	3944,
+	3945,
+	3946,
+	3947,
+	3948,
+	3949,
+	3950,
+	3951,
+	3952,
+	3953,
+	3954,
+	3955,
+	3956,
+	3957,
+	3958,
+	3959,
+	3960,
+	3961,
+	3962,
+	3963,
+	3964,
+	3965,
+	3966,
+	3967,
+	3968,
+	3969,
+	3970,
+	3971,
+	3972,
+	3973,
+	3974,
+	3975,
+	3976,
+	3977,
+	3978,
+	3979,
+	3980,
+	3981,
+	3982,
+	3983,
+	3984,
+	3985,
+	3986,
+	3987,
+	3988,
+	3989,
+	3990,
+	3991,
+	3992,
+	3993,
+	3994,
+	3995,
+	3996,
+	3997,
+	3998,
+	3999,
+	4000,
+	4001,
+	4002,
+	4003,
+	4004,
+	4005,
+	4006,
+	4007,
+	4008,
+	4009,
+	4010,
+	4011,
+	4012,
+	4013,
+	4014,
+	4015,
+	4016,
+	4017,
+	4018,
+	4019,
+	4020,
+	4021,
+	4022,
+	4023,
+	4024,
+	4025,
+	4026,
+	4027,
+	4028,
+	4029,
+	4030,
+	4031,
+	4032,
+	4033,
+	4034,
+	4035,
+	4036,
+	4037,
+	4038,
+	4039,
+	4040,
+	4041,
+	4042,
+	4043,
+	4044,
+	4045,
+	4046,
+	4047,
+	4048,
+	4049,
+	4050,
+	4051,
+	4052,
+	4053,
+	4054,
+	4055,
+	4056,
+	4057,
+	4058,
+	4059,
+	4060,
+	4061,
+	4062,
+	4063,
+	4064,
+	4065,
+	4066,
+	4067,
+	4068,
+	4069,
+	4070,
+	4071,
+	4072,
+	4073,
+	4074,
+	4075,
+	4076,
+	4077,
+	4078,
+	4079,
+	4080,
+	4081,
+	4082,
+	4083,
+	4084,
+	4085,
+	4086,
+	4087,
+	4088,
+	4089,
+	4090,
+	4091,
+	4092,
+	4093,
+	4094,
+	4095,
+	4096,
+	4097,
+	4098,
+	4099,
+	4100,
+	4101,
+	4102,
+	4103,
+	4104,
+	4105,
+	4106,
+	4107,
+	4108,
+	4109,
+	4110,
+	4111,
+	4112,
+	4113,
+	4114,
+	4115,
+	4116,
+	4117,
+	4118,
+	4119,
+	4120,
+	4121,
+	4122,
+	4123,
+	4124,
+	4125,
+	4126,
+	4127,
+	4128,
+	4129,
+	4130,
+	4131,
+	4132,
+	4133,
+	4134,
+	4135,
+	4136,
+	4137,
+	4138,
+	4139,
+	4140,
+	4141,
+	4142,
+	4143,
+	4144,
+	4145,
+	4146,
+	4147,
+	4148,
+	4149,
+	4150,
+	4151,
+	4152,
+	4153,
+	4154,
+	4155,
+	4156,
+	4157,
+	4158,
+	4159,
+	4160,
+	4161,
+	4162,
+	4163,
+	4164,
+	4165,
+	4166,
+	6000,
+	6001,
+	6002,
+	6003,
+	6004,
+	6005,
+	6006,
+	6007,
+	6008,
+	6009,
+	6010,
+	6011,
+	6012,
+	6013,
+	6014,
+	6015,
+	6016,
+	6017,
+	6018,
+	6019,
+	6020,
+	6021,
+	6022,
+	6023,
+	6024,
+	6025,
+	6026,
+	6027,
+	6028,
+	6029,
+	6030,
+	6031,
+	6032,
+	6033,
+	6034,
+	6035,
+	6036,
+	6037,
+	6038,
+	6039,
+	6040,
+	6041,
+	6042,
+	6043,
+	6044,
+	6045,
+	6046,
+	6047,
+	6048,
+	6049,
+	6050,
+	6051,
+	6052,
+	6053,
+	6054,
+	6055,
+	6056,
+	6057,
+	6058,
+	6059,
+	6060,
+	6061,
+	6062,
+	6063,
+	6064,
+	6065,
+	6066,
+	6067,
+	6068,
+	6069,
+	6070,
+	6071,
+	6072,
+	6073,
+	6074,
+	6075,
+	6076,
+	6077,
+	6078,
+	6079,
+	6080,
+	6081,
+	6082,
+	6083,
+	6084,
+	6085,
+	6086,
+	6087,
+	6088,
+	6089,
+	6090,
+	6091,
+	6092,
+	6093,
+	6094,
+	6095,
+	6096,
+	6097,
+	6098,
+	6099,
+	6100,
+	6101,
+	6102,
+	6103,
+	6104,
+	6105,
+	6106,
+	6107,
+	6108,
+	6109,
+	6110,
+	6111,
+	6112,
+	6113,
+	6114,
+	6115,
+	6116,
+	6117,
+	6118,
+	6119,
+	6120,
+	6121,
+	6122,
+	6123,
+	6124,
+	6125,
+	6126,
+	6127,
+	6128,
+	6129,
+	6130,
+	6131,
+	6132,
+	6133,
+	6134,
+	6135,
+	6136,
+	6137,
+	6138,
+	6139,
+	6140,
+}
+var errorNames = [...]string{
+	"OBSOLETE_ER_HASHCHK",
+	"OBSOLETE_ER_NISAMCHK",
+	"ER_NO",
+	"ER_YES",
+	"ER_CANT_CREATE_FILE",
+	"ER_CANT_CREATE_TABLE",
+	"ER_CANT_CREATE_DB",
+	"ER_DB_CREATE_EXISTS",
+	"ER_DB_DROP_EXISTS",
+	"OBSOLETE_ER_DB_DROP_DELETE",
+	"ER_DB_DROP_RMDIR",
+	"OBSOLETE_ER_CANT_DELETE_FILE",
+	"ER_CANT_FIND_SYSTEM_REC",
+	"ER_CANT_GET_STAT",
+	"OBSOLETE_ER_CANT_GET_WD",
+	"ER_CANT_LOCK",
+	"ER_CANT_OPEN_FILE",
+	"ER_FILE_NOT_FOUND",
+	"ER_CANT_READ_DIR",
+	"OBSOLETE_ER_CANT_SET_WD",
+	"ER_CHECKREAD",
+	"OBSOLETE_ER_DISK_FULL",
+	"ER_DUP_KEY",
+	"OBSOLETE_ER_ERROR_ON_CLOSE",
+	"ER_ERROR_ON_READ",
+	"ER_ERROR_ON_RENAME",
+	"ER_ERROR_ON_WRITE",
+	"ER_FILE_USED",
+	"OBSOLETE_ER_FILSORT_ABORT",
+	"OBSOLETE_ER_FORM_NOT_FOUND",
+	"ER_GET_ERRNO",
+	"ER_ILLEGAL_HA",
+	"ER_KEY_NOT_FOUND",
+	"ER_NOT_FORM_FILE",
+	"ER_NOT_KEYFILE",
+	"ER_OLD_KEYFILE",
+	"ER_OPEN_AS_READONLY",
+	"ER_OUTOFMEMORY",
+	"ER_OUT_OF_SORTMEMORY",
+	"OBSOLETE_ER_UNEXPECTED_EOF",
+	"ER_CON_COUNT_ERROR",
+	"ER_OUT_OF_RESOURCES",
+	"ER_BAD_HOST_ERROR",
+	"ER_HANDSHAKE_ERROR",
+	"ER_DBACCESS_DENIED_ERROR",
+	"ER_ACCESS_DENIED_ERROR",
+	"ER_NO_DB_ERROR",
+	"ER_UNKNOWN_COM_ERROR",
+	"ER_BAD_NULL_ERROR",
+	"ER_BAD_DB_ERROR",
+	"ER_TABLE_EXISTS_ERROR",
+	"ER_BAD_TABLE_ERROR",
+	"ER_NON_UNIQ_ERROR",
+	"ER_SERVER_SHUTDOWN",
+	"ER_BAD_FIELD_ERROR",
+	"ER_WRONG_FIELD_WITH_GROUP",
+	"ER_WRONG_GROUP_FIELD",
+	"ER_WRONG_SUM_SELECT",
+	"ER_WRONG_VALUE_COUNT",
+	"ER_TOO_LONG_IDENT",
+	"ER_DUP_FIELDNAME",
+	"ER_DUP_KEYNAME",
+	"ER_DUP_ENTRY",
+	"ER_WRONG_FIELD_SPEC",
+	"ER_PARSE_ERROR",
+	"ER_EMPTY_QUERY",
+	"ER_NONUNIQ_TABLE",
+	"ER_INVALID_DEFAULT",
+	"ER_MULTIPLE_PRI_KEY",
+	"ER_TOO_MANY_KEYS",
+	"ER_TOO_MANY_KEY_PARTS",
+	"ER_TOO_LONG_KEY",
+	"ER_KEY_COLUMN_DOES_NOT_EXITS",
+	"ER_BLOB_USED_AS_KEY",
+	"ER_TOO_BIG_FIELDLENGTH",
+	"ER_WRONG_AUTO_KEY",
+	"ER_READY",
+	"OBSOLETE_ER_NORMAL_SHUTDOWN",
+	"OBSOLETE_ER_GOT_SIGNAL",
+	"ER_SHUTDOWN_COMPLETE",
+	"ER_FORCING_CLOSE",
+	"ER_IPSOCK_ERROR",
+	"ER_NO_SUCH_INDEX",
+	"ER_WRONG_FIELD_TERMINATORS",
+	"ER_BLOBS_AND_NO_TERMINATED",
+	"ER_TEXTFILE_NOT_READABLE",
+	"ER_FILE_EXISTS_ERROR",
+	"ER_LOAD_INFO",
+	"ER_ALTER_INFO",
+	"ER_WRONG_SUB_KEY",
+	"ER_CANT_REMOVE_ALL_FIELDS",
+	"ER_CANT_DROP_FIELD_OR_KEY",
+	"ER_INSERT_INFO",
+	"ER_UPDATE_TABLE_USED",
+	"ER_NO_SUCH_THREAD",
+	"ER_KILL_DENIED_ERROR",
+	"ER_NO_TABLES_USED",
+	"ER_TOO_BIG_SET",
+	"ER_NO_UNIQUE_LOGFILE",
+	"ER_TABLE_NOT_LOCKED_FOR_WRITE",
+	"ER_TABLE_NOT_LOCKED",
+	"ER_BLOB_CANT_HAVE_DEFAULT",
+	"ER_WRONG_DB_NAME",
+	"ER_WRONG_TABLE_NAME",
+	"ER_TOO_BIG_SELECT",
+	"ER_UNKNOWN_ERROR",
+	"ER_UNKNOWN_PROCEDURE",
+	"ER_WRONG_PARAMCOUNT_TO_PROCEDURE",
+	"ER_WRONG_PARAMETERS_TO_PROCEDURE",
+	"ER_UNKNOWN_TABLE",
+	"ER_FIELD_SPECIFIED_TWICE",
+	"ER_INVALID_GROUP_FUNC_USE",
+	"ER_UNSUPPORTED_EXTENSION",
+	"ER_TABLE_MUST_HAVE_COLUMNS",
+	"ER_RECORD_FILE_FULL",
+	"ER_UNKNOWN_CHARACTER_SET",
+	"ER_TOO_MANY_TABLES",
+	"ER_TOO_MANY_FIELDS",
+	"ER_TOO_BIG_ROWSIZE",
+	"ER_STACK_OVERRUN",
+	"ER_WRONG_OUTER_JOIN_UNUSED",
+	"ER_NULL_COLUMN_IN_INDEX",
+	"ER_CANT_FIND_UDF",
+	"ER_CANT_INITIALIZE_UDF",
+	"ER_UDF_NO_PATHS",
+	"ER_UDF_EXISTS",
+	"ER_CANT_OPEN_LIBRARY",
+	"ER_CANT_FIND_DL_ENTRY",
+	"ER_FUNCTION_NOT_DEFINED",
+	"ER_HOST_IS_BLOCKED",
+	"ER_HOST_NOT_PRIVILEGED",
+	"ER_PASSWORD_ANONYMOUS_USER",
+	"ER_PASSWORD_NOT_ALLOWED",
+	"ER_PASSWORD_NO_MATCH",
+	"ER_UPDATE_INFO",
+	"ER_CANT_CREATE_THREAD",
+	"ER_WRONG_VALUE_COUNT_ON_ROW",
+	"ER_CANT_REOPEN_TABLE",
+	"ER_INVALID_USE_OF_NULL",
+	"ER_REGEXP_ERROR",
+	"ER_MIX_OF_GROUP_FUNC_AND_FIELDS",
+	"ER_NONEXISTING_GRANT",
+	"ER_TABLEACCESS_DENIED_ERROR",
+	"ER_COLUMNACCESS_DENIED_ERROR",
+	"ER_ILLEGAL_GRANT_FOR_TABLE",
+	"ER_GRANT_WRONG_HOST_OR_USER",
+	"ER_NO_SUCH_TABLE",
+	"ER_NONEXISTING_TABLE_GRANT",
+	"ER_NOT_ALLOWED_COMMAND",
+	"ER_SYNTAX_ERROR",
+	"OBSOLETE_ER_UNUSED1",
+	"OBSOLETE_ER_UNUSED2",
+	"ER_ABORTING_CONNECTION",
+	"ER_NET_PACKET_TOO_LARGE",
+	"ER_NET_READ_ERROR_FROM_PIPE",
+	"ER_NET_FCNTL_ERROR",
+	"ER_NET_PACKETS_OUT_OF_ORDER",
+	"ER_NET_UNCOMPRESS_ERROR",
+	"ER_NET_READ_ERROR",
+	"ER_NET_READ_INTERRUPTED",
+	"ER_NET_ERROR_ON_WRITE",
+	"ER_NET_WRITE_INTERRUPTED",
+	"ER_TOO_LONG_STRING",
+	"ER_TABLE_CANT_HANDLE_BLOB",
+	"ER_TABLE_CANT_HANDLE_AUTO_INCREMENT",
+	"OBSOLETE_ER_UNUSED3",
+	"ER_WRONG_COLUMN_NAME",
+	"ER_WRONG_KEY_COLUMN",
+	"ER_WRONG_MRG_TABLE",
+	"ER_DUP_UNIQUE",
+	"ER_BLOB_KEY_WITHOUT_LENGTH",
+	"ER_PRIMARY_CANT_HAVE_NULL",
+	"ER_TOO_MANY_ROWS",
+	"ER_REQUIRES_PRIMARY_KEY",
+	"OBSOLETE_ER_NO_RAID_COMPILED",
+	"ER_UPDATE_WITHOUT_KEY_IN_SAFE_MODE",
+	"ER_KEY_DOES_NOT_EXITS",
+	"ER_CHECK_NO_SUCH_TABLE",
+	"ER_CHECK_NOT_IMPLEMENTED",
+	"ER_CANT_DO_THIS_DURING_AN_TRANSACTION",
+	"ER_ERROR_DURING_COMMIT",
+	"ER_ERROR_DURING_ROLLBACK",
+	"ER_ERROR_DURING_FLUSH_LOGS",
+	"OBSOLETE_ER_ERROR_DURING_CHECKPOINT",
+	"ER_NEW_ABORTING_CONNECTION",
+	"OBSOLETE_ER_DUMP_NOT_IMPLEMENTED",
+	"OBSOLETE_ER_FLUSH_MASTER_BINLOG_CLOSED",
+	"OBSOLETE_ER_INDEX_REBUILD",
+	"ER_SOURCE",
+	"ER_SOURCE_NET_READ",
+	"ER_SOURCE_NET_WRITE",
+	"ER_FT_MATCHING_KEY_NOT_FOUND",
+	"ER_LOCK_OR_ACTIVE_TRANSACTION",
+	"ER_UNKNOWN_SYSTEM_VARIABLE",
+	"ER_CRASHED_ON_USAGE",
+	"ER_CRASHED_ON_REPAIR",
+	"ER_WARNING_NOT_COMPLETE_ROLLBACK",
+	"ER_TRANS_CACHE_FULL",
+	"OBSOLETE_ER_SLAVE_MUST_STOP",
+	"ER_REPLICA_NOT_RUNNING",
+	"ER_BAD_REPLICA",
+	"ER_CONNECTION_METADATA",
+	"ER_REPLICA_THREAD",
+	"ER_TOO_MANY_USER_CONNECTIONS",
+	"ER_SET_CONSTANTS_ONLY",
+	"ER_LOCK_WAIT_TIMEOUT",
+	"ER_LOCK_TABLE_FULL",
+	"ER_READ_ONLY_TRANSACTION",
+	"OBSOLETE_ER_DROP_DB_WITH_READ_LOCK",
+	"OBSOLETE_ER_CREATE_DB_WITH_READ_LOCK",
+	"ER_WRONG_ARGUMENTS",
+	"ER_NO_PERMISSION_TO_CREATE_USER",
+	"OBSOLETE_ER_UNION_TABLES_IN_DIFFERENT_DIR",
+	"ER_LOCK_DEADLOCK",
+	"ER_TABLE_CANT_HANDLE_FT",
+	"ER_CANNOT_ADD_FOREIGN",
+	"ER_NO_REFERENCED_ROW",
+	"ER_ROW_IS_REFERENCED",
+	"ER_CONNECT_TO_SOURCE",
+	"OBSOLETE_ER_QUERY_ON_MASTER",
+	"ER_ERROR_WHEN_EXECUTING_COMMAND",
+	"ER_WRONG_USAGE",
+	"ER_WRONG_NUMBER_OF_COLUMNS_IN_SELECT",
+	"ER_CANT_UPDATE_WITH_READLOCK",
+	"ER_MIXING_NOT_ALLOWED",
+	"ER_DUP_ARGUMENT",
+	"ER_USER_LIMIT_REACHED",
+	"ER_SPECIFIC_ACCESS_DENIED_ERROR",
+	"ER_LOCAL_VARIABLE",
+	"ER_GLOBAL_VARIABLE",
+	"ER_NO_DEFAULT",
+	"ER_WRONG_VALUE_FOR_VAR",
+	"ER_WRONG_TYPE_FOR_VAR",
+	"ER_VAR_CANT_BE_READ",
+	"ER_CANT_USE_OPTION_HERE",
+	"ER_NOT_SUPPORTED_YET",
+	"ER_SOURCE_FATAL_ERROR_READING_BINLOG",
+	"ER_REPLICA_IGNORED_TABLE",
+	"ER_INCORRECT_GLOBAL_LOCAL_VAR",
+	"ER_WRONG_FK_DEF",
+	"ER_KEY_REF_DO_NOT_MATCH_TABLE_REF",
+	"ER_OPERAND_COLUMNS",
+	"ER_SUBQUERY_NO_1_ROW",
+	"ER_UNKNOWN_STMT_HANDLER",
+	"ER_CORRUPT_HELP_DB",
+	"OBSOLETE_ER_CYCLIC_REFERENCE",
+	"ER_AUTO_CONVERT",
+	"ER_ILLEGAL_REFERENCE",
+	"ER_DERIVED_MUST_HAVE_ALIAS",
+	"ER_SELECT_REDUCED",
+	"ER_TABLENAME_NOT_ALLOWED_HERE",
+	"ER_NOT_SUPPORTED_AUTH_MODE",
+	"ER_SPATIAL_CANT_HAVE_NULL",
+	"ER_COLLATION_CHARSET_MISMATCH",
+	"OBSOLETE_ER_SLAVE_WAS_RUNNING",
+	"OBSOLETE_ER_SLAVE_WAS_NOT_RUNNING",
+	"ER_TOO_BIG_FOR_UNCOMPRESS",
+	"ER_ZLIB_Z_MEM_ERROR",
+	"ER_ZLIB_Z_BUF_ERROR",
+	"ER_ZLIB_Z_DATA_ERROR",
+	"ER_CUT_VALUE_GROUP_CONCAT",
+	"ER_WARN_TOO_FEW_RECORDS",
+	"ER_WARN_TOO_MANY_RECORDS",
+	"ER_WARN_NULL_TO_NOTNULL",
+	"ER_WARN_DATA_OUT_OF_RANGE",
+	"WARN_DATA_TRUNCATED",
+	"ER_WARN_USING_OTHER_HANDLER",
+	"ER_CANT_AGGREGATE_2COLLATIONS",
+	"OBSOLETE_ER_DROP_USER",
+	"ER_REVOKE_GRANTS",
+	"ER_CANT_AGGREGATE_3COLLATIONS",
+	"ER_CANT_AGGREGATE_NCOLLATIONS",
+	"ER_VARIABLE_IS_NOT_STRUCT",
+	"ER_UNKNOWN_COLLATION",
+	"ER_REPLICA_IGNORED_SSL_PARAMS",
+	"OBSOLETE_ER_SERVER_IS_IN_SECURE_AUTH_MODE",
+	"ER_WARN_FIELD_RESOLVED",
+	"ER_BAD_REPLICA_UNTIL_COND",
+	"ER_MISSING_SKIP_REPLICA",
+	"ER_UNTIL_COND_IGNORED",
+	"ER_WRONG_NAME_FOR_INDEX",
+	"ER_WRONG_NAME_FOR_CATALOG",
+	"OBSOLETE_ER_WARN_QC_RESIZE",
+	"ER_BAD_FT_COLUMN",
+	"ER_UNKNOWN_KEY_CACHE",
+	"ER_WARN_HOSTNAME_WONT_WORK",
+	"ER_UNKNOWN_STORAGE_ENGINE",
+	"ER_WARN_DEPRECATED_SYNTAX",
+	"ER_NON_UPDATABLE_TABLE",
+	"ER_FEATURE_DISABLED",
+	"ER_OPTION_PREVENTS_STATEMENT",
+	"ER_DUPLICATED_VALUE_IN_TYPE",
+	"ER_TRUNCATED_WRONG_VALUE",
+	"OBSOLETE_ER_TOO_MUCH_AUTO_TIMESTAMP_COLS",
+	"ER_INVALID_ON_UPDATE",
+	"ER_UNSUPPORTED_PS",
+	"ER_GET_ERRMSG",
+	"ER_GET_TEMPORARY_ERRMSG",
+	"ER_UNKNOWN_TIME_ZONE",
+	"ER_WARN_INVALID_TIMESTAMP",
+	"ER_INVALID_CHARACTER_STRING",
+	"ER_WARN_ALLOWED_PACKET_OVERFLOWED",
+	"ER_CONFLICTING_DECLARATIONS",
+	"ER_SP_NO_RECURSIVE_CREATE",
+	"ER_SP_ALREADY_EXISTS",
+	"ER_SP_DOES_NOT_EXIST",
+	"ER_SP_DROP_FAILED",
+	"ER_SP_STORE_FAILED",
+	"ER_SP_LILABEL_MISMATCH",
+	"ER_SP_LABEL_REDEFINE",
+	"ER_SP_LABEL_MISMATCH",
+	"ER_SP_UNINIT_VAR",
+	"ER_SP_BADSELECT",
+	"ER_SP_BADRETURN",
+	"ER_SP_BADSTATEMENT",
+	"ER_UPDATE_LOG_DEPRECATED_IGNORED",
+	"ER_UPDATE_LOG_DEPRECATED_TRANSLATED",
+	"ER_QUERY_INTERRUPTED",
+	"ER_SP_WRONG_NO_OF_ARGS",
+	"ER_SP_COND_MISMATCH",
+	"ER_SP_NORETURN",
+	"ER_SP_NORETURNEND",
+	"ER_SP_BAD_CURSOR_QUERY",
+	"ER_SP_BAD_CURSOR_SELECT",
+	"ER_SP_CURSOR_MISMATCH",
+	"ER_SP_CURSOR_ALREADY_OPEN",
+	"ER_SP_CURSOR_NOT_OPEN",
+	"ER_SP_UNDECLARED_VAR",
+	"ER_SP_WRONG_NO_OF_FETCH_ARGS",
+	"ER_SP_FETCH_NO_DATA",
+	"ER_SP_DUP_PARAM",
+	"ER_SP_DUP_VAR",
+	"ER_SP_DUP_COND",
+	"ER_SP_DUP_CURS",
+	"ER_SP_CANT_ALTER",
+	"ER_SP_SUBSELECT_NYI",
+	"ER_STMT_NOT_ALLOWED_IN_SF_OR_TRG",
+	"ER_SP_VARCOND_AFTER_CURSHNDLR",
+	"ER_SP_CURSOR_AFTER_HANDLER",
+	"ER_SP_CASE_NOT_FOUND",
+	"ER_FPARSER_TOO_BIG_FILE",
+	"ER_FPARSER_BAD_HEADER",
+	"ER_FPARSER_EOF_IN_COMMENT",
+	"ER_FPARSER_ERROR_IN_PARAMETER",
+	"ER_FPARSER_EOF_IN_UNKNOWN_PARAMETER",
+	"ER_VIEW_NO_EXPLAIN",
+	"OBSOLETE_ER_FRM_UNKNOWN_TYPE",
+	"ER_WRONG_OBJECT",
+	"ER_NONUPDATEABLE_COLUMN",
+	"OBSOLETE_ER_VIEW_SELECT_DERIVED_UNUSED",
+	"ER_VIEW_SELECT_CLAUSE",
+	"ER_VIEW_SELECT_VARIABLE",
+	"ER_VIEW_SELECT_TMPTABLE",
+	"ER_VIEW_WRONG_LIST",
+	"ER_WARN_VIEW_MERGE",
+	"ER_WARN_VIEW_WITHOUT_KEY",
+	"ER_VIEW_INVALID",
+	"ER_SP_NO_DROP_SP",
+	"OBSOLETE_ER_SP_GOTO_IN_HNDLR",
+	"ER_TRG_ALREADY_EXISTS",
+	"ER_TRG_DOES_NOT_EXIST",
+	"ER_TRG_ON_VIEW_OR_TEMP_TABLE",
+	"ER_TRG_CANT_CHANGE_ROW",
+	"ER_TRG_NO_SUCH_ROW_IN_TRG",
+	"ER_NO_DEFAULT_FOR_FIELD",
+	"ER_DIVISION_BY_ZERO",
+	"ER_TRUNCATED_WRONG_VALUE_FOR_FIELD",
+	"ER_ILLEGAL_VALUE_FOR_TYPE",
+	"ER_VIEW_NONUPD_CHECK",
+	"ER_VIEW_CHECK_FAILED",
+	"ER_PROCACCESS_DENIED_ERROR",
+	"ER_RELAY_LOG_FAIL",
+	"OBSOLETE_ER_PASSWD_LENGTH",
+	"ER_UNKNOWN_TARGET_BINLOG",
+	"ER_IO_ERR_LOG_INDEX_READ",
+	"ER_BINLOG_PURGE_PROHIBITED",
+	"ER_FSEEK_FAIL",
+	"ER_BINLOG_PURGE_FATAL_ERR",
+	"ER_LOG_IN_USE",
+	"ER_LOG_PURGE_UNKNOWN_ERR",
+	"ER_RELAY_LOG_INIT",
+	"ER_NO_BINARY_LOGGING",
+	"ER_RESERVED_SYNTAX",
+	"OBSOLETE_ER_WSAS_FAILED",
+	"OBSOLETE_ER_DIFF_GROUPS_PROC",
+	"OBSOLETE_ER_NO_GROUP_FOR_PROC",
+	"OBSOLETE_ER_ORDER_WITH_PROC",
+	"OBSOLETE_ER_LOGGING_PROHIBIT_CHANGING_OF",
+	"OBSOLETE_ER_NO_FILE_MAPPING",
+	"OBSOLETE_ER_WRONG_MAGIC",
+	"ER_PS_MANY_PARAM",
+	"ER_KEY_PART_0",
+	"ER_VIEW_CHECKSUM",
+	"ER_VIEW_MULTIUPDATE",
+	"ER_VIEW_NO_INSERT_FIELD_LIST",
+	"ER_VIEW_DELETE_MERGE_VIEW",
+	"ER_CANNOT_USER",
+	"ER_XAER_NOTA",
+	"ER_XAER_INVAL",
+	"ER_XAER_RMFAIL",
+	"ER_XAER_OUTSIDE",
+	"ER_XAER_RMERR",
+	"ER_XA_RBROLLBACK",
+	"ER_NONEXISTING_PROC_GRANT",
+	"ER_PROC_AUTO_GRANT_FAIL",
+	"ER_PROC_AUTO_REVOKE_FAIL",
+	"ER_DATA_TOO_LONG",
+	"ER_SP_BAD_SQLSTATE",
+	"ER_STARTUP",
+	"ER_LOAD_FROM_FIXED_SIZE_ROWS_TO_VAR",
+	"ER_CANT_CREATE_USER_WITH_GRANT",
+	"ER_WRONG_VALUE_FOR_TYPE",
+	"ER_TABLE_DEF_CHANGED",
+	"ER_SP_DUP_HANDLER",
+	"ER_SP_NOT_VAR_ARG",
+	"ER_SP_NO_RETSET",
+	"ER_CANT_CREATE_GEOMETRY_OBJECT",
+	"OBSOLETE_ER_FAILED_ROUTINE_BREAK_BINLOG",
+	"ER_BINLOG_UNSAFE_ROUTINE",
+	"ER_BINLOG_CREATE_ROUTINE_NEED_SUPER",
+	"OBSOLETE_ER_EXEC_STMT_WITH_OPEN_CURSOR",
+	"ER_STMT_HAS_NO_OPEN_CURSOR",
+	"ER_COMMIT_NOT_ALLOWED_IN_SF_OR_TRG",
+	"ER_NO_DEFAULT_FOR_VIEW_FIELD",
+	"ER_SP_NO_RECURSION",
+	"ER_TOO_BIG_SCALE",
+	"ER_TOO_BIG_PRECISION",
+	"ER_M_BIGGER_THAN_D",
+	"ER_WRONG_LOCK_OF_SYSTEM_TABLE",
+	"ER_CONNECT_TO_FOREIGN_DATA_SOURCE",
+	"ER_QUERY_ON_FOREIGN_DATA_SOURCE",
+	"ER_FOREIGN_DATA_SOURCE_DOESNT_EXIST",
+	"ER_FOREIGN_DATA_STRING_INVALID_CANT_CREATE",
+	"ER_FOREIGN_DATA_STRING_INVALID",
+	"OBSOLETE_ER_CANT_CREATE_FEDERATED_TABLE",
+	"ER_TRG_IN_WRONG_SCHEMA",
+	"ER_STACK_OVERRUN_NEED_MORE",
+	"ER_TOO_LONG_BODY",
+	"ER_WARN_CANT_DROP_DEFAULT_KEYCACHE",
+	"ER_TOO_BIG_DISPLAYWIDTH",
+	"ER_XAER_DUPID",
+	"ER_DATETIME_FUNCTION_OVERFLOW",
+	"ER_CANT_UPDATE_USED_TABLE_IN_SF_OR_TRG",
+	"ER_VIEW_PREVENT_UPDATE",
+	"ER_PS_NO_RECURSION",
+	"ER_SP_CANT_SET_AUTOCOMMIT",
+	"OBSOLETE_ER_MALFORMED_DEFINER",
+	"ER_VIEW_FRM_NO_USER",
+	"ER_VIEW_OTHER_USER",
+	"ER_NO_SUCH_USER",
+	"ER_FORBID_SCHEMA_CHANGE",
+	"ER_ROW_IS_REFERENCED_2",
+	"ER_NO_REFERENCED_ROW_2",
+	"ER_SP_BAD_VAR_SHADOW",
+	"ER_TRG_NO_DEFINER",
+	"ER_OLD_FILE_FORMAT",
+	"ER_SP_RECURSION_LIMIT",
+	"OBSOLETE_ER_SP_PROC_TABLE_CORRUPT",
+	"ER_SP_WRONG_NAME",
+	"ER_TABLE_NEEDS_UPGRADE",
+	"ER_SP_NO_AGGREGATE",
+	"ER_MAX_PREPARED_STMT_COUNT_REACHED",
+	"ER_VIEW_RECURSIVE",
+	"ER_NON_GROUPING_FIELD_USED",
+	"ER_TABLE_CANT_HANDLE_SPKEYS",
+	"ER_NO_TRIGGERS_ON_SYSTEM_SCHEMA",
+	"ER_REMOVED_SPACES",
+	"ER_AUTOINC_READ_FAILED",
+	"ER_USERNAME",
+	"ER_HOSTNAME",
+	"ER_WRONG_STRING_LENGTH",
+	"ER_NON_INSERTABLE_TABLE",
+	"ER_ADMIN_WRONG_MRG_TABLE",
+	"ER_TOO_HIGH_LEVEL_OF_NESTING_FOR_SELECT",
+	"ER_NAME_BECOMES_EMPTY",
+	"ER_AMBIGUOUS_FIELD_TERM",
+	"ER_FOREIGN_SERVER_EXISTS",
+	"ER_FOREIGN_SERVER_DOESNT_EXIST",
+	"ER_ILLEGAL_HA_CREATE_OPTION",
+	"ER_PARTITION_REQUIRES_VALUES_ERROR",
+	"ER_PARTITION_WRONG_VALUES_ERROR",
+	"ER_PARTITION_MAXVALUE_ERROR",
+	"OBSOLETE_ER_PARTITION_SUBPARTITION_ERROR",
+	"OBSOLETE_ER_PARTITION_SUBPART_MIX_ERROR",
+	"ER_PARTITION_WRONG_NO_PART_ERROR",
+	"ER_PARTITION_WRONG_NO_SUBPART_ERROR",
+	"ER_WRONG_EXPR_IN_PARTITION_FUNC_ERROR",
+	"OBSOLETE_ER_NO_CONST_EXPR_IN_RANGE_OR_LIST_ERROR",
+	"ER_FIELD_NOT_FOUND_PART_ERROR",
+	"OBSOLETE_ER_LIST_OF_FIELDS_ONLY_IN_HASH_ERROR",
+	"ER_INCONSISTENT_PARTITION_INFO_ERROR",
+	"ER_PARTITION_FUNC_NOT_ALLOWED_ERROR",
+	"ER_PARTITIONS_MUST_BE_DEFINED_ERROR",
+	"ER_RANGE_NOT_INCREASING_ERROR",
+	"ER_INCONSISTENT_TYPE_OF_FUNCTIONS_ERROR",
+	"ER_MULTIPLE_DEF_CONST_IN_LIST_PART_ERROR",
+	"ER_PARTITION_ENTRY_ERROR",
+	"ER_MIX_HANDLER_ERROR",
+	"ER_PARTITION_NOT_DEFINED_ERROR",
+	"ER_TOO_MANY_PARTITIONS_ERROR",
+	"ER_SUBPARTITION_ERROR",
+	"ER_CANT_CREATE_HANDLER_FILE",
+	"ER_BLOB_FIELD_IN_PART_FUNC_ERROR",
+	"ER_UNIQUE_KEY_NEED_ALL_FIELDS_IN_PF",
+	"ER_NO_PARTS_ERROR",
+	"ER_PARTITION_MGMT_ON_NONPARTITIONED",
+	"ER_FOREIGN_KEY_ON_PARTITIONED",
+	"ER_DROP_PARTITION_NON_EXISTENT",
+	"ER_DROP_LAST_PARTITION",
+	"ER_COALESCE_ONLY_ON_HASH_PARTITION",
+	"ER_REORG_HASH_ONLY_ON_SAME_NO",
+	"ER_REORG_NO_PARAM_ERROR",
+	"ER_ONLY_ON_RANGE_LIST_PARTITION",
+	"ER_ADD_PARTITION_SUBPART_ERROR",
+	"ER_ADD_PARTITION_NO_NEW_PARTITION",
+	"ER_COALESCE_PARTITION_NO_PARTITION",
+	"ER_REORG_PARTITION_NOT_EXIST",
+	"ER_SAME_NAME_PARTITION",
+	"ER_NO_BINLOG_ERROR",
+	"ER_CONSECUTIVE_REORG_PARTITIONS",
+	"ER_REORG_OUTSIDE_RANGE",
+	"ER_PARTITION_FUNCTION_FAILURE",
+	"OBSOLETE_ER_PART_STATE_ERROR",
+	"ER_LIMITED_PART_RANGE",
+	"ER_PLUGIN_IS_NOT_LOADED",
+	"ER_WRONG_VALUE",
+	"ER_NO_PARTITION_FOR_GIVEN_VALUE",
+	"ER_FILEGROUP_OPTION_ONLY_ONCE",
+	"ER_CREATE_FILEGROUP_FAILED",
+	"ER_DROP_FILEGROUP_FAILED",
+	"ER_TABLESPACE_AUTO_EXTEND_ERROR",
+	"ER_WRONG_SIZE_NUMBER",
+	"ER_SIZE_OVERFLOW_ERROR",
+	"ER_ALTER_FILEGROUP_FAILED",
+	"ER_BINLOG_ROW_LOGGING_FAILED",
+	"OBSOLETE_ER_BINLOG_ROW_WRONG_TABLE_DEF",
+	"OBSOLETE_ER_BINLOG_ROW_RBR_TO_SBR",
+	"ER_EVENT_ALREADY_EXISTS",
+	"OBSOLETE_ER_EVENT_STORE_FAILED",
+	"ER_EVENT_DOES_NOT_EXIST",
+	"OBSOLETE_ER_EVENT_CANT_ALTER",
+	"OBSOLETE_ER_EVENT_DROP_FAILED",
+	"ER_EVENT_INTERVAL_NOT_POSITIVE_OR_TOO_BIG",
+	"ER_EVENT_ENDS_BEFORE_STARTS",
+	"ER_EVENT_EXEC_TIME_IN_THE_PAST",
+	"OBSOLETE_ER_EVENT_OPEN_TABLE_FAILED",
+	"OBSOLETE_ER_EVENT_NEITHER_M_EXPR_NOR_M_AT",
+	"OBSOLETE_ER_COL_COUNT_DOESNT_MATCH_CORRUPTED",
+	"OBSOLETE_ER_CANNOT_LOAD_FROM_TABLE",
+	"OBSOLETE_ER_EVENT_CANNOT_DELETE",
+	"OBSOLETE_ER_EVENT_COMPILE_ERROR",
+	"ER_EVENT_SAME_NAME",
+	"OBSOLETE_ER_EVENT_DATA_TOO_LONG",
+	"ER_DROP_INDEX_FK",
+	"ER_WARN_DEPRECATED_SYNTAX_WITH_VER",
+	"OBSOLETE_ER_CANT_WRITE_LOCK_LOG_TABLE",
+	"ER_CANT_LOCK_LOG_TABLE",
+	"ER_FOREIGN_DUPLICATE_KEY_OLD_UNUSED",
+	"ER_COL_COUNT_DOESNT_MATCH_PLEASE_UPDATE",
+	"OBSOLETE_ER_TEMP_TABLE_PREVENTS_SWITCH_OUT_OF_RBR",
+	"ER_STORED_FUNCTION_PREVENTS_SWITCH_BINLOG_FORMAT",
+	"OBSOLETE_ER_NDB_CANT_SWITCH_BINLOG_FORMAT",
+	"ER_PARTITION_NO_TEMPORARY",
+	"ER_PARTITION_CONST_DOMAIN_ERROR",
+	"ER_PARTITION_FUNCTION_IS_NOT_ALLOWED",
+	"OBSOLETE_ER_DDL_LOG_ERROR_UNUSED",
+	"ER_NULL_IN_VALUES_LESS_THAN",
+	"ER_WRONG_PARTITION_NAME",
+	"ER_CANT_CHANGE_TX_CHARACTERISTICS",
+	"ER_DUP_ENTRY_AUTOINCREMENT_CASE",
+	"OBSOLETE_ER_EVENT_MODIFY_QUEUE_ERROR",
+	"ER_EVENT_SET_VAR_ERROR",
+	"ER_PARTITION_MERGE_ERROR",
+	"OBSOLETE_ER_CANT_ACTIVATE_LOG",
+	"OBSOLETE_ER_RBR_NOT_AVAILABLE",
+	"ER_BASE64_DECODE_ERROR",
+	"ER_EVENT_RECURSION_FORBIDDEN",
+	"OBSOLETE_ER_EVENTS_DB_ERROR",
+	"ER_ONLY_INTEGERS_ALLOWED",
+	"ER_UNSUPORTED_LOG_ENGINE",
+	"ER_BAD_LOG_STATEMENT",
+	"ER_CANT_RENAME_LOG_TABLE",
+	"ER_WRONG_PARAMCOUNT_TO_NATIVE_FCT",
+	"ER_WRONG_PARAMETERS_TO_NATIVE_FCT",
+	"ER_WRONG_PARAMETERS_TO_STORED_FCT",
+	"ER_NATIVE_FCT_NAME_COLLISION",
+	"ER_DUP_ENTRY_WITH_KEY_NAME",
+	"ER_BINLOG_PURGE_EMFILE",
+	"ER_EVENT_CANNOT_CREATE_IN_THE_PAST",
+	"ER_EVENT_CANNOT_ALTER_IN_THE_PAST",
+	"OBSOLETE_ER_SLAVE_INCIDENT",
+	"ER_NO_PARTITION_FOR_GIVEN_VALUE_SILENT",
+	"ER_BINLOG_UNSAFE_STATEMENT",
+	"ER_BINLOG_FATAL_ERROR",
+	"OBSOLETE_ER_SLAVE_RELAY_LOG_READ_FAILURE",
+	"OBSOLETE_ER_SLAVE_RELAY_LOG_WRITE_FAILURE",
+	"OBSOLETE_ER_SLAVE_CREATE_EVENT_FAILURE",
+	"OBSOLETE_ER_SLAVE_MASTER_COM_FAILURE",
+	"ER_BINLOG_LOGGING_IMPOSSIBLE",
+	"ER_VIEW_NO_CREATION_CTX",
+	"ER_VIEW_INVALID_CREATION_CTX",
+	"OBSOLETE_ER_SR_INVALID_CREATION_CTX",
+	"ER_TRG_CORRUPTED_FILE",
+	"ER_TRG_NO_CREATION_CTX",
+	"ER_TRG_INVALID_CREATION_CTX",
+	"ER_EVENT_INVALID_CREATION_CTX",
+	"ER_TRG_CANT_OPEN_TABLE",
+	"OBSOLETE_ER_CANT_CREATE_SROUTINE",
+	"OBSOLETE_ER_NEVER_USED",
+	"ER_NO_FORMAT_DESCRIPTION_EVENT_BEFORE_BINLOG_STATEMENT",
+	"ER_REPLICA_CORRUPT_EVENT",
+	"OBSOLETE_ER_LOAD_DATA_INVALID_COLUMN_UNUSED",
+	"ER_LOG_PURGE_NO_FILE",
+	"ER_XA_RBTIMEOUT",
+	"ER_XA_RBDEADLOCK",
+	"ER_NEED_REPREPARE",
+	"OBSOLETE_ER_DELAYED_NOT_SUPPORTED",
+	"WARN_NO_CONNECTION_METADATA",
+	"WARN_OPTION_IGNORED",
+	"ER_PLUGIN_DELETE_BUILTIN",
+	"WARN_PLUGIN_BUSY",
+	"ER_VARIABLE_IS_READONLY",
+	"ER_WARN_ENGINE_TRANSACTION_ROLLBACK",
+	"OBSOLETE_ER_SLAVE_HEARTBEAT_FAILURE",
+	"ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE",
+	"ER_NDB_REPLICATION_SCHEMA_ERROR",
+	"ER_CONFLICT_FN_PARSE_ERROR",
+	"ER_EXCEPTIONS_WRITE_ERROR",
+	"ER_TOO_LONG_TABLE_COMMENT",
+	"ER_TOO_LONG_FIELD_COMMENT",
+	"ER_FUNC_INEXISTENT_NAME_COLLISION",
+	"ER_DATABASE_NAME",
+	"ER_TABLE_NAME",
+	"ER_PARTITION_NAME",
+	"ER_SUBPARTITION_NAME",
+	"ER_TEMPORARY_NAME",
+	"ER_RENAMED_NAME",
+	"ER_TOO_MANY_CONCURRENT_TRXS",
+	"WARN_NON_ASCII_SEPARATOR_NOT_IMPLEMENTED",
+	"ER_DEBUG_SYNC_TIMEOUT",
+	"ER_DEBUG_SYNC_HIT_LIMIT",
+	"ER_DUP_SIGNAL_SET",
+	"ER_SIGNAL_WARN",
+	"ER_SIGNAL_NOT_FOUND",
+	"ER_SIGNAL_EXCEPTION",
+	"ER_RESIGNAL_WITHOUT_ACTIVE_HANDLER",
+	"ER_SIGNAL_BAD_CONDITION_TYPE",
+	"WARN_COND_ITEM_TRUNCATED",
+	"ER_COND_ITEM_TOO_LONG",
+	"ER_UNKNOWN_LOCALE",
+	"ER_REPLICA_IGNORE_SERVER_IDS",
+	"OBSOLETE_ER_QUERY_CACHE_DISABLED",
+	"ER_SAME_NAME_PARTITION_FIELD",
+	"ER_PARTITION_COLUMN_LIST_ERROR",
+	"ER_WRONG_TYPE_COLUMN_VALUE_ERROR",
+	"ER_TOO_MANY_PARTITION_FUNC_FIELDS_ERROR",
+	"ER_MAXVALUE_IN_VALUES_IN",
+	"ER_TOO_MANY_VALUES_ERROR",
+	"ER_ROW_SINGLE_PARTITION_FIELD_ERROR",
+	"ER_FIELD_TYPE_NOT_ALLOWED_AS_PARTITION_FIELD",
+	"ER_PARTITION_FIELDS_TOO_LONG",
+	"ER_BINLOG_ROW_ENGINE_AND_STMT_ENGINE",
+	"ER_BINLOG_ROW_MODE_AND_STMT_ENGINE",
+	"ER_BINLOG_UNSAFE_AND_STMT_ENGINE",
+	"ER_BINLOG_ROW_INJECTION_AND_STMT_ENGINE",
+	"ER_BINLOG_STMT_MODE_AND_ROW_ENGINE",
+	"ER_BINLOG_ROW_INJECTION_AND_STMT_MODE",
+	"ER_BINLOG_MULTIPLE_ENGINES_AND_SELF_LOGGING_ENGINE",
+	"ER_BINLOG_UNSAFE_LIMIT",
+	"OBSOLETE_ER_UNUSED4",
+	"ER_BINLOG_UNSAFE_SYSTEM_TABLE",
+	"ER_BINLOG_UNSAFE_AUTOINC_COLUMNS",
+	"ER_BINLOG_UNSAFE_UDF",
+	"ER_BINLOG_UNSAFE_SYSTEM_VARIABLE",
+	"ER_BINLOG_UNSAFE_SYSTEM_FUNCTION",
+	"ER_BINLOG_UNSAFE_NONTRANS_AFTER_TRANS",
+	"ER_MESSAGE_AND_STATEMENT",
+	"OBSOLETE_ER_SLAVE_CONVERSION_FAILED",
+	"ER_REPLICA_CANT_CREATE_CONVERSION",
+	"ER_INSIDE_TRANSACTION_PREVENTS_SWITCH_BINLOG_FORMAT",
+	"ER_PATH_LENGTH",
+	"ER_WARN_DEPRECATED_SYNTAX_NO_REPLACEMENT",
+	"ER_WRONG_NATIVE_TABLE_STRUCTURE",
+	"ER_WRONG_PERFSCHEMA_USAGE",
+	"ER_WARN_I_S_SKIPPED_TABLE",
+	"ER_INSIDE_TRANSACTION_PREVENTS_SWITCH_BINLOG_DIRECT",
+	"ER_STORED_FUNCTION_PREVENTS_SWITCH_BINLOG_DIRECT",
+	"ER_SPATIAL_MUST_HAVE_GEOM_COL",
+	"ER_TOO_LONG_INDEX_COMMENT",
+	"ER_LOCK_ABORTED",
+	"ER_DATA_OUT_OF_RANGE",
+	"OBSOLETE_ER_WRONG_SPVAR_TYPE_IN_LIMIT",
+	"ER_BINLOG_UNSAFE_MULTIPLE_ENGINES_AND_SELF_LOGGING_ENGINE",
+	"ER_BINLOG_UNSAFE_MIXED_STATEMENT",
+	"ER_INSIDE_TRANSACTION_PREVENTS_SWITCH_SQL_LOG_BIN",
+	"ER_STORED_FUNCTION_PREVENTS_SWITCH_SQL_LOG_BIN",
+	"ER_FAILED_READ_FROM_PAR_FILE",
+	"ER_VALUES_IS_NOT_INT_TYPE_ERROR",
+	"ER_ACCESS_DENIED_NO_PASSWORD_ERROR",
+	"OBSOLETE_ER_SET_PASSWORD_AUTH_PLUGIN",
+	"OBSOLETE_ER_GRANT_PLUGIN_USER_EXISTS",
+	"ER_TRUNCATE_ILLEGAL_FK",
+	"ER_PLUGIN_IS_PERMANENT",
+	"ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MIN",
+	"ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MAX",
+	"ER_STMT_CACHE_FULL",
+	"ER_MULTI_UPDATE_KEY_CONFLICT",
+	"ER_TABLE_NEEDS_REBUILD",
+	"WARN_OPTION_BELOW_LIMIT",
+	"ER_INDEX_COLUMN_TOO_LONG",
+	"ER_ERROR_IN_TRIGGER_BODY",
+	"ER_ERROR_IN_UNKNOWN_TRIGGER_BODY",
+	"ER_INDEX_CORRUPT",
+	"ER_UNDO_RECORD_TOO_BIG",
+	"ER_BINLOG_UNSAFE_INSERT_IGNORE_SELECT",
+	"ER_BINLOG_UNSAFE_INSERT_SELECT_UPDATE",
+	"ER_BINLOG_UNSAFE_REPLACE_SELECT",
+	"ER_BINLOG_UNSAFE_CREATE_IGNORE_SELECT",
+	"ER_BINLOG_UNSAFE_CREATE_REPLACE_SELECT",
+	"ER_BINLOG_UNSAFE_UPDATE_IGNORE",
+	"ER_PLUGIN_NO_UNINSTALL",
+	"ER_PLUGIN_NO_INSTALL",
+	"ER_BINLOG_UNSAFE_WRITE_AUTOINC_SELECT",
+	"ER_BINLOG_UNSAFE_CREATE_SELECT_AUTOINC",
+	"ER_BINLOG_UNSAFE_INSERT_TWO_KEYS",
+	"ER_TABLE_IN_FK_CHECK",
+	"ER_UNSUPPORTED_ENGINE",
+	"ER_BINLOG_UNSAFE_AUTOINC_NOT_FIRST",
+	"ER_CANNOT_LOAD_FROM_TABLE_V2",
+	"ER_SOURCE_DELAY_VALUE_OUT_OF_RANGE",
+	"ER_ONLY_FD_AND_RBR_EVENTS_ALLOWED_IN_BINLOG_STATEMENT",
+	"ER_PARTITION_EXCHANGE_DIFFERENT_OPTION",
+	"ER_PARTITION_EXCHANGE_PART_TABLE",
+	"ER_PARTITION_EXCHANGE_TEMP_TABLE",
+	"ER_PARTITION_INSTEAD_OF_SUBPARTITION",
+	"ER_UNKNOWN_PARTITION",
+	"ER_TABLES_DIFFERENT_METADATA",
+	"ER_ROW_DOES_NOT_MATCH_PARTITION",
+	"ER_BINLOG_CACHE_SIZE_GREATER_THAN_MAX",
+	"ER_WARN_INDEX_NOT_APPLICABLE",
+	"ER_PARTITION_EXCHANGE_FOREIGN_KEY",
+	"OBSOLETE_ER_NO_SUCH_KEY_VALUE",
+	"ER_RPL_INFO_DATA_TOO_LONG",
+	"OBSOLETE_ER_NETWORK_READ_EVENT_CHECKSUM_FAILURE",
+	"OBSOLETE_ER_BINLOG_READ_EVENT_CHECKSUM_FAILURE",
+	"ER_BINLOG_STMT_CACHE_SIZE_GREATER_THAN_MAX",
+	"ER_CANT_UPDATE_TABLE_IN_CREATE_TABLE_SELECT",
+	"ER_PARTITION_CLAUSE_ON_NONPARTITIONED",
+	"ER_ROW_DOES_NOT_MATCH_GIVEN_PARTITION_SET",
+	"OBSOLETE_ER_NO_SUCH_PARTITION__UNUSED",
+	"ER_CHANGE_RPL_INFO_REPOSITORY_FAILURE",
+	"ER_WARNING_NOT_COMPLETE_ROLLBACK_WITH_CREATED_TEMP_TABLE",
+	"ER_WARNING_NOT_COMPLETE_ROLLBACK_WITH_DROPPED_TEMP_TABLE",
+	"ER_MTA_FEATURE_IS_NOT_SUPPORTED",
+	"ER_MTA_UPDATED_DBS_GREATER_MAX",
+	"ER_MTA_CANT_PARALLEL",
+	"ER_MTA_INCONSISTENT_DATA",
+	"ER_FULLTEXT_NOT_SUPPORTED_WITH_PARTITIONING",
+	"ER_DA_INVALID_CONDITION_NUMBER",
+	"ER_INSECURE_PLAIN_TEXT",
+	"ER_INSECURE_CHANGE_SOURCE",
+	"ER_FOREIGN_DUPLICATE_KEY_WITH_CHILD_INFO",
+	"ER_FOREIGN_DUPLICATE_KEY_WITHOUT_CHILD_INFO",
+	"ER_SQLTHREAD_WITH_SECURE_REPLICA",
+	"ER_TABLE_HAS_NO_FT",
+	"ER_VARIABLE_NOT_SETTABLE_IN_SF_OR_TRIGGER",
+	"ER_VARIABLE_NOT_SETTABLE_IN_TRANSACTION",
+	"OBSOLETE_ER_GTID_NEXT_IS_NOT_IN_GTID_NEXT_LIST",
+	"OBSOLETE_ER_CANT_CHANGE_GTID_NEXT_IN_TRANSACTION",
+	"ER_SET_STATEMENT_CANNOT_INVOKE_FUNCTION",
+	"ER_GTID_NEXT_CANT_BE_AUTOMATIC_IF_GTID_NEXT_LIST_IS_NON_NULL",
+	"OBSOLETE_ER_SKIPPING_LOGGED_TRANSACTION",
+	"ER_MALFORMED_GTID_SET_SPECIFICATION",
+	"ER_MALFORMED_GTID_SET_ENCODING",
+	"ER_MALFORMED_GTID_SPECIFICATION",
+	"ER_GNO_EXHAUSTED",
+	"ER_BAD_REPLICA_AUTO_POSITION",
+	"ER_AUTO_POSITION_REQUIRES_GTID_MODE_NOT_OFF",
+	"ER_CANT_DO_IMPLICIT_COMMIT_IN_TRX_WHEN_GTID_NEXT_IS_SET",
+	"ER_GTID_MODE_ON_REQUIRES_ENFORCE_GTID_CONSISTENCY_ON",
+	"OBSOLETE_ER_GTID_MODE_REQUIRES_BINLOG",
+	"ER_CANT_SET_GTID_NEXT_TO_GTID_WHEN_GTID_MODE_IS_OFF",
+	"ER_CANT_SET_GTID_NEXT_TO_ANONYMOUS_WHEN_GTID_MODE_IS_ON",
+	"ER_CANT_SET_GTID_NEXT_LIST_TO_NON_NULL_WHEN_GTID_MODE_IS_OFF",
+	"OBSOLETE_ER_FOUND_GTID_EVENT_WHEN_GTID_MODE_IS_OFF__UNUSED",
+	"ER_GTID_UNSAFE_NON_TRANSACTIONAL_TABLE",
+	"ER_GTID_UNSAFE_CREATE_SELECT",
+	"OBSOLETE_ER_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRANSACTION",
+	"ER_GTID_MODE_CAN_ONLY_CHANGE_ONE_STEP_AT_A_TIME",
+	"ER_SOURCE_HAS_PURGED_REQUIRED_GTIDS",
+	"ER_CANT_SET_GTID_NEXT_WHEN_OWNING_GTID",
+	"ER_UNKNOWN_EXPLAIN_FORMAT",
+	"ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION",
+	"ER_TOO_LONG_TABLE_PARTITION_COMMENT",
+	"ER_REPLICA_CONFIGURATION",
+	"ER_INNODB_FT_LIMIT",
+	"ER_INNODB_NO_FT_TEMP_TABLE",
+	"ER_INNODB_FT_WRONG_DOCID_COLUMN",
+	"ER_INNODB_FT_WRONG_DOCID_INDEX",
+	"ER_INNODB_ONLINE_LOG_TOO_BIG",
+	"ER_UNKNOWN_ALTER_ALGORITHM",
+	"ER_UNKNOWN_ALTER_LOCK",
+	"ER_MTA_CHANGE_SOURCE_CANT_RUN_WITH_GAPS",
+	"ER_MTA_RECOVERY_FAILURE",
+	"ER_MTA_RESET_WORKERS",
+	"ER_COL_COUNT_DOESNT_MATCH_CORRUPTED_V2",
+	"ER_REPLICA_SILENT_RETRY_TRANSACTION",
+	"ER_DISCARD_FK_CHECKS_RUNNING",
+	"ER_TABLE_SCHEMA_MISMATCH",
+	"ER_TABLE_IN_SYSTEM_TABLESPACE",
+	"ER_IO_READ_ERROR",
+	"ER_IO_WRITE_ERROR",
+	"ER_TABLESPACE_MISSING",
+	"ER_TABLESPACE_EXISTS",
+	"ER_TABLESPACE_DISCARDED",
+	"ER_INTERNAL_ERROR",
+	"ER_INNODB_IMPORT_ERROR",
+	"ER_INNODB_INDEX_CORRUPT",
+	"ER_INVALID_YEAR_COLUMN_LENGTH",
+	"ER_NOT_VALID_PASSWORD",
+	"ER_MUST_CHANGE_PASSWORD",
+	"ER_FK_NO_INDEX_CHILD",
+	"ER_FK_NO_INDEX_PARENT",
+	"ER_FK_FAIL_ADD_SYSTEM",
+	"ER_FK_CANNOT_OPEN_PARENT",
+	"ER_FK_INCORRECT_OPTION",
+	"ER_FK_DUP_NAME",
+	"ER_PASSWORD_FORMAT",
+	"ER_FK_COLUMN_CANNOT_DROP",
+	"ER_FK_COLUMN_CANNOT_DROP_CHILD",
+	"ER_FK_COLUMN_NOT_NULL",
+	"ER_DUP_INDEX",
+	"ER_FK_COLUMN_CANNOT_CHANGE",
+	"ER_FK_COLUMN_CANNOT_CHANGE_CHILD",
+	"OBSOLETE_ER_UNUSED5",
+	"ER_MALFORMED_PACKET",
+	"ER_READ_ONLY_MODE",
+	"ER_GTID_NEXT_TYPE_UNDEFINED_GTID",
+	"ER_VARIABLE_NOT_SETTABLE_IN_SP",
+	"OBSOLETE_ER_CANT_SET_GTID_PURGED_WHEN_GTID_MODE_IS_OFF",
+	"ER_CANT_SET_GTID_PURGED_WHEN_GTID_EXECUTED_IS_NOT_EMPTY",
+	"ER_CANT_SET_GTID_PURGED_WHEN_OWNED_GTIDS_IS_NOT_EMPTY",
+	"ER_GTID_PURGED_WAS_CHANGED",
+	"ER_GTID_EXECUTED_WAS_CHANGED",
+	"ER_BINLOG_STMT_MODE_AND_NO_REPL_TABLES",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COPY",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_PARTITION",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FK_RENAME",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COLUMN_TYPE",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FK_CHECK",
+	"OBSOLETE_ER_UNUSED6",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_NOPK",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_AUTOINC",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_HIDDEN_FTS",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_CHANGE_FTS",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_FTS",
+	"OBSOLETE_ER_SQL_REPLICA_SKIP_COUNTER_NOT_SETTABLE_IN_GTID_MODE",
+	"ER_DUP_UNKNOWN_IN_INDEX",
+	"ER_IDENT_CAUSES_TOO_LONG_PATH",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_NOT_NULL",
+	"ER_MUST_CHANGE_PASSWORD_LOGIN",
+	"ER_ROW_IN_WRONG_PARTITION",
+	"ER_MTA_EVENT_BIGGER_PENDING_JOBS_SIZE_MAX",
+	"OBSOLETE_ER_INNODB_NO_FT_USES_PARSER",
+	"ER_BINLOG_LOGICAL_CORRUPTION",
+	"ER_WARN_PURGE_LOG_IN_USE",
+	"ER_WARN_PURGE_LOG_IS_ACTIVE",
+	"ER_AUTO_INCREMENT_CONFLICT",
+	"WARN_ON_BLOCKHOLE_IN_RBR",
+	"ER_REPLICA_CM_INIT_REPOSITORY",
+	"ER_REPLICA_AM_INIT_REPOSITORY",
+	"ER_ACCESS_DENIED_CHANGE_USER_ERROR",
+	"ER_INNODB_READ_ONLY",
+	"ER_STOP_REPLICA_SQL_THREAD_TIMEOUT",
+	"ER_STOP_REPLICA_IO_THREAD_TIMEOUT",
+	"ER_TABLE_CORRUPT",
+	"ER_TEMP_FILE_WRITE_FAILURE",
+	"ER_INNODB_FT_AUX_NOT_HEX_ID",
+	"ER_OLD_TEMPORALS_UPGRADED",
+	"ER_INNODB_FORCED_RECOVERY",
+	"ER_AES_INVALID_IV",
+	"ER_PLUGIN_CANNOT_BE_UNINSTALLED",
+	"ER_GTID_UNSAFE_BINLOG_SPLITTABLE_STATEMENT_AND_ASSIGNED_GTID",
+	"ER_REPLICA_HAS_MORE_GTIDS_THAN_SOURCE",
+	"ER_MISSING_KEY",
+	"WARN_NAMED_PIPE_ACCESS_EVERYONE",
+	"ER_FILE_CORRUPT",
+	"ER_ERROR_ON_SOURCE",
+	"OBSOLETE_ER_INCONSISTENT_ERROR",
+	"ER_STORAGE_ENGINE_NOT_LOADED",
+	"ER_GET_STACKED_DA_WITHOUT_ACTIVE_HANDLER",
+	"ER_WARN_LEGACY_SYNTAX_CONVERTED",
+	"ER_BINLOG_UNSAFE_FULLTEXT_PLUGIN",
+	"ER_CANNOT_DISCARD_TEMPORARY_TABLE",
+	"ER_FK_DEPTH_EXCEEDED",
+	"ER_COL_COUNT_DOESNT_MATCH_PLEASE_UPDATE_V2",
+	"ER_WARN_TRIGGER_DOESNT_HAVE_CREATED",
+	"ER_REFERENCED_TRG_DOES_NOT_EXIST",
+	"ER_EXPLAIN_NOT_SUPPORTED",
+	"ER_INVALID_FIELD_SIZE",
+	"ER_MISSING_HA_CREATE_OPTION",
+	"ER_ENGINE_OUT_OF_MEMORY",
+	"ER_PASSWORD_EXPIRE_ANONYMOUS_USER",
+	"ER_REPLICA_SQL_THREAD_MUST_STOP",
+	"ER_NO_FT_MATERIALIZED_SUBQUERY",
+	"ER_INNODB_UNDO_LOG_FULL",
+	"ER_INVALID_ARGUMENT_FOR_LOGARITHM",
+	"ER_REPLICA_CHANNEL_IO_THREAD_MUST_STOP",
+	"ER_WARN_OPEN_TEMP_TABLES_MUST_BE_ZERO",
+	"ER_WARN_ONLY_SOURCE_LOG_FILE_NO_POS",
+	"ER_QUERY_TIMEOUT",
+	"ER_NON_RO_SELECT_DISABLE_TIMER",
+	"ER_DUP_LIST_ENTRY",
+	"OBSOLETE_ER_SQL_MODE_NO_EFFECT",
+	"ER_AGGREGATE_ORDER_FOR_UNION",
+	"ER_AGGREGATE_ORDER_NON_AGG_QUERY",
+	"ER_REPLICA_WORKER_STOPPED_PREVIOUS_THD_ERROR",
+	"ER_DONT_SUPPORT_REPLICA_PRESERVE_COMMIT_ORDER",
+	"ER_SERVER_OFFLINE_MODE",
+	"ER_GIS_DIFFERENT_SRIDS",
+	"ER_GIS_UNSUPPORTED_ARGUMENT",
+	"ER_GIS_UNKNOWN_ERROR",
+	"ER_GIS_UNKNOWN_EXCEPTION",
+	"ER_GIS_INVALID_DATA",
+	"ER_BOOST_GEOMETRY_EMPTY_INPUT_EXCEPTION",
+	"ER_BOOST_GEOMETRY_CENTROID_EXCEPTION",
+	"ER_BOOST_GEOMETRY_OVERLAY_INVALID_INPUT_EXCEPTION",
+	"ER_BOOST_GEOMETRY_TURN_INFO_EXCEPTION",
+	"ER_BOOST_GEOMETRY_SELF_INTERSECTION_POINT_EXCEPTION",
+	"ER_BOOST_GEOMETRY_UNKNOWN_EXCEPTION",
+	"ER_STD_BAD_ALLOC_ERROR",
+	"ER_STD_DOMAIN_ERROR",
+	"ER_STD_LENGTH_ERROR",
+	"ER_STD_INVALID_ARGUMENT",
+	"ER_STD_OUT_OF_RANGE_ERROR",
+	"ER_STD_OVERFLOW_ERROR",
+	"ER_STD_RANGE_ERROR",
+	"ER_STD_UNDERFLOW_ERROR",
+	"ER_STD_LOGIC_ERROR",
+	"ER_STD_RUNTIME_ERROR",
+	"ER_STD_UNKNOWN_EXCEPTION",
+	"ER_GIS_DATA_WRONG_ENDIANESS",
+	"ER_CHANGE_SOURCE_PASSWORD_LENGTH",
+	"ER_USER_LOCK_WRONG_NAME",
+	"ER_USER_LOCK_DEADLOCK",
+	"ER_REPLACE_INACCESSIBLE_ROWS",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_GIS",
+	"ER_ILLEGAL_USER_VAR",
+	"ER_GTID_MODE_OFF",
+	"OBSOLETE_ER_UNSUPPORTED_BY_REPLICATION_THREAD",
+	"ER_INCORRECT_TYPE",
+	"ER_FIELD_IN_ORDER_NOT_SELECT",
+	"ER_AGGREGATE_IN_ORDER_NOT_SELECT",
+	"ER_INVALID_RPL_WILD_TABLE_FILTER_PATTERN",
+	"ER_NET_OK_PACKET_TOO_LARGE",
+	"ER_INVALID_JSON_DATA",
+	"ER_INVALID_GEOJSON_MISSING_MEMBER",
+	"ER_INVALID_GEOJSON_WRONG_TYPE",
+	"ER_INVALID_GEOJSON_UNSPECIFIED",
+	"ER_DIMENSION_UNSUPPORTED",
+	"ER_REPLICA_CHANNEL_DOES_NOT_EXIST",
+	"OBSOLETE_ER_SLAVE_MULTIPLE_CHANNELS_HOST_PORT",
+	"ER_REPLICA_CHANNEL_NAME_INVALID_OR_TOO_LONG",
+	"ER_REPLICA_NEW_CHANNEL_WRONG_REPOSITORY",
+	"OBSOLETE_ER_SLAVE_CHANNEL_DELETE",
+	"ER_REPLICA_MULTIPLE_CHANNELS_CMD",
+	"ER_REPLICA_MAX_CHANNELS_EXCEEDED",
+	"ER_REPLICA_CHANNEL_MUST_STOP",
+	"ER_REPLICA_CHANNEL_NOT_RUNNING",
+	"ER_REPLICA_CHANNEL_WAS_RUNNING",
+	"ER_REPLICA_CHANNEL_WAS_NOT_RUNNING",
+	"ER_REPLICA_CHANNEL_SQL_THREAD_MUST_STOP",
+	"ER_REPLICA_CHANNEL_SQL_SKIP_COUNTER",
+	"ER_WRONG_FIELD_WITH_GROUP_V2",
+	"ER_MIX_OF_GROUP_FUNC_AND_FIELDS_V2",
+	"ER_WARN_DEPRECATED_SYSVAR_UPDATE",
+	"ER_WARN_DEPRECATED_SQLMODE",
+	"ER_CANNOT_LOG_PARTIAL_DROP_DATABASE_WITH_GTID",
+	"ER_GROUP_REPLICATION_CONFIGURATION",
+	"ER_GROUP_REPLICATION_RUNNING",
+	"ER_GROUP_REPLICATION_APPLIER_INIT_ERROR",
+	"ER_GROUP_REPLICATION_STOP_APPLIER_THREAD_TIMEOUT",
+	"ER_GROUP_REPLICATION_COMMUNICATION_LAYER_SESSION_ERROR",
+	"ER_GROUP_REPLICATION_COMMUNICATION_LAYER_JOIN_ERROR",
+	"ER_BEFORE_DML_VALIDATION_ERROR",
+	"ER_PREVENTS_VARIABLE_WITHOUT_RBR",
+	"ER_RUN_HOOK_ERROR",
+	"ER_TRANSACTION_ROLLBACK_DURING_COMMIT",
+	"ER_GENERATED_COLUMN_FUNCTION_IS_NOT_ALLOWED",
+	"ER_UNSUPPORTED_ALTER_INPLACE_ON_VIRTUAL_COLUMN",
+	"ER_WRONG_FK_OPTION_FOR_GENERATED_COLUMN",
+	"ER_NON_DEFAULT_VALUE_FOR_GENERATED_COLUMN",
+	"ER_UNSUPPORTED_ACTION_ON_GENERATED_COLUMN",
+	"ER_GENERATED_COLUMN_NON_PRIOR",
+	"ER_DEPENDENT_BY_GENERATED_COLUMN",
+	"ER_GENERATED_COLUMN_REF_AUTO_INC",
+	"ER_FEATURE_NOT_AVAILABLE",
+	"ER_CANT_SET_GTID_MODE",
+	"ER_CANT_USE_AUTO_POSITION_WITH_GTID_MODE_OFF",
+	"OBSOLETE_ER_CANT_REPLICATE_ANONYMOUS_WITH_AUTO_POSITION",
+	"OBSOLETE_ER_CANT_REPLICATE_ANONYMOUS_WITH_GTID_MODE_ON",
+	"OBSOLETE_ER_CANT_REPLICATE_GTID_WITH_GTID_MODE_OFF",
+	"ER_CANT_ENFORCE_GTID_CONSISTENCY_WITH_ONGOING_GTID_VIOLATING_TX",
+	"ER_ENFORCE_GTID_CONSISTENCY_WARN_WITH_ONGOING_GTID_VIOLATING_TX",
+	"ER_ACCOUNT_HAS_BEEN_LOCKED",
+	"ER_WRONG_TABLESPACE_NAME",
+	"ER_TABLESPACE_IS_NOT_EMPTY",
+	"ER_WRONG_FILE_NAME",
+	"ER_BOOST_GEOMETRY_INCONSISTENT_TURNS_EXCEPTION",
+	"ER_WARN_OPTIMIZER_HINT_SYNTAX_ERROR",
+	"ER_WARN_BAD_MAX_EXECUTION_TIME",
+	"ER_WARN_UNSUPPORTED_MAX_EXECUTION_TIME",
+	"ER_WARN_CONFLICTING_HINT",
+	"ER_WARN_UNKNOWN_QB_NAME",
+	"ER_UNRESOLVED_HINT_NAME",
+	"ER_WARN_ON_MODIFYING_GTID_EXECUTED_TABLE",
+	"ER_PLUGGABLE_PROTOCOL_COMMAND_NOT_SUPPORTED",
+	"ER_LOCKING_SERVICE_WRONG_NAME",
+	"ER_LOCKING_SERVICE_DEADLOCK",
+	"ER_LOCKING_SERVICE_TIMEOUT",
+	"ER_GIS_MAX_POINTS_IN_GEOMETRY_OVERFLOWED",
+	"ER_SQL_MODE_MERGED",
+	"ER_VTOKEN_PLUGIN_TOKEN_MISMATCH",
+	"ER_VTOKEN_PLUGIN_TOKEN_NOT_FOUND",
+	"ER_CANT_SET_VARIABLE_WHEN_OWNING_GTID",
+	"ER_REPLICA_CHANNEL_OPERATION_NOT_ALLOWED",
+	"ER_INVALID_JSON_TEXT",
+	"ER_INVALID_JSON_TEXT_IN_PARAM",
+	"ER_INVALID_JSON_BINARY_DATA",
+	"ER_INVALID_JSON_PATH",
+	"ER_INVALID_JSON_CHARSET",
+	"ER_INVALID_JSON_CHARSET_IN_FUNCTION",
+	"ER_INVALID_TYPE_FOR_JSON",
+	"ER_INVALID_CAST_TO_JSON",
+	"ER_INVALID_JSON_PATH_CHARSET",
+	"ER_INVALID_JSON_PATH_WILDCARD",
+	"ER_JSON_VALUE_TOO_BIG",
+	"ER_JSON_KEY_TOO_BIG",
+	"ER_JSON_USED_AS_KEY",
+	"ER_JSON_VACUOUS_PATH",
+	"ER_JSON_BAD_ONE_OR_ALL_ARG",
+	"ER_NUMERIC_JSON_VALUE_OUT_OF_RANGE",
+	"ER_INVALID_JSON_VALUE_FOR_CAST",
+	"ER_JSON_DOCUMENT_TOO_DEEP",
+	"ER_JSON_DOCUMENT_NULL_KEY",
+	"ER_SECURE_TRANSPORT_REQUIRED",
+	"ER_NO_SECURE_TRANSPORTS_CONFIGURED",
+	"ER_DISABLED_STORAGE_ENGINE",
+	"ER_USER_DOES_NOT_EXIST",
+	"ER_USER_ALREADY_EXISTS",
+	"ER_AUDIT_API_ABORT",
+	"ER_INVALID_JSON_PATH_ARRAY_CELL",
+	"ER_BUFPOOL_RESIZE_INPROGRESS",
+	"ER_FEATURE_DISABLED_SEE_DOC",
+	"ER_SERVER_ISNT_AVAILABLE",
+	"ER_SESSION_WAS_KILLED",
+	"ER_CAPACITY_EXCEEDED",
+	"ER_CAPACITY_EXCEEDED_IN_RANGE_OPTIMIZER",
+	"OBSOLETE_ER_TABLE_NEEDS_UPG_PART",
+	"ER_CANT_WAIT_FOR_EXECUTED_GTID_SET_WHILE_OWNING_A_GTID",
+	"ER_CANNOT_ADD_FOREIGN_BASE_COL_VIRTUAL",
+	"ER_CANNOT_CREATE_VIRTUAL_INDEX_CONSTRAINT",
+	"ER_ERROR_ON_MODIFYING_GTID_EXECUTED_TABLE",
+	"ER_LOCK_REFUSED_BY_ENGINE",
+	"ER_UNSUPPORTED_ALTER_ONLINE_ON_VIRTUAL_COLUMN",
+	"ER_MASTER_KEY_ROTATION_NOT_SUPPORTED_BY_SE",
+	"OBSOLETE_ER_MASTER_KEY_ROTATION_ERROR_BY_SE",
+	"ER_MASTER_KEY_ROTATION_BINLOG_FAILED",
+	"ER_MASTER_KEY_ROTATION_SE_UNAVAILABLE",
+	"ER_TABLESPACE_CANNOT_ENCRYPT",
+	"ER_INVALID_ENCRYPTION_OPTION",
+	"ER_CANNOT_FIND_KEY_IN_KEYRING",
+	"ER_CAPACITY_EXCEEDED_IN_PARSER",
+	"ER_UNSUPPORTED_ALTER_ENCRYPTION_INPLACE",
+	"ER_KEYRING_UDF_KEYRING_SERVICE_ERROR",
+	"ER_USER_COLUMN_OLD_LENGTH",
+	"ER_CANT_RESET_SOURCE",
+	"ER_GROUP_REPLICATION_MAX_GROUP_SIZE",
+	"ER_CANNOT_ADD_FOREIGN_BASE_COL_STORED",
+	"ER_TABLE_REFERENCED",
+	"OBSOLETE_ER_PARTITION_ENGINE_DEPRECATED_FOR_TABLE",
+	"OBSOLETE_ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID_ZERO",
+	"OBSOLETE_ER_WARN_USING_GEOMFROMWKB_TO_SET_SRID",
+	"ER_XA_RETRY",
+	"ER_KEYRING_AWS_UDF_AWS_KMS_ERROR",
+	"ER_BINLOG_UNSAFE_XA",
+	"ER_UDF_ERROR",
+	"ER_KEYRING_MIGRATION_FAILURE",
+	"ER_KEYRING_ACCESS_DENIED_ERROR",
+	"ER_KEYRING_MIGRATION_STATUS",
+	"OBSOLETE_ER_PLUGIN_FAILED_TO_OPEN_TABLES",
+	"OBSOLETE_ER_PLUGIN_FAILED_TO_OPEN_TABLE",
+	"OBSOLETE_ER_AUDIT_LOG_NO_KEYRING_PLUGIN_INSTALLED",
+	"OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_HAS_NOT_BEEN_SET",
+	"OBSOLETE_ER_AUDIT_LOG_COULD_NOT_CREATE_AES_KEY",
+	"OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_CANNOT_BE_FETCHED",
+	"OBSOLETE_ER_AUDIT_LOG_JSON_FILTERING_NOT_ENABLED",
+	"OBSOLETE_ER_AUDIT_LOG_UDF_INSUFFICIENT_PRIVILEGE",
+	"OBSOLETE_ER_AUDIT_LOG_SUPER_PRIVILEGE_REQUIRED",
+	"OBSOLETE_ER_COULD_NOT_REINITIALIZE_AUDIT_LOG_FILTERS",
+	"OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_TYPE",
+	"OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_COUNT",
+	"OBSOLETE_ER_AUDIT_LOG_HAS_NOT_BEEN_INSTALLED",
+	"OBSOLETE_ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_TYPE",
+	"ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_VALUE",
+	"OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_PARSING_ERROR",
+	"OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_NAME_CANNOT_BE_EMPTY",
+	"OBSOLETE_ER_AUDIT_LOG_JSON_USER_NAME_CANNOT_BE_EMPTY",
+	"OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_DOES_NOT_EXISTS",
+	"OBSOLETE_ER_AUDIT_LOG_USER_FIRST_CHARACTER_MUST_BE_ALPHANUMERIC",
+	"OBSOLETE_ER_AUDIT_LOG_USER_NAME_INVALID_CHARACTER",
+	"OBSOLETE_ER_AUDIT_LOG_HOST_NAME_INVALID_CHARACTER",
+	"OBSOLETE_WARN_DEPRECATED_MAXDB_SQL_MODE_FOR_TIMESTAMP",
+	"OBSOLETE_ER_XA_REPLICATION_FILTERS",
+	"OBSOLETE_ER_CANT_OPEN_ERROR_LOG",
+	"OBSOLETE_ER_GROUPING_ON_TIMESTAMP_IN_DST",
+	"OBSOLETE_ER_CANT_START_SERVER_NAMED_PIPE",
+	"ER_WRITE_SET_EXCEEDS_LIMIT",
+	"OBSOLETE_ER_DEPRECATED_TLS_VERSION_SESSION_57",
+	"OBSOLETE_ER_WARN_DEPRECATED_TLS_VERSION_57",
+	"OBSOLETE_ER_WARN_WRONG_NATIVE_TABLE_STRUCTURE",
+	"ER_AES_INVALID_KDF_NAME",
+	"ER_AES_INVALID_KDF_ITERATIONS",
+	"WARN_AES_KEY_SIZE",
+	"ER_AES_INVALID_KDF_OPTION_SIZE",
+	"ER_UNSUPPORT_COMPRESSED_TEMPORARY_TABLE",
+	"ER_ACL_OPERATION_FAILED",
+	"ER_UNSUPPORTED_INDEX_ALGORITHM",
+	"ER_NO_SUCH_DB",
+	"ER_TOO_BIG_ENUM",
+	"ER_TOO_LONG_SET_ENUM_VALUE",
+	"ER_INVALID_DD_OBJECT",
+	"ER_UPDATING_DD_TABLE",
+	"ER_INVALID_DD_OBJECT_ID",
+	"ER_INVALID_DD_OBJECT_NAME",
+	"ER_TABLESPACE_MISSING_WITH_NAME",
+	"ER_TOO_LONG_ROUTINE_COMMENT",
+	"ER_SP_LOAD_FAILED",
+	"ER_INVALID_BITWISE_OPERANDS_SIZE",
+	"ER_INVALID_BITWISE_AGGREGATE_OPERANDS_SIZE",
+	"ER_WARN_UNSUPPORTED_HINT",
+	"ER_UNEXPECTED_GEOMETRY_TYPE",
+	"ER_SRS_PARSE_ERROR",
+	"ER_SRS_PROJ_PARAMETER_MISSING",
+	"ER_WARN_SRS_NOT_FOUND",
+	"ER_SRS_NOT_CARTESIAN",
+	"ER_SRS_NOT_CARTESIAN_UNDEFINED",
+	"ER_PK_INDEX_CANT_BE_INVISIBLE",
+	"ER_UNKNOWN_AUTHID",
+	"ER_FAILED_ROLE_GRANT",
+	"ER_OPEN_ROLE_TABLES",
+	"ER_FAILED_DEFAULT_ROLES",
+	"ER_COMPONENTS_NO_SCHEME",
+	"ER_COMPONENTS_NO_SCHEME_SERVICE",
+	"ER_COMPONENTS_CANT_LOAD",
+	"ER_ROLE_NOT_GRANTED",
+	"ER_FAILED_REVOKE_ROLE",
+	"ER_RENAME_ROLE",
+	"ER_COMPONENTS_CANT_ACQUIRE_SERVICE_IMPLEMENTATION",
+	"ER_COMPONENTS_CANT_SATISFY_DEPENDENCY",
+	"ER_COMPONENTS_LOAD_CANT_REGISTER_SERVICE_IMPLEMENTATION",
+	"ER_COMPONENTS_LOAD_CANT_INITIALIZE",
+	"ER_COMPONENTS_UNLOAD_NOT_LOADED",
+	"ER_COMPONENTS_UNLOAD_CANT_DEINITIALIZE",
+	"ER_COMPONENTS_CANT_RELEASE_SERVICE",
+	"ER_COMPONENTS_UNLOAD_CANT_UNREGISTER_SERVICE",
+	"ER_COMPONENTS_CANT_UNLOAD",
+	"ER_WARN_UNLOAD_THE_NOT_PERSISTED",
+	"ER_COMPONENT_TABLE_INCORRECT",
+	"ER_COMPONENT_MANIPULATE_ROW_FAILED",
+	"ER_COMPONENTS_UNLOAD_DUPLICATE_IN_GROUP",
+	"ER_CANT_SET_GTID_PURGED_DUE_SETS_CONSTRAINTS",
+	"ER_CANNOT_LOCK_USER_MANAGEMENT_CACHES",
+	"ER_SRS_NOT_FOUND",
+	"ER_VARIABLE_NOT_PERSISTED",
+	"ER_IS_QUERY_INVALID_CLAUSE",
+	"ER_UNABLE_TO_STORE_STATISTICS",
+	"ER_NO_SYSTEM_SCHEMA_ACCESS",
+	"ER_NO_SYSTEM_TABLESPACE_ACCESS",
+	"ER_NO_SYSTEM_TABLE_ACCESS",
+	"ER_NO_SYSTEM_TABLE_ACCESS_FOR_DICTIONARY_TABLE",
+	"ER_NO_SYSTEM_TABLE_ACCESS_FOR_SYSTEM_TABLE",
+	"ER_NO_SYSTEM_TABLE_ACCESS_FOR_TABLE",
+	"ER_INVALID_OPTION_KEY",
+	"ER_INVALID_OPTION_VALUE",
+	"ER_INVALID_OPTION_KEY_VALUE_PAIR",
+	"ER_INVALID_OPTION_START_CHARACTER",
+	"ER_INVALID_OPTION_END_CHARACTER",
+	"ER_INVALID_OPTION_CHARACTERS",
+	"ER_DUPLICATE_OPTION_KEY",
+	"ER_WARN_SRS_NOT_FOUND_AXIS_ORDER",
+	"ER_NO_ACCESS_TO_NATIVE_FCT",
+	"ER_RESET_SOURCE_TO_VALUE_OUT_OF_RANGE",
+	"ER_UNRESOLVED_TABLE_LOCK",
+	"ER_DUPLICATE_TABLE_LOCK",
+	"ER_BINLOG_UNSAFE_SKIP_LOCKED",
+	"ER_BINLOG_UNSAFE_NOWAIT",
+	"ER_LOCK_NOWAIT",
+	"ER_CTE_RECURSIVE_REQUIRES_UNION",
+	"ER_CTE_RECURSIVE_REQUIRES_NONRECURSIVE_FIRST",
+	"ER_CTE_RECURSIVE_FORBIDS_AGGREGATION",
+	"ER_CTE_RECURSIVE_FORBIDDEN_JOIN_ORDER",
+	"ER_CTE_RECURSIVE_REQUIRES_SINGLE_REFERENCE",
+	"ER_SWITCH_TMP_ENGINE",
+	"ER_WINDOW_NO_SUCH_WINDOW",
+	"ER_WINDOW_CIRCULARITY_IN_WINDOW_GRAPH",
+	"ER_WINDOW_NO_CHILD_PARTITIONING",
+	"ER_WINDOW_NO_INHERIT_FRAME",
+	"ER_WINDOW_NO_REDEFINE_ORDER_BY",
+	"ER_WINDOW_FRAME_START_ILLEGAL",
+	"ER_WINDOW_FRAME_END_ILLEGAL",
+	"ER_WINDOW_FRAME_ILLEGAL",
+	"ER_WINDOW_RANGE_FRAME_ORDER_TYPE",
+	"ER_WINDOW_RANGE_FRAME_TEMPORAL_TYPE",
+	"ER_WINDOW_RANGE_FRAME_NUMERIC_TYPE",
+	"ER_WINDOW_RANGE_BOUND_NOT_CONSTANT",
+	"ER_WINDOW_DUPLICATE_NAME",
+	"ER_WINDOW_ILLEGAL_ORDER_BY",
+	"ER_WINDOW_INVALID_WINDOW_FUNC_USE",
+	"ER_WINDOW_INVALID_WINDOW_FUNC_ALIAS_USE",
+	"ER_WINDOW_NESTED_WINDOW_FUNC_USE_IN_WINDOW_SPEC",
+	"ER_WINDOW_ROWS_INTERVAL_USE",
+	"ER_WINDOW_NO_GROUP_ORDER_UNUSED",
+	"ER_WINDOW_EXPLAIN_JSON",
+	"ER_WINDOW_FUNCTION_IGNORES_FRAME",
+	"ER_WL9236_NOW_UNUSED",
+	"ER_INVALID_NO_OF_ARGS",
+	"ER_FIELD_IN_GROUPING_NOT_GROUP_BY",
+	"ER_TOO_LONG_TABLESPACE_COMMENT",
+	"ER_ENGINE_CANT_DROP_TABLE",
+	"ER_ENGINE_CANT_DROP_MISSING_TABLE",
+	"ER_TABLESPACE_DUP_FILENAME",
+	"ER_DB_DROP_RMDIR2",
+	"ER_IMP_NO_FILES_MATCHED",
+	"ER_IMP_SCHEMA_DOES_NOT_EXIST",
+	"ER_IMP_TABLE_ALREADY_EXISTS",
+	"ER_IMP_INCOMPATIBLE_MYSQLD_VERSION",
+	"ER_IMP_INCOMPATIBLE_DD_VERSION",
+	"ER_IMP_INCOMPATIBLE_SDI_VERSION",
+	"ER_WARN_INVALID_HINT",
+	"ER_VAR_DOES_NOT_EXIST",
+	"ER_LONGITUDE_OUT_OF_RANGE",
+	"ER_LATITUDE_OUT_OF_RANGE",
+	"ER_NOT_IMPLEMENTED_FOR_GEOGRAPHIC_SRS",
+	"ER_ILLEGAL_PRIVILEGE_LEVEL",
+	"ER_NO_SYSTEM_VIEW_ACCESS",
+	"ER_COMPONENT_FILTER_FLABBERGASTED",
+	"ER_PART_EXPR_TOO_LONG",
+	"ER_UDF_DROP_DYNAMICALLY_REGISTERED",
+	"ER_UNABLE_TO_STORE_COLUMN_STATISTICS",
+	"ER_UNABLE_TO_UPDATE_COLUMN_STATISTICS",
+	"ER_UNABLE_TO_DROP_COLUMN_STATISTICS",
+	"ER_UNABLE_TO_BUILD_HISTOGRAM",
+	"ER_MANDATORY_ROLE",
+	"ER_MISSING_TABLESPACE_FILE",
+	"ER_PERSIST_ONLY_ACCESS_DENIED_ERROR",
+	"ER_CMD_NEED_SUPER",
+	"ER_PATH_IN_DATADIR",
+	"ER_CLONE_DDL_IN_PROGRESS",
+	"ER_CLONE_TOO_MANY_CONCURRENT_CLONES",
+	"ER_APPLIER_LOG_EVENT_VALIDATION_ERROR",
+	"ER_CTE_MAX_RECURSION_DEPTH",
+	"ER_NOT_HINT_UPDATABLE_VARIABLE",
+	"ER_CREDENTIALS_CONTRADICT_TO_HISTORY",
+	"ER_WARNING_PASSWORD_HISTORY_CLAUSES_VOID",
+	"ER_CLIENT_DOES_NOT_SUPPORT",
+	"ER_I_S_SKIPPED_TABLESPACE",
+	"ER_TABLESPACE_ENGINE_MISMATCH",
+	"ER_WRONG_SRID_FOR_COLUMN",
+	"ER_CANNOT_ALTER_SRID_DUE_TO_INDEX",
+	"ER_WARN_BINLOG_PARTIAL_UPDATES_DISABLED",
+	"OBSOLETE_ER_WARN_BINLOG_V1_ROW_EVENTS_DISABLED",
+	"ER_WARN_BINLOG_PARTIAL_UPDATES_SUGGESTS_PARTIAL_IMAGES",
+	"ER_COULD_NOT_APPLY_JSON_DIFF",
+	"ER_CORRUPTED_JSON_DIFF",
+	"ER_RESOURCE_GROUP_EXISTS",
+	"ER_RESOURCE_GROUP_NOT_EXISTS",
+	"ER_INVALID_VCPU_ID",
+	"ER_INVALID_VCPU_RANGE",
+	"ER_INVALID_THREAD_PRIORITY",
+	"ER_DISALLOWED_OPERATION",
+	"ER_RESOURCE_GROUP_BUSY",
+	"ER_RESOURCE_GROUP_DISABLED",
+	"ER_FEATURE_UNSUPPORTED",
+	"ER_ATTRIBUTE_IGNORED",
+	"ER_INVALID_THREAD_ID",
+	"ER_RESOURCE_GROUP_BIND_FAILED",
+	"ER_INVALID_USE_OF_FORCE_OPTION",
+	"ER_GROUP_REPLICATION_COMMAND_FAILURE",
+	"ER_SDI_OPERATION_FAILED",
+	"ER_MISSING_JSON_TABLE_VALUE",
+	"ER_WRONG_JSON_TABLE_VALUE",
+	"ER_TF_MUST_HAVE_ALIAS",
+	"ER_TF_FORBIDDEN_JOIN_TYPE",
+	"ER_JT_VALUE_OUT_OF_RANGE",
+	"ER_JT_MAX_NESTED_PATH",
+	"ER_PASSWORD_EXPIRATION_NOT_SUPPORTED_BY_AUTH_METHOD",
+	"ER_INVALID_GEOJSON_CRS_NOT_TOP_LEVEL",
+	"ER_BAD_NULL_ERROR_NOT_IGNORED",
+	"WARN_USELESS_SPATIAL_INDEX",
+	"ER_DISK_FULL_NOWAIT",
+	"ER_PARSE_ERROR_IN_DIGEST_FN",
+	"ER_UNDISCLOSED_PARSE_ERROR_IN_DIGEST_FN",
+	"ER_SCHEMA_DIR_EXISTS",
+	"ER_SCHEMA_DIR_MISSING",
+	"ER_SCHEMA_DIR_CREATE_FAILED",
+	"ER_SCHEMA_DIR_UNKNOWN",
+	"ER_ONLY_IMPLEMENTED_FOR_SRID_0_AND_4326",
+	"OBSOLETE_ER_BINLOG_EXPIRE_LOG_DAYS_AND_SECS_USED_TOGETHER",
+	"ER_REGEXP_BUFFER_OVERFLOW",
+	"ER_REGEXP_ILLEGAL_ARGUMENT",
+	"ER_REGEXP_INDEX_OUTOFBOUNDS_ERROR",
+	"ER_REGEXP_INTERNAL_ERROR",
+	"ER_REGEXP_RULE_SYNTAX",
+	"ER_REGEXP_BAD_ESCAPE_SEQUENCE",
+	"ER_REGEXP_UNIMPLEMENTED",
+	"ER_REGEXP_MISMATCHED_PAREN",
+	"ER_REGEXP_BAD_INTERVAL",
+	"ER_REGEXP_MAX_LT_MIN",
+	"ER_REGEXP_INVALID_BACK_REF",
+	"ER_REGEXP_LOOK_BEHIND_LIMIT",
+	"ER_REGEXP_MISSING_CLOSE_BRACKET",
+	"ER_REGEXP_INVALID_RANGE",
+	"ER_REGEXP_STACK_OVERFLOW",
+	"ER_REGEXP_TIME_OUT",
+	"ER_REGEXP_PATTERN_TOO_BIG",
+	"ER_CANT_SET_ERROR_LOG_SERVICE",
+	"ER_EMPTY_PIPELINE_FOR_ERROR_LOG_SERVICE",
+	"ER_COMPONENT_FILTER_DIAGNOSTICS",
+	"ER_NOT_IMPLEMENTED_FOR_CARTESIAN_SRS",
+	"ER_NOT_IMPLEMENTED_FOR_PROJECTED_SRS",
+	"ER_NONPOSITIVE_RADIUS",
+	"ER_RESTART_SERVER_FAILED",
+	"ER_SRS_MISSING_MANDATORY_ATTRIBUTE",
+	"ER_SRS_MULTIPLE_ATTRIBUTE_DEFINITIONS",
+	"ER_SRS_NAME_CANT_BE_EMPTY_OR_WHITESPACE",
+	"ER_SRS_ORGANIZATION_CANT_BE_EMPTY_OR_WHITESPACE",
+	"ER_SRS_ID_ALREADY_EXISTS",
+	"ER_WARN_SRS_ID_ALREADY_EXISTS",
+	"ER_CANT_MODIFY_SRID_0",
+	"ER_WARN_RESERVED_SRID_RANGE",
+	"ER_CANT_MODIFY_SRS_USED_BY_COLUMN",
+	"ER_SRS_INVALID_CHARACTER_IN_ATTRIBUTE",
+	"ER_SRS_ATTRIBUTE_STRING_TOO_LONG",
+	"ER_DEPRECATED_UTF8_ALIAS",
+	"ER_DEPRECATED_NATIONAL",
+	"ER_INVALID_DEFAULT_UTF8MB4_COLLATION",
+	"ER_UNABLE_TO_COLLECT_LOG_STATUS",
+	"ER_RESERVED_TABLESPACE_NAME",
+	"ER_UNABLE_TO_SET_OPTION",
+	"ER_REPLICA_POSSIBLY_DIVERGED_AFTER_DDL",
+	"ER_SRS_NOT_GEOGRAPHIC",
+	"ER_POLYGON_TOO_LARGE",
+	"ER_SPATIAL_UNIQUE_INDEX",
+	"ER_INDEX_TYPE_NOT_SUPPORTED_FOR_SPATIAL_INDEX",
+	"ER_FK_CANNOT_DROP_PARENT",
+	"ER_GEOMETRY_PARAM_LONGITUDE_OUT_OF_RANGE",
+	"ER_GEOMETRY_PARAM_LATITUDE_OUT_OF_RANGE",
+	"ER_FK_CANNOT_USE_VIRTUAL_COLUMN",
+	"ER_FK_NO_COLUMN_PARENT",
+	"ER_CANT_SET_ERROR_SUPPRESSION_LIST",
+	"ER_SRS_GEOGCS_INVALID_AXES",
+	"ER_SRS_INVALID_SEMI_MAJOR_AXIS",
+	"ER_SRS_INVALID_INVERSE_FLATTENING",
+	"ER_SRS_INVALID_ANGULAR_UNIT",
+	"ER_SRS_INVALID_PRIME_MERIDIAN",
+	"ER_TRANSFORM_SOURCE_SRS_NOT_SUPPORTED",
+	"ER_TRANSFORM_TARGET_SRS_NOT_SUPPORTED",
+	"ER_TRANSFORM_SOURCE_SRS_MISSING_TOWGS84",
+	"ER_TRANSFORM_TARGET_SRS_MISSING_TOWGS84",
+	"ER_TEMP_TABLE_PREVENTS_SWITCH_SESSION_BINLOG_FORMAT",
+	"ER_TEMP_TABLE_PREVENTS_SWITCH_GLOBAL_BINLOG_FORMAT",
+	"ER_RUNNING_APPLIER_PREVENTS_SWITCH_GLOBAL_BINLOG_FORMAT",
+	"ER_CLIENT_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRX_IN_SBR",
+	"OBSOLETE_ER_XA_CANT_CREATE_MDL_BACKUP",
+	"ER_TABLE_WITHOUT_PK",
+	"ER_WARN_DATA_TRUNCATED_FUNCTIONAL_INDEX",
+	"ER_WARN_DATA_OUT_OF_RANGE_FUNCTIONAL_INDEX",
+	"ER_FUNCTIONAL_INDEX_ON_JSON_OR_GEOMETRY_FUNCTION",
+	"ER_FUNCTIONAL_INDEX_REF_AUTO_INCREMENT",
+	"ER_CANNOT_DROP_COLUMN_FUNCTIONAL_INDEX",
+	"ER_FUNCTIONAL_INDEX_PRIMARY_KEY",
+	"ER_FUNCTIONAL_INDEX_ON_LOB",
+	"ER_FUNCTIONAL_INDEX_FUNCTION_IS_NOT_ALLOWED",
+	"ER_FULLTEXT_FUNCTIONAL_INDEX",
+	"ER_SPATIAL_FUNCTIONAL_INDEX",
+	"ER_WRONG_KEY_COLUMN_FUNCTIONAL_INDEX",
+	"ER_FUNCTIONAL_INDEX_ON_FIELD",
+	"ER_GENERATED_COLUMN_NAMED_FUNCTION_IS_NOT_ALLOWED",
+	"ER_GENERATED_COLUMN_ROW_VALUE",
+	"ER_GENERATED_COLUMN_VARIABLES",
+	"ER_DEPENDENT_BY_DEFAULT_GENERATED_VALUE",
+	"ER_DEFAULT_VAL_GENERATED_NON_PRIOR",
+	"ER_DEFAULT_VAL_GENERATED_REF_AUTO_INC",
+	"ER_DEFAULT_VAL_GENERATED_FUNCTION_IS_NOT_ALLOWED",
+	"ER_DEFAULT_VAL_GENERATED_NAMED_FUNCTION_IS_NOT_ALLOWED",
+	"ER_DEFAULT_VAL_GENERATED_ROW_VALUE",
+	"ER_DEFAULT_VAL_GENERATED_VARIABLES",
+	"ER_DEFAULT_AS_VAL_GENERATED",
+	"ER_UNSUPPORTED_ACTION_ON_DEFAULT_VAL_GENERATED",
+	"ER_GTID_UNSAFE_ALTER_ADD_COL_WITH_DEFAULT_EXPRESSION",
+	"ER_FK_CANNOT_CHANGE_ENGINE",
+	"ER_WARN_DEPRECATED_USER_SET_EXPR",
+	"ER_WARN_DEPRECATED_UTF8MB3_COLLATION",
+	"ER_WARN_DEPRECATED_NESTED_COMMENT_SYNTAX",
+	"ER_FK_INCOMPATIBLE_COLUMNS",
+	"ER_GR_HOLD_WAIT_TIMEOUT",
+	"ER_GR_HOLD_KILLED",
+	"ER_GR_HOLD_MEMBER_STATUS_ERROR",
+	"ER_RPL_ENCRYPTION_FAILED_TO_FETCH_KEY",
+	"ER_RPL_ENCRYPTION_KEY_NOT_FOUND",
+	"ER_RPL_ENCRYPTION_KEYRING_INVALID_KEY",
+	"ER_RPL_ENCRYPTION_HEADER_ERROR",
+	"ER_RPL_ENCRYPTION_FAILED_TO_ROTATE_LOGS",
+	"ER_RPL_ENCRYPTION_KEY_EXISTS_UNEXPECTED",
+	"ER_RPL_ENCRYPTION_FAILED_TO_GENERATE_KEY",
+	"ER_RPL_ENCRYPTION_FAILED_TO_STORE_KEY",
+	"ER_RPL_ENCRYPTION_FAILED_TO_REMOVE_KEY",
+	"ER_RPL_ENCRYPTION_UNABLE_TO_CHANGE_OPTION",
+	"ER_RPL_ENCRYPTION_MASTER_KEY_RECOVERY_FAILED",
+	"ER_SLOW_LOG_MODE_IGNORED_WHEN_NOT_LOGGING_TO_FILE",
+	"ER_GRP_TRX_CONSISTENCY_NOT_ALLOWED",
+	"ER_GRP_TRX_CONSISTENCY_BEFORE",
+	"ER_GRP_TRX_CONSISTENCY_AFTER_ON_TRX_BEGIN",
+	"ER_GRP_TRX_CONSISTENCY_BEGIN_NOT_ALLOWED",
+	"ER_FUNCTIONAL_INDEX_ROW_VALUE_IS_NOT_ALLOWED",
+	"ER_RPL_ENCRYPTION_FAILED_TO_ENCRYPT",
+	"ER_PAGE_TRACKING_NOT_STARTED",
+	"ER_PAGE_TRACKING_RANGE_NOT_TRACKED",
+	"ER_PAGE_TRACKING_CANNOT_PURGE",
+	"ER_RPL_ENCRYPTION_CANNOT_ROTATE_BINLOG_MASTER_KEY",
+	"ER_BINLOG_MASTER_KEY_RECOVERY_OUT_OF_COMBINATION",
+	"ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_OPERATE_KEY",
+	"ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_ROTATE_LOGS",
+	"ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_REENCRYPT_LOG",
+	"ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_CLEANUP_UNUSED_KEYS",
+	"ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_CLEANUP_AUX_KEY",
+	"ER_NON_BOOLEAN_EXPR_FOR_CHECK_CONSTRAINT",
+	"ER_COLUMN_CHECK_CONSTRAINT_REFERENCES_OTHER_COLUMN",
+	"ER_CHECK_CONSTRAINT_NAMED_FUNCTION_IS_NOT_ALLOWED",
+	"ER_CHECK_CONSTRAINT_FUNCTION_IS_NOT_ALLOWED",
+	"ER_CHECK_CONSTRAINT_VARIABLES",
+	"ER_CHECK_CONSTRAINT_ROW_VALUE",
+	"ER_CHECK_CONSTRAINT_REFERS_AUTO_INCREMENT_COLUMN",
+	"ER_CHECK_CONSTRAINT_VIOLATED",
+	"ER_CHECK_CONSTRAINT_REFERS_UNKNOWN_COLUMN",
+	"ER_CHECK_CONSTRAINT_NOT_FOUND",
+	"ER_CHECK_CONSTRAINT_DUP_NAME",
+	"ER_CHECK_CONSTRAINT_CLAUSE_USING_FK_REFER_ACTION_COLUMN",
+	"WARN_UNENCRYPTED_TABLE_IN_ENCRYPTED_DB",
+	"ER_INVALID_ENCRYPTION_REQUEST",
+	"ER_CANNOT_SET_TABLE_ENCRYPTION",
+	"ER_CANNOT_SET_DATABASE_ENCRYPTION",
+	"ER_CANNOT_SET_TABLESPACE_ENCRYPTION",
+	"ER_TABLESPACE_CANNOT_BE_ENCRYPTED",
+	"ER_TABLESPACE_CANNOT_BE_DECRYPTED",
+	"ER_TABLESPACE_TYPE_UNKNOWN",
+	"ER_TARGET_TABLESPACE_UNENCRYPTED",
+	"ER_CANNOT_USE_ENCRYPTION_CLAUSE",
+	"ER_INVALID_MULTIPLE_CLAUSES",
+	"ER_UNSUPPORTED_USE_OF_GRANT_AS",
+	"ER_UKNOWN_AUTH_ID_OR_ACCESS_DENIED_FOR_GRANT_AS",
+	"ER_DEPENDENT_BY_FUNCTIONAL_INDEX",
+	"ER_PLUGIN_NOT_EARLY",
+	"ER_INNODB_REDO_LOG_ARCHIVE_START_SUBDIR_PATH",
+	"ER_INNODB_REDO_LOG_ARCHIVE_START_TIMEOUT",
+	"ER_INNODB_REDO_LOG_ARCHIVE_DIRS_INVALID",
+	"ER_INNODB_REDO_LOG_ARCHIVE_LABEL_NOT_FOUND",
+	"ER_INNODB_REDO_LOG_ARCHIVE_DIR_EMPTY",
+	"ER_INNODB_REDO_LOG_ARCHIVE_NO_SUCH_DIR",
+	"ER_INNODB_REDO_LOG_ARCHIVE_DIR_CLASH",
+	"ER_INNODB_REDO_LOG_ARCHIVE_DIR_PERMISSIONS",
+	"ER_INNODB_REDO_LOG_ARCHIVE_FILE_CREATE",
+	"ER_INNODB_REDO_LOG_ARCHIVE_ACTIVE",
+	"ER_INNODB_REDO_LOG_ARCHIVE_INACTIVE",
+	"ER_INNODB_REDO_LOG_ARCHIVE_FAILED",
+	"ER_INNODB_REDO_LOG_ARCHIVE_SESSION",
+	"ER_STD_REGEX_ERROR",
+	"ER_INVALID_JSON_TYPE",
+	"ER_CANNOT_CONVERT_STRING",
+	"ER_DEPENDENT_BY_PARTITION_FUNC",
+	"ER_WARN_DEPRECATED_FLOAT_AUTO_INCREMENT",
+	"ER_RPL_CANT_STOP_REPLICA_WHILE_LOCKED_BACKUP",
+	"ER_WARN_DEPRECATED_FLOAT_DIGITS",
+	"ER_WARN_DEPRECATED_FLOAT_UNSIGNED",
+	"ER_WARN_DEPRECATED_INTEGER_DISPLAY_WIDTH",
+	"ER_WARN_DEPRECATED_ZEROFILL",
+	"ER_CLONE_DONOR",
+	"ER_CLONE_PROTOCOL",
+	"ER_CLONE_DONOR_VERSION",
+	"ER_CLONE_OS",
+	"ER_CLONE_PLATFORM",
+	"ER_CLONE_CHARSET",
+	"ER_CLONE_CONFIG",
+	"ER_CLONE_SYS_CONFIG",
+	"ER_CLONE_PLUGIN_MATCH",
+	"ER_CLONE_LOOPBACK",
+	"ER_CLONE_ENCRYPTION",
+	"ER_CLONE_DISK_SPACE",
+	"ER_CLONE_IN_PROGRESS",
+	"ER_CLONE_DISALLOWED",
+	"ER_CANNOT_GRANT_ROLES_TO_ANONYMOUS_USER",
+	"ER_SECONDARY_ENGINE_PLUGIN",
+	"ER_SECOND_PASSWORD_CANNOT_BE_EMPTY",
+	"ER_DB_ACCESS_DENIED",
+	"ER_DA_AUTH_ID_WITH_SYSTEM_USER_PRIV_IN_MANDATORY_ROLES",
+	"ER_DA_RPL_GTID_TABLE_CANNOT_OPEN",
+	"ER_GEOMETRY_IN_UNKNOWN_LENGTH_UNIT",
+	"ER_DA_PLUGIN_INSTALL_ERROR",
+	"ER_NO_SESSION_TEMP",
+	"ER_DA_UNKNOWN_ERROR_NUMBER",
+	"ER_COLUMN_CHANGE_SIZE",
+	"ER_REGEXP_INVALID_CAPTURE_GROUP_NAME",
+	"ER_DA_SSL_LIBRARY_ERROR",
+	"ER_SECONDARY_ENGINE",
+	"ER_SECONDARY_ENGINE_DDL",
+	"ER_INCORRECT_CURRENT_PASSWORD",
+	"ER_MISSING_CURRENT_PASSWORD",
+	"ER_CURRENT_PASSWORD_NOT_REQUIRED",
+	"ER_PASSWORD_CANNOT_BE_RETAINED_ON_PLUGIN_CHANGE",
+	"ER_CURRENT_PASSWORD_CANNOT_BE_RETAINED",
+	"ER_PARTIAL_REVOKES_EXIST",
+	"ER_CANNOT_GRANT_SYSTEM_PRIV_TO_MANDATORY_ROLE",
+	"ER_XA_REPLICATION_FILTERS",
+	"ER_UNSUPPORTED_SQL_MODE",
+	"ER_REGEXP_INVALID_FLAG",
+	"ER_PARTIAL_REVOKE_AND_DB_GRANT_BOTH_EXISTS",
+	"ER_UNIT_NOT_FOUND",
+	"ER_INVALID_JSON_VALUE_FOR_FUNC_INDEX",
+	"ER_JSON_VALUE_OUT_OF_RANGE_FOR_FUNC_INDEX",
+	"ER_EXCEEDED_MV_KEYS_NUM",
+	"ER_EXCEEDED_MV_KEYS_SPACE",
+	"ER_FUNCTIONAL_INDEX_DATA_IS_TOO_LONG",
+	"ER_WRONG_MVI_VALUE",
+	"ER_WARN_FUNC_INDEX_NOT_APPLICABLE",
+	"ER_GRP_RPL_UDF_ERROR",
+	"ER_UPDATE_GTID_PURGED_WITH_GR",
+	"ER_GROUPING_ON_TIMESTAMP_IN_DST",
+	"ER_TABLE_NAME_CAUSES_TOO_LONG_PATH",
+	"ER_AUDIT_LOG_INSUFFICIENT_PRIVILEGE",
+	"OBSOLETE_ER_AUDIT_LOG_PASSWORD_HAS_BEEN_COPIED",
+	"ER_DA_GRP_RPL_STARTED_AUTO_REJOIN",
+	"ER_SYSVAR_CHANGE_DURING_QUERY",
+	"ER_GLOBSTAT_CHANGE_DURING_QUERY",
+	"ER_GRP_RPL_MESSAGE_SERVICE_INIT_FAILURE",
+	"ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_CLIENT",
+	"ER_CHANGE_SOURCE_WRONG_COMPRESSION_LEVEL_CLIENT",
+	"ER_WRONG_COMPRESSION_ALGORITHM_CLIENT",
+	"ER_WRONG_COMPRESSION_LEVEL_CLIENT",
+	"ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_LIST_CLIENT",
+	"ER_CLIENT_PRIVILEGE_CHECKS_USER_CANNOT_BE_ANONYMOUS",
+	"ER_CLIENT_PRIVILEGE_CHECKS_USER_DOES_NOT_EXIST",
+	"ER_CLIENT_PRIVILEGE_CHECKS_USER_CORRUPT",
+	"ER_CLIENT_PRIVILEGE_CHECKS_USER_NEEDS_RPL_APPLIER_PRIV",
+	"ER_WARN_DA_PRIVILEGE_NOT_REGISTERED",
+	"ER_CLIENT_KEYRING_UDF_KEY_INVALID",
+	"ER_CLIENT_KEYRING_UDF_KEY_TYPE_INVALID",
+	"ER_CLIENT_KEYRING_UDF_KEY_TOO_LONG",
+	"ER_CLIENT_KEYRING_UDF_KEY_TYPE_TOO_LONG",
+	"ER_JSON_SCHEMA_VALIDATION_ERROR_WITH_DETAILED_REPORT",
+	"ER_DA_UDF_INVALID_CHARSET_SPECIFIED",
+	"ER_DA_UDF_INVALID_CHARSET",
+	"ER_DA_UDF_INVALID_COLLATION",
+	"ER_DA_UDF_INVALID_EXTENSION_ARGUMENT_TYPE",
+	"ER_MULTIPLE_CONSTRAINTS_WITH_SAME_NAME",
+	"ER_CONSTRAINT_NOT_FOUND",
+	"ER_ALTER_CONSTRAINT_ENFORCEMENT_NOT_SUPPORTED",
+	"ER_TABLE_VALUE_CONSTRUCTOR_MUST_HAVE_COLUMNS",
+	"ER_TABLE_VALUE_CONSTRUCTOR_CANNOT_HAVE_DEFAULT",
+	"ER_CLIENT_QUERY_FAILURE_INVALID_NON_ROW_FORMAT",
+	"ER_REQUIRE_ROW_FORMAT_INVALID_VALUE",
+	"ER_FAILED_TO_DETERMINE_IF_ROLE_IS_MANDATORY",
+	"ER_FAILED_TO_FETCH_MANDATORY_ROLE_LIST",
+	"ER_CLIENT_LOCAL_FILES_DISABLED",
+	"ER_IMP_INCOMPATIBLE_CFG_VERSION",
+	"ER_DA_OOM",
+	"ER_DA_UDF_INVALID_ARGUMENT_TO_SET_CHARSET",
+	"ER_DA_UDF_INVALID_RETURN_TYPE_TO_SET_CHARSET",
+	"ER_MULTIPLE_INTO_CLAUSES",
+	"ER_MISPLACED_INTO",
+	"ER_USER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK",
+	"ER_WARN_DEPRECATED_YEAR_UNSIGNED",
+	"ER_CLONE_NETWORK_PACKET",
+	"ER_SDI_OPERATION_FAILED_MISSING_RECORD",
+	"ER_DEPENDENT_BY_CHECK_CONSTRAINT",
+	"ER_GRP_OPERATION_NOT_ALLOWED_GR_MUST_STOP",
+	"ER_WARN_DEPRECATED_JSON_TABLE_ON_ERROR_ON_EMPTY",
+	"ER_WARN_DEPRECATED_INNER_INTO",
+	"ER_WARN_DEPRECATED_VALUES_FUNCTION_ALWAYS_NULL",
+	"ER_WARN_DEPRECATED_SQL_CALC_FOUND_ROWS",
+	"ER_WARN_DEPRECATED_FOUND_ROWS",
+	"ER_MISSING_JSON_VALUE",
+	"ER_MULTIPLE_JSON_VALUES",
+	"ER_HOSTNAME_TOO_LONG",
+	"OBSOLETE_ER_WARN_CLIENT_DEPRECATED_PARTITION_PREFIX_KEY",
+	"ER_GROUP_REPLICATION_USER_EMPTY_MSG",
+	"ER_GROUP_REPLICATION_USER_MANDATORY_MSG",
+	"ER_GROUP_REPLICATION_PASSWORD_LENGTH",
+	"ER_SUBQUERY_TRANSFORM_REJECTED",
+	"ER_DA_GRP_RPL_RECOVERY_ENDPOINT_FORMAT",
+	"ER_DA_GRP_RPL_RECOVERY_ENDPOINT_INVALID",
+	"ER_WRONG_VALUE_FOR_VAR_PLUS_ACTIONABLE_PART",
+	"ER_STATEMENT_NOT_ALLOWED_AFTER_START_TRANSACTION",
+	"ER_FOREIGN_KEY_WITH_ATOMIC_CREATE_SELECT",
+	"ER_NOT_ALLOWED_WITH_START_TRANSACTION",
+	"ER_INVALID_JSON_ATTRIBUTE",
+	"ER_ENGINE_ATTRIBUTE_NOT_SUPPORTED",
+	"ER_INVALID_USER_ATTRIBUTE_JSON",
+	"ER_INNODB_REDO_DISABLED",
+	"ER_INNODB_REDO_ARCHIVING_ENABLED",
+	"ER_MDL_OUT_OF_RESOURCES",
+	"ER_IMPLICIT_COMPARISON_FOR_JSON",
+	"ER_FUNCTION_DOES_NOT_SUPPORT_CHARACTER_SET",
+	"ER_IMPOSSIBLE_STRING_CONVERSION",
+	"ER_SCHEMA_READ_ONLY",
+	"ER_RPL_ASYNC_RECONNECT_GTID_MODE_OFF",
+	"ER_RPL_ASYNC_RECONNECT_AUTO_POSITION_OFF",
+	"ER_DISABLE_GTID_MODE_REQUIRES_ASYNC_RECONNECT_OFF",
+	"ER_DISABLE_AUTO_POSITION_REQUIRES_ASYNC_RECONNECT_OFF",
+	"ER_INVALID_PARAMETER_USE",
+	"ER_CHARACTER_SET_MISMATCH",
+	"ER_WARN_VAR_VALUE_CHANGE_NOT_SUPPORTED",
+	"ER_INVALID_TIME_ZONE_INTERVAL",
+	"ER_INVALID_CAST",
+	"ER_HYPERGRAPH_NOT_SUPPORTED_YET",
+	"ER_WARN_HYPERGRAPH_EXPERIMENTAL",
+	"ER_DA_NO_ERROR_LOG_PARSER_CONFIGURED",
+	"ER_DA_ERROR_LOG_TABLE_DISABLED",
+	"ER_DA_ERROR_LOG_MULTIPLE_FILTERS",
+	"ER_DA_CANT_OPEN_ERROR_LOG",
+	"ER_USER_REFERENCED_AS_DEFINER",
+	"ER_CANNOT_USER_REFERENCED_AS_DEFINER",
+	"ER_REGEX_NUMBER_TOO_BIG",
+	"ER_SPVAR_NONINTEGER_TYPE",
+	"WARN_UNSUPPORTED_ACL_TABLES_READ",
+	"ER_BINLOG_UNSAFE_ACL_TABLE_READ_IN_DML_DDL",
+	"ER_STOP_REPLICA_MONITOR_IO_THREAD_TIMEOUT",
+	"ER_STARTING_REPLICA_MONITOR_IO_THREAD",
+	"ER_CANT_USE_ANONYMOUS_TO_GTID_WITH_GTID_MODE_NOT_ON",
+	"ER_CANT_COMBINE_ANONYMOUS_TO_GTID_AND_AUTOPOSITION",
+	"ER_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_REQUIRES_GTID_MODE_ON",
+	"ER_SQL_REPLICA_SKIP_COUNTER_USED_WITH_GTID_MODE_ON",
+	"ER_USING_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_AS_LOCAL_OR_UUID",
+	"OBSOLETE_ER_SET_GTID_TO_ANON_AND_WAIT_UNTIL_SQL_THD_AFTER_GTIDS",
+	"ER_CANT_SET_SQL_AFTER_OR_BEFORE_GTIDS_WITH_ANONYMOUS_TO_GTID",
+	"ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_GROUP_NAME",
+	"ER_CANT_USE_SAME_UUID_AS_GROUP_NAME",
+	"ER_GRP_RPL_RECOVERY_CHANNEL_STILL_RUNNING",
+	"ER_INNODB_INVALID_AUTOEXTEND_SIZE_VALUE",
+	"ER_INNODB_INCOMPATIBLE_WITH_TABLESPACE",
+	"ER_INNODB_AUTOEXTEND_SIZE_OUT_OF_RANGE",
+	"ER_CANNOT_USE_AUTOEXTEND_SIZE_CLAUSE",
+	"ER_ROLE_GRANTED_TO_ITSELF",
+	"ER_TABLE_MUST_HAVE_A_VISIBLE_COLUMN",
+	"ER_INNODB_COMPRESSION_FAILURE",
+	"ER_WARN_ASYNC_CONN_FAILOVER_NETWORK_NAMESPACE",
+	"ER_CLIENT_INTERACTION_TIMEOUT",
+	"ER_INVALID_CAST_TO_GEOMETRY",
+	"ER_INVALID_CAST_POLYGON_RING_DIRECTION",
+	"ER_GIS_DIFFERENT_SRIDS_AGGREGATION",
+	"ER_RELOAD_KEYRING_FAILURE",
+	"ER_SDI_GET_KEYS_INVALID_TABLESPACE",
+	"ER_CHANGE_RPL_SRC_WRONG_COMPRESSION_ALGORITHM_SIZE",
+	"OBSOLETE_ER_WARN_DEPRECATED_TLS_VERSION_FOR_CHANNEL_CLI",
+	"ER_CANT_USE_SAME_UUID_AS_VIEW_CHANGE_UUID",
+	"ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_VIEW_CHANGE_UUID",
+	"ER_GRP_RPL_VIEW_CHANGE_UUID_FAIL_GET_VARIABLE",
+	"ER_WARN_ADUIT_LOG_MAX_SIZE_AND_PRUNE_SECONDS",
+	"ER_WARN_ADUIT_LOG_MAX_SIZE_CLOSE_TO_ROTATE_ON_SIZE",
+	"ER_KERBEROS_CREATE_USER",
+	"ER_INSTALL_PLUGIN_CONFLICT_CLIENT",
+	"ER_DA_ERROR_LOG_COMPONENT_FLUSH_FAILED",
+	"ER_WARN_SQL_AFTER_MTS_GAPS_GAP_NOT_CALCULATED",
+	"ER_INVALID_ASSIGNMENT_TARGET",
+	"ER_OPERATION_NOT_ALLOWED_ON_GR_SECONDARY",
+	"ER_GRP_RPL_FAILOVER_CHANNEL_STATUS_PROPAGATION",
+	"ER_WARN_AUDIT_LOG_FORMAT_UNIX_TIMESTAMP_ONLY_WHEN_JSON",
+	"ER_INVALID_MFA_PLUGIN_SPECIFIED",
+	"ER_IDENTIFIED_BY_UNSUPPORTED",
+	"ER_INVALID_PLUGIN_FOR_REGISTRATION",
+	"ER_PLUGIN_REQUIRES_REGISTRATION",
+	"ER_MFA_METHOD_EXISTS",
+	"ER_MFA_METHOD_NOT_EXISTS",
+	"ER_AUTHENTICATION_POLICY_MISMATCH",
+	"ER_PLUGIN_REGISTRATION_DONE",
+	"ER_INVALID_USER_FOR_REGISTRATION",
+	"ER_USER_REGISTRATION_FAILED",
+	"ER_MFA_METHODS_INVALID_ORDER",
+	"ER_MFA_METHODS_IDENTICAL",
+	"ER_INVALID_MFA_OPERATIONS_FOR_PASSWORDLESS_USER",
+	"ER_CHANGE_REPLICATION_SOURCE_NO_OPTIONS_FOR_GTID_ONLY",
+	"ER_CHANGE_REP_SOURCE_CANT_DISABLE_REQ_ROW_FORMAT_WITH_GTID_ONLY",
+	"ER_CHANGE_REP_SOURCE_CANT_DISABLE_AUTO_POSITION_WITH_GTID_ONLY",
+	"ER_CHANGE_REP_SOURCE_CANT_DISABLE_GTID_ONLY_WITHOUT_POSITIONS",
+	"ER_CHANGE_REP_SOURCE_CANT_DISABLE_AUTO_POS_WITHOUT_POSITIONS",
+	"ER_CHANGE_REP_SOURCE_GR_CHANNEL_WITH_GTID_MODE_NOT_ON",
+	"ER_CANT_USE_GTID_ONLY_WITH_GTID_MODE_NOT_ON",
+	"ER_WARN_C_DISABLE_GTID_ONLY_WITH_SOURCE_AUTO_POS_INVALID_POS",
+	"ER_DA_SSL_FIPS_MODE_ERROR",
+	"ER_VALUE_OUT_OF_RANGE",
+	"ER_FULLTEXT_WITH_ROLLUP",
+	"ER_REGEXP_MISSING_RESOURCE",
+	"ER_WARN_REGEXP_USING_DEFAULT",
+	"ER_REGEXP_MISSING_FILE",
+	"ER_WARN_DEPRECATED_COLLATION",
+	"ER_CONCURRENT_PROCEDURE_USAGE",
+	"ER_DA_GLOBAL_CONN_LIMIT",
+	"ER_DA_CONN_LIMIT",
+	"ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_COLUMN_TYPE_INSTANT",
+	"ER_WARN_SF_UDF_NAME_COLLISION",
+	"ER_CANNOT_PURGE_BINLOG_WITH_BACKUP_LOCK",
+	"ER_TOO_MANY_WINDOWS",
+	"ER_MYSQLBACKUP_CLIENT_MSG",
+	"ER_COMMENT_CONTAINS_INVALID_STRING",
+	"ER_DEFINITION_CONTAINS_INVALID_STRING",
+	"ER_CANT_EXECUTE_COMMAND_WITH_ASSIGNED_GTID_NEXT",
+	"ER_XA_TEMP_TABLE",
+	"ER_INNODB_MAX_ROW_VERSION",
+	"OBSOLETE_ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_SIZE",
+	"ER_OPERATION_NOT_ALLOWED_WHILE_PRIMARY_CHANGE_IS_RUNNING",
+	"ER_WARN_DEPRECATED_DATETIME_DELIMITER",
+	"ER_WARN_DEPRECATED_SUPERFLUOUS_DELIMITER",
+	"ER_CANNOT_PERSIST_SENSITIVE_VARIABLES",
+	"ER_WARN_CANNOT_SECURELY_PERSIST_SENSITIVE_VARIABLES",
+	"ER_WARN_TRG_ALREADY_EXISTS",
+	"ER_IF_NOT_EXISTS_UNSUPPORTED_TRG_EXISTS_ON_DIFFERENT_TABLE",
+	"ER_IF_NOT_EXISTS_UNSUPPORTED_UDF_NATIVE_FCT_NAME_COLLISION",
+	"ER_SET_PASSWORD_AUTH_PLUGIN_ERROR",
+	"OBSOLETE_ER_REDUCED_DBLWR_FILE_CORRUPTED",
+	"OBSOLETE_ER_REDUCED_DBLWR_PAGE_FOUND",
+	"ER_SRS_INVALID_LATITUDE_OF_ORIGIN",
+	"ER_SRS_INVALID_LONGITUDE_OF_ORIGIN",
+	"ER_SRS_UNUSED_PROJ_PARAMETER_PRESENT",
+	"ER_GIPK_COLUMN_EXISTS",
+	"ER_GIPK_FAILED_AUTOINC_COLUMN_EXISTS",
+	"ER_GIPK_COLUMN_ALTER_NOT_ALLOWED",
+	"ER_DROP_PK_COLUMN_TO_DROP_GIPK",
+	"ER_CREATE_SELECT_WITH_GIPK_DISALLOWED_IN_SBR",
+	"OBSOLETE_ER_DA_EXPIRE_LOGS_DAYS_IGNORED",
+	"ER_CTE_RECURSIVE_NOT_UNION",
+	"ER_COMMAND_BACKEND_FAILED_TO_FETCH_SECURITY_CTX",
+	"ER_COMMAND_SERVICE_BACKEND_FAILED",
+	"ER_CLIENT_FILE_PRIVILEGE_FOR_REPLICATION_CHECKS",
+	"ER_GROUP_REPLICATION_FORCE_MEMBERS_COMMAND_FAILURE",
+	"ER_WARN_DEPRECATED_IDENT",
+	"ER_INTERSECT_ALL_MAX_DUPLICATES_EXCEEDED",
+	"ER_TP_QUERY_THRS_PER_GRP_EXCEEDS_TXN_THR_LIMIT",
+	"ER_BAD_TIMESTAMP_FORMAT",
+	"ER_SHAPE_PRIDICTION_UDF",
+	"ER_SRS_INVALID_HEIGHT",
+	"ER_SRS_INVALID_SCALING",
+	"ER_SRS_INVALID_ZONE_WIDTH",
+	"ER_SRS_INVALID_LATITUDE_POLAR_STERE_VAR_A",
+	"ER_WARN_DEPRECATED_CLIENT_NO_SCHEMA_OPTION",
+	"ER_TABLE_NOT_EMPTY",
+	"ER_TABLE_NO_PRIMARY_KEY",
+	"ER_TABLE_IN_SHARED_TABLESPACE",
+	"ER_INDEX_OTHER_THAN_PK",
+	"ER_LOAD_BULK_DATA_UNSORTED",
+	"ER_BULK_EXECUTOR_ERROR",
+	"ER_BULK_READER_LIBCURL_INIT_FAILED",
+	"ER_BULK_READER_LIBCURL_ERROR",
+	"ER_BULK_READER_SERVER_ERROR",
+	"ER_BULK_READER_COMMUNICATION_ERROR",
+	"ER_BULK_LOAD_DATA_FAILED",
+	"ER_BULK_LOADER_COLUMN_TOO_BIG_FOR_LEFTOVER_BUFFER",
+	"ER_BULK_LOADER_COMPONENT_ERROR",
+	"ER_BULK_LOADER_FILE_CONTAINS_LESS_LINES_THAN_IGNORE_CLAUSE",
+	"ER_BULK_PARSER_MISSING_ENCLOSED_BY",
+	"ER_BULK_PARSER_ROW_BUFFER_MAX_TOTAL_COLS_EXCEEDED",
+	"ER_BULK_PARSER_COPY_BUFFER_SIZE_EXCEEDED",
+	"ER_BULK_PARSER_UNEXPECTED_END_OF_INPUT",
+	"ER_BULK_PARSER_UNEXPECTED_ROW_TERMINATOR",
+	"ER_BULK_PARSER_UNEXPECTED_CHAR_AFTER_ENDING_ENCLOSED_BY",
+	"ER_BULK_PARSER_UNEXPECTED_CHAR_AFTER_NULL_ESCAPE",
+	"ER_BULK_PARSER_UNEXPECTED_CHAR_AFTER_COLUMN_TERMINATOR",
+	"ER_BULK_PARSER_INCOMPLETE_ESCAPE_SEQUENCE",
+	"ER_LOAD_BULK_DATA_FAILED",
+	"ER_LOAD_BULK_DATA_WRONG_VALUE_FOR_FIELD",
+	"ER_LOAD_BULK_DATA_WARN_NULL_TO_NOTNULL",
+	"ER_REQUIRE_TABLE_PRIMARY_KEY_CHECK_GENERATE_WITH_GR",
+	"ER_CANT_CHANGE_SYS_VAR_IN_READ_ONLY_MODE",
+	"ER_INNODB_INSTANT_ADD_DROP_NOT_SUPPORTED_MAX_SIZE",
+	"ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_FIELDS",
+	"ER_CANT_SET_PERSISTED",
+	"ER_INSTALL_COMPONENT_SET_NULL_VALUE",
+	"ER_INSTALL_COMPONENT_SET_UNUSED_VALUE",
+	"ER_WARN_DEPRECATED_USER_DEFINED_COLLATIONS",
+	"ER_USER_LOCK_OVERLONG_NAME",
+	"ER_WARN_NO_SPACE_VERSION_COMMENT",
+	"ER_VALIDATE_PASSWORD_INSUFFICIENT_CHANGED_CHARACTERS",
+	"ER_WARN_DEPRECATED_WITH_NOTE",
+	"ER_LANGUAGE_COMPONENT",
+	"ER_LANGUAGE_COMPONENT_NOT_AVAILABLE",
+	"ER_LANGUAGE_COMPONENT_UNSUPPORTED_LANGUAGE",
+	"ER_LANGUAGE_COMPONENT_CANNOT_UNINSTALL",
+	"ER_SP_NO_ALTER_LANGUAGE",
+	"ER_EXPLAIN_INTO_ANALYZE_NOT_SUPPORTED",
+	"ER_EXPLAIN_INTO_IMPLICIT_FORMAT_NOT_SUPPORTED",
+	"ER_EXPLAIN_INTO_FORMAT_NOT_SUPPORTED",
+	"ER_NULL_CANT_BE_PERSISTED_FOR_READONLY",
+	"ER_EXPLAIN_INTO_FOR_CONNECTION_NOT_SUPPORTED",
+	"ER_INNODB_IMPORT_WRONG_DROPPED_ENUM_LENGTH",
+	"ER_INNODB_IMPORT_WRONG_NUMBER_OF_INDEXES_ZERO",
+	"ER_INNODB_IMPORT_WRONG_NUMBER_OF_INDEXES_TOO_HIGH",
+	"ER_INNODB_IMPORT_DROP_COL_METADATA_MISMATCH",
+	"ER_INNODB_IMPORT_ENUM_NULL_TERMINATOR_MISSING",
+	"ER_SIMULATED_INJECTION_ERROR",
+	"ER_WARN_DEPRECATED_DYNAMIC_PRIV_IN_GRANT",
+	"ER_BULK_MULTI_READER_OPEN_FILE_FAILED",
+	"ER_BULK_MULTI_READER_READ_FILE_FAILED",
+	"ER_BULK_MERGE_INVALID_CHUNK",
+	"ER_BULK_MERGE_NOT_ALL_CHUNKS_CONSUMED",
+	"ER_BULK_WRITER_LIBCURL_INIT_FAILED",
+	"ER_BULK_WRITER_LIBCURL_ERROR",
+	"ER_BULK_SORTING_LOADER_WRITE",
+	"ER_BULK_SORTING_LOADER_WAIT",
+	"ER_BULK_READER_OPEN_FILE_FAILED",
+	"ER_BULK_LOAD_TABLE_HAS_INSTANT_COLS",
+	"ER_BULK_LOAD_RESOURCE",
+	"ER_BULK_LOAD_SECONDARY_ENGINE",
+	"ER_BULK_READER_ERROR",
+	"ER_BULK_READER_FILE_DOESNT_EXIST",
+	"ER_BULK_READER_COULDNT_RESOLVE_HOST",
+	"ER_START_REPLICA_CHANNEL_INVALID_CONFIGURATION",
+	"ER_CANNOT_EXECUTE_IN_PRIMARY",
+	"ER_TOO_MANY_GROUP_BY_MODIFIER_BRANCHES",
+	"ER_WARN_DEPRECATED_ENGINE_SYNTAX_NO_REPLACEMENT",
+	"ER_QUALIFY_WITHOUT_WINDOW_FUNCTION",
+	"ER_SUPPORTED_ONLY_WITH_HYPERGRAPH",
+	"ER_SPECIFIC_ACCESS_DENIED",
+	"ER_CANT_SET_GTID_NEXT_TO_AUTOMATIC_TAGGED_WHEN_GTID_MODE_IS_OFF",
+	"ER_GTID_NEXT_TAG_GTID_MODE_OFF",
+	"ER_LH_COL_NOT_NULLABLE",
+	"ER_LH_WARN_COL_MISSING_NOT_NULLABLE",
+	"ER_LH_COL_IS_EMPTY",
+	"ER_LH_COL_IS_EMPTY_WARN",
+	"ER_LH_BAD_VALUE",
+	"ER_LH_DECIMAL_UNKNOWN_ERR",
+	"ER_LH_DECIMAL_OOM_ERR",
+	"ER_LH_WARN_DECIMAL_ROUNDING",
+	"ER_LH_DECIMAL_PRECISION_EXCEEDS_SCHEMA",
+	"ER_LH_EXCEEDS_MIN",
+	"ER_LH_EXCEEDS_MAX",
+	"ER_LH_WARN_EXCEEDS_MIN_TRUNCATING",
+	"ER_LH_WARN_EXCEEDS_MAX_TRUNCATING",
+	"ER_LH_REAL_IS_NAN",
+	"ER_LH_OUT_OF_RANGE",
+	"ER_LH_DATETIME_FORMAT",
+	"ER_LH_WARN_TRUNCATED",
+	"ER_LH_CANNOT_CONVERT_STRING",
+	"ER_LH_RESOURCE_PRINCIPAL_ERR",
+	"ER_LH_AWS_AUTH_ERR",
+	"ER_LH_CSV_PARSING_ERR",
+	"ER_LH_COLUMN_MISMATCH_ERR",
+	"ER_LH_COLUMN_MAX_ERR",
+	"ER_LH_CHARSET_UNSUPPORTED",
+	"ER_LH_PARQUET_DECIMAL_CONVERSION_ERR",
+	"ER_LH_STRING_TOO_LONG",
+	"ER_LH_RESOURCE_PRINCIPAL_BUCKET_ERR",
+	"ER_LH_NO_FILES_FOUND",
+	"ER_LH_EMPTY_FILE",
+	"ER_LH_DUPLICATE_FILE",
+	"ER_LH_AVRO_SCHEMA_DEPTH_EXCEEDS_MAX",
+	"ER_LH_AVRO_HEADER_MISMATCH",
+	"ER_LH_AVRO_ENUM_CANNOT_CONVERT_CHARSET",
+	"ER_LH_AVRO_ENUM_MISMATCH",
+	"ER_LH_AVRO_TYPE_CANNOT_CONVERT",
+	"ER_LH_AVRO_FILE_ENDS_UNEXPECTEDLY",
+	"ER_LH_AVRO_FILE_DATA_CORRUPT",
+	"ER_LH_AVRO_INVALID_UNION",
+	"ER_LH_AVRO_INVALID_BLOCK_SIZE",
+	"ER_LH_AVRO_INVALID_BLOCK_RECORD_COUNT",
+	"ER_LH_FORMAT_HEADER_NO_MAGIC_BYTES",
+	"ER_LH_AVRO_HEADER_METADATA_ERR",
+	"ER_LH_AVRO_HEADER_NO_SCHEMA",
+	"ER_LH_AVRO_NO_CODEC_IN_HEADER",
+	"ER_LH_AVRO_INVALID_NAME_IN_SCHEMA",
+	"ER_LH_AVRO_DECODING_ERR",
+	"ER_LH_PARQUET_NON_UTF8_FILE_ENC",
+	"ER_LH_PARQUET_SCHEMA_MISMATCH",
+	"ER_LH_PARQUET_ROW_GROUP_SIZE_EXCEEDS_MAX",
+	"ER_LH_PARQUET_CANNOT_LOCATE_OFFSET",
+	"ER_LH_PARQUET_TYPE_CANNOT_CONVERT",
+	"ER_LH_PARQUET_CANNOT_LOCATE_SCHEMA",
+	"ER_LH_INFER_SCHEMA_MISMATCH",
+	"ER_LH_OOM",
+	"ER_LH_WARN_INFER_SKIPPED_LINES",
+	"ER_LH_WARN_INFER_SKIPPED_FILES",
+	"ER_LH_INFER_FILE_HAS_NO_DATA",
+	"ER_LH_INFER_NO_DATA",
+	"ER_LH_INFER_NO_FILES",
+	"ER_LH_WARN_INFER_USE_DEFAULT_COL_NAMES",
+	"ER_LH_PARQUET_CANNOT_READ_HEADER",
+	"ER_LH_INFER_WARN_GOT_EXCEPTION",
+	"ER_LH_AVRO_CANNOT_PARSE_HEADER",
+	"ER_LH_PARQUET_CANT_OPEN_FILE",
+	"ER_LH_TOO_LARGE_VALUE_ERR",
+	"ER_LH_TOO_LARGE_ROW_ERR",
+	"ER_TABLESAMPLE_PERCENTAGE",
+	"ER_TABLESAMPLE_ONLY_ON_BASE_TABLES",
+	"OBSOLETE_ER_PARAMETER_INDEX_OUT_OF_RANGE",
+	"ER_RESULT_SIZE_LIMIT_EXCEEDED",
+	"ER_LANGUAGE_COMPONENT_INTERNAL",
+	"ER_LANGUAGE_COMPONENT_CONCURRENCY_LIMIT",
+	"ER_LANGUAGE_COMPONENT_RUNTIME",
+	"ER_LANGUAGE_COMPONENT_TIMEZONE",
+	"ER_LANGUAGE_COMPONENT_KEYWORD",
+	"ER_LANGUAGE_COMPONENT_SET_SYSTEM_VARIABLE",
+	"ER_LANGUAGE_COMPONENT_UNSUPPORTED_TYPE",
+	"ER_LANGUAGE_COMPONENT_CONVERSION",
+	"ER_WARN_SP_STATEMENT_PARTIALLY_EXECUTED",
+	"ER_STMT_EXECUTION_NOT_ALLOWED_WITHIN_SP_OR_TRG_OR_UDF",
+	"ER_LH_JSON_PARSING",
+	"ER_ENGINE_CANNOT_BE_DEFAULT",
+	"ER_PARTITION_PREFIX_KEY_NOT_SUPPORTED",
+	"ER_WARN_DEPRECATED_NON_STANDARD_KEY",
+	"ER_FK_NO_UNIQUE_INDEX_PARENT",
+	"ER_ACCESS_DENIED_NO_PROXY_GRANT",
+	"ER_ACCESS_DENIED_NO_PROXY",
+	"ER_LH_USER_DATA_ACCESS_FAILED",
+	"ER_BULK_READER_ZSTD_ERROR",
+	"ER_BULK_PARSER_ERROR",
+	"ER_LH_INVALID_JSON_FILE_FORMAT_SCHEMA",
+	"ER_LH_INFER_JSON_INVALID_SCHEMA",
+	"ER_LH_JSON_FILE_FORMAT_WARN_INFER_SCHEMA",
+	"ER_NON_SCALAR_USED_AS_KEY",
+	"ER_INCOMPATIBLE_TYPE_AGG",
+	"ER_DATA_INCOMPATIBLE_WITH_VECTOR",
+	"ER_EXCEEDS_VECTOR_MAX_DIMENSIONS",
+	"ER_TO_VECTOR_CONVERSION",
+	"ER_EXTERNAL_UNSUPPORTED_INDEX_ALGORITHM",
+	"ER_TP_CANNOT_DISABLE_MTL_WITH_DL",
+}
+
+func searchErrorCode(code int) int {
+	lo, hi := 0, len(errorCodes)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if int(errorCodes[m]) < code {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	if lo < len(errorCodes) && int(errorCodes[lo]) == code {
+		return lo
+	}
+	return -1
+}
+
+// catalogName returns the name of the error code.
+func catalogName(code int) (string, bool) {
+	if i := searchErrorCode(code); i >= 0 {
+		return errorNames[i], true
+	}
+	return "", false
+}
@@ -0,0 +1,68 @@
+// Package ndb classifies the NDB Cluster errors the ndbcluster engine surfaces
+// through ER_GET_TEMPORARY_ERRMSG and ER_GET_ERRMSG.
+package ndb
+
+import (
+	"strconv"
+
+	"github.com/orisano/mysqlerr"
+)
+
+var (
+	temporaryTemplate = mysqlerr.MustCompileTemplate("Got temporary error %d '%-.192s' from %s")
+	permanentTemplate = mysqlerr.MustCompileTemplate("Got error %d '%-.192s' from %s")
+)
+
+// Error is an NDB error reported by the engine.
+type Error struct {
+	// Code is the NDB error code, such as 266 for a lock wait timeout.
+	Code    int
+	Message string
+	// Engine is the engine that reported it, usually "NDB" or "NDBCLUSTER".
+	Engine string
+	// Temporary is set for the errors NDB classifies as temporary, which can be retried.
+	Temporary bool
+}
+
+// FromError returns the NDB error reported in err.
+func FromError(err error) (*Error, bool) {
+	e, ok := mysqlerr.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	var tmpl *mysqlerr.Template
+	switch e.Number {
+	case ER_GET_TEMPORARY_ERRMSG:
+		tmpl = temporaryTemplate
+	case ER_GET_ERRMSG:
+		tmpl = permanentTemplate
+	default:
+		return nil, false
+	}
+	values, ok := tmpl.Match(e.Message)
+	if !ok {
+		return nil, false
+	}
+	code, cerr := strconv.Atoi(values[0])
+	if cerr != nil {
+		return nil, false
+	}
+	return &Error{
+		Code:      code,
+		Message:   values[1],
+		Engine:    values[2],
+		Temporary: e.Number == ER_GET_TEMPORARY_ERRMSG,
+	}, true
+}
+
+// IsTemporary reports whether err is a temporary NDB error, such as a node failure
+// or a resource shortage, after which the transaction can be retried.
+func IsTemporary(err error) bool {
+	return mysqlerr.Code(err) == ER_GET_TEMPORARY_ERRMSG
+}
+
+// IsPermanent reports whether err is an NDB error that retrying will not fix.
+func IsPermanent(err error) bool {
+	e, ok := FromError(err)
+	return ok && !e.Temporary
+}
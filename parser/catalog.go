@@ -0,0 +1,114 @@
+package parser
+
+// The lookups scan the errors instead of indexing them, so that a caller may edit Errors between calls.
+
+// ByCode returns the error with the code.
+func (c *Catalog) ByCode(code int) (*Error, bool) {
+	for i := range c.Errors {
+		if c.Errors[i].Code == code {
+			return &c.Errors[i], true
+		}
+	}
+	return nil, false
+}
+
+// ByName returns the error with the name.
+func (c *Catalog) ByName(name string) (*Error, bool) {
+	for i := range c.Errors {
+		if c.Errors[i].Name == name {
+			return &c.Errors[i], true
+		}
+	}
+	return nil, false
+}
+
+// BySQLState returns the errors with the SQLSTATE, or with an SQLSTATE of the class if state has two characters.
+func (c *Catalog) BySQLState(state string) []*Error {
+	var errs []*Error
+	for i := range c.Errors {
+		s := c.Errors[i].SQLState
+		if s == state || len(state) == 2 && len(s) == 5 && s[:2] == state {
+			errs = append(errs, &c.Errors[i])
+		}
+	}
+	return errs
+}
+
+// Language returns the language declared with the short name, such as "eng".
+// The declared languages are in the Languages field.
+func (c *Catalog) Language(shortName string) (Language, bool) {
+	for _, l := range c.Languages {
+		if l.ShortName == shortName {
+			return l, true
+		}
+	}
+	return Language{}, false
+}
+
+// Change is an error defined by two catalogs with differences.
+type Change struct {
+	Old, New *Error
+}
+
+// MessageChanged reports whether the message of the language differs.
+func (c Change) MessageChanged(lang string) bool {
+	return c.Old.Message(lang) != c.New.Message(lang)
+}
+
+// CatalogDiff is the difference between an old and a new catalog, by code.
+type CatalogDiff struct {
+	// Added and Removed are the errors of only the new and only the old catalog.
+	Added, Removed []*Error
+	// Obsoleted are the errors the new catalog marks obsolete.
+	Obsoleted []Change
+	// Renamed are the errors whose name changed.
+	Renamed []Change
+	// Changed are the errors that are still live and whose message changed in any language.
+	Changed []Change
+}
+
+// Diff compares c, the old catalog, with newer.
+// The errors are listed in the order of the catalog defining them, newer for all but Removed.
+func (c *Catalog) Diff(newer *Catalog) *CatalogDiff {
+	old := map[int]*Error{}
+	for i := range c.Errors {
+		old[c.Errors[i].Code] = &c.Errors[i]
+	}
+	d := &CatalogDiff{}
+	codes := map[int]bool{}
+	for i := range newer.Errors {
+		e := &newer.Errors[i]
+		codes[e.Code] = true
+		o, ok := old[e.Code]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, e)
+			continue
+		case e.Obsolete && !o.Obsolete:
+			d.Obsoleted = append(d.Obsoleted, Change{o, e})
+		case e.Name != o.Name:
+			d.Renamed = append(d.Renamed, Change{o, e})
+		}
+		if !e.Obsolete && !sameMessages(o, e) {
+			d.Changed = append(d.Changed, Change{o, e})
+		}
+	}
+	for i := range c.Errors {
+		if !codes[c.Errors[i].Code] {
+			d.Removed = append(d.Removed, &c.Errors[i])
+		}
+	}
+	return d
+}
+
+func sameMessages(a, b *Error) bool {
+	if len(a.Messages) != len(b.Messages) {
+		return false
+	}
+	for _, m := range a.Messages {
+		if b.Message(m.Lang) != m.Text {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// headerDefine matches an error code define of a C header such as errmsg.h,
+// or an obsolete error that mysqld_error.h keeps commented out ("//#define OBSOLETE_ER_HASHCHK 1000 obsolete.").
+var headerDefine = regexp.MustCompile(`^(//)?#define\s+([A-Z][A-Z0-9_]*)\s+(\d+)\b`)
+
+// ParseHeader parses the error code defines of a C header such as include/errmsg.h or include/mysqld_error.h,
+// for when the message files are not available.
+// The range markers (CR_MIN_ERROR, CR_ERROR_LAST, ...) are skipped and the errors have no messages.
+func ParseHeader(r io.Reader) (*Catalog, error) {
+	s := bufio.NewScanner(r)
+	var errs []Error
+	for s.Scan() {
+		m := headerDefine.FindStringSubmatch(s.Text())
+		if m == nil || isRangeMarker(m[2]) {
+			continue
+		}
+		obsolete := strings.HasPrefix(m[2], "OBSOLETE_")
+		if m[1] != "" && !obsolete {
+			continue
+		}
+		code, err := strconv.Atoi(m[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid code: %q", s.Text())
+		}
+		errs = append(errs, Error{Name: m[2], Code: code, Obsolete: obsolete})
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+	if len(errs) == 0 {
+		return nil, fmt.Errorf("no error codes found")
+	}
+	return &Catalog{DefaultLanguage: "eng", Errors: errs}, nil
+}
+
+func isRangeMarker(name string) bool {
+	for _, suffix := range []string{"_MIN_ERROR", "_MAX_ERROR", "_ERROR_FIRST", "_ERROR_LAST"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
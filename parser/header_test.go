@@ -0,0 +1,33 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseHeader(t *testing.T) {
+	src := `#define CR_MIN_ERROR 2000
+#define CR_UNKNOWN_ERROR 2000
+#define CR_SOCKET_CREATE_ERROR 2001 /* comment */
+//#define OBSOLETE_ER_HASHCHK 1000 obsolete.
+//#define CR_COMMENTED_OUT 2002
+#define CR_ERROR_LAST 2001
+#define NOT_AN_ERROR_CODE "value"
+`
+	cat, err := ParseHeader(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Error{
+		{Name: "CR_UNKNOWN_ERROR", Code: 2000},
+		{Name: "CR_SOCKET_CREATE_ERROR", Code: 2001},
+		{Name: "OBSOLETE_ER_HASHCHK", Code: 1000, Obsolete: true},
+	}
+	if !reflect.DeepEqual(cat.Errors, want) {
+		t.Errorf("Errors = %+v, want %+v", cat.Errors, want)
+	}
+	if _, err := ParseHeader(strings.NewReader("int main() {}\n")); err == nil {
+		t.Error("a header without defines parsed")
+	}
+}
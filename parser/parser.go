@@ -0,0 +1,301 @@
+// Package parser parses the MySQL error message files (messages_to_clients.txt, errmsg-utf8.txt),
+// C headers of error codes and TiDB's errcode.go into a Catalog, the data mysqlerrgen generates from,
+// for tools that need the error definitions themselves:
+//
+//	resp, err := http.Get("https://raw.githubusercontent.com/mysql/mysql-server/mysql-8.4.2/share/messages_to_clients.txt")
+//	...
+//	cat, err := parser.Parse(resp.Body)
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Language is a language declared by the languages directive.
+type Language struct {
+	LongName  string `json:"long_name"`
+	ShortName string `json:"short_name"`
+	Charset   string `json:"charset"`
+}
+
+// Message is the text of an error in a language.
+type Message struct {
+	Lang string `json:"lang"`
+	Text string `json:"text"`
+}
+
+// Error is an error defined in the message file.
+type Error struct {
+	Name      string    `json:"name"`
+	Code      int       `json:"code"`
+	SQLState  string    `json:"sqlstate,omitempty"`
+	ODBCState string    `json:"odbc_state,omitempty"`
+	Messages  []Message `json:"messages"`
+	Obsolete  bool      `json:"obsolete"`
+	// Severity is the level of an error log message annotated with one, such as SeverityWarning.
+	Severity string `json:"severity,omitempty"`
+
+	// Section counts the start-error-number directives seen before the error.
+	Section int `json:"-"`
+}
+
+// Severities of the error log messages, given after the symbol as "severity WARNING" or "severity=WARNING".
+const (
+	SeverityError       = "ERROR"
+	SeverityWarning     = "WARNING"
+	SeverityInformation = "INFORMATION"
+)
+
+// Message returns the message text in the given language, or "" if there is none.
+func (e *Error) Message(lang string) string {
+	for _, m := range e.Messages {
+		if m.Lang == lang {
+			return m.Text
+		}
+	}
+	return ""
+}
+
+// Catalog is the content of a message file.
+type Catalog struct {
+	Languages       []Language `json:"languages"`
+	DefaultLanguage string     `json:"default_language"`
+	Errors          []Error    `json:"errors"`
+}
+
+// Options configures ParseWithOptions.
+type Options struct {
+	// AllowPrefixes, if not empty, are the prefixes the error symbols must have; another symbol fails the parse.
+	AllowPrefixes []string
+	// DenyPrefixes are the prefixes of the error symbols that are left out of the catalog.
+	// They still take their codes, so that the following errors keep theirs.
+	DenyPrefixes []string
+}
+
+func (o *Options) denies(name string) bool {
+	return o != nil && hasAnyPrefix(name, o.DenyPrefixes)
+}
+
+func (o *Options) allows(name string) bool {
+	return o == nil || len(o.AllowPrefixes) == 0 || hasAnyPrefix(name, o.AllowPrefixes)
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// symbolPattern matches the error symbols. Any upper case identifier is one, since the prefixes
+// (ER_, WARN_, OBSOLETE_ER_, ER_X_, WARN_OPTION_, ...) grow with the releases.
+var symbolPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// Parse parses a message file.
+func Parse(r io.Reader) (*Catalog, error) {
+	return ParseWithOptions(r, nil)
+}
+
+// ParseWithOptions parses a message file, filtering the error symbols by the options.
+func ParseWithOptions(r io.Reader, opts *Options) (*Catalog, error) {
+	s := bufio.NewScanner(r)
+	defaultLanguage := "eng"
+	errorCodeOffset := 1000
+	rCount := 0
+	section := 0
+	var languages []Language
+	var errs []Error
+	for n := 1; s.Scan(); n++ {
+		line := s.Text()
+		switch {
+		case strings.HasPrefix(line, "language"):
+			languages = parseLanguage(line)
+		case strings.HasPrefix(line, "start-error-number"):
+			_, line = consumeWord(line)
+			line = trimDelimiters(line)
+			offsetStr, rest := consumeWord(line)
+			if rest != "" {
+				return nil, fmt.Errorf("invalid format: %q", s.Text())
+			}
+			errorCodeOffset, _ = strconv.Atoi(offsetStr)
+			rCount = 0
+			section++
+		case strings.HasPrefix(line, "default-language"):
+			_, line = consumeWord(line)
+			line = trimDelimiters(line)
+			shortName, rest := consumeWord(line)
+			if rest != "" {
+				return nil, fmt.Errorf("invalid format: %q", s.Text())
+			}
+			defaultLanguage = shortName
+		case strings.HasPrefix(line, "\t"), strings.HasPrefix(line, " "):
+			line = strings.TrimLeft(line, " \t")
+			langShortName := ""
+			if i := strings.IndexAny(line, " \t"); i >= 0 {
+				langShortName, line = line[:i], line[i:]
+			} else {
+				langShortName, line = line, ""
+			}
+			line = strings.TrimLeft(line, " \t")
+			if !strings.HasPrefix(line, `"`) {
+				return nil, fmt.Errorf("unexpected EOL: %q", s.Text())
+			}
+			text, err := parseQuoted(line[1:])
+			if err != nil {
+				return nil, fmt.Errorf("parse quote(%q): %w", s.Text(), err)
+			}
+			if len(errs) == 0 {
+				return nil, fmt.Errorf("line %d: message before any error symbol: %q", n, s.Text())
+			}
+			curErr := &errs[len(errs)-1]
+			curErr.Messages = append(curErr.Messages, Message{
+				Lang: langShortName,
+				Text: text,
+			})
+		case line != "" && 'A' <= line[0] && line[0] <= 'Z':
+			var errorName, sqlState, odbcState string
+			errorName, line = consumeWord(line)
+			if !symbolPattern.MatchString(errorName) {
+				return nil, fmt.Errorf("invalid symbol: %q", s.Text())
+			}
+			if !opts.allows(errorName) {
+				return nil, fmt.Errorf("symbol without an allowed prefix: %q", errorName)
+			}
+			var severity string
+			for {
+				var word string
+				word, line = consumeWord(trimDelimiters(line))
+				if word == "" {
+					break
+				}
+				switch {
+				case word == "severity":
+					severity, line = consumeWord(trimDelimiters(line))
+					if severity != SeverityError && severity != SeverityWarning && severity != SeverityInformation {
+						return nil, fmt.Errorf("invalid severity: %q", s.Text())
+					}
+				case sqlState == "":
+					sqlState = word
+				case odbcState == "":
+					odbcState = word
+				}
+			}
+			errorCode := errorCodeOffset + rCount
+			rCount++
+			if opts.denies(errorName) {
+				// The messages that follow go to the denied error, which is discarded.
+				errs = append(errs, Error{Name: errorName, Code: -1})
+				continue
+			}
+			errs = append(errs, Error{
+				Name:      errorName,
+				Code:      errorCode,
+				SQLState:  sqlState,
+				ODBCState: odbcState,
+				Obsolete:  strings.HasPrefix(errorName, "OBSOLETE_"),
+				Severity:  severity,
+				Section:   section,
+			})
+		case strings.HasPrefix(line, "#"), line == "":
+			// comment
+		case strings.HasPrefix(line, "reserved-error-section"):
+		default:
+			// unknown format
+			return nil, fmt.Errorf("unknown format: %q", line)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+	if opts != nil && len(opts.DenyPrefixes) > 0 {
+		kept := errs[:0]
+		for _, e := range errs {
+			if e.Code >= 0 {
+				kept = append(kept, e)
+			}
+		}
+		errs = kept
+	}
+	return &Catalog{
+		Languages:       languages,
+		DefaultLanguage: defaultLanguage,
+		Errors:          errs,
+	}, nil
+}
+
+func consumeWord(s string) (string, string) {
+	i := strings.IndexAny(s, " ,\t\r\n=")
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], s[i:]
+}
+
+func trimDelimiters(s string) string {
+	return strings.TrimLeft(s, " ,\t=")
+}
+
+// <keyword> <lang>[, <lang>]* ;
+// keyword := language[s]
+// lang := <long_name>=<short_name> <charset>
+func parseLanguage(s string) []Language {
+	_, s = consumeWord(s) // skip keyword
+	s = trimDelimiters(s)
+
+	var languages []Language
+	for !(strings.HasPrefix(s, ";") || s == "") {
+		longName, x := consumeWord(s)
+		x = trimDelimiters(x)
+		shortName, x := consumeWord(x)
+		x = trimDelimiters(x)
+		charset, x := consumeWord(x)
+		// The list ends with a semicolon right after the last charset.
+		if i := strings.IndexByte(charset, ';'); i >= 0 {
+			charset, x = charset[:i], charset[i:]
+		}
+		s = trimDelimiters(x)
+		languages = append(languages, Language{
+			LongName:  longName,
+			ShortName: shortName,
+			Charset:   charset,
+		})
+	}
+	return languages
+}
+
+func parseQuoted(s string) (string, error) {
+	var b strings.Builder
+	r := []rune(s)
+	for i := 0; i < len(r); i++ {
+		if r[i] == '"' {
+			return b.String(), nil
+		}
+		if r[i] == '\\' && i+1 < len(r) {
+			i++
+			switch r[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				// Up to three octal digits, leaving i on the last one.
+				n := 0
+				for j := 0; j < 3 && i < len(r) && '0' <= r[i] && r[i] <= '7'; i, j = i+1, j+1 {
+					n = n*8 + int(r[i]-'0')
+				}
+				i--
+				b.WriteByte(byte(n))
+			default:
+				b.WriteRune(r[i])
+			}
+		} else {
+			b.WriteRune(r[i])
+		}
+	}
+	return "", fmt.Errorf("unexpected EOL")
+}
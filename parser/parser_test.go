@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const source = `languages english=eng latin1, japanese=jpn ujis;
+
+default-language eng
+
+start-error-number 1000
+
+ER_HASHCHK
+  eng "hashchk"
+ER_DUP_ENTRY 23000 S1009
+  eng "Duplicate entry '%-.192s' for key %d"
+  jpn "'%-.192s' は索引 %d で重複しています。"
+OBSOLETE_ER_UNUSED
+  eng "unused"
+
+# the error log
+start-error-number 10000
+
+ER_SERVER_STARTUP_MSG severity INFORMATION
+  eng "%s: ready for connections."
+`
+
+func TestParse(t *testing.T) {
+	cat, err := Parse(strings.NewReader(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLanguages := []Language{
+		{LongName: "english", ShortName: "eng", Charset: "latin1"},
+		{LongName: "japanese", ShortName: "jpn", Charset: "ujis"},
+	}
+	if !reflect.DeepEqual(cat.Languages, wantLanguages) {
+		t.Errorf("Languages = %+v, want %+v", cat.Languages, wantLanguages)
+	}
+	if cat.DefaultLanguage != "eng" {
+		t.Errorf("DefaultLanguage = %q, want eng", cat.DefaultLanguage)
+	}
+	want := []Error{
+		{Name: "ER_HASHCHK", Code: 1000, Messages: []Message{{"eng", "hashchk"}}, Section: 1},
+		{Name: "ER_DUP_ENTRY", Code: 1001, SQLState: "23000", ODBCState: "S1009", Messages: []Message{
+			{"eng", "Duplicate entry '%-.192s' for key %d"},
+			{"jpn", "'%-.192s' は索引 %d で重複しています。"},
+		}, Section: 1},
+		{Name: "OBSOLETE_ER_UNUSED", Code: 1002, Messages: []Message{{"eng", "unused"}}, Obsolete: true, Section: 1},
+		{Name: "ER_SERVER_STARTUP_MSG", Code: 10000, Messages: []Message{{"eng", "%s: ready for connections."}}, Severity: SeverityInformation, Section: 2},
+	}
+	if !reflect.DeepEqual(cat.Errors, want) {
+		t.Errorf("Errors = %+v, want %+v", cat.Errors, want)
+	}
+}
+
+func TestParseEscapes(t *testing.T) {
+	tests := []struct {
+		quoted string
+		want   string
+	}{
+		{`plain`, "plain"},
+		{`a\nb`, "a\nb"},
+		{`\"quoted\"`, `"quoted"`},
+		{`\101B`, "AB"},
+		{`\7x`, "\ax"},
+		{`\0`, "\x00"},
+		{`\1012`, "A2"},
+	}
+	for _, tt := range tests {
+		cat, err := Parse(strings.NewReader("ER_A\n  eng \"" + tt.quoted + "\"\n"))
+		if err != nil {
+			t.Errorf("%s: %v", tt.quoted, err)
+			continue
+		}
+		if got := cat.Errors[0].Message("eng"); got != tt.want {
+			t.Errorf("%s: message %q, want %q", tt.quoted, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{"message before symbol", "# comment\n  eng \"orphan\"\n", "line 2: message before any error symbol"},
+		{"unterminated message", "ER_A\n  eng \"open\n", "unexpected EOL"},
+		{"unquoted message", "ER_A\n  eng open\n", "unexpected EOL"},
+		{"lower case", "er_a\n", "unknown format"},
+		{"invalid symbol", "ER-A\n", "invalid symbol"},
+		{"invalid severity", "ER_A severity LOUD\n", "invalid severity"},
+		{"start-error-number", "start-error-number 1000 2000\n", "invalid format"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.source))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestParseWithOptions(t *testing.T) {
+	src := "start-error-number 2000\nCR_A\n  eng \"a\"\nER_B\n  eng \"b\"\nCR_C\n  eng \"c\"\n"
+	cat, err := ParseWithOptions(strings.NewReader(src), &Options{DenyPrefixes: []string{"ER_"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range cat.Errors {
+		got = append(got, e.Name+"="+e.Message("eng"))
+	}
+	if want := []string{"CR_A=a", "CR_C=c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("errors %v, want %v", got, want)
+	}
+	if cat.Errors[1].Code != 2002 {
+		t.Errorf("CR_C = %d, want 2002: a denied error keeps its code", cat.Errors[1].Code)
+	}
+	if _, err := ParseWithOptions(strings.NewReader(src), &Options{AllowPrefixes: []string{"CR_"}}); err == nil {
+		t.Error("ER_B passed AllowPrefixes CR_")
+	}
+}
@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// tidbErrcode matches a constant of TiDB's pkg/errno/errcode.go.
+var tidbErrcode = regexp.MustCompile(`^\s*(Err\w+)\s*=\s*(\d+)\b`)
+
+// ParseTiDB parses TiDB's pkg/errno/errcode.go.
+// The errors keep the names TiDB uses and have no messages.
+// The range markers ErrErrorFirst and ErrErrorLast are skipped.
+func ParseTiDB(r io.Reader) (*Catalog, error) {
+	s := bufio.NewScanner(r)
+	var errs []Error
+	for s.Scan() {
+		m := tidbErrcode.FindStringSubmatch(s.Text())
+		if m == nil || m[1] == "ErrErrorFirst" || m[1] == "ErrErrorLast" {
+			continue
+		}
+		code, err := strconv.Atoi(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid code: %q", s.Text())
+		}
+		errs = append(errs, Error{Name: m[1], Code: code})
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+	if len(errs) == 0 {
+		return nil, fmt.Errorf("no error codes found")
+	}
+	return &Catalog{DefaultLanguage: "eng", Errors: errs}, nil
+}
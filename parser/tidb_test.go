@@ -0,0 +1,41 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTiDB(t *testing.T) {
+	src := `package errno
+
+// MySQL error code.
+// This value is numeric. It is not portable to other database systems.
+const (
+	ErrErrorFirst = 1000
+	ErrHashchk    = 1000
+	ErrNisamchk   = 1001
+	ErrErrorLast  = 1863
+
+	// TiDB errors.
+	ErrMemExceedThreshold = 8001
+	ErrWriteConflict      = 9007 // trailing comment
+)
+`
+	cat, err := ParseTiDB(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Error{
+		{Name: "ErrHashchk", Code: 1000},
+		{Name: "ErrNisamchk", Code: 1001},
+		{Name: "ErrMemExceedThreshold", Code: 8001},
+		{Name: "ErrWriteConflict", Code: 9007},
+	}
+	if !reflect.DeepEqual(cat.Errors, want) {
+		t.Errorf("Errors = %+v, want %+v", cat.Errors, want)
+	}
+	if _, err := ParseTiDB(strings.NewReader("package errno\n")); err == nil {
+		t.Error("a file without codes parsed")
+	}
+}
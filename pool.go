@@ -0,0 +1,60 @@
+package mysqlerr
+
+import (
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+
+	"github.com/orisano/mysqlerr/client"
+	"github.com/orisano/mysqlerr/mariadb"
+)
+
+// PoolAction advises a connection pool or a driver wrapper on the connection that failed with err.
+// It reports true with the reason when the connection is unusable and should be discarded:
+// the server went away or is shutting down, the session was killed or disconnected for inactivity
+// (wait_timeout), or the protocol got out of sync, as after a malformed packet.
+// It reports false for the errors of a statement, after which the connection can be reused,
+// such as a duplicate key, a deadlock or a query killed with KILL QUERY.
+func PoolAction(err error) (discardConn bool, reason string) {
+	if err == nil {
+		return false, ""
+	}
+	switch Code(err) {
+	case client.CR_SERVER_GONE_ERROR, client.CR_SERVER_LOST, client.CR_SERVER_LOST_EXTENDED:
+		return true, "server gone"
+	case client.CR_COMMANDS_OUT_OF_SYNC:
+		return true, "commands out of sync"
+	case client.CR_MALFORMED_PACKET, ER_NET_PACKETS_OUT_OF_ORDER, ER_NET_UNCOMPRESS_ERROR:
+		return true, "malformed packet"
+	case client.CR_NET_PACKET_TOO_LARGE, ER_NET_PACKET_TOO_LARGE:
+		// The server closes the connection after refusing a packet larger than max_allowed_packet.
+		return true, "packet too large"
+	case ER_NET_READ_ERROR, ER_NET_READ_INTERRUPTED, ER_NET_ERROR_ON_WRITE, ER_NET_WRITE_INTERRUPTED,
+		ER_NET_FCNTL_ERROR, ER_NET_READ_ERROR_FROM_PIPE:
+		return true, "network error"
+	case ER_CLIENT_INTERACTION_TIMEOUT:
+		return true, "wait_timeout"
+	case ER_SESSION_WAS_KILLED, ER_ABORTING_CONNECTION, ER_NEW_ABORTING_CONNECTION, mariadb.ER_CONNECTION_KILLED:
+		return true, "session killed"
+	case ER_SERVER_SHUTDOWN:
+		return true, "server shutting down"
+	case ER_SERVER_OFFLINE_MODE:
+		return true, "server offline"
+	case 0:
+	default:
+		return false, ""
+	}
+	// Not a MySQL error: the driver failed to talk to the server.
+	if errors.Is(err, driver.ErrBadConn) {
+		return true, "bad connection"
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true, "connection closed"
+	}
+	var ne net.Error
+	if errors.As(err, &ne) {
+		return true, "network error"
+	}
+	return false, ""
+}
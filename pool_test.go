@@ -0,0 +1,51 @@
+package mysqlerr
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/orisano/mysqlerr/client"
+	"github.com/orisano/mysqlerr/mariadb"
+)
+
+func TestPoolAction(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		reason string
+	}{
+		{"server gone", &Error{Number: client.CR_SERVER_GONE_ERROR}, "server gone"},
+		{"server lost", &Error{Number: client.CR_SERVER_LOST}, "server gone"},
+		{"out of sync", &Error{Number: client.CR_COMMANDS_OUT_OF_SYNC}, "commands out of sync"},
+		{"packets out of order", &Error{Number: ER_NET_PACKETS_OUT_OF_ORDER}, "malformed packet"},
+		{"max_allowed_packet", &Error{Number: ER_NET_PACKET_TOO_LARGE}, "packet too large"},
+		{"read error", &Error{Number: ER_NET_READ_ERROR}, "network error"},
+		{"wait_timeout", &Error{Number: ER_CLIENT_INTERACTION_TIMEOUT}, "wait_timeout"},
+		{"KILL", &Error{Number: ER_SESSION_WAS_KILLED}, "session killed"},
+		{"MariaDB KILL", &Error{Number: mariadb.ER_CONNECTION_KILLED}, "session killed"},
+		{"shutdown", &Error{Number: ER_SERVER_SHUTDOWN}, "server shutting down"},
+		{"offline_mode", &Error{Number: ER_SERVER_OFFLINE_MODE}, "server offline"},
+		{"wrapped", fmt.Errorf("exec: %w", &driverError{Number: client.CR_SERVER_LOST}), "server gone"},
+		{"joined", joinError{errors.New("rollback"), &Error{Number: ER_SERVER_SHUTDOWN}}, "server shutting down"},
+		{"bad connection", fmt.Errorf("query: %w", driver.ErrBadConn), "bad connection"},
+		{"EOF", io.ErrUnexpectedEOF, "connection closed"},
+		{"net error", &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}, "network error"},
+		{"duplicate key", &Error{Number: ER_DUP_ENTRY}, ""},
+		{"deadlock", &Error{Number: ER_LOCK_DEADLOCK}, ""},
+		{"KILL QUERY", &Error{Number: ER_QUERY_INTERRUPTED}, ""},
+		// A statement error wrapping EOF keeps the connection: the server answered.
+		{"MySQL error before EOF", joinError{&Error{Number: ER_DUP_ENTRY}, io.EOF}, ""},
+		{"other error", errors.New("context canceled"), ""},
+		{"nil", nil, ""},
+	}
+	for _, tt := range tests {
+		discard, reason := PoolAction(tt.err)
+		if discard != (tt.reason != "") || reason != tt.reason {
+			t.Errorf("%s: PoolAction = %v, %q, want %q", tt.name, discard, reason, tt.reason)
+		}
+	}
+}
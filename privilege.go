@@ -0,0 +1,94 @@
+package mysqlerr
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	dbAccessDenied    = MustCompileTemplate("Access denied for user '%-.48s'@'%-.64s' to database '%-.192s'")
+	tableAccessDenied = MustCompileTemplate("%-.32s command denied to user '%-.48s'@'%-.64s' for table '%-.192s'")
+	// MariaDB quotes the database and the table apart: `db`.`t`.
+	mariadbTableAccessDenied = MustCompileTemplate("%-.100s command denied to user '%s'@'%s' for table `%s`.`%s`")
+	columnAccessDenied       = MustCompileTemplate("%-.32s command denied to user '%-.48s'@'%-.64s' for column '%-.192s' in table '%-.192s'")
+	specificAccessDenied     = MustCompileTemplate("Access denied; you need (at least one of) the %-.128s privilege(s) for this operation")
+)
+
+// AccessDenial is the detail of a privilege error.
+type AccessDenial struct {
+	// Privilege is the missing privilege, such as SELECT, or the alternatives of ER_SPECIFIC_ACCESS_DENIED_ERROR,
+	// such as "SUPER or SYSTEM_VARIABLES_ADMIN". It is empty for ER_DBACCESS_DENIED_ERROR.
+	Privilege string
+	// User and Host are the account, empty for ER_SPECIFIC_ACCESS_DENIED_ERROR which does not name it.
+	User string
+	Host string
+	// Object is the database or the table the privilege is missing on, empty for a global privilege.
+	Object string
+	// Column is the column of ER_COLUMNACCESS_DENIED_ERROR.
+	Column string
+}
+
+// AccessDenied returns the detail of an ER_DBACCESS_DENIED_ERROR, ER_TABLEACCESS_DENIED_ERROR,
+// ER_COLUMNACCESS_DENIED_ERROR or ER_SPECIFIC_ACCESS_DENIED_ERROR.
+// It reports false if err is not one of them or its message is not understood.
+func AccessDenied(err error) (AccessDenial, bool) {
+	e, ok := FromError(err)
+	if !ok {
+		return AccessDenial{}, false
+	}
+	switch e.Number {
+	case ER_DBACCESS_DENIED_ERROR:
+		if v, ok := dbAccessDenied.Match(e.Message); ok {
+			return AccessDenial{User: v[0], Host: v[1], Object: v[2]}, true
+		}
+	case ER_TABLEACCESS_DENIED_ERROR:
+		if v, ok := tableAccessDenied.Match(e.Message); ok {
+			return AccessDenial{Privilege: v[0], User: v[1], Host: v[2], Object: v[3]}, true
+		}
+		if v, ok := mariadbTableAccessDenied.Match(e.Message); ok {
+			return AccessDenial{Privilege: v[0], User: v[1], Host: v[2], Object: v[3] + "." + v[4]}, true
+		}
+	case ER_COLUMNACCESS_DENIED_ERROR:
+		if v, ok := columnAccessDenied.Match(e.Message); ok {
+			return AccessDenial{Privilege: v[0], User: v[1], Host: v[2], Column: v[3], Object: v[4]}, true
+		}
+	case ER_SPECIFIC_ACCESS_DENIED_ERROR:
+		if v, ok := specificAccessDenied.Match(e.Message); ok {
+			return AccessDenial{Privilege: v[0]}, true
+		}
+	}
+	return AccessDenial{}, false
+}
+
+// Grant returns the GRANT statement that gives the account the missing privilege.
+// Of the alternatives of ER_SPECIFIC_ACCESS_DENIED_ERROR it grants the last one, the narrowest,
+// as MySQL lists SUPER first. A table without a database is relative to the current database.
+// It reports false if the privilege or the account is unknown; set User and Host to the account
+// of the connection for ER_SPECIFIC_ACCESS_DENIED_ERROR.
+func (d AccessDenial) Grant() (string, bool) {
+	if d.Privilege == "" || d.User == "" && d.Host == "" {
+		return "", false
+	}
+	priv := d.Privilege
+	if i := strings.LastIndex(priv, " or "); i >= 0 {
+		priv = priv[i+len(" or "):]
+	}
+	if i := strings.LastIndex(priv, ", "); i >= 0 {
+		priv = priv[i+len(", "):]
+	}
+	if d.Column != "" {
+		priv += " (" + quoteIdentifier(d.Column) + ")"
+	}
+	on := "*.*"
+	if d.Object != "" {
+		on = quoteIdentifier(d.Object)
+		if db := strings.SplitN(d.Object, ".", 2); len(db) == 2 {
+			on = quoteIdentifier(db[0]) + "." + quoteIdentifier(db[1])
+		}
+	}
+	return fmt.Sprintf("GRANT %s ON %s TO %s@%s", priv, on, quoteString(d.User), quoteString(d.Host)), true
+}
+
+func quoteIdentifier(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
@@ -0,0 +1,63 @@
+package mysqlerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestAccessDenied(t *testing.T) {
+	table := &Error{Number: ER_TABLEACCESS_DENIED_ERROR, Message: "SELECT command denied to user 'app'@'10.0.0.1' for table 'orders'"}
+	tests := []struct {
+		name  string
+		err   error
+		want  AccessDenial
+		grant string
+	}{
+		{"database", &Error{Number: ER_DBACCESS_DENIED_ERROR, Message: "Access denied for user 'app'@'%' to database 'shop'"},
+			AccessDenial{User: "app", Host: "%", Object: "shop"}, ""},
+		{"table", table,
+			AccessDenial{Privilege: "SELECT", User: "app", Host: "10.0.0.1", Object: "orders"}, "GRANT SELECT ON `orders` TO 'app'@'10.0.0.1'"},
+		{"column", &Error{Number: ER_COLUMNACCESS_DENIED_ERROR, Message: "UPDATE command denied to user 'app'@'localhost' for column 'price' in table 'items'"},
+			AccessDenial{Privilege: "UPDATE", User: "app", Host: "localhost", Object: "items", Column: "price"}, "GRANT UPDATE (`price`) ON `items` TO 'app'@'localhost'"},
+		{"specific", &Error{Number: ER_SPECIFIC_ACCESS_DENIED_ERROR, Message: "Access denied; you need (at least one of) the SUPER or SYSTEM_VARIABLES_ADMIN privilege(s) for this operation"},
+			AccessDenial{Privilege: "SUPER or SYSTEM_VARIABLES_ADMIN"}, ""},
+		{"MariaDB table", &driverError{Number: 1142, Message: "INSERT command denied to user 'app'@'localhost' for table `shop`.`orders`"},
+			AccessDenial{Privilege: "INSERT", User: "app", Host: "localhost", Object: "shop.orders"}, "GRANT INSERT ON `shop`.`orders` TO 'app'@'localhost'"},
+		{"MariaDB database", &driverError{Number: 1044, Message: "Access denied for user 'app'@'localhost' to database 'shop'"},
+			AccessDenial{User: "app", Host: "localhost", Object: "shop"}, ""},
+		{"wrapped", fmt.Errorf("query: %w", table),
+			AccessDenial{Privilege: "SELECT", User: "app", Host: "10.0.0.1", Object: "orders"}, "GRANT SELECT ON `orders` TO 'app'@'10.0.0.1'"},
+		{"joined", joinError{errors.New("report"), table},
+			AccessDenial{Privilege: "SELECT", User: "app", Host: "10.0.0.1", Object: "orders"}, "GRANT SELECT ON `orders` TO 'app'@'10.0.0.1'"},
+	}
+	for _, tt := range tests {
+		got, ok := AccessDenied(tt.err)
+		if !ok || got != tt.want {
+			t.Errorf("%s: AccessDenied = %+v, %v, want %+v", tt.name, got, ok, tt.want)
+			continue
+		}
+		grant, ok := got.Grant()
+		if grant != tt.grant || ok != (tt.grant != "") {
+			t.Errorf("%s: Grant = %q, %v, want %q", tt.name, grant, ok, tt.grant)
+		}
+	}
+
+	for _, err := range []error{
+		&Error{Number: ER_TABLEACCESS_DENIED_ERROR, Message: "denied"},
+		&Error{Number: ER_DUP_ENTRY, Message: "Access denied for user 'app'@'%' to database 'shop'"},
+		errors.New("access denied"),
+		nil,
+	} {
+		if got, ok := AccessDenied(err); ok {
+			t.Errorf("AccessDenied(%v) = %+v", err, got)
+		}
+	}
+}
+
+func TestGrantSpecific(t *testing.T) {
+	d := AccessDenial{Privilege: "SUPER or SYSTEM_VARIABLES_ADMIN, SESSION_VARIABLES_ADMIN", User: "app", Host: "%"}
+	if got, ok := d.Grant(); !ok || got != "GRANT SESSION_VARIABLES_ADMIN ON *.* TO 'app'@'%'" {
+		t.Errorf("Grant = %q, %v", got, ok)
+	}
+}
@@ -0,0 +1,103 @@
+// Package proxysql classifies the errors ProxySQL generates itself,
+// so that applications behind it can tell proxy failures from server ones.
+package proxysql
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// Error codes ProxySQL sends on its own behalf.
+const (
+	// ER_PROXYSQL_MAX_CONNECT_TIMEOUT is sent when no backend of the hostgroup could be reached in time.
+	ER_PROXYSQL_MAX_CONNECT_TIMEOUT = 9001
+	// ER_PROXYSQL_HOSTGROUP_LOCKED is sent when a connection locked to a hostgroup is routed to another one.
+	ER_PROXYSQL_HOSTGROUP_LOCKED = 9006
+)
+
+// Error is an error of the ProxySQL catalog.
+type Error struct {
+	Code     int
+	Name     string
+	SQLState string
+	// Template is the message, with the directives ProxySQL fills in.
+	Template *mysqlerr.Template
+	// HostgroupArg is the index of the directive holding the hostgroup, or -1.
+	HostgroupArg int
+}
+
+// Errors is the catalog of the errors ProxySQL generates.
+var Errors = []Error{
+	{
+		Code:         ER_PROXYSQL_MAX_CONNECT_TIMEOUT,
+		Name:         "ER_PROXYSQL_MAX_CONNECT_TIMEOUT",
+		SQLState:     "HY000",
+		Template:     mysqlerr.MustCompileTemplate("Max connect timeout reached while reaching hostgroup %d after %llums"),
+		HostgroupArg: 0,
+	},
+	{
+		Code:         ER_PROXYSQL_HOSTGROUP_LOCKED,
+		Name:         "ER_PROXYSQL_HOSTGROUP_LOCKED",
+		SQLState:     "HY000",
+		Template:     mysqlerr.MustCompileTemplate("ProxySQL Error: connection is locked to hostgroup %d but trying to reach hostgroup %d"),
+		HostgroupArg: 1,
+	},
+}
+
+// messagePrefix starts most of the messages ProxySQL generates.
+const messagePrefix = "ProxySQL Error:"
+
+// serverNotAllowed is the server's own message for ER_NOT_ALLOWED_COMMAND,
+// the code ProxySQL reuses for the error_msg of its query rules.
+const serverNotAllowed = "The used command is not allowed with this MySQL version"
+
+// Lookup returns the catalog entry of err and the values of its message directives.
+func Lookup(err error) (*Error, []string, bool) {
+	e, ok := mysqlerr.FromError(err)
+	if !ok {
+		return nil, nil, false
+	}
+	for i := range Errors {
+		if Errors[i].Code != int(e.Number) {
+			continue
+		}
+		values, _ := Errors[i].Template.Match(e.Message)
+		return &Errors[i], values, true
+	}
+	return nil, nil, false
+}
+
+// IsProxyError reports whether err was generated by ProxySQL rather than a backend server.
+func IsProxyError(err error) bool {
+	if _, _, ok := Lookup(err); ok {
+		return true
+	}
+	e, ok := mysqlerr.FromError(err)
+	if !ok {
+		return false
+	}
+	return strings.HasPrefix(e.Message, messagePrefix) || IsQueryRuleRejection(err)
+}
+
+// IsHostgroupUnavailable reports whether ProxySQL could not reach any backend of the hostgroup.
+func IsHostgroupUnavailable(err error) bool {
+	return mysqlerr.Code(err) == ER_PROXYSQL_MAX_CONNECT_TIMEOUT
+}
+
+// IsQueryRuleRejection reports whether a query rule with an error_msg rejected the query.
+func IsQueryRuleRejection(err error) bool {
+	e, ok := mysqlerr.FromError(err)
+	return ok && e.Number == mysqlerr.ER_NOT_ALLOWED_COMMAND && e.Message != serverNotAllowed
+}
+
+// Hostgroup returns the hostgroup named in a ProxySQL routing error.
+func Hostgroup(err error) (int, bool) {
+	e, values, ok := Lookup(err)
+	if !ok || e.HostgroupArg < 0 || e.HostgroupArg >= len(values) {
+		return 0, false
+	}
+	hg, perr := strconv.Atoi(values[e.HostgroupArg])
+	return hg, perr == nil
+}
@@ -0,0 +1,51 @@
+package mysqlerr
+
+import "strings"
+
+// optionPreventsStatement matches ER_OPTION_PREVENTS_STATEMENT of MySQL and of MariaDB, which says "The MariaDB server".
+var optionPreventsStatement = MustCompileTemplate("The %s server is running with the %s option so it cannot execute this statement")
+
+// Options reported by ReadOnlyOption.
+const (
+	ReadOnlyServer      = "read_only"
+	ReadOnlySuperServer = "super_read_only"
+	ReadOnlyInnoDB      = "innodb_read_only"
+	ReadOnlyTransaction = "transaction_read_only"
+)
+
+// IsReadOnly reports whether a write was refused because the server or the transaction is read-only,
+// as happens on a replica or on a primary that has just been demoted.
+func IsReadOnly(err error) bool {
+	_, ok := ReadOnlyOption(err)
+	return ok
+}
+
+// ReadOnlyOption returns the system variable that made the server refuse a write:
+// ReadOnlyServer, ReadOnlySuperServer, ReadOnlyInnoDB or ReadOnlyTransaction.
+// It reports false if err is not a read-only error.
+func ReadOnlyOption(err error) (string, bool) {
+	e, ok := FromError(err)
+	if !ok {
+		return "", false
+	}
+	switch e.Number {
+	case ER_OPTION_PREVENTS_STATEMENT:
+		values, ok := optionPreventsStatement.Match(e.Message)
+		if !ok {
+			return "", false
+		}
+		// The option is printed as --read-only or --super-read-only, or as read_only by older servers.
+		option := strings.ReplaceAll(strings.TrimLeft(values[1], "-"), "-", "_")
+		switch option {
+		case ReadOnlyServer, ReadOnlySuperServer:
+			return option, true
+		}
+	case ER_READ_ONLY_MODE:
+		return ReadOnlyServer, true
+	case ER_INNODB_READ_ONLY:
+		return ReadOnlyInnoDB, true
+	case ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION:
+		return ReadOnlyTransaction, true
+	}
+	return "", false
+}
@@ -0,0 +1,37 @@
+package mysqlerr
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestReadOnlyOption(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"MySQL read_only", &Error{Number: ER_OPTION_PREVENTS_STATEMENT, Message: "The MySQL server is running with the --read-only option so it cannot execute this statement"}, ReadOnlyServer},
+		{"MySQL super_read_only", &Error{Number: ER_OPTION_PREVENTS_STATEMENT, Message: "The MySQL server is running with the --super-read-only option so it cannot execute this statement"}, ReadOnlySuperServer},
+		{"MySQL 5.6", &Error{Number: ER_OPTION_PREVENTS_STATEMENT, Message: "The MySQL server is running with the read_only option so it cannot execute this statement"}, ReadOnlyServer},
+		{"MariaDB read_only", &Error{Number: ER_OPTION_PREVENTS_STATEMENT, Message: "The MariaDB server is running with the --read-only option so it cannot execute this statement"}, ReadOnlyServer},
+		{"other option", &Error{Number: ER_OPTION_PREVENTS_STATEMENT, Message: "The MySQL server is running with the --skip-grant-tables option so it cannot execute this statement"}, ""},
+		{"unknown message", &Error{Number: ER_OPTION_PREVENTS_STATEMENT, Message: "read only"}, ""},
+		{"read only mode", &Error{Number: ER_READ_ONLY_MODE}, ReadOnlyServer},
+		{"InnoDB", &Error{Number: ER_INNODB_READ_ONLY}, ReadOnlyInnoDB},
+		{"transaction", &Error{Number: ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION}, ReadOnlyTransaction},
+		{"wrapped", fmt.Errorf("update: %w", &Error{Number: ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION}), ReadOnlyTransaction},
+		{"joined", joinError{fmt.Errorf("ping"), &Error{Number: ER_READ_ONLY_MODE}}, ReadOnlyServer},
+		{"other error", &Error{Number: ER_DUP_ENTRY}, ""},
+		{"nil", nil, ""},
+	}
+	for _, tt := range tests {
+		got, ok := ReadOnlyOption(tt.err)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Errorf("%s: ReadOnlyOption = %q, %v, want %q", tt.name, got, ok, tt.want)
+		}
+		if got := IsReadOnly(tt.err); got != (tt.want != "") {
+			t.Errorf("%s: IsReadOnly = %v", tt.name, got)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Package registry aggregates the error codes of MySQL, MariaDB, TiDB and the MySQL
+// client library. The dialects reuse overlapping ranges, so a code can have several entries.
+//
+// The MySQL entries are the codes of the messages sent to clients (share/messages_to_clients.txt).
+// The codes only written to the error log (share/messages_to_error_log.txt) are the ErrorLog entries,
+// registered by registry/errorlog.go once go generate has read that file.
+package registry
+
+import (
+	"sort"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// Dialects.
+const (
+	MySQL   = "mysql"
+	MariaDB = "mariadb"
+	TiDB    = "tidb"
+	Client  = "client"
+	// ErrorLog is the codes of MySQL only written to the error log, from MY-010000 on.
+	ErrorLog = "errorlog"
+)
+
+// Entry is an error code as defined by a dialect.
+type Entry struct {
+	Code    int
+	Name    string
+	Dialect string
+	// Version is the version of the dialect's source the entry was generated from.
+	Version string
+	// Severity is the level of an error log message, ERROR, WARNING or INFORMATION,
+	// if its source annotates it, so that log tooling can alert on MY- codes accordingly.
+	Severity string
+}
+
+type entry struct {
+	code int
+	name string
+}
+
+var (
+	byCode   = map[int][]Entry{}
+	dialects = map[string][]Entry{}
+)
+
+func register(dialect, version string, entries []entry) {
+	for _, e := range entries {
+		en := Entry{Code: e.code, Name: e.name, Dialect: dialect, Version: version}
+		byCode[e.code] = append(byCode[e.code], en)
+		dialects[dialect] = append(dialects[dialect], en)
+	}
+}
+
+// registerSeverities sets the severities of the entries of dialect registered before.
+func registerSeverities(dialect string, severities map[int]string) {
+	for code, severity := range severities {
+		es := byCode[code]
+		for i := range es {
+			if es[i].Dialect == dialect {
+				es[i].Severity = severity
+			}
+		}
+	}
+	es := dialects[dialect]
+	for i := range es {
+		if severity, ok := severities[es[i].Code]; ok {
+			es[i].Severity = severity
+		}
+	}
+}
+
+// LookupAny returns the entries of every dialect defining code, ordered by dialect.
+func LookupAny(code int) []Entry {
+	es := append([]Entry(nil), byCode[code]...)
+	sort.SliceStable(es, func(i, j int) bool {
+		return es[i].Dialect < es[j].Dialect
+	})
+	return es
+}
+
+// Lookup returns the entry of code in dialect.
+// When the dialect keeps an obsolete name next to the current one, the first one defined is returned.
+func Lookup(dialect string, code int) (Entry, bool) {
+	for _, e := range byCode[code] {
+		if e.Dialect == dialect {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Entries returns the entries of dialect in the order of its source.
+func Entries(dialect string) []Entry {
+	return append([]Entry(nil), dialects[dialect]...)
+}
+
+// Dialects returns the registered dialects.
+func Dialects() []string {
+	var ds []string
+	for d := range dialects {
+		ds = append(ds, d)
+	}
+	sort.Strings(ds)
+	return ds
+}
+
+// LookupSymbol returns the MySQL and ErrorLog entries of the MySQL 8 error log identifier s,
+// such as "MY-001213" or "MY-010931".
+func LookupSymbol(s string) ([]Entry, error) {
+	code, err := mysqlerr.ParseErrorLogSymbol(s)
+	if err != nil {
+		return nil, err
+	}
+	var es []Entry
+	for _, e := range byCode[code] {
+		if e.Dialect == MySQL || e.Dialect == ErrorLog {
+			es = append(es, e)
+		}
+	}
+	return es, nil
+}
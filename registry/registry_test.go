@@ -0,0 +1,36 @@
+package registry
+
+import "testing"
+
+func TestLookupSymbol(t *testing.T) {
+	es, err := LookupSymbol("MY-001213")
+	if err != nil || len(es) != 1 || es[0].Name != "ER_LOCK_DEADLOCK" || es[0].Dialect != MySQL {
+		t.Errorf("LookupSymbol(MY-001213) = %+v, %v", es, err)
+	}
+	if _, err := LookupSymbol("ER-1213"); err == nil {
+		t.Error("LookupSymbol(ER-1213) succeeded")
+	}
+
+	if len(Entries(ErrorLog)) == 0 {
+		t.Skip("registry/errorlog.go is not generated")
+	}
+	es, err = LookupSymbol("MY-010926")
+	if err != nil || len(es) != 1 || es[0].Name != "ER_ACCESS_DENIED_ERROR_WITH_PASSWORD" || es[0].Dialect != ErrorLog {
+		t.Errorf("LookupSymbol(MY-010926) = %+v, %v", es, err)
+	}
+}
+
+func TestRegisterSeverities(t *testing.T) {
+	const dialect = "severity-test"
+	register(dialect, "8.4.2", []entry{{10931, "ER_SERVER_STARTUP_MSG"}, {10932, "ER_SERVER_STARTUP_NO_ERROR"}})
+	registerSeverities(dialect, map[int]string{10931: "INFORMATION"})
+
+	e, ok := Lookup(dialect, 10931)
+	if !ok || e.Severity != "INFORMATION" {
+		t.Errorf("Lookup(10931) = %+v, %v, want severity INFORMATION", e, ok)
+	}
+	es := Entries(dialect)
+	if len(es) != 2 || es[0].Severity != "INFORMATION" || es[1].Severity != "" {
+		t.Errorf("Entries = %+v", es)
+	}
+}
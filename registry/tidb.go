@@ -0,0 +1,1137 @@
+// Code generated mysqlerrgen DO NOT EDIT.
+
+package registry
+
+func init() {
+	register("tidb", "8.1", []entry{
+		{1000, "ErrHashchk"},
+		{1001, "ErrNisamchk"},
+		{1002, "ErrNo"},
+		{1003, "ErrYes"},
+		{1004, "ErrCantCreateFile"},
+		{1005, "ErrCantCreateTable"},
+		{1006, "ErrCantCreateDB"},
+		{1007, "ErrDBCreateExists"},
+		{1008, "ErrDBDropExists"},
+		{1009, "ErrDBDropDelete"},
+		{1010, "ErrDBDropRmdir"},
+		{1011, "ErrCantDeleteFile"},
+		{1012, "ErrCantFindSystemRec"},
+		{1013, "ErrCantGetStat"},
+		{1014, "ErrCantGetWd"},
+		{1015, "ErrCantLock"},
+		{1016, "ErrCantOpenFile"},
+		{1017, "ErrFileNotFound"},
+		{1018, "ErrCantReadDir"},
+		{1019, "ErrCantSetWd"},
+		{1020, "ErrCheckread"},
+		{1021, "ErrDiskFull"},
+		{1022, "ErrDupKey"},
+		{1023, "ErrErrorOnClose"},
+		{1024, "ErrErrorOnRead"},
+		{1025, "ErrErrorOnRename"},
+		{1026, "ErrErrorOnWrite"},
+		{1027, "ErrFileUsed"},
+		{1028, "ErrFilsortAbort"},
+		{1029, "ErrFormNotFound"},
+		{1030, "ErrGetErrno"},
+		{1031, "ErrIllegalHa"},
+		{1032, "ErrKeyNotFound"},
+		{1033, "ErrNotFormFile"},
+		{1034, "ErrNotKeyFile"},
+		{1035, "ErrOldKeyFile"},
+		{1036, "ErrOpenAsReadonly"},
+		{1037, "ErrOutofMemory"},
+		{1038, "ErrOutOfSortMemory"},
+		{1039, "ErrUnexpectedEOF"},
+		{1040, "ErrConCount"},
+		{1041, "ErrOutOfResources"},
+		{1042, "ErrBadHost"},
+		{1043, "ErrHandshake"},
+		{1044, "ErrDBaccessDenied"},
+		{1045, "ErrAccessDenied"},
+		{1046, "ErrNoDB"},
+		{1047, "ErrUnknownCom"},
+		{1048, "ErrBadNull"},
+		{1049, "ErrBadDB"},
+		{1050, "ErrTableExists"},
+		{1051, "ErrBadTable"},
+		{1052, "ErrNonUniq"},
+		{1053, "ErrServerShutdown"},
+		{1054, "ErrBadField"},
+		{1055, "ErrFieldNotInGroupBy"},
+		{1056, "ErrWrongGroupField"},
+		{1057, "ErrWrongSumSelect"},
+		{1058, "ErrWrongValueCount"},
+		{1059, "ErrTooLongIdent"},
+		{1060, "ErrDupFieldName"},
+		{1061, "ErrDupKeyName"},
+		{1062, "ErrDupEntry"},
+		{1063, "ErrWrongFieldSpec"},
+		{1064, "ErrParse"},
+		{1065, "ErrEmptyQuery"},
+		{1066, "ErrNonuniqTable"},
+		{1067, "ErrInvalidDefault"},
+		{1068, "ErrMultiplePriKey"},
+		{1069, "ErrTooManyKeys"},
+		{1070, "ErrTooManyKeyParts"},
+		{1071, "ErrTooLongKey"},
+		{1072, "ErrKeyColumnDoesNotExits"},
+		{1073, "ErrBlobUsedAsKey"},
+		{1074, "ErrTooBigFieldlength"},
+		{1075, "ErrWrongAutoKey"},
+		{1076, "ErrReady"},
+		{1077, "ErrNormalShutdown"},
+		{1078, "ErrGotSignal"},
+		{1079, "ErrShutdownComplete"},
+		{1080, "ErrForcingClose"},
+		{1081, "ErrIpsock"},
+		{1082, "ErrNoSuchIndex"},
+		{1083, "ErrWrongFieldTerminators"},
+		{1084, "ErrBlobsAndNoTerminated"},
+		{1085, "ErrTextFileNotReadable"},
+		{1086, "ErrFileExists"},
+		{1087, "ErrLoadInfo"},
+		{1088, "ErrAlterInfo"},
+		{1089, "ErrWrongSubKey"},
+		{1090, "ErrCantRemoveAllFields"},
+		{1091, "ErrCantDropFieldOrKey"},
+		{1092, "ErrInsertInfo"},
+		{1093, "ErrUpdateTableUsed"},
+		{1094, "ErrNoSuchThread"},
+		{1095, "ErrKillDenied"},
+		{1096, "ErrNoTablesUsed"},
+		{1097, "ErrTooBigSet"},
+		{1098, "ErrNoUniqueLogFile"},
+		{1099, "ErrTableNotLockedForWrite"},
+		{1100, "ErrTableNotLocked"},
+		{1101, "ErrBlobCantHaveDefault"},
+		{1102, "ErrWrongDBName"},
+		{1103, "ErrWrongTableName"},
+		{1104, "ErrTooBigSelect"},
+		{1105, "ErrUnknown"},
+		{1106, "ErrUnknownProcedure"},
+		{1107, "ErrWrongParamcountToProcedure"},
+		{1108, "ErrWrongParametersToProcedure"},
+		{1109, "ErrUnknownTable"},
+		{1110, "ErrFieldSpecifiedTwice"},
+		{1111, "ErrInvalidGroupFuncUse"},
+		{1112, "ErrUnsupportedExtension"},
+		{1113, "ErrTableMustHaveColumns"},
+		{1114, "ErrRecordFileFull"},
+		{1115, "ErrUnknownCharacterSet"},
+		{1116, "ErrTooManyTables"},
+		{1117, "ErrTooManyFields"},
+		{1118, "ErrTooBigRowsize"},
+		{1119, "ErrStackOverrun"},
+		{1120, "ErrWrongOuterJoin"},
+		{1121, "ErrNullColumnInIndex"},
+		{1122, "ErrCantFindUdf"},
+		{1123, "ErrCantInitializeUdf"},
+		{1124, "ErrUdfNoPaths"},
+		{1125, "ErrUdfExists"},
+		{1126, "ErrCantOpenLibrary"},
+		{1127, "ErrCantFindDlEntry"},
+		{1128, "ErrFunctionNotDefined"},
+		{1129, "ErrHostIsBlocked"},
+		{1130, "ErrHostNotPrivileged"},
+		{1131, "ErrPasswordAnonymousUser"},
+		{1132, "ErrPasswordNotAllowed"},
+		{1133, "ErrPasswordNoMatch"},
+		{1134, "ErrUpdateInfo"},
+		{1135, "ErrCantCreateThread"},
+		{1136, "ErrWrongValueCountOnRow"},
+		{1137, "ErrCantReopenTable"},
+		{1138, "ErrInvalidUseOfNull"},
+		{1139, "ErrRegexp"},
+		{1140, "ErrMixOfGroupFuncAndFields"},
+		{1141, "ErrNonexistingGrant"},
+		{1142, "ErrTableaccessDenied"},
+		{1143, "ErrColumnaccessDenied"},
+		{1144, "ErrIllegalGrantForTable"},
+		{1145, "ErrGrantWrongHostOrUser"},
+		{1146, "ErrNoSuchTable"},
+		{1147, "ErrNonexistingTableGrant"},
+		{1148, "ErrNotAllowedCommand"},
+		{1149, "ErrSyntax"},
+		{1150, "ErrDelayedCantChangeLock"},
+		{1151, "ErrTooManyDelayedThreads"},
+		{1152, "ErrAbortingConnection"},
+		{1153, "ErrNetPacketTooLarge"},
+		{1154, "ErrNetReadErrorFromPipe"},
+		{1155, "ErrNetFcntl"},
+		{1156, "ErrNetPacketsOutOfOrder"},
+		{1157, "ErrNetUncompress"},
+		{1158, "ErrNetRead"},
+		{1159, "ErrNetReadInterrupted"},
+		{1160, "ErrNetErrorOnWrite"},
+		{1161, "ErrNetWriteInterrupted"},
+		{1162, "ErrTooLongString"},
+		{1163, "ErrTableCantHandleBlob"},
+		{1164, "ErrTableCantHandleAutoIncrement"},
+		{1165, "ErrDelayedInsertTableLocked"},
+		{1166, "ErrWrongColumnName"},
+		{1167, "ErrWrongKeyColumn"},
+		{1168, "ErrWrongMrgTable"},
+		{1169, "ErrDupUnique"},
+		{1170, "ErrBlobKeyWithoutLength"},
+		{1171, "ErrPrimaryCantHaveNull"},
+		{1172, "ErrTooManyRows"},
+		{1173, "ErrRequiresPrimaryKey"},
+		{1174, "ErrNoRaidCompiled"},
+		{1175, "ErrUpdateWithoutKeyInSafeMode"},
+		{1176, "ErrKeyDoesNotExist"},
+		{1177, "ErrCheckNoSuchTable"},
+		{1178, "ErrCheckNotImplemented"},
+		{1179, "ErrCantDoThisDuringAnTransaction"},
+		{1180, "ErrErrorDuringCommit"},
+		{1181, "ErrErrorDuringRollback"},
+		{1182, "ErrErrorDuringFlushLogs"},
+		{1183, "ErrErrorDuringCheckpoint"},
+		{1184, "ErrNewAbortingConnection"},
+		{1185, "ErrDumpNotImplemented"},
+		{1187, "ErrIndexRebuild"},
+		{1191, "ErrFtMatchingKeyNotFound"},
+		{1192, "ErrLockOrActiveTransaction"},
+		{1193, "ErrUnknownSystemVariable"},
+		{1194, "ErrCrashedOnUsage"},
+		{1195, "ErrCrashedOnRepair"},
+		{1196, "ErrWarningNotCompleteRollback"},
+		{1197, "ErrTransCacheFull"},
+		{1203, "ErrTooManyUserConnections"},
+		{1204, "ErrSetConstantsOnly"},
+		{1205, "ErrLockWaitTimeout"},
+		{1206, "ErrLockTableFull"},
+		{1207, "ErrReadOnlyTransaction"},
+		{1208, "ErrDropDBWithReadLock"},
+		{1209, "ErrCreateDBWithReadLock"},
+		{1210, "ErrWrongArguments"},
+		{1211, "ErrNoPermissionToCreateUser"},
+		{1212, "ErrUnionTablesInDifferentDir"},
+		{1213, "ErrLockDeadlock"},
+		{1214, "ErrTableCantHandleFt"},
+		{1215, "ErrCannotAddForeign"},
+		{1216, "ErrNoReferencedRow"},
+		{1217, "ErrRowIsReferenced"},
+		{1220, "ErrErrorWhenExecutingCommand"},
+		{1221, "ErrWrongUsage"},
+		{1222, "ErrWrongNumberOfColumnsInSelect"},
+		{1223, "ErrCantUpdateWithReadlock"},
+		{1224, "ErrMixingNotAllowed"},
+		{1225, "ErrDupArgument"},
+		{1226, "ErrUserLimitReached"},
+		{1227, "ErrSpecificAccessDenied"},
+		{1228, "ErrLocalVariable"},
+		{1229, "ErrGlobalVariable"},
+		{1230, "ErrNoDefault"},
+		{1231, "ErrWrongValueForVar"},
+		{1232, "ErrWrongTypeForVar"},
+		{1233, "ErrVarCantBeRead"},
+		{1234, "ErrCantUseOptionHere"},
+		{1235, "ErrNotSupportedYet"},
+		{1238, "ErrIncorrectGlobalLocalVar"},
+		{1239, "ErrWrongFkDef"},
+		{1240, "ErrKeyRefDoNotMatchTableRef"},
+		{1241, "ErrOperandColumns"},
+		{1242, "ErrSubqueryNo1Row"},
+		{1243, "ErrUnknownStmtHandler"},
+		{1244, "ErrCorruptHelpDB"},
+		{1245, "ErrCyclicReference"},
+		{1246, "ErrAutoConvert"},
+		{1247, "ErrIllegalReference"},
+		{1248, "ErrDerivedMustHaveAlias"},
+		{1249, "ErrSelectReduced"},
+		{1250, "ErrTablenameNotAllowedHere"},
+		{1251, "ErrNotSupportedAuthMode"},
+		{1252, "ErrSpatialCantHaveNull"},
+		{1253, "ErrCollationCharsetMismatch"},
+		{1256, "ErrTooBigForUncompress"},
+		{1257, "ErrZlibZMem"},
+		{1258, "ErrZlibZBuf"},
+		{1259, "ErrZlibZData"},
+		{1260, "ErrCutValueGroupConcat"},
+		{1261, "ErrWarnTooFewRecords"},
+		{1262, "ErrWarnTooManyRecords"},
+		{1263, "ErrWarnNullToNotnull"},
+		{1264, "ErrWarnDataOutOfRange"},
+		{1266, "ErrWarnUsingOtherHandler"},
+		{1267, "ErrCantAggregate2collations"},
+		{1268, "ErrDropUser"},
+		{1269, "ErrRevokeGrants"},
+		{1270, "ErrCantAggregate3collations"},
+		{1271, "ErrCantAggregateNcollations"},
+		{1272, "ErrVariableIsNotStruct"},
+		{1273, "ErrUnknownCollation"},
+		{1275, "ErrServerIsInSecureAuthMode"},
+		{1276, "ErrWarnFieldResolved"},
+		{1279, "ErrUntilCondIgnored"},
+		{1280, "ErrWrongNameForIndex"},
+		{1281, "ErrWrongNameForCatalog"},
+		{1282, "ErrWarnQcResize"},
+		{1283, "ErrBadFtColumn"},
+		{1284, "ErrUnknownKeyCache"},
+		{1285, "ErrWarnHostnameWontWork"},
+		{1286, "ErrUnknownStorageEngine"},
+		{1287, "ErrWarnDeprecatedSyntax"},
+		{1288, "ErrNonUpdatableTable"},
+		{1289, "ErrFeatureDisabled"},
+		{1290, "ErrOptionPreventsStatement"},
+		{1291, "ErrDuplicatedValueInType"},
+		{1292, "ErrTruncatedWrongValue"},
+		{1293, "ErrTooMuchAutoTimestampCols"},
+		{1294, "ErrInvalidOnUpdate"},
+		{1295, "ErrUnsupportedPs"},
+		{1296, "ErrGetErrmsg"},
+		{1297, "ErrGetTemporaryErrmsg"},
+		{1298, "ErrUnknownTimeZone"},
+		{1299, "ErrWarnInvalidTimestamp"},
+		{1300, "ErrInvalidCharacterString"},
+		{1301, "ErrWarnAllowedPacketOverflowed"},
+		{1302, "ErrConflictingDeclarations"},
+		{1303, "ErrSpNoRecursiveCreate"},
+		{1304, "ErrSpAlreadyExists"},
+		{1305, "ErrSpDoesNotExist"},
+		{1306, "ErrSpDropFailed"},
+		{1307, "ErrSpStoreFailed"},
+		{1308, "ErrSpLilabelMismatch"},
+		{1309, "ErrSpLabelRedefine"},
+		{1310, "ErrSpLabelMismatch"},
+		{1311, "ErrSpUninitVar"},
+		{1312, "ErrSpBadselect"},
+		{1313, "ErrSpBadreturn"},
+		{1314, "ErrSpBadstatement"},
+		{1315, "ErrUpdateLogDeprecatedIgnored"},
+		{1316, "ErrUpdateLogDeprecatedTranslated"},
+		{1317, "ErrQueryInterrupted"},
+		{1318, "ErrSpWrongNoOfArgs"},
+		{1319, "ErrSpCondMismatch"},
+		{1320, "ErrSpNoreturn"},
+		{1321, "ErrSpNoreturnend"},
+		{1322, "ErrSpBadCursorQuery"},
+		{1323, "ErrSpBadCursorSelect"},
+		{1324, "ErrSpCursorMismatch"},
+		{1325, "ErrSpCursorAlreadyOpen"},
+		{1326, "ErrSpCursorNotOpen"},
+		{1327, "ErrSpUndeclaredVar"},
+		{1328, "ErrSpWrongNoOfFetchArgs"},
+		{1329, "ErrSpFetchNoData"},
+		{1330, "ErrSpDupParam"},
+		{1331, "ErrSpDupVar"},
+		{1332, "ErrSpDupCond"},
+		{1333, "ErrSpDupCurs"},
+		{1334, "ErrSpCantAlter"},
+		{1335, "ErrSpSubselectNyi"},
+		{1336, "ErrStmtNotAllowedInSfOrTrg"},
+		{1337, "ErrSpVarcondAfterCurshndlr"},
+		{1338, "ErrSpCursorAfterHandler"},
+		{1339, "ErrSpCaseNotFound"},
+		{1340, "ErrFparserTooBigFile"},
+		{1341, "ErrFparserBadHeader"},
+		{1342, "ErrFparserEOFInComment"},
+		{1343, "ErrFparserErrorInParameter"},
+		{1344, "ErrFparserEOFInUnknownParameter"},
+		{1345, "ErrViewNoExplain"},
+		{1346, "ErrFrmUnknownType"},
+		{1347, "ErrWrongObject"},
+		{1348, "ErrNonupdateableColumn"},
+		{1349, "ErrViewSelectDerived"},
+		{1350, "ErrViewSelectClause"},
+		{1351, "ErrViewSelectVariable"},
+		{1352, "ErrViewSelectTmptable"},
+		{1353, "ErrViewWrongList"},
+		{1354, "ErrWarnViewMerge"},
+		{1355, "ErrWarnViewWithoutKey"},
+		{1356, "ErrViewInvalid"},
+		{1357, "ErrSpNoDropSp"},
+		{1358, "ErrSpGotoInHndlr"},
+		{1359, "ErrTrgAlreadyExists"},
+		{1360, "ErrTrgDoesNotExist"},
+		{1361, "ErrTrgOnViewOrTempTable"},
+		{1362, "ErrTrgCantChangeRow"},
+		{1363, "ErrTrgNoSuchRowInTrg"},
+		{1364, "ErrNoDefaultForField"},
+		{1365, "ErrDivisionByZero"},
+		{1366, "ErrTruncatedWrongValueForField"},
+		{1367, "ErrIllegalValueForType"},
+		{1368, "ErrViewNonupdCheck"},
+		{1369, "ErrViewCheckFailed"},
+		{1370, "ErrProcaccessDenied"},
+		{1371, "ErrRelayLogFail"},
+		{1372, "ErrPasswdLength"},
+		{1373, "ErrUnknownTargetBinlog"},
+		{1374, "ErrIoErrLogIndexRead"},
+		{1375, "ErrBinlogPurgeProhibited"},
+		{1376, "ErrFseekFail"},
+		{1377, "ErrBinlogPurgeFatalErr"},
+		{1378, "ErrLogInUse"},
+		{1379, "ErrLogPurgeUnknownErr"},
+		{1380, "ErrRelayLogInit"},
+		{1381, "ErrNoBinaryLogging"},
+		{1382, "ErrReservedSyntax"},
+		{1383, "ErrWsasFailed"},
+		{1384, "ErrDiffGroupsProc"},
+		{1385, "ErrNoGroupForProc"},
+		{1386, "ErrOrderWithProc"},
+		{1387, "ErrLoggingProhibitChangingOf"},
+		{1388, "ErrNoFileMapping"},
+		{1389, "ErrWrongMagic"},
+		{1390, "ErrPsManyParam"},
+		{1391, "ErrKeyPart0"},
+		{1392, "ErrViewChecksum"},
+		{1393, "ErrViewMultiupdate"},
+		{1394, "ErrViewNoInsertFieldList"},
+		{1395, "ErrViewDeleteMergeView"},
+		{1396, "ErrCannotUser"},
+		{1397, "ErrXaerNota"},
+		{1398, "ErrXaerInval"},
+		{1399, "ErrXaerRmfail"},
+		{1400, "ErrXaerOutside"},
+		{1401, "ErrXaerRmerr"},
+		{1402, "ErrXaRbrollback"},
+		{1403, "ErrNonexistingProcGrant"},
+		{1404, "ErrProcAutoGrantFail"},
+		{1405, "ErrProcAutoRevokeFail"},
+		{1406, "ErrDataTooLong"},
+		{1407, "ErrSpBadSQLstate"},
+		{1408, "ErrStartup"},
+		{1409, "ErrLoadFromFixedSizeRowsToVar"},
+		{1410, "ErrCantCreateUserWithGrant"},
+		{1411, "ErrWrongValueForType"},
+		{1412, "ErrTableDefChanged"},
+		{1413, "ErrSpDupHandler"},
+		{1414, "ErrSpNotVarArg"},
+		{1415, "ErrSpNoRetset"},
+		{1416, "ErrCantCreateGeometryObject"},
+		{1417, "ErrFailedRoutineBreakBinlog"},
+		{1418, "ErrBinlogUnsafeRoutine"},
+		{1419, "ErrBinlogCreateRoutineNeedSuper"},
+		{1420, "ErrExecStmtWithOpenCursor"},
+		{1421, "ErrStmtHasNoOpenCursor"},
+		{1422, "ErrCommitNotAllowedInSfOrTrg"},
+		{1423, "ErrNoDefaultForViewField"},
+		{1424, "ErrSpNoRecursion"},
+		{1425, "ErrTooBigScale"},
+		{1426, "ErrTooBigPrecision"},
+		{1427, "ErrMBiggerThanD"},
+		{1428, "ErrWrongLockOfSystemTable"},
+		{1429, "ErrConnectToForeignDataSource"},
+		{1430, "ErrQueryOnForeignDataSource"},
+		{1431, "ErrForeignDataSourceDoesntExist"},
+		{1432, "ErrForeignDataStringInvalidCantCreate"},
+		{1433, "ErrForeignDataStringInvalid"},
+		{1434, "ErrCantCreateFederatedTable"},
+		{1435, "ErrTrgInWrongSchema"},
+		{1436, "ErrStackOverrunNeedMore"},
+		{1437, "ErrTooLongBody"},
+		{1438, "ErrWarnCantDropDefaultKeycache"},
+		{1439, "ErrTooBigDisplaywidth"},
+		{1440, "ErrXaerDupid"},
+		{1441, "ErrDatetimeFunctionOverflow"},
+		{1442, "ErrCantUpdateUsedTableInSfOrTrg"},
+		{1443, "ErrViewPreventUpdate"},
+		{1444, "ErrPsNoRecursion"},
+		{1445, "ErrSpCantSetAutocommit"},
+		{1446, "ErrMalformedDefiner"},
+		{1447, "ErrViewFrmNoUser"},
+		{1448, "ErrViewOtherUser"},
+		{1449, "ErrNoSuchUser"},
+		{1450, "ErrForbidSchemaChange"},
+		{1451, "ErrRowIsReferenced2"},
+		{1452, "ErrNoReferencedRow2"},
+		{1453, "ErrSpBadVarShadow"},
+		{1454, "ErrTrgNoDefiner"},
+		{1455, "ErrOldFileFormat"},
+		{1456, "ErrSpRecursionLimit"},
+		{1457, "ErrSpProcTableCorrupt"},
+		{1458, "ErrSpWrongName"},
+		{1459, "ErrTableNeedsUpgrade"},
+		{1460, "ErrSpNoAggregate"},
+		{1461, "ErrMaxPreparedStmtCountReached"},
+		{1462, "ErrViewRecursive"},
+		{1463, "ErrNonGroupingFieldUsed"},
+		{1464, "ErrTableCantHandleSpkeys"},
+		{1465, "ErrNoTriggersOnSystemSchema"},
+		{1466, "ErrRemovedSpaces"},
+		{1467, "ErrAutoincReadFailed"},
+		{1468, "ErrUsername"},
+		{1469, "ErrHostname"},
+		{1470, "ErrWrongStringLength"},
+		{1471, "ErrNonInsertableTable"},
+		{1472, "ErrAdminWrongMrgTable"},
+		{1473, "ErrTooHighLevelOfNestingForSelect"},
+		{1474, "ErrNameBecomesEmpty"},
+		{1475, "ErrAmbiguousFieldTerm"},
+		{1476, "ErrForeignServerExists"},
+		{1477, "ErrForeignServerDoesntExist"},
+		{1478, "ErrIllegalHaCreateOption"},
+		{1479, "ErrPartitionRequiresValues"},
+		{1480, "ErrPartitionWrongValues"},
+		{1481, "ErrPartitionMaxvalue"},
+		{1482, "ErrPartitionSubpartition"},
+		{1483, "ErrPartitionSubpartMix"},
+		{1484, "ErrPartitionWrongNoPart"},
+		{1485, "ErrPartitionWrongNoSubpart"},
+		{1486, "ErrWrongExprInPartitionFunc"},
+		{1487, "ErrNoConstExprInRangeOrList"},
+		{1488, "ErrFieldNotFoundPart"},
+		{1489, "ErrListOfFieldsOnlyInHash"},
+		{1490, "ErrInconsistentPartitionInfo"},
+		{1491, "ErrPartitionFuncNotAllowed"},
+		{1492, "ErrPartitionsMustBeDefined"},
+		{1493, "ErrRangeNotIncreasing"},
+		{1494, "ErrInconsistentTypeOfFunctions"},
+		{1495, "ErrMultipleDefConstInListPart"},
+		{1496, "ErrPartitionEntry"},
+		{1497, "ErrMixHandler"},
+		{1498, "ErrPartitionNotDefined"},
+		{1499, "ErrTooManyPartitions"},
+		{1500, "ErrSubpartition"},
+		{1501, "ErrCantCreateHandlerFile"},
+		{1502, "ErrBlobFieldInPartFunc"},
+		{1503, "ErrUniqueKeyNeedAllFieldsInPf"},
+		{1504, "ErrNoParts"},
+		{1505, "ErrPartitionMgmtOnNonpartitioned"},
+		{1506, "ErrForeignKeyOnPartitioned"},
+		{1507, "ErrDropPartitionNonExistent"},
+		{1508, "ErrDropLastPartition"},
+		{1509, "ErrCoalesceOnlyOnHashPartition"},
+		{1510, "ErrReorgHashOnlyOnSameNo"},
+		{1511, "ErrReorgNoParam"},
+		{1512, "ErrOnlyOnRangeListPartition"},
+		{1513, "ErrAddPartitionSubpart"},
+		{1514, "ErrAddPartitionNoNewPartition"},
+		{1515, "ErrCoalescePartitionNoPartition"},
+		{1516, "ErrReorgPartitionNotExist"},
+		{1517, "ErrSameNamePartition"},
+		{1518, "ErrNoBinlog"},
+		{1519, "ErrConsecutiveReorgPartitions"},
+		{1520, "ErrReorgOutsideRange"},
+		{1521, "ErrPartitionFunctionFailure"},
+		{1522, "ErrPartState"},
+		{1523, "ErrLimitedPartRange"},
+		{1524, "ErrPluginIsNotLoaded"},
+		{1525, "ErrWrongValue"},
+		{1526, "ErrNoPartitionForGivenValue"},
+		{1527, "ErrFilegroupOptionOnlyOnce"},
+		{1528, "ErrCreateFilegroupFailed"},
+		{1529, "ErrDropFilegroupFailed"},
+		{1530, "ErrTablespaceAutoExtend"},
+		{1531, "ErrWrongSizeNumber"},
+		{1532, "ErrSizeOverflow"},
+		{1533, "ErrAlterFilegroupFailed"},
+		{1534, "ErrBinlogRowLoggingFailed"},
+		{1537, "ErrEventAlreadyExists"},
+		{1538, "ErrEventStoreFailed"},
+		{1539, "ErrEventDoesNotExist"},
+		{1540, "ErrEventCantAlter"},
+		{1541, "ErrEventDropFailed"},
+		{1542, "ErrEventIntervalNotPositiveOrTooBig"},
+		{1543, "ErrEventEndsBeforeStarts"},
+		{1544, "ErrEventExecTimeInThePast"},
+		{1545, "ErrEventOpenTableFailed"},
+		{1546, "ErrEventNeitherMExprNorMAt"},
+		{1547, "ErrObsoleteColCountDoesntMatchCorrupted"},
+		{1548, "ErrObsoleteCannotLoadFromTable"},
+		{1549, "ErrEventCannotDelete"},
+		{1550, "ErrEventCompile"},
+		{1551, "ErrEventSameName"},
+		{1552, "ErrEventDataTooLong"},
+		{1553, "ErrDropIndexNeededInForeignKey"},
+		{1554, "ErrWarnDeprecatedSyntaxWithVer"},
+		{1555, "ErrCantWriteLockLogTable"},
+		{1556, "ErrCantLockLogTable"},
+		{1557, "ErrForeignDuplicateKeyOldUnused"},
+		{1558, "ErrColCountDoesntMatchPleaseUpdate"},
+		{1559, "ErrTempTablePreventsSwitchOutOfRbr"},
+		{1560, "ErrStoredFunctionPreventsSwitchBinlogFormat"},
+		{1561, "ErrNdbCantSwitchBinlogFormat"},
+		{1562, "ErrPartitionNoTemporary"},
+		{1563, "ErrPartitionConstDomain"},
+		{1564, "ErrPartitionFunctionIsNotAllowed"},
+		{1565, "ErrDdlLog"},
+		{1566, "ErrNullInValuesLessThan"},
+		{1567, "ErrWrongPartitionName"},
+		{1568, "ErrCantChangeTxCharacteristics"},
+		{1569, "ErrDupEntryAutoincrementCase"},
+		{1570, "ErrEventModifyQueue"},
+		{1571, "ErrEventSetVar"},
+		{1572, "ErrPartitionMerge"},
+		{1573, "ErrCantActivateLog"},
+		{1574, "ErrRbrNotAvailable"},
+		{1575, "ErrBase64Decode"},
+		{1576, "ErrEventRecursionForbidden"},
+		{1577, "ErrEventsDB"},
+		{1578, "ErrOnlyIntegersAllowed"},
+		{1579, "ErrUnsuportedLogEngine"},
+		{1580, "ErrBadLogStatement"},
+		{1581, "ErrCantRenameLogTable"},
+		{1582, "ErrWrongParamcountToNativeFct"},
+		{1583, "ErrWrongParametersToNativeFct"},
+		{1584, "ErrWrongParametersToStoredFct"},
+		{1585, "ErrNativeFctNameCollision"},
+		{1586, "ErrDupEntryWithKeyName"},
+		{1587, "ErrBinlogPurgeEmFile"},
+		{1588, "ErrEventCannotCreateInThePast"},
+		{1589, "ErrEventCannotAlterInThePast"},
+		{1591, "ErrNoPartitionForGivenValueSilent"},
+		{1592, "ErrBinlogUnsafeStatement"},
+		{1598, "ErrBinlogLoggingImpossible"},
+		{1599, "ErrViewNoCreationCtx"},
+		{1600, "ErrViewInvalidCreationCtx"},
+		{1601, "ErrSrInvalidCreationCtx"},
+		{1602, "ErrTrgCorruptedFile"},
+		{1603, "ErrTrgNoCreationCtx"},
+		{1604, "ErrTrgInvalidCreationCtx"},
+		{1605, "ErrEventInvalidCreationCtx"},
+		{1606, "ErrTrgCantOpenTable"},
+		{1607, "ErrCantCreateSroutine"},
+		{1609, "ErrNoFormatDescriptionEventBeforeBinlogStatement"},
+		{1611, "ErrLoadDataInvalidColumn"},
+		{1612, "ErrLogPurgeNoFile"},
+		{1613, "ErrXaRbtimeout"},
+		{1614, "ErrXaRbdeadlock"},
+		{1615, "ErrNeedReprepare"},
+		{1616, "ErrDelayedNotSupported"},
+		{1621, "ErrVariableIsReadonly"},
+		{1622, "ErrWarnEngineTransactionRollback"},
+		{1625, "ErrNdbReplicationSchema"},
+		{1626, "ErrConflictFnParse"},
+		{1627, "ErrExceptionsWrite"},
+		{1628, "ErrTooLongTableComment"},
+		{1629, "ErrTooLongFieldComment"},
+		{1630, "ErrFuncInexistentNameCollision"},
+		{1631, "ErrDatabaseName"},
+		{1632, "ErrTableName"},
+		{1633, "ErrPartitionName"},
+		{1634, "ErrSubpartitionName"},
+		{1635, "ErrTemporaryName"},
+		{1636, "ErrRenamedName"},
+		{1637, "ErrTooManyConcurrentTrxs"},
+		{1639, "ErrDebugSyncTimeout"},
+		{1640, "ErrDebugSyncHitLimit"},
+		{1641, "ErrDupSignalSet"},
+		{1642, "ErrSignalWarn"},
+		{1643, "ErrSignalNotFound"},
+		{1644, "ErrSignalException"},
+		{1645, "ErrResignalWithoutActiveHandler"},
+		{1646, "ErrSignalBadConditionType"},
+		{1648, "ErrCondItemTooLong"},
+		{1649, "ErrUnknownLocale"},
+		{1651, "ErrQueryCacheDisabled"},
+		{1652, "ErrSameNamePartitionField"},
+		{1653, "ErrPartitionColumnList"},
+		{1654, "ErrWrongTypeColumnValue"},
+		{1655, "ErrTooManyPartitionFuncFields"},
+		{1656, "ErrMaxvalueInValuesIn"},
+		{1657, "ErrTooManyValues"},
+		{1658, "ErrRowSinglePartitionField"},
+		{1659, "ErrFieldTypeNotAllowedAsPartitionField"},
+		{1660, "ErrPartitionFieldsTooLong"},
+		{1661, "ErrBinlogRowEngineAndStmtEngine"},
+		{1662, "ErrBinlogRowModeAndStmtEngine"},
+		{1663, "ErrBinlogUnsafeAndStmtEngine"},
+		{1664, "ErrBinlogRowInjectionAndStmtEngine"},
+		{1665, "ErrBinlogStmtModeAndRowEngine"},
+		{1666, "ErrBinlogRowInjectionAndStmtMode"},
+		{1667, "ErrBinlogMultipleEnginesAndSelfLoggingEngine"},
+		{1668, "ErrBinlogUnsafeLimit"},
+		{1669, "ErrBinlogUnsafeInsertDelayed"},
+		{1671, "ErrBinlogUnsafeAutoincColumns"},
+		{1674, "ErrBinlogUnsafeSystemFunction"},
+		{1675, "ErrBinlogUnsafeNontransAfterTrans"},
+		{1676, "ErrMessageAndStatement"},
+		{1679, "ErrInsideTransactionPreventsSwitchBinlogFormat"},
+		{1680, "ErrPathLength"},
+		{1681, "ErrWarnDeprecatedSyntaxNoReplacement"},
+		{1682, "ErrWrongNativeTableStructure"},
+		{1683, "ErrWrongPerfSchemaUsage"},
+		{1684, "ErrWarnISSkippedTable"},
+		{1685, "ErrInsideTransactionPreventsSwitchBinlogDirect"},
+		{1686, "ErrStoredFunctionPreventsSwitchBinlogDirect"},
+		{1687, "ErrSpatialMustHaveGeomCol"},
+		{1688, "ErrTooLongIndexComment"},
+		{1689, "ErrLockAborted"},
+		{1690, "ErrDataOutOfRange"},
+		{1691, "ErrWrongSpvarTypeInLimit"},
+		{1692, "ErrBinlogUnsafeMultipleEnginesAndSelfLoggingEngine"},
+		{1693, "ErrBinlogUnsafeMixedStatement"},
+		{1694, "ErrInsideTransactionPreventsSwitchSQLLogBin"},
+		{1695, "ErrStoredFunctionPreventsSwitchSQLLogBin"},
+		{1696, "ErrFailedReadFromParFile"},
+		{1697, "ErrValuesIsNotIntType"},
+		{1698, "ErrAccessDeniedNoPassword"},
+		{1699, "ErrSetPasswordAuthPlugin"},
+		{1700, "ErrGrantPluginUserExists"},
+		{1701, "ErrTruncateIllegalForeignKey"},
+		{1702, "ErrPluginIsPermanent"},
+		{1705, "ErrStmtCacheFull"},
+		{1706, "ErrMultiUpdateKeyConflict"},
+		{1707, "ErrTableNeedsRebuild"},
+		{1709, "ErrIndexColumnTooLong"},
+		{1710, "ErrErrorInTriggerBody"},
+		{1711, "ErrErrorInUnknownTriggerBody"},
+		{1712, "ErrIndexCorrupt"},
+		{1713, "ErrUndoRecordTooBig"},
+		{1720, "ErrPluginNoUninstall"},
+		{1721, "ErrPluginNoInstall"},
+		{1724, "ErrBinlogUnsafeInsertTwoKeys"},
+		{1725, "ErrTableInFkCheck"},
+		{1726, "ErrUnsupportedEngine"},
+		{1727, "ErrBinlogUnsafeAutoincNotFirst"},
+		{1728, "ErrCannotLoadFromTableV2"},
+		{1730, "ErrOnlyFdAndRbrEventsAllowedInBinlogStatement"},
+		{1731, "ErrPartitionExchangeDifferentOption"},
+		{1732, "ErrPartitionExchangePartTable"},
+		{1733, "ErrPartitionExchangeTempTable"},
+		{1734, "ErrPartitionInsteadOfSubpartition"},
+		{1735, "ErrUnknownPartition"},
+		{1736, "ErrTablesDifferentMetadata"},
+		{1737, "ErrRowDoesNotMatchPartition"},
+		{1738, "ErrBinlogCacheSizeGreaterThanMax"},
+		{1739, "ErrWarnIndexNotApplicable"},
+		{1740, "ErrPartitionExchangeForeignKey"},
+		{1741, "ErrNoSuchKeyValue"},
+		{1742, "ErrRplInfoDataTooLong"},
+		{1743, "ErrNetworkReadEventChecksumFailure"},
+		{1744, "ErrBinlogReadEventChecksumFailure"},
+		{1745, "ErrBinlogStmtCacheSizeGreaterThanMax"},
+		{1746, "ErrCantUpdateTableInCreateTableSelect"},
+		{1747, "ErrPartitionClauseOnNonpartitioned"},
+		{1748, "ErrRowDoesNotMatchGivenPartitionSet"},
+		{1749, "ErrNoSuchPartitionunused"},
+		{1750, "ErrChangeRplInfoRepositoryFailure"},
+		{1751, "ErrWarningNotCompleteRollbackWithCreatedTempTable"},
+		{1752, "ErrWarningNotCompleteRollbackWithDroppedTempTable"},
+		{1754, "ErrMtsUpdatedDBsGreaterMax"},
+		{1755, "ErrMtsCantParallel"},
+		{1756, "ErrMtsInconsistentData"},
+		{1757, "ErrFulltextNotSupportedWithPartitioning"},
+		{1758, "ErrDaInvalidConditionNumber"},
+		{1759, "ErrInsecurePlainText"},
+		{1761, "ErrForeignDuplicateKeyWithChildInfo"},
+		{1762, "ErrForeignDuplicateKeyWithoutChildInfo"},
+		{1764, "ErrTableHasNoFt"},
+		{1765, "ErrVariableNotSettableInSfOrTrigger"},
+		{1766, "ErrVariableNotSettableInTransaction"},
+		{1767, "ErrGtidNextIsNotInGtidNextList"},
+		{1768, "ErrCantChangeGtidNextInTransactionWhenGtidNextListIsNull"},
+		{1769, "ErrSetStatementCannotInvokeFunction"},
+		{1770, "ErrGtidNextCantBeAutomaticIfGtidNextListIsNonNull"},
+		{1771, "ErrSkippingLoggedTransaction"},
+		{1772, "ErrMalformedGtidSetSpecification"},
+		{1773, "ErrMalformedGtidSetEncoding"},
+		{1774, "ErrMalformedGtidSpecification"},
+		{1775, "ErrGnoExhausted"},
+		{1778, "ErrCantDoImplicitCommitInTrxWhenGtidNextIsSet"},
+		{1779, "ErrGtidMode2Or3RequiresEnforceGtidConsistencyOn"},
+		{1781, "ErrCantSetGtidNextToGtidWhenGtidModeIsOff"},
+		{1782, "ErrCantSetGtidNextToAnonymousWhenGtidModeIsOn"},
+		{1783, "ErrCantSetGtidNextListToNonNullWhenGtidModeIsOff"},
+		{1784, "ErrFoundGtidEventWhenGtidModeIsOff"},
+		{1785, "ErrGtidUnsafeNonTransactionalTable"},
+		{1786, "ErrGtidUnsafeCreateSelect"},
+		{1787, "ErrGtidUnsafeCreateDropTemporaryTableInTransaction"},
+		{1788, "ErrGtidModeCanOnlyChangeOneStepAtATime"},
+		{1790, "ErrCantSetGtidNextWhenOwningGtid"},
+		{1791, "ErrUnknownExplainFormat"},
+		{1792, "ErrCantExecuteInReadOnlyTransaction"},
+		{1793, "ErrTooLongTablePartitionComment"},
+		{1795, "ErrInnodbFtLimit"},
+		{1796, "ErrInnodbNoFtTempTable"},
+		{1797, "ErrInnodbFtWrongDocidColumn"},
+		{1798, "ErrInnodbFtWrongDocidIndex"},
+		{1799, "ErrInnodbOnlineLogTooBig"},
+		{1800, "ErrUnknownAlterAlgorithm"},
+		{1801, "ErrUnknownAlterLock"},
+		{1804, "ErrMtsResetWorkers"},
+		{1805, "ErrColCountDoesntMatchCorruptedV2"},
+		{1807, "ErrDiscardFkChecksRunning"},
+		{1808, "ErrTableSchemaMismatch"},
+		{1809, "ErrTableInSystemTablespace"},
+		{1810, "ErrIoRead"},
+		{1811, "ErrIoWrite"},
+		{1812, "ErrTablespaceMissing"},
+		{1813, "ErrTablespaceExists"},
+		{1814, "ErrTablespaceDiscarded"},
+		{1815, "ErrInternal"},
+		{1816, "ErrInnodbImport"},
+		{1817, "ErrInnodbIndexCorrupt"},
+		{1818, "ErrInvalidYearColumnLength"},
+		{1819, "ErrNotValidPassword"},
+		{1820, "ErrMustChangePassword"},
+		{1821, "ErrFkNoIndexChild"},
+		{1822, "ErrForeignKeyNoIndexInParent"},
+		{1823, "ErrFkFailAddSystem"},
+		{1824, "ErrForeignKeyCannotOpenParent"},
+		{1825, "ErrFkIncorrectOption"},
+		{1826, "ErrFkDupName"},
+		{1827, "ErrPasswordFormat"},
+		{1828, "ErrFkColumnCannotDrop"},
+		{1829, "ErrFkColumnCannotDropChild"},
+		{1830, "ErrForeignKeyColumnNotNull"},
+		{1831, "ErrDupIndex"},
+		{1832, "ErrForeignKeyColumnCannotChange"},
+		{1833, "ErrForeignKeyColumnCannotChangeChild"},
+		{1834, "ErrFkCannotDeleteParent"},
+		{1835, "ErrMalformedPacket"},
+		{1836, "ErrReadOnlyMode"},
+		{1838, "ErrVariableNotSettableInSp"},
+		{1839, "ErrCantSetGtidPurgedWhenGtidModeIsOff"},
+		{1840, "ErrCantSetGtidPurgedWhenGtidExecutedIsNotEmpty"},
+		{1841, "ErrCantSetGtidPurgedWhenOwnedGtidsIsNotEmpty"},
+		{1842, "ErrGtidPurgedWasChanged"},
+		{1843, "ErrGtidExecutedWasChanged"},
+		{1844, "ErrBinlogStmtModeAndNoReplTables"},
+		{1845, "ErrAlterOperationNotSupported"},
+		{1846, "ErrAlterOperationNotSupportedReason"},
+		{1847, "ErrAlterOperationNotSupportedReasonCopy"},
+		{1848, "ErrAlterOperationNotSupportedReasonPartition"},
+		{1849, "ErrAlterOperationNotSupportedReasonFkRename"},
+		{1850, "ErrAlterOperationNotSupportedReasonColumnType"},
+		{1851, "ErrAlterOperationNotSupportedReasonFkCheck"},
+		{1852, "ErrAlterOperationNotSupportedReasonIgnore"},
+		{1853, "ErrAlterOperationNotSupportedReasonNopk"},
+		{1854, "ErrAlterOperationNotSupportedReasonAutoinc"},
+		{1855, "ErrAlterOperationNotSupportedReasonHiddenFts"},
+		{1856, "ErrAlterOperationNotSupportedReasonChangeFts"},
+		{1857, "ErrAlterOperationNotSupportedReasonFts"},
+		{1859, "ErrDupUnknownInIndex"},
+		{1860, "ErrIdentCausesTooLongPath"},
+		{1861, "ErrAlterOperationNotSupportedReasonNotNull"},
+		{1862, "ErrMustChangePasswordLogin"},
+		{1863, "ErrRowInWrongPartition"},
+		{3008, "ErrForeignKeyCascadeDepthExceeded"},
+		{3013, "ErrInvalidFieldSize"},
+		{3016, "ErrPasswordExpireAnonymousUser"},
+		{3020, "ErrInvalidArgumentForLogarithm"},
+		{3024, "ErrMaxExecTimeExceeded"},
+		{3029, "ErrAggregateOrderNonAggQuery"},
+		{3057, "ErrUserLockWrongName"},
+		{3058, "ErrUserLockDeadlock"},
+		{3064, "ErrIncorrectType"},
+		{3065, "ErrFieldInOrderNotSelect"},
+		{3066, "ErrAggregateInOrderNotSelect"},
+		{3069, "ErrInvalidJSONData"},
+		{3102, "ErrGeneratedColumnFunctionIsNotAllowed"},
+		{3103, "ErrUnsupportedAlterInplaceOnVirtualColumn"},
+		{3104, "ErrWrongFKOptionForGeneratedColumn"},
+		{3105, "ErrBadGeneratedColumn"},
+		{3106, "ErrUnsupportedOnGeneratedColumn"},
+		{3107, "ErrGeneratedColumnNonPrior"},
+		{3108, "ErrDependentByGeneratedColumn"},
+		{3109, "ErrGeneratedColumnRefAutoInc"},
+		{3118, "ErrAccountHasBeenLocked"},
+		{3126, "ErrWarnConflictingHint"},
+		{3128, "ErrUnresolvedHintName"},
+		{3140, "ErrInvalidJSONText"},
+		{3141, "ErrInvalidJSONTextInParam"},
+		{3143, "ErrInvalidJSONPath"},
+		{3144, "ErrInvalidJSONCharset"},
+		{3146, "ErrInvalidTypeForJSON"},
+		{3149, "ErrInvalidJSONPathMultipleSelection"},
+		{3150, "ErrInvalidJSONContainsPathType"},
+		{3152, "ErrJSONUsedAsKey"},
+		{3153, "ErrJSONVacuousPath"},
+		{3154, "ErrJSONBadOneOrAllArg"},
+		{3157, "ErrJSONDocumentTooDeep"},
+		{3158, "ErrJSONDocumentNULLKey"},
+		{3159, "ErrSecureTransportRequired"},
+		{3162, "ErrBadUser"},
+		{3163, "ErrUserAlreadyExists"},
+		{3165, "ErrInvalidJSONPathArrayCell"},
+		{3184, "ErrInvalidEncryptionOption"},
+		{3505, "ErrTooLongValueForType"},
+		{3522, "ErrPKIndexCantBeInvisible"},
+		{3523, "ErrGrantRole"},
+		{3530, "ErrRoleNotGranted"},
+		{3572, "ErrLockAcquireFailAndNoWaitSet"},
+		{3573, "ErrCTERecursiveRequiresUnion"},
+		{3574, "ErrCTERecursiveRequiresNonRecursiveFirst"},
+		{3575, "ErrCTERecursiveForbidsAggregation"},
+		{3576, "ErrCTERecursiveForbiddenJoinOrder"},
+		{3577, "ErrInvalidRequiresSingleReference"},
+		{3579, "ErrWindowNoSuchWindow"},
+		{3580, "ErrWindowCircularityInWindowGraph"},
+		{3581, "ErrWindowNoChildPartitioning"},
+		{3582, "ErrWindowNoInherentFrame"},
+		{3583, "ErrWindowNoRedefineOrderBy"},
+		{3584, "ErrWindowFrameStartIllegal"},
+		{3585, "ErrWindowFrameEndIllegal"},
+		{3586, "ErrWindowFrameIllegal"},
+		{3587, "ErrWindowRangeFrameOrderType"},
+		{3588, "ErrWindowRangeFrameTemporalType"},
+		{3589, "ErrWindowRangeFrameNumericType"},
+		{3590, "ErrWindowRangeBoundNotConstant"},
+		{3591, "ErrWindowDuplicateName"},
+		{3592, "ErrWindowIllegalOrderBy"},
+		{3593, "ErrWindowInvalidWindowFuncUse"},
+		{3594, "ErrWindowInvalidWindowFuncAliasUse"},
+		{3595, "ErrWindowNestedWindowFuncUseInWindowSpec"},
+		{3596, "ErrWindowRowsIntervalUse"},
+		{3597, "ErrWindowNoGroupOrderUnused"},
+		{3598, "ErrWindowExplainJSON"},
+		{3599, "ErrWindowFunctionIgnoresFrame"},
+		{3601, "ErrInvalidNumberOfArgs"},
+		{3602, "ErrFieldInGroupingNotGroupBy"},
+		{3619, "ErrIllegalPrivilegeLevel"},
+		{3636, "ErrCTEMaxRecursionDepth"},
+		{3637, "ErrNotHintUpdatable"},
+		{3638, "ErrExistsInHistoryPassword"},
+		{3721, "ErrInvalidDefaultUTF8MB4Collation"},
+		{3730, "ErrForeignKeyCannotDropParent"},
+		{3733, "ErrForeignKeyCannotUseVirtualColumn"},
+		{3734, "ErrForeignKeyNoColumnInParent"},
+		{3751, "ErrDataTruncatedFunctionalIndex"},
+		{3752, "ErrDataOutOfRangeFunctionalIndex"},
+		{3753, "ErrFunctionalIndexOnJSONOrGeometryFunction"},
+		{3754, "ErrFunctionalIndexRefAutoIncrement"},
+		{3755, "ErrCannotDropColumnFunctionalIndex"},
+		{3756, "ErrFunctionalIndexPrimaryKey"},
+		{3757, "ErrFunctionalIndexOnBlob"},
+		{3758, "ErrFunctionalIndexFunctionIsNotAllowed"},
+		{3759, "ErrFulltextFunctionalIndex"},
+		{3760, "ErrSpatialFunctionalIndex"},
+		{3761, "ErrWrongKeyColumnFunctionalIndex"},
+		{3762, "ErrFunctionalIndexOnField"},
+		{3764, "ErrGeneratedColumnRowValueIsNotAllowed"},
+		{3770, "ErrDefValGeneratedNamedFunctionIsNotAllowed"},
+		{3780, "ErrFKIncompatibleColumns"},
+		{3800, "ErrFunctionalIndexRowValueIsNotAllowed"},
+		{3812, "ErrNonBooleanExprForCheckConstraint"},
+		{3813, "ErrColumnCheckConstraintReferencesOtherColumn"},
+		{3814, "ErrCheckConstraintNamedFunctionIsNotAllowed"},
+		{3815, "ErrCheckConstraintFunctionIsNotAllowed"},
+		{3816, "ErrCheckConstraintVariables"},
+		{3818, "ErrCheckConstraintRefersAutoIncrementColumn"},
+		{3819, "ErrCheckConstraintViolated"},
+		{3820, "ErrTableCheckConstraintReferUnknown"},
+		{3822, "ErrCheckConstraintDupName"},
+		{3823, "ErrCheckConstraintClauseUsingFKReferActionColumn"},
+		{3837, "ErrDependentByFunctionalIndex"},
+		{3853, "ErrInvalidJSONType"},
+		{3854, "ErrCannotConvertString"},
+		{3855, "ErrDependentByPartitionFunctional"},
+		{3903, "ErrInvalidJSONValueForFuncIndex"},
+		{3904, "ErrJSONValueOutOfRangeForFuncIndex"},
+		{3907, "ErrFunctionalIndexDataIsTooLong"},
+		{3909, "ErrFunctionalIndexNotApplicable"},
+		{3929, "ErrDynamicPrivilegeNotRegistered"},
+		{3940, "ErrConstraintNotFound"},
+		{3959, "ErrDependentByCheckConstraint"},
+		{3986, "ErrJSONInBooleanContext"},
+		{3750, "ErrTableWithoutPrimaryKey"},
+		{4030, "ErrOnlyOneDefaultPartionAllowed"},
+		{4113, "ErrWrongPartitionTypeExpectedSystemTime"},
+		{4128, "ErrSystemVersioningWrongPartitions"},
+		{4135, "ErrSequenceRunOut"},
+		{4136, "ErrSequenceInvalidData"},
+		{4137, "ErrSequenceAccessFail"},
+		{4138, "ErrNotSequence"},
+		{4139, "ErrUnknownSequence"},
+		{4140, "ErrWrongInsertIntoSequence"},
+		{4141, "ErrSequenceInvalidTableStructure"},
+		{8001, "ErrMemExceedThreshold"},
+		{8002, "ErrForUpdateCantRetry"},
+		{8003, "ErrAdminCheckTable"},
+		{8004, "ErrTxnTooLarge"},
+		{8005, "ErrWriteConflictInTiDB"},
+		{8006, "ErrOptOnTemporaryTable"},
+		{8007, "ErrDropTableOnTemporaryTable"},
+		{8018, "ErrUnsupportedReloadPlugin"},
+		{8019, "ErrUnsupportedReloadPluginVar"},
+		{8020, "ErrTableLocked"},
+		{8021, "ErrNotExist"},
+		{8022, "ErrTxnRetryable"},
+		{8023, "ErrCannotSetNilValue"},
+		{8024, "ErrInvalidTxn"},
+		{8025, "ErrEntryTooLarge"},
+		{8026, "ErrNotImplemented"},
+		{8027, "ErrInfoSchemaExpired"},
+		{8028, "ErrInfoSchemaChanged"},
+		{8029, "ErrBadNumber"},
+		{8030, "ErrCastAsSignedOverflow"},
+		{8031, "ErrCastNegIntAsUnsigned"},
+		{8032, "ErrInvalidYearFormat"},
+		{8033, "ErrInvalidYear"},
+		{8034, "ErrIncorrectDatetimeValue"},
+		{8036, "ErrInvalidTimeFormat"},
+		{8037, "ErrInvalidWeekModeFormat"},
+		{8038, "ErrFieldGetDefaultFailed"},
+		{8039, "ErrIndexOutBound"},
+		{8040, "ErrUnsupportedOp"},
+		{8041, "ErrRowNotFound"},
+		{8042, "ErrTableStateCantNone"},
+		{8043, "ErrColumnStateNonPublic"},
+		{8044, "ErrIndexStateCantNone"},
+		{8045, "ErrInvalidRecordKey"},
+		{8046, "ErrColumnStateCantNone"},
+		{8047, "ErrUnsupportedValueForVar"},
+		{8048, "ErrUnsupportedIsolationLevel"},
+		{8049, "ErrLoadPrivilege"},
+		{8050, "ErrInvalidPrivilegeType"},
+		{8051, "ErrUnknownFieldType"},
+		{8052, "ErrInvalidSequence"},
+		{8053, "ErrCantGetValidID"},
+		{8054, "ErrCantSetToNull"},
+		{8055, "ErrSnapshotTooOld"},
+		{8056, "ErrInvalidTableID"},
+		{8057, "ErrInvalidType"},
+		{8058, "ErrUnknownAllocatorType"},
+		{8059, "ErrAutoRandReadFailed"},
+		{8060, "ErrInvalidIncrementAndOffset"},
+		{8061, "ErrWarnOptimizerHintUnsupportedHint"},
+		{8062, "ErrWarnOptimizerHintInvalidToken"},
+		{8063, "ErrWarnMemoryQuotaOverflow"},
+		{8064, "ErrWarnOptimizerHintParseError"},
+		{8065, "ErrWarnOptimizerHintInvalidInteger"},
+		{8066, "ErrWarnOptimizerHintWrongPos"},
+		{8067, "ErrUnsupportedSecondArgumentType"},
+		{8068, "ErrColumnNotMatched"},
+		{8101, "ErrInvalidPluginID"},
+		{8102, "ErrInvalidPluginManifest"},
+		{8103, "ErrInvalidPluginName"},
+		{8104, "ErrInvalidPluginVersion"},
+		{8105, "ErrDuplicatePlugin"},
+		{8106, "ErrInvalidPluginSysVarName"},
+		{8107, "ErrRequireVersionCheckFail"},
+		{8108, "ErrUnsupportedType"},
+		{8109, "ErrAnalyzeMissIndex"},
+		{8110, "ErrCartesianProductUnsupported"},
+		{8111, "ErrPreparedStmtNotFound"},
+		{8112, "ErrWrongParamCount"},
+		{8113, "ErrSchemaChanged"},
+		{8114, "ErrUnknownPlan"},
+		{8115, "ErrPrepareMulti"},
+		{8116, "ErrPrepareDDL"},
+		{8117, "ErrResultIsEmpty"},
+		{8118, "ErrBuildExecutor"},
+		{8119, "ErrBatchInsertFail"},
+		{8120, "ErrGetStartTS"},
+		{8121, "ErrPrivilegeCheckFail"},
+		{8122, "ErrInvalidWildCard"},
+		{8123, "ErrMixOfGroupFuncAndFieldsIncompatible"},
+		{8124, "ErrBRIEBackupFailed"},
+		{8125, "ErrBRIERestoreFailed"},
+		{8126, "ErrBRIEImportFailed"},
+		{8127, "ErrBRIEExportFailed"},
+		{8128, "ErrInvalidTableSample"},
+		{8129, "ErrJSONObjectKeyTooLong"},
+		{8130, "ErrMultiStatementDisabled"},
+		{8131, "ErrPartitionStatsMissing"},
+		{8132, "ErrNotSupportedWithSem"},
+		{8133, "ErrDataInconsistentMismatchCount"},
+		{8134, "ErrDataInconsistentMismatchIndex"},
+		{8135, "ErrAsOf"},
+		{8136, "ErrVariableNoLongerSupported"},
+		{8137, "ErrAnalyzeMissColumn"},
+		{8138, "ErrInconsistentRowValue"},
+		{8139, "ErrInconsistentHandle"},
+		{8140, "ErrInconsistentIndexedValue"},
+		{8141, "ErrAssertionFailed"},
+		{8142, "ErrInstanceScope"},
+		{8143, "ErrNonTransactionalJobFailure"},
+		{8144, "ErrSettingNoopVariable"},
+		{8145, "ErrGettingNoopVariable"},
+		{8146, "ErrCannotMigrateSession"},
+		{8147, "ErrLazyUniquenessCheckFailure"},
+		{8148, "ErrUnsupportedColumnInTTLConfig"},
+		{8149, "ErrTTLColumnCannotDrop"},
+		{8150, "ErrSetTTLOptionForNonTTLTable"},
+		{8151, "ErrTempTableNotAllowedWithTTL"},
+		{8152, "ErrUnsupportedTTLReferencedByFK"},
+		{8153, "ErrUnsupportedPrimaryKeyTypeWithTTL"},
+		{8154, "ErrLoadDataFromServerDisk"},
+		{8155, "ErrLoadParquetFromLocal"},
+		{8156, "ErrLoadDataEmptyPath"},
+		{8157, "ErrLoadDataUnsupportedFormat"},
+		{8158, "ErrLoadDataInvalidURI"},
+		{8159, "ErrLoadDataCantAccess"},
+		{8160, "ErrLoadDataCantRead"},
+		{8162, "ErrLoadDataWrongFormatConfig"},
+		{8163, "ErrUnknownOption"},
+		{8164, "ErrInvalidOptionVal"},
+		{8165, "ErrDuplicateOption"},
+		{8166, "ErrLoadDataUnsupportedOption"},
+		{8170, "ErrLoadDataJobNotFound"},
+		{8171, "ErrLoadDataInvalidOperation"},
+		{8172, "ErrLoadDataLocalUnsupportedOption"},
+		{8173, "ErrLoadDataPreCheckFailed"},
+		{8174, "ErrBRJobNotFound"},
+		{8175, "ErrMemoryExceedForQuery"},
+		{8176, "ErrMemoryExceedForInstance"},
+		{8200, "ErrUnsupportedDDLOperation"},
+		{8201, "ErrNotOwner"},
+		{8202, "ErrCantDecodeRecord"},
+		{8203, "ErrInvalidDDLWorker"},
+		{8204, "ErrInvalidDDLJob"},
+		{8205, "ErrInvalidDDLJobFlag"},
+		{8206, "ErrWaitReorgTimeout"},
+		{8207, "ErrInvalidStoreVersion"},
+		{8208, "ErrUnknownTypeLength"},
+		{8209, "ErrUnknownFractionLength"},
+		{8210, "ErrInvalidDDLState"},
+		{8211, "ErrReorgPanic"},
+		{8212, "ErrInvalidSplitRegionRanges"},
+		{8213, "ErrInvalidDDLJobVersion"},
+		{8214, "ErrCancelledDDLJob"},
+		{8215, "ErrRepairTable"},
+		{8216, "ErrInvalidAutoRandom"},
+		{8217, "ErrInvalidHashKeyFlag"},
+		{8218, "ErrInvalidListIndex"},
+		{8219, "ErrInvalidListMetaData"},
+		{8220, "ErrWriteOnSnapshot"},
+		{8221, "ErrInvalidKey"},
+		{8222, "ErrInvalidIndexKey"},
+		{8223, "ErrDataInconsistent"},
+		{8224, "ErrDDLJobNotFound"},
+		{8225, "ErrCancelFinishedDDLJob"},
+		{8226, "ErrCannotCancelDDLJob"},
+		{8227, "ErrSequenceUnsupportedTableOption"},
+		{8228, "ErrColumnTypeUnsupportedNextValue"},
+		{8229, "ErrLockExpire"},
+		{8230, "ErrAddColumnWithSequenceAsDefault"},
+		{8231, "ErrUnsupportedConstraintCheck"},
+		{8232, "ErrTableOptionUnionUnsupported"},
+		{8233, "ErrTableOptionInsertMethodUnsupported"},
+		{8235, "ErrDDLReorgElementNotExist"},
+		{8236, "ErrPlacementPolicyCheck"},
+		{8237, "ErrInvalidAttributesSpec"},
+		{8238, "ErrPlacementPolicyExists"},
+		{8239, "ErrPlacementPolicyNotExists"},
+		{8240, "ErrPlacementPolicyWithDirectOption"},
+		{8241, "ErrPlacementPolicyInUse"},
+		{8242, "ErrOptOnCacheTable"},
+		{8243, "ErrHTTPServiceError"},
+		{8244, "ErrPartitionColumnStatsMissing"},
+		{8245, "ErrColumnInChange"},
+		{8246, "ErrDDLSetting"},
+		{8247, "ErrIngestFailed"},
+		{8256, "ErrIngestCheckEnvFailed"},
+		{8260, "ErrCannotPauseDDLJob"},
+		{8261, "ErrCannotResumeDDLJob"},
+		{8262, "ErrPausedDDLJob"},
+		{8263, "ErrBDRRestrictedDDL"},
+		{8248, "ErrResourceGroupExists"},
+		{8249, "ErrResourceGroupNotExists"},
+		{8250, "ErrResourceGroupSupportDisabled"},
+		{8251, "ErrResourceGroupConfigUnavailable"},
+		{8252, "ErrResourceGroupThrottled"},
+		{8253, "ErrResourceGroupQueryRunawayInterrupted"},
+		{8254, "ErrResourceGroupQueryRunawayQuarantine"},
+		{8255, "ErrResourceGroupInvalidBackgroundTaskName"},
+		{8257, "ErrResourceGroupInvalidForRole"},
+		{9001, "ErrPDServerTimeout"},
+		{9002, "ErrTiKVServerTimeout"},
+		{9003, "ErrTiKVServerBusy"},
+		{9004, "ErrResolveLockTimeout"},
+		{9005, "ErrRegionUnavailable"},
+		{9006, "ErrGCTooEarly"},
+		{9007, "ErrWriteConflict"},
+		{9008, "ErrTiKVStoreLimit"},
+		{9009, "ErrPrometheusAddrIsNotSet"},
+		{9010, "ErrTiKVStaleCommand"},
+		{9011, "ErrTiKVMaxTimestampNotSynced"},
+		{9012, "ErrTiFlashServerTimeout"},
+		{9013, "ErrTiFlashServerBusy"},
+	})
+}
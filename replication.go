@@ -0,0 +1,76 @@
+package mysqlerr
+
+// gtidCodes are the errors about global transaction identifiers.
+var gtidCodes = map[int]bool{
+	ER_GTID_NEXT_CANT_BE_AUTOMATIC_IF_GTID_NEXT_LIST_IS_NON_NULL:     true,
+	ER_MALFORMED_GTID_SET_SPECIFICATION:                              true,
+	ER_MALFORMED_GTID_SET_ENCODING:                                   true,
+	ER_MALFORMED_GTID_SPECIFICATION:                                  true,
+	ER_AUTO_POSITION_REQUIRES_GTID_MODE_NOT_OFF:                      true,
+	ER_CANT_DO_IMPLICIT_COMMIT_IN_TRX_WHEN_GTID_NEXT_IS_SET:          true,
+	ER_GTID_MODE_ON_REQUIRES_ENFORCE_GTID_CONSISTENCY_ON:             true,
+	ER_CANT_SET_GTID_NEXT_TO_GTID_WHEN_GTID_MODE_IS_OFF:              true,
+	ER_CANT_SET_GTID_NEXT_TO_ANONYMOUS_WHEN_GTID_MODE_IS_ON:          true,
+	ER_CANT_SET_GTID_NEXT_LIST_TO_NON_NULL_WHEN_GTID_MODE_IS_OFF:     true,
+	ER_GTID_UNSAFE_NON_TRANSACTIONAL_TABLE:                           true,
+	ER_GTID_UNSAFE_CREATE_SELECT:                                     true,
+	ER_GTID_MODE_CAN_ONLY_CHANGE_ONE_STEP_AT_A_TIME:                  true,
+	ER_SOURCE_HAS_PURGED_REQUIRED_GTIDS:                              true,
+	ER_CANT_SET_GTID_NEXT_WHEN_OWNING_GTID:                           true,
+	ER_GTID_NEXT_TYPE_UNDEFINED_GTID:                                 true,
+	ER_CANT_SET_GTID_PURGED_WHEN_GTID_EXECUTED_IS_NOT_EMPTY:          true,
+	ER_CANT_SET_GTID_PURGED_WHEN_OWNED_GTIDS_IS_NOT_EMPTY:            true,
+	ER_GTID_PURGED_WAS_CHANGED:                                       true,
+	ER_GTID_EXECUTED_WAS_CHANGED:                                     true,
+	ER_GTID_UNSAFE_BINLOG_SPLITTABLE_STATEMENT_AND_ASSIGNED_GTID:     true,
+	ER_REPLICA_HAS_MORE_GTIDS_THAN_SOURCE:                            true,
+	ER_GTID_MODE_OFF:                                                 true,
+	ER_CANNOT_LOG_PARTIAL_DROP_DATABASE_WITH_GTID:                    true,
+	ER_CANT_SET_GTID_MODE:                                            true,
+	ER_CANT_USE_AUTO_POSITION_WITH_GTID_MODE_OFF:                     true,
+	ER_CANT_ENFORCE_GTID_CONSISTENCY_WITH_ONGOING_GTID_VIOLATING_TX:  true,
+	ER_ENFORCE_GTID_CONSISTENCY_WARN_WITH_ONGOING_GTID_VIOLATING_TX:  true,
+	ER_WARN_ON_MODIFYING_GTID_EXECUTED_TABLE:                         true,
+	ER_CANT_SET_VARIABLE_WHEN_OWNING_GTID:                            true,
+	ER_CANT_WAIT_FOR_EXECUTED_GTID_SET_WHILE_OWNING_A_GTID:           true,
+	ER_ERROR_ON_MODIFYING_GTID_EXECUTED_TABLE:                        true,
+	ER_CANT_SET_GTID_PURGED_DUE_SETS_CONSTRAINTS:                     true,
+	ER_CLIENT_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRX_IN_SBR:       true,
+	ER_GTID_UNSAFE_ALTER_ADD_COL_WITH_DEFAULT_EXPRESSION:             true,
+	ER_DA_RPL_GTID_TABLE_CANNOT_OPEN:                                 true,
+	ER_UPDATE_GTID_PURGED_WITH_GR:                                    true,
+	ER_RPL_ASYNC_RECONNECT_GTID_MODE_OFF:                             true,
+	ER_DISABLE_GTID_MODE_REQUIRES_ASYNC_RECONNECT_OFF:                true,
+	ER_CANT_USE_ANONYMOUS_TO_GTID_WITH_GTID_MODE_NOT_ON:              true,
+	ER_CANT_COMBINE_ANONYMOUS_TO_GTID_AND_AUTOPOSITION:               true,
+	ER_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_REQUIRES_GTID_MODE_ON:  true,
+	ER_SQL_REPLICA_SKIP_COUNTER_USED_WITH_GTID_MODE_ON:               true,
+	ER_USING_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_AS_LOCAL_OR_UUID: true,
+	ER_CANT_SET_SQL_AFTER_OR_BEFORE_GTIDS_WITH_ANONYMOUS_TO_GTID:     true,
+	ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_GROUP_NAME:                     true,
+	ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_VIEW_CHANGE_UUID:               true,
+	ER_CHANGE_REPLICATION_SOURCE_NO_OPTIONS_FOR_GTID_ONLY:            true,
+	ER_CHANGE_REP_SOURCE_CANT_DISABLE_REQ_ROW_FORMAT_WITH_GTID_ONLY:  true,
+	ER_CHANGE_REP_SOURCE_CANT_DISABLE_AUTO_POSITION_WITH_GTID_ONLY:   true,
+	ER_CHANGE_REP_SOURCE_CANT_DISABLE_GTID_ONLY_WITHOUT_POSITIONS:    true,
+	ER_CHANGE_REP_SOURCE_GR_CHANNEL_WITH_GTID_MODE_NOT_ON:            true,
+	ER_CANT_USE_GTID_ONLY_WITH_GTID_MODE_NOT_ON:                      true,
+	ER_WARN_C_DISABLE_GTID_ONLY_WITH_SOURCE_AUTO_POS_INVALID_POS:     true,
+	ER_CANT_EXECUTE_COMMAND_WITH_ASSIGNED_GTID_NEXT:                  true,
+	ER_CANT_SET_GTID_NEXT_TO_AUTOMATIC_TAGGED_WHEN_GTID_MODE_IS_OFF:  true,
+	ER_GTID_NEXT_TAG_GTID_MODE_OFF:                                   true,
+}
+
+// IsReplicationError reports whether err was raised by asynchronous replication:
+// the replica threads, the binary log, the replication channels and GTIDs.
+// Group Replication has its own IsGroupReplicationError.
+func IsReplicationError(err error) bool {
+	code := Code(err)
+	return Subsystem(code) == SubsystemReplication || gtidCodes[code]
+}
+
+// IsGTIDError reports whether err is about global transaction identifiers,
+// such as a replica missing purged GTIDs or a statement unsafe under enforce_gtid_consistency.
+func IsGTIDError(err error) bool {
+	return gtidCodes[Code(err)]
+}
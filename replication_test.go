@@ -0,0 +1,36 @@
+package mysqlerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestIsReplicationError(t *testing.T) {
+	purged := &Error{Number: ER_SOURCE_HAS_PURGED_REQUIRED_GTIDS, Message: "Cannot replicate because the source purged required binary logs."}
+	tests := []struct {
+		name        string
+		err         error
+		replication bool
+		gtid        bool
+	}{
+		{"binlog", &Error{Number: ER_SOURCE_FATAL_ERROR_READING_BINLOG}, true, false},
+		{"purged GTIDs", purged, true, true},
+		{"GTID consistency", &Error{Number: ER_GTID_UNSAFE_CREATE_SELECT}, true, true},
+		{"MariaDB binlog", &driverError{Number: 1236, Message: "Got fatal error 1236 from master when reading data from binary log"}, true, false},
+		{"wrapped", fmt.Errorf("change source: %w", purged), true, true},
+		{"joined", joinError{errors.New("io thread"), purged}, true, true},
+		{"Group Replication", &Error{Number: ER_GROUP_REPLICATION_CONFIGURATION}, false, false},
+		{"duplicate entry", &Error{Number: ER_DUP_ENTRY}, false, false},
+		{"other error", errors.New("replication"), false, false},
+		{"nil", nil, false, false},
+	}
+	for _, tt := range tests {
+		if got := IsReplicationError(tt.err); got != tt.replication {
+			t.Errorf("%s: IsReplicationError = %v, want %v", tt.name, got, tt.replication)
+		}
+		if got := IsGTIDError(tt.err); got != tt.gtid {
+			t.Errorf("%s: IsGTIDError = %v, want %v", tt.name, got, tt.gtid)
+		}
+	}
+}
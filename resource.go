@@ -0,0 +1,30 @@
+package mysqlerr
+
+// IsResourceExhausted reports whether the server ran out of connections, memory,
+// disk or another limited resource. Retrying right away adds to the pressure,
+// so callers should shed load or back off instead.
+func IsResourceExhausted(err error) bool {
+	switch Code(err) {
+	case ER_CON_COUNT_ERROR,
+		ER_TOO_MANY_USER_CONNECTIONS,
+		ER_USER_LIMIT_REACHED,
+		ER_OUT_OF_RESOURCES,
+		ER_OUTOFMEMORY,
+		ER_OUT_OF_SORTMEMORY,
+		ER_ENGINE_OUT_OF_MEMORY,
+		ER_CAPACITY_EXCEEDED,
+		ER_CANT_CREATE_THREAD,
+		ER_DISK_FULL,
+		ER_DISK_FULL_NOWAIT,
+		ER_RECORD_FILE_FULL,
+		ER_TEMP_FILE_WRITE_FAILURE,
+		ER_TOO_BIG_SELECT,
+		ER_TOO_MANY_CONCURRENT_TRXS,
+		ER_LOCK_TABLE_FULL,
+		ER_TRANS_CACHE_FULL,
+		ER_STMT_CACHE_FULL,
+		ER_MAX_PREPARED_STMT_COUNT_REACHED:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package mysqlerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestIsResourceExhausted(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"max_connections", &Error{Number: ER_CON_COUNT_ERROR, SQLState: "08004", Message: "Too many connections"}, true},
+		{"max_user_connections", &Error{Number: ER_TOO_MANY_USER_CONNECTIONS}, true},
+		{"out of memory", &Error{Number: ER_OUTOFMEMORY}, true},
+		{"sort buffer", &Error{Number: ER_OUT_OF_SORTMEMORY}, true},
+		{"disk full", &Error{Number: ER_DISK_FULL}, true},
+		{"table full", &Error{Number: ER_RECORD_FILE_FULL}, true},
+		{"max_join_size", &Error{Number: ER_TOO_BIG_SELECT}, true},
+		{"lock table full", &Error{Number: ER_LOCK_TABLE_FULL}, true},
+		{"max_prepared_stmt_count", &Error{Number: ER_MAX_PREPARED_STMT_COUNT_REACHED}, true},
+		// MariaDB sends the same codes.
+		{"MariaDB max_connections", &driverError{Number: 1040, Message: "Too many connections"}, true},
+		{"wrapped", fmt.Errorf("connect: %w", &Error{Number: ER_CON_COUNT_ERROR}), true},
+		{"joined", joinError{errors.New("ping"), &Error{Number: ER_OUT_OF_RESOURCES}}, true},
+		{"deadlock", &Error{Number: ER_LOCK_DEADLOCK}, false},
+		{"access denied", &Error{Number: ER_ACCESS_DENIED_ERROR}, false},
+		{"not a MySQL error", errors.New("dial tcp: too many open files"), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		if got := IsResourceExhausted(tt.err); got != tt.want {
+			t.Errorf("%s: IsResourceExhausted = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package mysqlerr
+
+import (
+	"context"
+	"database/sql"
+	"math/rand"
+	"time"
+)
+
+// IsRetryable reports whether the transaction that failed with err was rolled back
+// by a conflict with another one and is likely to succeed when run again:
+// a deadlock, a lock wait timeout, or a certification conflict of Galera or Group Replication.
+func IsRetryable(err error) bool {
+	switch Code(err) {
+	case ER_LOCK_DEADLOCK, ER_LOCK_WAIT_TIMEOUT, ER_TRANSACTION_ROLLBACK_DURING_COMMIT:
+		return true
+	}
+	return false
+}
+
+// TxBeginner is implemented by *sql.DB and *sql.Conn.
+type TxBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// RetryOptions configures RunInTx. The zero value is usable.
+type RetryOptions struct {
+	// Tx is passed to BeginTx.
+	Tx *sql.TxOptions
+	// MaxAttempts is the number of times the transaction is run at most (default 3).
+	MaxAttempts int
+	// Backoff returns how long to wait after the attempt-th failure (default DefaultBackoff).
+	Backoff func(attempt int) time.Duration
+	// Retryable reports whether an error is worth another attempt (default IsRetryable).
+	Retryable func(error) bool
+}
+
+// DefaultBackoff returns a random duration in [d/2, d), where d starts at 10ms
+// and doubles with every attempt up to a second.
+func DefaultBackoff(attempt int) time.Duration {
+	d := 10 * time.Millisecond << uint(attempt-1)
+	if d > time.Second || d <= 0 {
+		d = time.Second
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
+// RunInTx runs fn in a transaction of db and commits it.
+// When fn, or the commit, fails with a retryable error the transaction is rolled back and run again,
+// so fn must not have effects outside of the transaction.
+// It returns the error of the last attempt, or ctx.Err() if ctx is done while waiting.
+func RunInTx(ctx context.Context, db TxBeginner, opts *RetryOptions, fn func(*sql.Tx) error) error {
+	var o RetryOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.MaxAttempts <= 0 {
+		o.MaxAttempts = 3
+	}
+	if o.Backoff == nil {
+		o.Backoff = DefaultBackoff
+	}
+	if o.Retryable == nil {
+		o.Retryable = IsRetryable
+	}
+	for attempt := 1; ; attempt++ {
+		err := runTx(ctx, db, o.Tx, fn)
+		if err == nil || attempt >= o.MaxAttempts || !o.Retryable(err) {
+			return err
+		}
+		t := time.NewTimer(o.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func runTx(ctx context.Context, db TxBeginner, opts *sql.TxOptions, fn func(*sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,32 @@
+package mysqlerr
+
+// IsSchemaConflict reports whether a DDL statement failed because the schema is already
+// in the state it asks for: the object it creates exists, or the object it drops does not.
+// Migration tools can treat such a failure as a migration that was already applied.
+func IsSchemaConflict(err error) bool {
+	switch Code(err) {
+	case ER_DB_CREATE_EXISTS,
+		ER_DB_DROP_EXISTS,
+		ER_TABLE_EXISTS_ERROR,
+		ER_BAD_TABLE_ERROR,
+		ER_DUP_FIELDNAME,
+		ER_DUP_KEYNAME,
+		ER_DUP_INDEX,
+		ER_CANT_DROP_FIELD_OR_KEY,
+		ER_KEY_DOES_NOT_EXITS,
+		ER_FK_DUP_NAME,
+		ER_CHECK_CONSTRAINT_DUP_NAME,
+		ER_CONSTRAINT_NOT_FOUND,
+		ER_SP_ALREADY_EXISTS,
+		ER_SP_DOES_NOT_EXIST,
+		ER_TRG_ALREADY_EXISTS,
+		ER_TRG_DOES_NOT_EXIST,
+		ER_EVENT_ALREADY_EXISTS,
+		ER_EVENT_DOES_NOT_EXIST,
+		ER_TABLESPACE_EXISTS,
+		ER_USER_ALREADY_EXISTS,
+		ER_USER_DOES_NOT_EXIST:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package mysqlerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestIsSchemaConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"CREATE TABLE", &Error{Number: ER_TABLE_EXISTS_ERROR, Message: "Table 't' already exists"}, true},
+		{"DROP TABLE", &Error{Number: ER_BAD_TABLE_ERROR, Message: "Unknown table 'db.t'"}, true},
+		{"ADD COLUMN", &Error{Number: ER_DUP_FIELDNAME, Message: "Duplicate column name 'c'"}, true},
+		{"ADD INDEX", &Error{Number: ER_DUP_KEYNAME, Message: "Duplicate key name 'i'"}, true},
+		{"DROP COLUMN", &Error{Number: ER_CANT_DROP_FIELD_OR_KEY}, true},
+		{"CREATE DATABASE", &Error{Number: ER_DB_CREATE_EXISTS}, true},
+		{"CREATE PROCEDURE", &Error{Number: ER_SP_ALREADY_EXISTS}, true},
+		{"CREATE USER", &Error{Number: ER_USER_ALREADY_EXISTS}, true},
+		// MariaDB shares the DDL codes with MySQL.
+		{"MariaDB CREATE TABLE", &driverError{Number: 1050, Message: "Table 't' already exists"}, true},
+		{"MariaDB ADD INDEX", &driverError{Number: 1061, Message: "Duplicate key name 'i'"}, true},
+		{"wrapped", fmt.Errorf("migration 0042: %w", &Error{Number: ER_DUP_FIELDNAME}), true},
+		{"joined", joinError{errors.New("rollback"), &Error{Number: ER_DUP_KEYNAME}}, true},
+		{"duplicate entry", &Error{Number: ER_DUP_ENTRY}, false},
+		{"syntax error", &Error{Number: ER_PARSE_ERROR}, false},
+		{"lock wait timeout", &Error{Number: ER_LOCK_WAIT_TIMEOUT}, false},
+		{"not a MySQL error", errors.New("table exists"), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		if got := IsSchemaConflict(tt.err); got != tt.want {
+			t.Errorf("%s: IsSchemaConflict = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package mysqlerr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxSignalMessage is the length in characters of the longest MESSAGE_TEXT a SIGNAL can set.
+const maxSignalMessage = 128
+
+// Signal returns a SIGNAL statement raising the error a server sends for code,
+// with its message rendered from args like NewError, for stored routines and test fixtures:
+//
+//	SIGNAL SQLSTATE '23000' SET MYSQL_ERRNO = 1062, MESSAGE_TEXT = 'Duplicate entry ''a'' for key ''t.name'''
+//
+// Messages longer than the 128 characters MESSAGE_TEXT holds are truncated.
+func Signal(code int, args ...interface{}) (string, error) {
+	return signalStatement("SIGNAL", NewError(code, args...))
+}
+
+// Resignal is like Signal, but returns a RESIGNAL statement for use in a handler.
+func Resignal(code int, args ...interface{}) (string, error) {
+	return signalStatement("RESIGNAL", NewError(code, args...))
+}
+
+// SignalError returns a SIGNAL statement raising e.
+func SignalError(e *Error) (string, error) {
+	return signalStatement("SIGNAL", e)
+}
+
+func signalStatement(verb string, e *Error) (string, error) {
+	state := e.sqlState()
+	if len(state) != 5 || strings.HasPrefix(state, "00") {
+		return "", fmt.Errorf("mysqlerr: %s cannot raise SQLSTATE %q", verb, state)
+	}
+	if e.Number == 0 {
+		return "", fmt.Errorf("mysqlerr: %s cannot set MYSQL_ERRNO 0", verb)
+	}
+	msg := e.Message
+	if r := []rune(msg); len(r) > maxSignalMessage {
+		msg = string(r[:maxSignalMessage])
+	}
+	return fmt.Sprintf("%s SQLSTATE %s SET MYSQL_ERRNO = %d, MESSAGE_TEXT = %s", verb, quoteString(state), e.Number, quoteString(msg)), nil
+}
+
+var stringEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `''`,
+	"\x00", `\0`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\x1a", `\Z`,
+)
+
+// quoteString quotes s as a string literal of the default sql_mode, the one without NO_BACKSLASH_ESCAPES.
+func quoteString(s string) string {
+	return "'" + stringEscaper.Replace(s) + "'"
+}
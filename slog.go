@@ -0,0 +1,38 @@
+//go:build go1.21
+// +build go1.21
+
+package mysqlerr
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer, so that logging an *Error records its fields.
+func (e *Error) LogValue() slog.Value {
+	return slog.GroupValue(errorAttrs(e)...)
+}
+
+// SlogAttrs returns the fields of the first MySQL error in err's chain as slog attributes:
+//
+//	slog.Error("insert user", slog.Group("mysql", mysqlerr.SlogAttrs(err)...))
+//
+// It returns nil if err carries no MySQL error.
+func SlogAttrs(err error) []slog.Attr {
+	e, ok := FromError(err)
+	if !ok {
+		return nil
+	}
+	return errorAttrs(e)
+}
+
+func errorAttrs(e *Error) []slog.Attr {
+	code := int(e.Number)
+	name, _ := Name(code)
+	attrs := []slog.Attr{slog.Int("code", code), slog.String("name", name)}
+	if e.SQLState != "" {
+		attrs = append(attrs, slog.String("sqlstate", e.SQLState))
+	}
+	return append(attrs,
+		slog.String("message", e.Message),
+		slog.Bool("retryable", IsRetryable(e)),
+		slog.String("category", string(CategoryOf(e))),
+	)
+}
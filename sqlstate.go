@@ -0,0 +1,106 @@
+package mysqlerr
+
+import "strings"
+
+// SQLStateClass is the class of an SQLSTATE, its first two characters.
+type SQLStateClass string
+
+// SQLSTATE classes MySQL uses.
+const (
+	ClassWarning                           SQLStateClass = "01"
+	ClassNoData                            SQLStateClass = "02"
+	ClassDynamicSQLError                   SQLStateClass = "07"
+	ClassConnectionException               SQLStateClass = "08"
+	ClassFeatureNotSupported               SQLStateClass = "0A"
+	ClassResignalWhenHandlerNotActive      SQLStateClass = "0K"
+	ClassCaseNotFound                      SQLStateClass = "20"
+	ClassCardinalityViolation              SQLStateClass = "21"
+	ClassDataException                     SQLStateClass = "22"
+	ClassIntegrityConstraintViolation      SQLStateClass = "23"
+	ClassInvalidCursorState                SQLStateClass = "24"
+	ClassInvalidTransactionState           SQLStateClass = "25"
+	ClassInvalidAuthorizationSpecification SQLStateClass = "28"
+	ClassSQLRoutineException               SQLStateClass = "2F"
+	ClassInvalidCursorName                 SQLStateClass = "34"
+	ClassInvalidConditionNumber            SQLStateClass = "35"
+	ClassInvalidCatalogName                SQLStateClass = "3D"
+	ClassTransactionRollback               SQLStateClass = "40"
+	ClassSyntaxErrorOrAccessRuleViolation  SQLStateClass = "42"
+	ClassWithCheckOptionViolation          SQLStateClass = "44"
+	ClassUnhandledUserDefinedException     SQLStateClass = "45"
+	ClassGeneralError                      SQLStateClass = "HY"
+	ClassXATransaction                     SQLStateClass = "XA"
+)
+
+var classDescriptions = map[SQLStateClass]string{
+	ClassWarning:                           "warning",
+	ClassNoData:                            "no data",
+	ClassDynamicSQLError:                   "dynamic SQL error",
+	ClassConnectionException:               "connection exception",
+	ClassFeatureNotSupported:               "feature not supported",
+	ClassResignalWhenHandlerNotActive:      "resignal when handler not active",
+	ClassCaseNotFound:                      "case not found for case statement",
+	ClassCardinalityViolation:              "cardinality violation",
+	ClassDataException:                     "data exception",
+	ClassIntegrityConstraintViolation:      "integrity constraint violation",
+	ClassInvalidCursorState:                "invalid cursor state",
+	ClassInvalidTransactionState:           "invalid transaction state",
+	ClassInvalidAuthorizationSpecification: "invalid authorization specification",
+	ClassSQLRoutineException:               "SQL routine exception",
+	ClassInvalidCursorName:                 "invalid cursor name",
+	ClassInvalidConditionNumber:            "invalid condition number",
+	ClassInvalidCatalogName:                "invalid catalog name",
+	ClassTransactionRollback:               "transaction rollback",
+	ClassSyntaxErrorOrAccessRuleViolation:  "syntax error or access rule violation",
+	ClassWithCheckOptionViolation:          "with check option violation",
+	ClassUnhandledUserDefinedException:     "unhandled user-defined exception",
+	ClassGeneralError:                      "general error",
+	ClassXATransaction:                     "XA transaction error",
+}
+
+// ClassOf returns the class of the SQLSTATE, or "" if it is too short to have one.
+func ClassOf(state string) SQLStateClass {
+	if len(state) < 2 {
+		return ""
+	}
+	return SQLStateClass(strings.ToUpper(state[:2]))
+}
+
+// Description returns the name the SQL standard gives the class, or "" for the classes MySQL does not use.
+func (c SQLStateClass) Description() string {
+	return classDescriptions[c]
+}
+
+// SQLState returns the SQLSTATE of the first MySQL error in err's tree, or "" if there is none.
+// The state the error carries, as the *mysql.MySQLError of go-sql-driver/mysql 1.9 and later does,
+// is preferred to the one of the catalog, which older servers do not always agree with.
+func SQLState(err error) string {
+	e, ok := FromError(err)
+	if !ok {
+		return ""
+	}
+	return e.sqlState()
+}
+
+// SQLStateMismatch reports whether the first MySQL error in err's tree carries an SQLSTATE
+// other than the one the catalog has for its code, and returns both.
+// Errors without a state and codes whose state the catalog does not know never mismatch.
+func SQLStateMismatch(err error) (sent, catalog string, mismatch bool) {
+	e, ok := FromError(err)
+	if !ok || e.SQLState == "" {
+		return "", "", false
+	}
+	catalog, known := catalogSQLState(int(e.Number))
+	if !known {
+		return "", "", false
+	}
+	return e.SQLState, catalog, e.SQLState != catalog
+}
+
+// sqlState returns the SQLSTATE e carries, or the one of the catalog for e's code.
+func (e *Error) sqlState() string {
+	if e.SQLState != "" {
+		return e.SQLState
+	}
+	return SQLStateOf(int(e.Number))
+}
@@ -0,0 +1,58 @@
+package mysqlerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSQLState(t *testing.T) {
+	fk := &Error{Number: ER_NO_REFERENCED_ROW_2}
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"catalog", fk, "23000"},
+		{"sent", &Error{Number: ER_NO_REFERENCED_ROW_2, SQLState: "HY000"}, "HY000"},
+		{"MariaDB", &driverError{Number: 1452, SQLState: [5]byte{'2', '3', '0', '0', '0'}}, "23000"},
+		{"unknown code", &Error{Number: 9999}, DefaultSQLState},
+		{"wrapped", fmt.Errorf("insert: %w", fk), "23000"},
+		{"joined", joinError{errors.New("batch"), fk}, "23000"},
+		{"nil", nil, ""},
+	}
+	for _, tt := range tests {
+		if got := SQLState(tt.err); got != tt.want {
+			t.Errorf("%s: SQLState = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSQLStateMismatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		sent     string
+		catalog  string
+		mismatch bool
+	}{
+		{"agree", &Error{Number: ER_NO_REFERENCED_ROW_2, SQLState: "23000"}, "23000", "23000", false},
+		{"disagree", &Error{Number: ER_NO_REFERENCED_ROW_2, SQLState: "HY000"}, "HY000", "23000", true},
+		{"no state", &Error{Number: ER_NO_REFERENCED_ROW_2}, "", "", false},
+		{"unknown code", &Error{Number: 9999, SQLState: "HY000"}, "", "", false},
+	}
+	for _, tt := range tests {
+		sent, catalog, mismatch := SQLStateMismatch(tt.err)
+		if sent != tt.sent || catalog != tt.catalog || mismatch != tt.mismatch {
+			t.Errorf("%s: SQLStateMismatch = %q, %q, %v, want %q, %q, %v", tt.name, sent, catalog, mismatch, tt.sent, tt.catalog, tt.mismatch)
+		}
+	}
+}
+
+func TestNewError(t *testing.T) {
+	e := NewError(ER_NO_REFERENCED_ROW_2, "`shop`.`orders`, CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)")
+	want := "Cannot add or update a child row: a foreign key constraint fails (`shop`.`orders`, CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`))"
+	if e.Number != ER_NO_REFERENCED_ROW_2 || e.SQLState != "23000" || e.Message != want {
+		t.Errorf("NewError = %+v", e)
+	}
+}
@@ -0,0 +1,32 @@
+package mysqlerr
+
+// Server subsystems returned by Subsystem.
+const (
+	SubsystemAudit            = "Audit"
+	SubsystemClone            = "Clone"
+	SubsystemFullTextSearch   = "Full-Text Search"
+	SubsystemGIS              = "GIS"
+	SubsystemGroupReplication = "Group Replication"
+	SubsystemInnoDB           = "InnoDB"
+	SubsystemJSON             = "JSON"
+	SubsystemKeyring          = "Keyring"
+	SubsystemNDB              = "NDB"
+	SubsystemOptimizer        = "Optimizer"
+	SubsystemPartitioning     = "Partitioning"
+	SubsystemPrivileges       = "Privileges"
+	SubsystemReplication      = "Replication"
+	SubsystemStoredPrograms   = "Stored Programs"
+	SubsystemXPlugin          = "X Plugin"
+)
+
+// Subsystem returns the server subsystem code belongs to, or "" for the general errors.
+// The table is generated from the name prefixes and ranges of the error codes.
+func Subsystem(code int) string {
+	if s, ok := subsystems[code]; ok {
+		return s
+	}
+	if 5000 <= code && code <= 5999 {
+		return SubsystemXPlugin
+	}
+	return ""
+}
@@ -0,0 +1,31 @@
+package mysqlerr
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSubsystem(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"access denied", &Error{Number: ER_ACCESS_DENIED_ERROR}, SubsystemPrivileges},
+		{"binlog", &Error{Number: ER_SOURCE_FATAL_ERROR_READING_BINLOG}, SubsystemReplication},
+		{"Group Replication", &Error{Number: ER_GROUP_REPLICATION_CONFIGURATION}, SubsystemGroupReplication},
+		{"InnoDB", &Error{Number: ER_INNODB_FT_LIMIT}, SubsystemInnoDB},
+		{"X Plugin range", &Error{Number: 5010}, SubsystemXPlugin},
+		{"MariaDB access denied", &driverError{Number: 1045, Message: "Access denied for user 'u'@'h' (using password: YES)"}, SubsystemPrivileges},
+		{"wrapped", fmt.Errorf("connect: %w", &Error{Number: ER_DBACCESS_DENIED_ERROR}), SubsystemPrivileges},
+		{"joined", joinError{fmt.Errorf("replica: %w", &Error{Number: ER_SOURCE_FATAL_ERROR_READING_BINLOG}), &Error{Number: ER_DUP_ENTRY}}, SubsystemReplication},
+		{"general", &Error{Number: ER_DUP_ENTRY}, ""},
+		{"client", &Error{Number: 2013}, ""},
+		{"nil", nil, ""},
+	}
+	for _, tt := range tests {
+		if got := Subsystem(Code(tt.err)); got != tt.want {
+			t.Errorf("%s: Subsystem(%d) = %q, want %q", tt.name, Code(tt.err), got, tt.want)
+		}
+	}
+}
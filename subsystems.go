@@ -0,0 +1,664 @@
+// Code generated mysqlerrgen DO NOT EDIT.
+
+package mysqlerr
+
+var subsystems = map[int]string{
+	1044: "Privileges",        // ER_DBACCESS_DENIED_ERROR
+	1045: "Privileges",        // ER_ACCESS_DENIED_ERROR
+	1104: "Optimizer",         // ER_TOO_BIG_SELECT
+	1130: "Privileges",        // ER_HOST_NOT_PRIVILEGED
+	1131: "Privileges",        // ER_PASSWORD_ANONYMOUS_USER
+	1132: "Privileges",        // ER_PASSWORD_NOT_ALLOWED
+	1133: "Privileges",        // ER_PASSWORD_NO_MATCH
+	1141: "Privileges",        // ER_NONEXISTING_GRANT
+	1142: "Privileges",        // ER_TABLEACCESS_DENIED_ERROR
+	1143: "Privileges",        // ER_COLUMNACCESS_DENIED_ERROR
+	1144: "Privileges",        // ER_ILLEGAL_GRANT_FOR_TABLE
+	1145: "Privileges",        // ER_GRANT_WRONG_HOST_OR_USER
+	1147: "Privileges",        // ER_NONEXISTING_TABLE_GRANT
+	1189: "Replication",       // ER_SOURCE_NET_READ
+	1190: "Replication",       // ER_SOURCE_NET_WRITE
+	1191: "Full-Text Search",  // ER_FT_MATCHING_KEY_NOT_FOUND
+	1198: "Replication",       // OBSOLETE_ER_SLAVE_MUST_STOP
+	1199: "Replication",       // ER_REPLICA_NOT_RUNNING
+	1200: "Replication",       // ER_BAD_REPLICA
+	1202: "Replication",       // ER_REPLICA_THREAD
+	1227: "Privileges",        // ER_SPECIFIC_ACCESS_DENIED_ERROR
+	1236: "Replication",       // ER_SOURCE_FATAL_ERROR_READING_BINLOG
+	1237: "Replication",       // ER_REPLICA_IGNORED_TABLE
+	1242: "Optimizer",         // ER_SUBQUERY_NO_1_ROW
+	1254: "Replication",       // OBSOLETE_ER_SLAVE_WAS_RUNNING
+	1255: "Replication",       // OBSOLETE_ER_SLAVE_WAS_NOT_RUNNING
+	1269: "Privileges",        // ER_REVOKE_GRANTS
+	1274: "Replication",       // ER_REPLICA_IGNORED_SSL_PARAMS
+	1277: "Replication",       // ER_BAD_REPLICA_UNTIL_COND
+	1303: "Stored Programs",   // ER_SP_NO_RECURSIVE_CREATE
+	1304: "Stored Programs",   // ER_SP_ALREADY_EXISTS
+	1305: "Stored Programs",   // ER_SP_DOES_NOT_EXIST
+	1306: "Stored Programs",   // ER_SP_DROP_FAILED
+	1307: "Stored Programs",   // ER_SP_STORE_FAILED
+	1308: "Stored Programs",   // ER_SP_LILABEL_MISMATCH
+	1309: "Stored Programs",   // ER_SP_LABEL_REDEFINE
+	1310: "Stored Programs",   // ER_SP_LABEL_MISMATCH
+	1311: "Stored Programs",   // ER_SP_UNINIT_VAR
+	1312: "Stored Programs",   // ER_SP_BADSELECT
+	1313: "Stored Programs",   // ER_SP_BADRETURN
+	1314: "Stored Programs",   // ER_SP_BADSTATEMENT
+	1318: "Stored Programs",   // ER_SP_WRONG_NO_OF_ARGS
+	1319: "Stored Programs",   // ER_SP_COND_MISMATCH
+	1320: "Stored Programs",   // ER_SP_NORETURN
+	1321: "Stored Programs",   // ER_SP_NORETURNEND
+	1322: "Stored Programs",   // ER_SP_BAD_CURSOR_QUERY
+	1323: "Stored Programs",   // ER_SP_BAD_CURSOR_SELECT
+	1324: "Stored Programs",   // ER_SP_CURSOR_MISMATCH
+	1325: "Stored Programs",   // ER_SP_CURSOR_ALREADY_OPEN
+	1326: "Stored Programs",   // ER_SP_CURSOR_NOT_OPEN
+	1327: "Stored Programs",   // ER_SP_UNDECLARED_VAR
+	1328: "Stored Programs",   // ER_SP_WRONG_NO_OF_FETCH_ARGS
+	1329: "Stored Programs",   // ER_SP_FETCH_NO_DATA
+	1330: "Stored Programs",   // ER_SP_DUP_PARAM
+	1331: "Stored Programs",   // ER_SP_DUP_VAR
+	1332: "Stored Programs",   // ER_SP_DUP_COND
+	1333: "Stored Programs",   // ER_SP_DUP_CURS
+	1334: "Stored Programs",   // ER_SP_CANT_ALTER
+	1335: "Stored Programs",   // ER_SP_SUBSELECT_NYI
+	1337: "Stored Programs",   // ER_SP_VARCOND_AFTER_CURSHNDLR
+	1338: "Stored Programs",   // ER_SP_CURSOR_AFTER_HANDLER
+	1339: "Stored Programs",   // ER_SP_CASE_NOT_FOUND
+	1345: "Stored Programs",   // ER_VIEW_NO_EXPLAIN
+	1349: "Stored Programs",   // OBSOLETE_ER_VIEW_SELECT_DERIVED_UNUSED
+	1350: "Stored Programs",   // ER_VIEW_SELECT_CLAUSE
+	1351: "Stored Programs",   // ER_VIEW_SELECT_VARIABLE
+	1352: "Stored Programs",   // ER_VIEW_SELECT_TMPTABLE
+	1353: "Stored Programs",   // ER_VIEW_WRONG_LIST
+	1356: "Stored Programs",   // ER_VIEW_INVALID
+	1357: "Stored Programs",   // ER_SP_NO_DROP_SP
+	1358: "Stored Programs",   // OBSOLETE_ER_SP_GOTO_IN_HNDLR
+	1359: "Stored Programs",   // ER_TRG_ALREADY_EXISTS
+	1360: "Stored Programs",   // ER_TRG_DOES_NOT_EXIST
+	1361: "Stored Programs",   // ER_TRG_ON_VIEW_OR_TEMP_TABLE
+	1362: "Stored Programs",   // ER_TRG_CANT_CHANGE_ROW
+	1363: "Stored Programs",   // ER_TRG_NO_SUCH_ROW_IN_TRG
+	1368: "Stored Programs",   // ER_VIEW_NONUPD_CHECK
+	1369: "Stored Programs",   // ER_VIEW_CHECK_FAILED
+	1370: "Privileges",        // ER_PROCACCESS_DENIED_ERROR
+	1371: "Replication",       // ER_RELAY_LOG_FAIL
+	1375: "Replication",       // ER_BINLOG_PURGE_PROHIBITED
+	1377: "Replication",       // ER_BINLOG_PURGE_FATAL_ERR
+	1380: "Replication",       // ER_RELAY_LOG_INIT
+	1392: "Stored Programs",   // ER_VIEW_CHECKSUM
+	1393: "Stored Programs",   // ER_VIEW_MULTIUPDATE
+	1394: "Stored Programs",   // ER_VIEW_NO_INSERT_FIELD_LIST
+	1395: "Stored Programs",   // ER_VIEW_DELETE_MERGE_VIEW
+	1396: "Privileges",        // ER_CANNOT_USER
+	1403: "Privileges",        // ER_NONEXISTING_PROC_GRANT
+	1404: "Privileges",        // ER_PROC_AUTO_GRANT_FAIL
+	1407: "Stored Programs",   // ER_SP_BAD_SQLSTATE
+	1410: "Privileges",        // ER_CANT_CREATE_USER_WITH_GRANT
+	1413: "Stored Programs",   // ER_SP_DUP_HANDLER
+	1414: "Stored Programs",   // ER_SP_NOT_VAR_ARG
+	1415: "Stored Programs",   // ER_SP_NO_RETSET
+	1416: "GIS",               // ER_CANT_CREATE_GEOMETRY_OBJECT
+	1418: "Replication",       // ER_BINLOG_UNSAFE_ROUTINE
+	1419: "Replication",       // ER_BINLOG_CREATE_ROUTINE_NEED_SUPER
+	1424: "Stored Programs",   // ER_SP_NO_RECURSION
+	1435: "Stored Programs",   // ER_TRG_IN_WRONG_SCHEMA
+	1443: "Stored Programs",   // ER_VIEW_PREVENT_UPDATE
+	1445: "Stored Programs",   // ER_SP_CANT_SET_AUTOCOMMIT
+	1447: "Stored Programs",   // ER_VIEW_FRM_NO_USER
+	1448: "Stored Programs",   // ER_VIEW_OTHER_USER
+	1453: "Stored Programs",   // ER_SP_BAD_VAR_SHADOW
+	1454: "Stored Programs",   // ER_TRG_NO_DEFINER
+	1456: "Stored Programs",   // ER_SP_RECURSION_LIMIT
+	1457: "Stored Programs",   // OBSOLETE_ER_SP_PROC_TABLE_CORRUPT
+	1458: "Stored Programs",   // ER_SP_WRONG_NAME
+	1460: "Stored Programs",   // ER_SP_NO_AGGREGATE
+	1462: "Stored Programs",   // ER_VIEW_RECURSIVE
+	1473: "Optimizer",         // ER_TOO_HIGH_LEVEL_OF_NESTING_FOR_SELECT
+	1479: "Partitioning",      // ER_PARTITION_REQUIRES_VALUES_ERROR
+	1480: "Partitioning",      // ER_PARTITION_WRONG_VALUES_ERROR
+	1481: "Partitioning",      // ER_PARTITION_MAXVALUE_ERROR
+	1482: "Partitioning",      // OBSOLETE_ER_PARTITION_SUBPARTITION_ERROR
+	1483: "Partitioning",      // OBSOLETE_ER_PARTITION_SUBPART_MIX_ERROR
+	1484: "Partitioning",      // ER_PARTITION_WRONG_NO_PART_ERROR
+	1485: "Partitioning",      // ER_PARTITION_WRONG_NO_SUBPART_ERROR
+	1486: "Partitioning",      // ER_WRONG_EXPR_IN_PARTITION_FUNC_ERROR
+	1490: "Partitioning",      // ER_INCONSISTENT_PARTITION_INFO_ERROR
+	1491: "Partitioning",      // ER_PARTITION_FUNC_NOT_ALLOWED_ERROR
+	1492: "Partitioning",      // ER_PARTITIONS_MUST_BE_DEFINED_ERROR
+	1496: "Partitioning",      // ER_PARTITION_ENTRY_ERROR
+	1498: "Partitioning",      // ER_PARTITION_NOT_DEFINED_ERROR
+	1499: "Partitioning",      // ER_TOO_MANY_PARTITIONS_ERROR
+	1500: "Partitioning",      // ER_SUBPARTITION_ERROR
+	1503: "Partitioning",      // ER_UNIQUE_KEY_NEED_ALL_FIELDS_IN_PF
+	1505: "Partitioning",      // ER_PARTITION_MGMT_ON_NONPARTITIONED
+	1506: "Partitioning",      // ER_FOREIGN_KEY_ON_PARTITIONED
+	1507: "Partitioning",      // ER_DROP_PARTITION_NON_EXISTENT
+	1508: "Partitioning",      // ER_DROP_LAST_PARTITION
+	1509: "Partitioning",      // ER_COALESCE_ONLY_ON_HASH_PARTITION
+	1512: "Partitioning",      // ER_ONLY_ON_RANGE_LIST_PARTITION
+	1513: "Partitioning",      // ER_ADD_PARTITION_SUBPART_ERROR
+	1514: "Partitioning",      // ER_ADD_PARTITION_NO_NEW_PARTITION
+	1515: "Partitioning",      // ER_COALESCE_PARTITION_NO_PARTITION
+	1516: "Partitioning",      // ER_REORG_PARTITION_NOT_EXIST
+	1517: "Partitioning",      // ER_SAME_NAME_PARTITION
+	1519: "Partitioning",      // ER_CONSECUTIVE_REORG_PARTITIONS
+	1521: "Partitioning",      // ER_PARTITION_FUNCTION_FAILURE
+	1526: "Partitioning",      // ER_NO_PARTITION_FOR_GIVEN_VALUE
+	1534: "Replication",       // ER_BINLOG_ROW_LOGGING_FAILED
+	1535: "Replication",       // OBSOLETE_ER_BINLOG_ROW_WRONG_TABLE_DEF
+	1536: "Replication",       // OBSOLETE_ER_BINLOG_ROW_RBR_TO_SBR
+	1537: "Stored Programs",   // ER_EVENT_ALREADY_EXISTS
+	1538: "Stored Programs",   // OBSOLETE_ER_EVENT_STORE_FAILED
+	1539: "Stored Programs",   // ER_EVENT_DOES_NOT_EXIST
+	1540: "Stored Programs",   // OBSOLETE_ER_EVENT_CANT_ALTER
+	1541: "Stored Programs",   // OBSOLETE_ER_EVENT_DROP_FAILED
+	1542: "Stored Programs",   // ER_EVENT_INTERVAL_NOT_POSITIVE_OR_TOO_BIG
+	1543: "Stored Programs",   // ER_EVENT_ENDS_BEFORE_STARTS
+	1544: "Stored Programs",   // ER_EVENT_EXEC_TIME_IN_THE_PAST
+	1545: "Stored Programs",   // OBSOLETE_ER_EVENT_OPEN_TABLE_FAILED
+	1546: "Stored Programs",   // OBSOLETE_ER_EVENT_NEITHER_M_EXPR_NOR_M_AT
+	1549: "Stored Programs",   // OBSOLETE_ER_EVENT_CANNOT_DELETE
+	1550: "Stored Programs",   // OBSOLETE_ER_EVENT_COMPILE_ERROR
+	1551: "Stored Programs",   // ER_EVENT_SAME_NAME
+	1552: "Stored Programs",   // OBSOLETE_ER_EVENT_DATA_TOO_LONG
+	1561: "NDB",               // OBSOLETE_ER_NDB_CANT_SWITCH_BINLOG_FORMAT
+	1562: "Partitioning",      // ER_PARTITION_NO_TEMPORARY
+	1563: "Partitioning",      // ER_PARTITION_CONST_DOMAIN_ERROR
+	1564: "Partitioning",      // ER_PARTITION_FUNCTION_IS_NOT_ALLOWED
+	1567: "Partitioning",      // ER_WRONG_PARTITION_NAME
+	1570: "Stored Programs",   // OBSOLETE_ER_EVENT_MODIFY_QUEUE_ERROR
+	1571: "Stored Programs",   // ER_EVENT_SET_VAR_ERROR
+	1572: "Partitioning",      // ER_PARTITION_MERGE_ERROR
+	1576: "Stored Programs",   // ER_EVENT_RECURSION_FORBIDDEN
+	1587: "Replication",       // ER_BINLOG_PURGE_EMFILE
+	1588: "Stored Programs",   // ER_EVENT_CANNOT_CREATE_IN_THE_PAST
+	1589: "Stored Programs",   // ER_EVENT_CANNOT_ALTER_IN_THE_PAST
+	1590: "Replication",       // OBSOLETE_ER_SLAVE_INCIDENT
+	1591: "Partitioning",      // ER_NO_PARTITION_FOR_GIVEN_VALUE_SILENT
+	1592: "Replication",       // ER_BINLOG_UNSAFE_STATEMENT
+	1593: "Replication",       // ER_BINLOG_FATAL_ERROR
+	1594: "Replication",       // OBSOLETE_ER_SLAVE_RELAY_LOG_READ_FAILURE
+	1595: "Replication",       // OBSOLETE_ER_SLAVE_RELAY_LOG_WRITE_FAILURE
+	1596: "Replication",       // OBSOLETE_ER_SLAVE_CREATE_EVENT_FAILURE
+	1597: "Replication",       // OBSOLETE_ER_SLAVE_MASTER_COM_FAILURE
+	1598: "Replication",       // ER_BINLOG_LOGGING_IMPOSSIBLE
+	1599: "Stored Programs",   // ER_VIEW_NO_CREATION_CTX
+	1600: "Stored Programs",   // ER_VIEW_INVALID_CREATION_CTX
+	1602: "Stored Programs",   // ER_TRG_CORRUPTED_FILE
+	1603: "Stored Programs",   // ER_TRG_NO_CREATION_CTX
+	1604: "Stored Programs",   // ER_TRG_INVALID_CREATION_CTX
+	1605: "Stored Programs",   // ER_EVENT_INVALID_CREATION_CTX
+	1606: "Stored Programs",   // ER_TRG_CANT_OPEN_TABLE
+	1610: "Replication",       // ER_REPLICA_CORRUPT_EVENT
+	1623: "Replication",       // OBSOLETE_ER_SLAVE_HEARTBEAT_FAILURE
+	1624: "Replication",       // ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE
+	1625: "NDB",               // ER_NDB_REPLICATION_SCHEMA_ERROR
+	1633: "Partitioning",      // ER_PARTITION_NAME
+	1634: "Partitioning",      // ER_SUBPARTITION_NAME
+	1650: "Replication",       // ER_REPLICA_IGNORE_SERVER_IDS
+	1652: "Partitioning",      // ER_SAME_NAME_PARTITION_FIELD
+	1653: "Partitioning",      // ER_PARTITION_COLUMN_LIST_ERROR
+	1655: "Partitioning",      // ER_TOO_MANY_PARTITION_FUNC_FIELDS_ERROR
+	1658: "Partitioning",      // ER_ROW_SINGLE_PARTITION_FIELD_ERROR
+	1659: "Partitioning",      // ER_FIELD_TYPE_NOT_ALLOWED_AS_PARTITION_FIELD
+	1660: "Partitioning",      // ER_PARTITION_FIELDS_TOO_LONG
+	1661: "Replication",       // ER_BINLOG_ROW_ENGINE_AND_STMT_ENGINE
+	1662: "Replication",       // ER_BINLOG_ROW_MODE_AND_STMT_ENGINE
+	1663: "Replication",       // ER_BINLOG_UNSAFE_AND_STMT_ENGINE
+	1664: "Replication",       // ER_BINLOG_ROW_INJECTION_AND_STMT_ENGINE
+	1665: "Replication",       // ER_BINLOG_STMT_MODE_AND_ROW_ENGINE
+	1666: "Replication",       // ER_BINLOG_ROW_INJECTION_AND_STMT_MODE
+	1667: "Replication",       // ER_BINLOG_MULTIPLE_ENGINES_AND_SELF_LOGGING_ENGINE
+	1668: "Replication",       // ER_BINLOG_UNSAFE_LIMIT
+	1670: "Replication",       // ER_BINLOG_UNSAFE_SYSTEM_TABLE
+	1671: "Replication",       // ER_BINLOG_UNSAFE_AUTOINC_COLUMNS
+	1672: "Replication",       // ER_BINLOG_UNSAFE_UDF
+	1673: "Replication",       // ER_BINLOG_UNSAFE_SYSTEM_VARIABLE
+	1674: "Replication",       // ER_BINLOG_UNSAFE_SYSTEM_FUNCTION
+	1675: "Replication",       // ER_BINLOG_UNSAFE_NONTRANS_AFTER_TRANS
+	1677: "Replication",       // OBSOLETE_ER_SLAVE_CONVERSION_FAILED
+	1678: "Replication",       // ER_REPLICA_CANT_CREATE_CONVERSION
+	1692: "Replication",       // ER_BINLOG_UNSAFE_MULTIPLE_ENGINES_AND_SELF_LOGGING_ENGINE
+	1693: "Replication",       // ER_BINLOG_UNSAFE_MIXED_STATEMENT
+	1698: "Privileges",        // ER_ACCESS_DENIED_NO_PASSWORD_ERROR
+	1699: "Privileges",        // OBSOLETE_ER_SET_PASSWORD_AUTH_PLUGIN
+	1700: "Privileges",        // OBSOLETE_ER_GRANT_PLUGIN_USER_EXISTS
+	1703: "Replication",       // ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MIN
+	1704: "Replication",       // ER_REPLICA_HEARTBEAT_VALUE_OUT_OF_RANGE_MAX
+	1714: "Replication",       // ER_BINLOG_UNSAFE_INSERT_IGNORE_SELECT
+	1715: "Replication",       // ER_BINLOG_UNSAFE_INSERT_SELECT_UPDATE
+	1716: "Replication",       // ER_BINLOG_UNSAFE_REPLACE_SELECT
+	1717: "Replication",       // ER_BINLOG_UNSAFE_CREATE_IGNORE_SELECT
+	1718: "Replication",       // ER_BINLOG_UNSAFE_CREATE_REPLACE_SELECT
+	1719: "Replication",       // ER_BINLOG_UNSAFE_UPDATE_IGNORE
+	1722: "Replication",       // ER_BINLOG_UNSAFE_WRITE_AUTOINC_SELECT
+	1723: "Replication",       // ER_BINLOG_UNSAFE_CREATE_SELECT_AUTOINC
+	1724: "Replication",       // ER_BINLOG_UNSAFE_INSERT_TWO_KEYS
+	1727: "Replication",       // ER_BINLOG_UNSAFE_AUTOINC_NOT_FIRST
+	1729: "Replication",       // ER_SOURCE_DELAY_VALUE_OUT_OF_RANGE
+	1731: "Partitioning",      // ER_PARTITION_EXCHANGE_DIFFERENT_OPTION
+	1732: "Partitioning",      // ER_PARTITION_EXCHANGE_PART_TABLE
+	1733: "Partitioning",      // ER_PARTITION_EXCHANGE_TEMP_TABLE
+	1734: "Partitioning",      // ER_PARTITION_INSTEAD_OF_SUBPARTITION
+	1735: "Partitioning",      // ER_UNKNOWN_PARTITION
+	1737: "Partitioning",      // ER_ROW_DOES_NOT_MATCH_PARTITION
+	1738: "Replication",       // ER_BINLOG_CACHE_SIZE_GREATER_THAN_MAX
+	1740: "Partitioning",      // ER_PARTITION_EXCHANGE_FOREIGN_KEY
+	1742: "Replication",       // ER_RPL_INFO_DATA_TOO_LONG
+	1744: "Replication",       // OBSOLETE_ER_BINLOG_READ_EVENT_CHECKSUM_FAILURE
+	1745: "Replication",       // ER_BINLOG_STMT_CACHE_SIZE_GREATER_THAN_MAX
+	1747: "Partitioning",      // ER_PARTITION_CLAUSE_ON_NONPARTITIONED
+	1748: "Partitioning",      // ER_ROW_DOES_NOT_MATCH_GIVEN_PARTITION_SET
+	1749: "Partitioning",      // OBSOLETE_ER_NO_SUCH_PARTITION__UNUSED
+	1757: "Partitioning",      // ER_FULLTEXT_NOT_SUPPORTED_WITH_PARTITIONING
+	1767: "Replication",       // OBSOLETE_ER_GTID_NEXT_IS_NOT_IN_GTID_NEXT_LIST
+	1768: "Replication",       // OBSOLETE_ER_CANT_CHANGE_GTID_NEXT_IN_TRANSACTION
+	1770: "Replication",       // ER_GTID_NEXT_CANT_BE_AUTOMATIC_IF_GTID_NEXT_LIST_IS_NON_NULL
+	1772: "Replication",       // ER_MALFORMED_GTID_SET_SPECIFICATION
+	1773: "Replication",       // ER_MALFORMED_GTID_SET_ENCODING
+	1774: "Replication",       // ER_MALFORMED_GTID_SPECIFICATION
+	1776: "Replication",       // ER_BAD_REPLICA_AUTO_POSITION
+	1777: "Replication",       // ER_AUTO_POSITION_REQUIRES_GTID_MODE_NOT_OFF
+	1778: "Replication",       // ER_CANT_DO_IMPLICIT_COMMIT_IN_TRX_WHEN_GTID_NEXT_IS_SET
+	1779: "Replication",       // ER_GTID_MODE_ON_REQUIRES_ENFORCE_GTID_CONSISTENCY_ON
+	1780: "Replication",       // OBSOLETE_ER_GTID_MODE_REQUIRES_BINLOG
+	1781: "Replication",       // ER_CANT_SET_GTID_NEXT_TO_GTID_WHEN_GTID_MODE_IS_OFF
+	1782: "Replication",       // ER_CANT_SET_GTID_NEXT_TO_ANONYMOUS_WHEN_GTID_MODE_IS_ON
+	1783: "Replication",       // ER_CANT_SET_GTID_NEXT_LIST_TO_NON_NULL_WHEN_GTID_MODE_IS_OFF
+	1784: "Replication",       // OBSOLETE_ER_FOUND_GTID_EVENT_WHEN_GTID_MODE_IS_OFF__UNUSED
+	1785: "Replication",       // ER_GTID_UNSAFE_NON_TRANSACTIONAL_TABLE
+	1786: "Replication",       // ER_GTID_UNSAFE_CREATE_SELECT
+	1787: "Replication",       // OBSOLETE_ER_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRANSACTION
+	1788: "Replication",       // ER_GTID_MODE_CAN_ONLY_CHANGE_ONE_STEP_AT_A_TIME
+	1789: "Replication",       // ER_SOURCE_HAS_PURGED_REQUIRED_GTIDS
+	1790: "Replication",       // ER_CANT_SET_GTID_NEXT_WHEN_OWNING_GTID
+	1793: "Partitioning",      // ER_TOO_LONG_TABLE_PARTITION_COMMENT
+	1794: "Replication",       // ER_REPLICA_CONFIGURATION
+	1795: "InnoDB",            // ER_INNODB_FT_LIMIT
+	1796: "InnoDB",            // ER_INNODB_NO_FT_TEMP_TABLE
+	1797: "InnoDB",            // ER_INNODB_FT_WRONG_DOCID_COLUMN
+	1798: "InnoDB",            // ER_INNODB_FT_WRONG_DOCID_INDEX
+	1799: "InnoDB",            // ER_INNODB_ONLINE_LOG_TOO_BIG
+	1806: "Replication",       // ER_REPLICA_SILENT_RETRY_TRANSACTION
+	1816: "InnoDB",            // ER_INNODB_IMPORT_ERROR
+	1817: "InnoDB",            // ER_INNODB_INDEX_CORRUPT
+	1819: "Privileges",        // ER_NOT_VALID_PASSWORD
+	1820: "Privileges",        // ER_MUST_CHANGE_PASSWORD
+	1827: "Privileges",        // ER_PASSWORD_FORMAT
+	1837: "Replication",       // ER_GTID_NEXT_TYPE_UNDEFINED_GTID
+	1839: "Replication",       // OBSOLETE_ER_CANT_SET_GTID_PURGED_WHEN_GTID_MODE_IS_OFF
+	1840: "Replication",       // ER_CANT_SET_GTID_PURGED_WHEN_GTID_EXECUTED_IS_NOT_EMPTY
+	1841: "Replication",       // ER_CANT_SET_GTID_PURGED_WHEN_OWNED_GTIDS_IS_NOT_EMPTY
+	1842: "Replication",       // ER_GTID_PURGED_WAS_CHANGED
+	1843: "Replication",       // ER_GTID_EXECUTED_WAS_CHANGED
+	1844: "Replication",       // ER_BINLOG_STMT_MODE_AND_NO_REPL_TABLES
+	1848: "Partitioning",      // ER_ALTER_OPERATION_NOT_SUPPORTED_REASON_PARTITION
+	1858: "Replication",       // OBSOLETE_ER_SQL_REPLICA_SKIP_COUNTER_NOT_SETTABLE_IN_GTID_MODE
+	1862: "Privileges",        // ER_MUST_CHANGE_PASSWORD_LOGIN
+	1863: "Partitioning",      // ER_ROW_IN_WRONG_PARTITION
+	1865: "InnoDB",            // OBSOLETE_ER_INNODB_NO_FT_USES_PARSER
+	1866: "Replication",       // ER_BINLOG_LOGICAL_CORRUPTION
+	1871: "Replication",       // ER_REPLICA_CM_INIT_REPOSITORY
+	1872: "Replication",       // ER_REPLICA_AM_INIT_REPOSITORY
+	1873: "Privileges",        // ER_ACCESS_DENIED_CHANGE_USER_ERROR
+	1874: "InnoDB",            // ER_INNODB_READ_ONLY
+	1879: "InnoDB",            // ER_INNODB_FT_AUX_NOT_HEX_ID
+	1881: "InnoDB",            // ER_INNODB_FORCED_RECOVERY
+	1884: "Replication",       // ER_GTID_UNSAFE_BINLOG_SPLITTABLE_STATEMENT_AND_ASSIGNED_GTID
+	1885: "Replication",       // ER_REPLICA_HAS_MORE_GTIDS_THAN_SOURCE
+	3006: "Replication",       // ER_BINLOG_UNSAFE_FULLTEXT_PLUGIN
+	3016: "Privileges",        // ER_PASSWORD_EXPIRE_ANONYMOUS_USER
+	3017: "Replication",       // ER_REPLICA_SQL_THREAD_MUST_STOP
+	3019: "InnoDB",            // ER_INNODB_UNDO_LOG_FULL
+	3021: "Replication",       // ER_REPLICA_CHANNEL_IO_THREAD_MUST_STOP
+	3030: "Replication",       // ER_REPLICA_WORKER_STOPPED_PREVIOUS_THD_ERROR
+	3033: "GIS",               // ER_GIS_DIFFERENT_SRIDS
+	3034: "GIS",               // ER_GIS_UNSUPPORTED_ARGUMENT
+	3035: "GIS",               // ER_GIS_UNKNOWN_ERROR
+	3036: "GIS",               // ER_GIS_UNKNOWN_EXCEPTION
+	3037: "GIS",               // ER_GIS_INVALID_DATA
+	3038: "GIS",               // ER_BOOST_GEOMETRY_EMPTY_INPUT_EXCEPTION
+	3039: "GIS",               // ER_BOOST_GEOMETRY_CENTROID_EXCEPTION
+	3040: "GIS",               // ER_BOOST_GEOMETRY_OVERLAY_INVALID_INPUT_EXCEPTION
+	3041: "GIS",               // ER_BOOST_GEOMETRY_TURN_INFO_EXCEPTION
+	3042: "GIS",               // ER_BOOST_GEOMETRY_SELF_INTERSECTION_POINT_EXCEPTION
+	3043: "GIS",               // ER_BOOST_GEOMETRY_UNKNOWN_EXCEPTION
+	3055: "GIS",               // ER_GIS_DATA_WRONG_ENDIANESS
+	3056: "Replication",       // ER_CHANGE_SOURCE_PASSWORD_LENGTH
+	3062: "Replication",       // ER_GTID_MODE_OFF
+	3069: "JSON",              // ER_INVALID_JSON_DATA
+	3070: "JSON",              // ER_INVALID_GEOJSON_MISSING_MEMBER
+	3071: "JSON",              // ER_INVALID_GEOJSON_WRONG_TYPE
+	3072: "JSON",              // ER_INVALID_GEOJSON_UNSPECIFIED
+	3074: "Replication",       // ER_REPLICA_CHANNEL_DOES_NOT_EXIST
+	3075: "Replication",       // OBSOLETE_ER_SLAVE_MULTIPLE_CHANNELS_HOST_PORT
+	3076: "Replication",       // ER_REPLICA_CHANNEL_NAME_INVALID_OR_TOO_LONG
+	3077: "Replication",       // ER_REPLICA_NEW_CHANNEL_WRONG_REPOSITORY
+	3078: "Replication",       // OBSOLETE_ER_SLAVE_CHANNEL_DELETE
+	3079: "Replication",       // ER_REPLICA_MULTIPLE_CHANNELS_CMD
+	3080: "Replication",       // ER_REPLICA_MAX_CHANNELS_EXCEEDED
+	3081: "Replication",       // ER_REPLICA_CHANNEL_MUST_STOP
+	3082: "Replication",       // ER_REPLICA_CHANNEL_NOT_RUNNING
+	3083: "Replication",       // ER_REPLICA_CHANNEL_WAS_RUNNING
+	3084: "Replication",       // ER_REPLICA_CHANNEL_WAS_NOT_RUNNING
+	3085: "Replication",       // ER_REPLICA_CHANNEL_SQL_THREAD_MUST_STOP
+	3086: "Replication",       // ER_REPLICA_CHANNEL_SQL_SKIP_COUNTER
+	3091: "Replication",       // ER_CANNOT_LOG_PARTIAL_DROP_DATABASE_WITH_GTID
+	3092: "Group Replication", // ER_GROUP_REPLICATION_CONFIGURATION
+	3093: "Group Replication", // ER_GROUP_REPLICATION_RUNNING
+	3094: "Group Replication", // ER_GROUP_REPLICATION_APPLIER_INIT_ERROR
+	3095: "Group Replication", // ER_GROUP_REPLICATION_STOP_APPLIER_THREAD_TIMEOUT
+	3096: "Group Replication", // ER_GROUP_REPLICATION_COMMUNICATION_LAYER_SESSION_ERROR
+	3097: "Group Replication", // ER_GROUP_REPLICATION_COMMUNICATION_LAYER_JOIN_ERROR
+	3111: "Replication",       // ER_CANT_SET_GTID_MODE
+	3112: "Replication",       // ER_CANT_USE_AUTO_POSITION_WITH_GTID_MODE_OFF
+	3114: "Replication",       // OBSOLETE_ER_CANT_REPLICATE_ANONYMOUS_WITH_GTID_MODE_ON
+	3115: "Replication",       // OBSOLETE_ER_CANT_REPLICATE_GTID_WITH_GTID_MODE_OFF
+	3116: "Replication",       // ER_CANT_ENFORCE_GTID_CONSISTENCY_WITH_ONGOING_GTID_VIOLATING_TX
+	3117: "Replication",       // ER_ENFORCE_GTID_CONSISTENCY_WARN_WITH_ONGOING_GTID_VIOLATING_TX
+	3122: "GIS",               // ER_BOOST_GEOMETRY_INCONSISTENT_TURNS_EXCEPTION
+	3129: "Replication",       // ER_WARN_ON_MODIFYING_GTID_EXECUTED_TABLE
+	3134: "GIS",               // ER_GIS_MAX_POINTS_IN_GEOMETRY_OVERFLOWED
+	3138: "Replication",       // ER_CANT_SET_VARIABLE_WHEN_OWNING_GTID
+	3139: "Replication",       // ER_REPLICA_CHANNEL_OPERATION_NOT_ALLOWED
+	3140: "JSON",              // ER_INVALID_JSON_TEXT
+	3141: "JSON",              // ER_INVALID_JSON_TEXT_IN_PARAM
+	3142: "JSON",              // ER_INVALID_JSON_BINARY_DATA
+	3143: "JSON",              // ER_INVALID_JSON_PATH
+	3144: "JSON",              // ER_INVALID_JSON_CHARSET
+	3145: "JSON",              // ER_INVALID_JSON_CHARSET_IN_FUNCTION
+	3146: "JSON",              // ER_INVALID_TYPE_FOR_JSON
+	3147: "JSON",              // ER_INVALID_CAST_TO_JSON
+	3148: "JSON",              // ER_INVALID_JSON_PATH_CHARSET
+	3149: "JSON",              // ER_INVALID_JSON_PATH_WILDCARD
+	3150: "JSON",              // ER_JSON_VALUE_TOO_BIG
+	3151: "JSON",              // ER_JSON_KEY_TOO_BIG
+	3152: "JSON",              // ER_JSON_USED_AS_KEY
+	3153: "JSON",              // ER_JSON_VACUOUS_PATH
+	3154: "JSON",              // ER_JSON_BAD_ONE_OR_ALL_ARG
+	3155: "JSON",              // ER_NUMERIC_JSON_VALUE_OUT_OF_RANGE
+	3156: "JSON",              // ER_INVALID_JSON_VALUE_FOR_CAST
+	3157: "JSON",              // ER_JSON_DOCUMENT_TOO_DEEP
+	3158: "JSON",              // ER_JSON_DOCUMENT_NULL_KEY
+	3164: "Audit",             // ER_AUDIT_API_ABORT
+	3165: "JSON",              // ER_INVALID_JSON_PATH_ARRAY_CELL
+	3173: "Replication",       // ER_CANT_WAIT_FOR_EXECUTED_GTID_SET_WHILE_OWNING_A_GTID
+	3176: "Replication",       // ER_ERROR_ON_MODIFYING_GTID_EXECUTED_TABLE
+	3179: "Replication",       // ER_MASTER_KEY_ROTATION_NOT_SUPPORTED_BY_SE
+	3180: "Replication",       // OBSOLETE_ER_MASTER_KEY_ROTATION_ERROR_BY_SE
+	3181: "Replication",       // ER_MASTER_KEY_ROTATION_BINLOG_FAILED
+	3182: "Replication",       // ER_MASTER_KEY_ROTATION_SE_UNAVAILABLE
+	3185: "Keyring",           // ER_CANNOT_FIND_KEY_IN_KEYRING
+	3188: "Keyring",           // ER_KEYRING_UDF_KEYRING_SERVICE_ERROR
+	3191: "Group Replication", // ER_GROUP_REPLICATION_MAX_GROUP_SIZE
+	3194: "Partitioning",      // OBSOLETE_ER_PARTITION_ENGINE_DEPRECATED_FOR_TABLE
+	3198: "Keyring",           // ER_KEYRING_AWS_UDF_AWS_KMS_ERROR
+	3199: "Replication",       // ER_BINLOG_UNSAFE_XA
+	3201: "Keyring",           // ER_KEYRING_MIGRATION_FAILURE
+	3202: "Keyring",           // ER_KEYRING_ACCESS_DENIED_ERROR
+	3203: "Keyring",           // ER_KEYRING_MIGRATION_STATUS
+	3206: "Keyring",           // OBSOLETE_ER_AUDIT_LOG_NO_KEYRING_PLUGIN_INSTALLED
+	3207: "Audit",             // OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_HAS_NOT_BEEN_SET
+	3208: "Audit",             // OBSOLETE_ER_AUDIT_LOG_COULD_NOT_CREATE_AES_KEY
+	3209: "Audit",             // OBSOLETE_ER_AUDIT_LOG_ENCRYPTION_PASSWORD_CANNOT_BE_FETCHED
+	3210: "Audit",             // OBSOLETE_ER_AUDIT_LOG_JSON_FILTERING_NOT_ENABLED
+	3211: "Audit",             // OBSOLETE_ER_AUDIT_LOG_UDF_INSUFFICIENT_PRIVILEGE
+	3212: "Audit",             // OBSOLETE_ER_AUDIT_LOG_SUPER_PRIVILEGE_REQUIRED
+	3214: "Audit",             // OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_TYPE
+	3215: "Audit",             // OBSOLETE_ER_AUDIT_LOG_UDF_INVALID_ARGUMENT_COUNT
+	3216: "Audit",             // OBSOLETE_ER_AUDIT_LOG_HAS_NOT_BEEN_INSTALLED
+	3217: "Audit",             // OBSOLETE_ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_TYPE
+	3218: "Audit",             // ER_AUDIT_LOG_UDF_READ_INVALID_MAX_ARRAY_LENGTH_ARG_VALUE
+	3219: "Audit",             // OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_PARSING_ERROR
+	3220: "Audit",             // OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_NAME_CANNOT_BE_EMPTY
+	3221: "Audit",             // OBSOLETE_ER_AUDIT_LOG_JSON_USER_NAME_CANNOT_BE_EMPTY
+	3222: "Audit",             // OBSOLETE_ER_AUDIT_LOG_JSON_FILTER_DOES_NOT_EXISTS
+	3223: "Audit",             // OBSOLETE_ER_AUDIT_LOG_USER_FIRST_CHARACTER_MUST_BE_ALPHANUMERIC
+	3224: "Audit",             // OBSOLETE_ER_AUDIT_LOG_USER_NAME_INVALID_CHARACTER
+	3225: "Audit",             // OBSOLETE_ER_AUDIT_LOG_HOST_NAME_INVALID_CHARACTER
+	3512: "Stored Programs",   // ER_SP_LOAD_FAILED
+	3516: "GIS",               // ER_UNEXPECTED_GEOMETRY_TYPE
+	3517: "GIS",               // ER_SRS_PARSE_ERROR
+	3518: "GIS",               // ER_SRS_PROJ_PARAMETER_MISSING
+	3520: "GIS",               // ER_SRS_NOT_CARTESIAN
+	3521: "GIS",               // ER_SRS_NOT_CARTESIAN_UNDEFINED
+	3524: "Privileges",        // ER_FAILED_ROLE_GRANT
+	3530: "Privileges",        // ER_ROLE_NOT_GRANTED
+	3546: "Replication",       // ER_CANT_SET_GTID_PURGED_DUE_SETS_CONSTRAINTS
+	3548: "GIS",               // ER_SRS_NOT_FOUND
+	3570: "Replication",       // ER_BINLOG_UNSAFE_SKIP_LOCKED
+	3571: "Replication",       // ER_BINLOG_UNSAFE_NOWAIT
+	3573: "Optimizer",         // ER_CTE_RECURSIVE_REQUIRES_UNION
+	3574: "Optimizer",         // ER_CTE_RECURSIVE_REQUIRES_NONRECURSIVE_FIRST
+	3575: "Optimizer",         // ER_CTE_RECURSIVE_FORBIDS_AGGREGATION
+	3576: "Optimizer",         // ER_CTE_RECURSIVE_FORBIDDEN_JOIN_ORDER
+	3577: "Optimizer",         // ER_CTE_RECURSIVE_REQUIRES_SINGLE_REFERENCE
+	3579: "Optimizer",         // ER_WINDOW_NO_SUCH_WINDOW
+	3580: "Optimizer",         // ER_WINDOW_CIRCULARITY_IN_WINDOW_GRAPH
+	3581: "Partitioning",      // ER_WINDOW_NO_CHILD_PARTITIONING
+	3582: "Optimizer",         // ER_WINDOW_NO_INHERIT_FRAME
+	3583: "Optimizer",         // ER_WINDOW_NO_REDEFINE_ORDER_BY
+	3584: "Optimizer",         // ER_WINDOW_FRAME_START_ILLEGAL
+	3585: "Optimizer",         // ER_WINDOW_FRAME_END_ILLEGAL
+	3586: "Optimizer",         // ER_WINDOW_FRAME_ILLEGAL
+	3587: "Optimizer",         // ER_WINDOW_RANGE_FRAME_ORDER_TYPE
+	3588: "Optimizer",         // ER_WINDOW_RANGE_FRAME_TEMPORAL_TYPE
+	3589: "Optimizer",         // ER_WINDOW_RANGE_FRAME_NUMERIC_TYPE
+	3590: "Optimizer",         // ER_WINDOW_RANGE_BOUND_NOT_CONSTANT
+	3591: "Optimizer",         // ER_WINDOW_DUPLICATE_NAME
+	3592: "Optimizer",         // ER_WINDOW_ILLEGAL_ORDER_BY
+	3593: "Optimizer",         // ER_WINDOW_INVALID_WINDOW_FUNC_USE
+	3594: "Optimizer",         // ER_WINDOW_INVALID_WINDOW_FUNC_ALIAS_USE
+	3595: "Optimizer",         // ER_WINDOW_NESTED_WINDOW_FUNC_USE_IN_WINDOW_SPEC
+	3596: "Optimizer",         // ER_WINDOW_ROWS_INTERVAL_USE
+	3597: "Optimizer",         // ER_WINDOW_NO_GROUP_ORDER_UNUSED
+	3598: "Optimizer",         // ER_WINDOW_EXPLAIN_JSON
+	3599: "Optimizer",         // ER_WINDOW_FUNCTION_IGNORES_FRAME
+	3619: "Privileges",        // ER_ILLEGAL_PRIVILEGE_LEVEL
+	3630: "Privileges",        // ER_PERSIST_ONLY_ACCESS_DENIED_ERROR
+	3633: "Clone",             // ER_CLONE_DDL_IN_PROGRESS
+	3634: "Clone",             // ER_CLONE_TOO_MANY_CONCURRENT_CLONES
+	3636: "Optimizer",         // ER_CTE_MAX_RECURSION_DEPTH
+	3639: "Privileges",        // ER_WARNING_PASSWORD_HISTORY_CLAUSES_VOID
+	3648: "JSON",              // ER_COULD_NOT_APPLY_JSON_DIFF
+	3649: "JSON",              // ER_CORRUPTED_JSON_DIFF
+	3663: "Group Replication", // ER_GROUP_REPLICATION_COMMAND_FAILURE
+	3665: "JSON",              // ER_MISSING_JSON_TABLE_VALUE
+	3666: "JSON",              // ER_WRONG_JSON_TABLE_VALUE
+	3671: "Privileges",        // ER_PASSWORD_EXPIRATION_NOT_SUPPORTED_BY_AUTH_METHOD
+	3672: "JSON",              // ER_INVALID_GEOJSON_CRS_NOT_TOP_LEVEL
+	3683: "Replication",       // OBSOLETE_ER_BINLOG_EXPIRE_LOG_DAYS_AND_SECS_USED_TOGETHER
+	3708: "GIS",               // ER_SRS_MISSING_MANDATORY_ATTRIBUTE
+	3709: "GIS",               // ER_SRS_MULTIPLE_ATTRIBUTE_DEFINITIONS
+	3710: "GIS",               // ER_SRS_NAME_CANT_BE_EMPTY_OR_WHITESPACE
+	3711: "GIS",               // ER_SRS_ORGANIZATION_CANT_BE_EMPTY_OR_WHITESPACE
+	3712: "GIS",               // ER_SRS_ID_ALREADY_EXISTS
+	3717: "GIS",               // ER_SRS_INVALID_CHARACTER_IN_ATTRIBUTE
+	3718: "GIS",               // ER_SRS_ATTRIBUTE_STRING_TOO_LONG
+	3725: "Replication",       // ER_REPLICA_POSSIBLY_DIVERGED_AFTER_DDL
+	3726: "GIS",               // ER_SRS_NOT_GEOGRAPHIC
+	3731: "GIS",               // ER_GEOMETRY_PARAM_LONGITUDE_OUT_OF_RANGE
+	3732: "GIS",               // ER_GEOMETRY_PARAM_LATITUDE_OUT_OF_RANGE
+	3736: "GIS",               // ER_SRS_GEOGCS_INVALID_AXES
+	3737: "GIS",               // ER_SRS_INVALID_SEMI_MAJOR_AXIS
+	3738: "GIS",               // ER_SRS_INVALID_INVERSE_FLATTENING
+	3739: "GIS",               // ER_SRS_INVALID_ANGULAR_UNIT
+	3740: "GIS",               // ER_SRS_INVALID_PRIME_MERIDIAN
+	3748: "Replication",       // ER_CLIENT_GTID_UNSAFE_CREATE_DROP_TEMP_TABLE_IN_TRX_IN_SBR
+	3753: "JSON",              // ER_FUNCTIONAL_INDEX_ON_JSON_OR_GEOMETRY_FUNCTION
+	3759: "Full-Text Search",  // ER_FULLTEXT_FUNCTIONAL_INDEX
+	3775: "Replication",       // ER_GTID_UNSAFE_ALTER_ADD_COL_WITH_DEFAULT_EXPRESSION
+	3784: "Replication",       // ER_RPL_ENCRYPTION_FAILED_TO_FETCH_KEY
+	3785: "Replication",       // ER_RPL_ENCRYPTION_KEY_NOT_FOUND
+	3786: "Replication",       // ER_RPL_ENCRYPTION_KEYRING_INVALID_KEY
+	3787: "Replication",       // ER_RPL_ENCRYPTION_HEADER_ERROR
+	3788: "Replication",       // ER_RPL_ENCRYPTION_FAILED_TO_ROTATE_LOGS
+	3789: "Replication",       // ER_RPL_ENCRYPTION_KEY_EXISTS_UNEXPECTED
+	3790: "Replication",       // ER_RPL_ENCRYPTION_FAILED_TO_GENERATE_KEY
+	3791: "Replication",       // ER_RPL_ENCRYPTION_FAILED_TO_STORE_KEY
+	3792: "Replication",       // ER_RPL_ENCRYPTION_FAILED_TO_REMOVE_KEY
+	3793: "Replication",       // ER_RPL_ENCRYPTION_UNABLE_TO_CHANGE_OPTION
+	3794: "Replication",       // ER_RPL_ENCRYPTION_MASTER_KEY_RECOVERY_FAILED
+	3796: "Group Replication", // ER_GRP_TRX_CONSISTENCY_NOT_ALLOWED
+	3797: "Group Replication", // ER_GRP_TRX_CONSISTENCY_BEFORE
+	3798: "Group Replication", // ER_GRP_TRX_CONSISTENCY_AFTER_ON_TRX_BEGIN
+	3799: "Group Replication", // ER_GRP_TRX_CONSISTENCY_BEGIN_NOT_ALLOWED
+	3801: "Replication",       // ER_RPL_ENCRYPTION_FAILED_TO_ENCRYPT
+	3805: "Replication",       // ER_RPL_ENCRYPTION_CANNOT_ROTATE_BINLOG_MASTER_KEY
+	3806: "Replication",       // ER_BINLOG_MASTER_KEY_RECOVERY_OUT_OF_COMBINATION
+	3807: "Replication",       // ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_OPERATE_KEY
+	3808: "Replication",       // ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_ROTATE_LOGS
+	3809: "Replication",       // ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_REENCRYPT_LOG
+	3810: "Replication",       // ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_CLEANUP_UNUSED_KEYS
+	3811: "Replication",       // ER_BINLOG_MASTER_KEY_ROTATION_FAIL_TO_CLEANUP_AUX_KEY
+	3835: "Privileges",        // ER_UNSUPPORTED_USE_OF_GRANT_AS
+	3836: "Privileges",        // ER_UKNOWN_AUTH_ID_OR_ACCESS_DENIED_FOR_GRANT_AS
+	3839: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_START_SUBDIR_PATH
+	3840: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_START_TIMEOUT
+	3841: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_DIRS_INVALID
+	3842: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_LABEL_NOT_FOUND
+	3843: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_DIR_EMPTY
+	3844: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_NO_SUCH_DIR
+	3845: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_DIR_CLASH
+	3846: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_DIR_PERMISSIONS
+	3847: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_FILE_CREATE
+	3848: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_ACTIVE
+	3849: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_INACTIVE
+	3850: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_FAILED
+	3851: "InnoDB",            // ER_INNODB_REDO_LOG_ARCHIVE_SESSION
+	3853: "JSON",              // ER_INVALID_JSON_TYPE
+	3855: "Partitioning",      // ER_DEPENDENT_BY_PARTITION_FUNC
+	3857: "Replication",       // ER_RPL_CANT_STOP_REPLICA_WHILE_LOCKED_BACKUP
+	3862: "Clone",             // ER_CLONE_DONOR
+	3863: "Clone",             // ER_CLONE_PROTOCOL
+	3864: "Clone",             // ER_CLONE_DONOR_VERSION
+	3865: "Clone",             // ER_CLONE_OS
+	3866: "Clone",             // ER_CLONE_PLATFORM
+	3867: "Clone",             // ER_CLONE_CHARSET
+	3868: "Clone",             // ER_CLONE_CONFIG
+	3869: "Clone",             // ER_CLONE_SYS_CONFIG
+	3870: "Clone",             // ER_CLONE_PLUGIN_MATCH
+	3871: "Clone",             // ER_CLONE_LOOPBACK
+	3872: "Clone",             // ER_CLONE_ENCRYPTION
+	3873: "Clone",             // ER_CLONE_DISK_SPACE
+	3874: "Clone",             // ER_CLONE_IN_PROGRESS
+	3875: "Clone",             // ER_CLONE_DISALLOWED
+	3876: "Privileges",        // ER_CANNOT_GRANT_ROLES_TO_ANONYMOUS_USER
+	3878: "Privileges",        // ER_SECOND_PASSWORD_CANNOT_BE_EMPTY
+	3879: "Privileges",        // ER_DB_ACCESS_DENIED
+	3881: "Replication",       // ER_DA_RPL_GTID_TABLE_CANNOT_OPEN
+	3882: "GIS",               // ER_GEOMETRY_IN_UNKNOWN_LENGTH_UNIT
+	3891: "Privileges",        // ER_INCORRECT_CURRENT_PASSWORD
+	3892: "Privileges",        // ER_MISSING_CURRENT_PASSWORD
+	3893: "Privileges",        // ER_CURRENT_PASSWORD_NOT_REQUIRED
+	3894: "Privileges",        // ER_PASSWORD_CANNOT_BE_RETAINED_ON_PLUGIN_CHANGE
+	3895: "Privileges",        // ER_CURRENT_PASSWORD_CANNOT_BE_RETAINED
+	3897: "Privileges",        // ER_CANNOT_GRANT_SYSTEM_PRIV_TO_MANDATORY_ROLE
+	3901: "Privileges",        // ER_PARTIAL_REVOKE_AND_DB_GRANT_BOTH_EXISTS
+	3903: "JSON",              // ER_INVALID_JSON_VALUE_FOR_FUNC_INDEX
+	3904: "JSON",              // ER_JSON_VALUE_OUT_OF_RANGE_FOR_FUNC_INDEX
+	3910: "Group Replication", // ER_GRP_RPL_UDF_ERROR
+	3911: "Replication",       // ER_UPDATE_GTID_PURGED_WITH_GR
+	3914: "Audit",             // ER_AUDIT_LOG_INSUFFICIENT_PRIVILEGE
+	3915: "Audit",             // OBSOLETE_ER_AUDIT_LOG_PASSWORD_HAS_BEEN_COPIED
+	3919: "Group Replication", // ER_GRP_RPL_MESSAGE_SERVICE_INIT_FAILURE
+	3920: "Replication",       // ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_CLIENT
+	3921: "Replication",       // ER_CHANGE_SOURCE_WRONG_COMPRESSION_LEVEL_CLIENT
+	3924: "Replication",       // ER_CHANGE_SOURCE_WRONG_COMPRESSION_ALGORITHM_LIST_CLIENT
+	3925: "Privileges",        // ER_CLIENT_PRIVILEGE_CHECKS_USER_CANNOT_BE_ANONYMOUS
+	3926: "Privileges",        // ER_CLIENT_PRIVILEGE_CHECKS_USER_DOES_NOT_EXIST
+	3927: "Privileges",        // ER_CLIENT_PRIVILEGE_CHECKS_USER_CORRUPT
+	3928: "Privileges",        // ER_CLIENT_PRIVILEGE_CHECKS_USER_NEEDS_RPL_APPLIER_PRIV
+	3929: "Privileges",        // ER_WARN_DA_PRIVILEGE_NOT_REGISTERED
+	3930: "Keyring",           // ER_CLIENT_KEYRING_UDF_KEY_INVALID
+	3931: "Keyring",           // ER_CLIENT_KEYRING_UDF_KEY_TYPE_INVALID
+	3932: "Keyring",           // ER_CLIENT_KEYRING_UDF_KEY_TOO_LONG
+	3933: "Keyring",           // ER_CLIENT_KEYRING_UDF_KEY_TYPE_TOO_LONG
+	3934: "JSON",              // ER_JSON_SCHEMA_VALIDATION_ERROR_WITH_DETAILED_REPORT
+	3955: "Privileges",        // ER_USER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK
+	3957: "Clone",             // ER_CLONE_NETWORK_PACKET
+	3960: "Group Replication", // ER_GRP_OPERATION_NOT_ALLOWED_GR_MUST_STOP
+	3961: "JSON",              // ER_WARN_DEPRECATED_JSON_TABLE_ON_ERROR_ON_EMPTY
+	3966: "JSON",              // ER_MISSING_JSON_VALUE
+	3967: "JSON",              // ER_MULTIPLE_JSON_VALUES
+	3969: "Partitioning",      // OBSOLETE_ER_WARN_CLIENT_DEPRECATED_PARTITION_PREFIX_KEY
+	3970: "Group Replication", // ER_GROUP_REPLICATION_USER_EMPTY_MSG
+	3971: "Group Replication", // ER_GROUP_REPLICATION_USER_MANDATORY_MSG
+	3972: "Group Replication", // ER_GROUP_REPLICATION_PASSWORD_LENGTH
+	3973: "Optimizer",         // ER_SUBQUERY_TRANSFORM_REJECTED
+	3980: "JSON",              // ER_INVALID_JSON_ATTRIBUTE
+	3982: "JSON",              // ER_INVALID_USER_ATTRIBUTE_JSON
+	3983: "InnoDB",            // ER_INNODB_REDO_DISABLED
+	3984: "InnoDB",            // ER_INNODB_REDO_ARCHIVING_ENABLED
+	3986: "JSON",              // ER_IMPLICIT_COMPARISON_FOR_JSON
+	3990: "Replication",       // ER_RPL_ASYNC_RECONNECT_GTID_MODE_OFF
+	3991: "Replication",       // ER_RPL_ASYNC_RECONNECT_AUTO_POSITION_OFF
+	3992: "Replication",       // ER_DISABLE_GTID_MODE_REQUIRES_ASYNC_RECONNECT_OFF
+	4006: "Privileges",        // ER_CANNOT_USER_REFERENCED_AS_DEFINER
+	4010: "Replication",       // ER_BINLOG_UNSAFE_ACL_TABLE_READ_IN_DML_DDL
+	4013: "Replication",       // ER_CANT_USE_ANONYMOUS_TO_GTID_WITH_GTID_MODE_NOT_ON
+	4014: "Replication",       // ER_CANT_COMBINE_ANONYMOUS_TO_GTID_AND_AUTOPOSITION
+	4015: "Replication",       // ER_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_REQUIRES_GTID_MODE_ON
+	4016: "Replication",       // ER_SQL_REPLICA_SKIP_COUNTER_USED_WITH_GTID_MODE_ON
+	4017: "Replication",       // ER_USING_ASSIGN_GTIDS_TO_ANONYMOUS_TRANSACTIONS_AS_LOCAL_OR_UUID
+	4018: "Replication",       // OBSOLETE_ER_SET_GTID_TO_ANON_AND_WAIT_UNTIL_SQL_THD_AFTER_GTIDS
+	4019: "Replication",       // ER_CANT_SET_SQL_AFTER_OR_BEFORE_GTIDS_WITH_ANONYMOUS_TO_GTID
+	4020: "Replication",       // ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_GROUP_NAME
+	4022: "Group Replication", // ER_GRP_RPL_RECOVERY_CHANNEL_STILL_RUNNING
+	4023: "InnoDB",            // ER_INNODB_INVALID_AUTOEXTEND_SIZE_VALUE
+	4024: "InnoDB",            // ER_INNODB_INCOMPATIBLE_WITH_TABLESPACE
+	4025: "InnoDB",            // ER_INNODB_AUTOEXTEND_SIZE_OUT_OF_RANGE
+	4027: "Privileges",        // ER_ROLE_GRANTED_TO_ITSELF
+	4029: "InnoDB",            // ER_INNODB_COMPRESSION_FAILURE
+	4032: "GIS",               // ER_INVALID_CAST_TO_GEOMETRY
+	4034: "GIS",               // ER_GIS_DIFFERENT_SRIDS_AGGREGATION
+	4035: "Keyring",           // ER_RELOAD_KEYRING_FAILURE
+	4040: "Replication",       // ER_ANONYMOUS_TO_GTID_UUID_SAME_AS_VIEW_CHANGE_UUID
+	4041: "Group Replication", // ER_GRP_RPL_VIEW_CHANGE_UUID_FAIL_GET_VARIABLE
+	4050: "Group Replication", // ER_GRP_RPL_FAILOVER_CHANNEL_STATUS_PROPAGATION
+	4051: "JSON",              // ER_WARN_AUDIT_LOG_FORMAT_UNIX_TIMESTAMP_ONLY_WHEN_JSON
+	4064: "Privileges",        // ER_INVALID_MFA_OPERATIONS_FOR_PASSWORDLESS_USER
+	4065: "Replication",       // ER_CHANGE_REPLICATION_SOURCE_NO_OPTIONS_FOR_GTID_ONLY
+	4066: "Replication",       // ER_CHANGE_REP_SOURCE_CANT_DISABLE_REQ_ROW_FORMAT_WITH_GTID_ONLY
+	4067: "Replication",       // ER_CHANGE_REP_SOURCE_CANT_DISABLE_AUTO_POSITION_WITH_GTID_ONLY
+	4068: "Replication",       // ER_CHANGE_REP_SOURCE_CANT_DISABLE_GTID_ONLY_WITHOUT_POSITIONS
+	4070: "Replication",       // ER_CHANGE_REP_SOURCE_GR_CHANNEL_WITH_GTID_MODE_NOT_ON
+	4071: "Replication",       // ER_CANT_USE_GTID_ONLY_WITH_GTID_MODE_NOT_ON
+	4072: "Replication",       // ER_WARN_C_DISABLE_GTID_ONLY_WITH_SOURCE_AUTO_POS_INVALID_POS
+	4075: "Full-Text Search",  // ER_FULLTEXT_WITH_ROLLUP
+	4090: "Replication",       // ER_CANT_EXECUTE_COMMAND_WITH_ASSIGNED_GTID_NEXT
+	4092: "InnoDB",            // ER_INNODB_MAX_ROW_VERSION
+	4093: "InnoDB",            // OBSOLETE_ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_SIZE
+	4102: "Privileges",        // ER_SET_PASSWORD_AUTH_PLUGIN_ERROR
+	4105: "GIS",               // ER_SRS_INVALID_LATITUDE_OF_ORIGIN
+	4106: "GIS",               // ER_SRS_INVALID_LONGITUDE_OF_ORIGIN
+	4107: "GIS",               // ER_SRS_UNUSED_PROJ_PARAMETER_PRESENT
+	4114: "Optimizer",         // ER_CTE_RECURSIVE_NOT_UNION
+	4117: "Privileges",        // ER_CLIENT_FILE_PRIVILEGE_FOR_REPLICATION_CHECKS
+	4118: "Group Replication", // ER_GROUP_REPLICATION_FORCE_MEMBERS_COMMAND_FAILURE
+	4124: "GIS",               // ER_SRS_INVALID_HEIGHT
+	4125: "GIS",               // ER_SRS_INVALID_SCALING
+	4126: "GIS",               // ER_SRS_INVALID_ZONE_WIDTH
+	4127: "GIS",               // ER_SRS_INVALID_LATITUDE_POLAR_STERE_VAR_A
+	4157: "InnoDB",            // ER_INNODB_INSTANT_ADD_DROP_NOT_SUPPORTED_MAX_SIZE
+	4158: "InnoDB",            // ER_INNODB_INSTANT_ADD_NOT_SUPPORTED_MAX_FIELDS
+	4165: "Privileges",        // ER_VALIDATE_PASSWORD_INSUFFICIENT_CHANGED_CHARACTERS
+	6004: "Stored Programs",   // ER_SP_NO_ALTER_LANGUAGE
+	6010: "InnoDB",            // ER_INNODB_IMPORT_WRONG_DROPPED_ENUM_LENGTH
+	6011: "InnoDB",            // ER_INNODB_IMPORT_WRONG_NUMBER_OF_INDEXES_ZERO
+	6012: "InnoDB",            // ER_INNODB_IMPORT_WRONG_NUMBER_OF_INDEXES_TOO_HIGH
+	6013: "InnoDB",            // ER_INNODB_IMPORT_DROP_COL_METADATA_MISMATCH
+	6014: "InnoDB",            // ER_INNODB_IMPORT_ENUM_NULL_TERMINATOR_MISSING
+	6016: "Privileges",        // ER_WARN_DEPRECATED_DYNAMIC_PRIV_IN_GRANT
+	6038: "Privileges",        // ER_SPECIFIC_ACCESS_DENIED
+	6039: "Replication",       // ER_CANT_SET_GTID_NEXT_TO_AUTOMATIC_TAGGED_WHEN_GTID_MODE_IS_OFF
+	6040: "Replication",       // ER_GTID_NEXT_TAG_GTID_MODE_OFF
+	6121: "JSON",              // ER_LH_JSON_PARSING
+	6123: "Partitioning",      // ER_PARTITION_PREFIX_KEY_NOT_SUPPORTED
+	6126: "Privileges",        // ER_ACCESS_DENIED_NO_PROXY_GRANT
+	6127: "Privileges",        // ER_ACCESS_DENIED_NO_PROXY
+	6131: "JSON",              // ER_LH_INVALID_JSON_FILE_FORMAT_SCHEMA
+	6132: "JSON",              // ER_LH_INFER_JSON_INVALID_SCHEMA
+	6133: "JSON",              // ER_LH_JSON_FILE_FORMAT_WARN_INFER_SCHEMA
+}
@@ -0,0 +1,27 @@
+package mysqlerr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrorLogSymbol returns the identifier MySQL 8 error logs print for code, such as "MY-001062".
+func ErrorLogSymbol(code int) string {
+	return fmt.Sprintf("MY-%06d", code)
+}
+
+// ParseErrorLogSymbol returns the code of an error log identifier such as "MY-010926".
+// The codes of the messages sent to clients and of those only written to the error log
+// share one number space, so the result can be looked up in either.
+func ParseErrorLogSymbol(s string) (int, error) {
+	digits := strings.TrimPrefix(s, "MY-")
+	if digits == s || len(digits) != 6 {
+		return 0, fmt.Errorf("invalid error log symbol: %q", s)
+	}
+	code, err := strconv.Atoi(digits)
+	if err != nil || code < 0 {
+		return 0, fmt.Errorf("invalid error log symbol: %q", s)
+	}
+	return code, nil
+}
@@ -0,0 +1,152 @@
+package mysqlerr
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Template is a compiled MySQL message template such as "Duplicate entry '%-.192s' for key '%-.192s'".
+type Template struct {
+	source   string
+	re       *regexp.Regexp
+	format   string
+	literals int
+}
+
+// CompileTemplate compiles a message template written with the printf-style directives MySQL uses.
+func CompileTemplate(tmpl string) (*Template, error) {
+	var b, f strings.Builder
+	b.WriteString(`(?s)^`)
+	literals := 0
+	for i := 0; i < len(tmpl); i++ {
+		if tmpl[i] != '%' {
+			j := strings.IndexByte(tmpl[i:], '%')
+			if j < 0 {
+				j = len(tmpl) - i
+			}
+			b.WriteString(regexp.QuoteMeta(tmpl[i : i+j]))
+			f.WriteString(tmpl[i : i+j])
+			literals += j
+			i += j - 1
+			continue
+		}
+		pattern, n, err := directivePattern(tmpl[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("compile template %q: %w", tmpl, err)
+		}
+		if pattern == "%" {
+			b.WriteString("%")
+			f.WriteString("%%")
+			literals++
+		} else {
+			b.WriteString(pattern)
+			f.WriteString(goDirective(tmpl[i+1 : i+1+n]))
+		}
+		i += n
+	}
+	b.WriteString(`$`)
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("compile template %q: %w", tmpl, err)
+	}
+	return &Template{source: tmpl, re: re, format: f.String(), literals: literals}, nil
+}
+
+// MustCompileTemplate is like CompileTemplate but panics if the template cannot be compiled.
+func MustCompileTemplate(tmpl string) *Template {
+	t, err := CompileTemplate(tmpl)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// directivePattern returns the pattern matching the directive at the start of s (after the %)
+// and the number of bytes it spans. The pattern "%" stands for a literal percent sign.
+func directivePattern(s string) (string, int, error) {
+	i := 0
+	for i < len(s) && strings.IndexByte("-+ #0`", s[i]) >= 0 {
+		i++
+	}
+	for i < len(s) && (s[i] == '*' || '0' <= s[i] && s[i] <= '9') {
+		i++
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		for i < len(s) && (s[i] == '*' || '0' <= s[i] && s[i] <= '9') {
+			i++
+		}
+	}
+	for i < len(s) && strings.IndexByte("hlLqjzt", s[i]) >= 0 {
+		i++
+	}
+	if i >= len(s) {
+		return "", 0, fmt.Errorf("incomplete directive %%%s", s)
+	}
+	switch s[i] {
+	case '%':
+		return "%", i + 1, nil
+	case 's', 'M', 'b':
+		return `(.*?)`, i + 1, nil
+	case 'd', 'i', 'u':
+		return `(-?\d+)`, i + 1, nil
+	case 'x', 'X', 'p':
+		return `((?:0x)?[0-9a-fA-F]+)`, i + 1, nil
+	case 'o':
+		return `([0-7]+)`, i + 1, nil
+	case 'f', 'g', 'e', 'G', 'E':
+		return `([-+]?[0-9.]+(?:[eE][-+]?\d+)?|inf|nan)`, i + 1, nil
+	case 'c':
+		return `(.)`, i + 1, nil
+	default:
+		return "", 0, fmt.Errorf("unknown directive %%%s", s[:i+1])
+	}
+}
+
+// goDirective translates a MySQL directive (without the %) to the fmt verb printing the same.
+func goDirective(d string) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	for i := 0; i < len(d)-1; i++ {
+		if strings.IndexByte("`hlLqjzt", d[i]) < 0 {
+			b.WriteByte(d[i])
+		}
+	}
+	switch c := d[len(d)-1]; c {
+	case 'M', 'b':
+		b.WriteByte('s')
+	case 'i', 'u':
+		b.WriteByte('d')
+	case 'p':
+		b.WriteByte('v')
+	default:
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// Format renders the template with args as the server would.
+func (t *Template) Format(args ...interface{}) string {
+	return fmt.Sprintf(t.format, args...)
+}
+
+// String returns the template source.
+func (t *Template) String() string {
+	return t.source
+}
+
+// Match reports whether msg was produced from the template and returns the values of its directives.
+func (t *Template) Match(msg string) ([]string, bool) {
+	m := t.re.FindStringSubmatch(msg)
+	if m == nil {
+		return nil, false
+	}
+	return m[1:], true
+}
+
+// Specificity returns the number of literal bytes in the template.
+// When several templates match a message, the most specific one is the most likely source.
+func (t *Template) Specificity() int {
+	return t.literals
+}
@@ -0,0 +1,108 @@
+package mysqlerr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTemplateMatch(t *testing.T) {
+	tests := []struct {
+		tmpl string
+		msg  string
+		want []string
+	}{
+		{"Duplicate entry '%-.192s' for key '%-.192s'", "Duplicate entry 'a' for key 't.PRIMARY'", []string{"a", "t.PRIMARY"}},
+		{"Duplicate entry '%-.192s' for key '%-.192s'", "Duplicate entry 'it's' for key 'k'", []string{"it's", "k"}},
+		{"Data too long for column '%s' at row %ld", "Data too long for column 'c' at row 12", []string{"c", "12"}},
+		{"Out of range value for column '%s' at row %ld", "Out of range value for column 'c' at row -1", []string{"c", "-1"}},
+		{"Got error %d - '%-.192s' from storage engine", "Got error 28 - 'No space\nleft' from storage engine", []string{"28", "No space\nleft"}},
+		{"Can't create table '%-.200s' (errno: %d - %s)", "Can't create table 't' (errno: 150 - Foreign key constraint is incorrectly formed)", []string{"t", "150", "Foreign key constraint is incorrectly formed"}},
+		{"Thread stack overrun: %ld bytes used of a %ld byte stack", "Thread stack overrun: 10 bytes used of a 20 byte stack", []string{"10", "20"}},
+		{"Query execution was interrupted, maximum statement execution time exceeded", "Query execution was interrupted, maximum statement execution time exceeded", []string{}},
+		{"%d%% done", "50% done", []string{"50"}},
+		{"address %p", "address 0x7f00ab", []string{"0x7f00ab"}},
+		{"ratio %f", "ratio 0.5", []string{"0.5"}},
+		{"char %c!", "char x!", []string{"x"}},
+	}
+	for _, tt := range tests {
+		got, ok := MustCompileTemplate(tt.tmpl).Match(tt.msg)
+		if !ok {
+			t.Errorf("%q did not match %q", tt.tmpl, tt.msg)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q matched %q with %q, want %q", tt.tmpl, tt.msg, got, tt.want)
+		}
+	}
+}
+
+func TestTemplateMismatch(t *testing.T) {
+	tests := []struct {
+		tmpl string
+		msg  string
+	}{
+		{"Unknown column '%-.192s' in '%-.192s'", "Unknown table 'c' in 'field list'"},
+		{"Data too long for column '%s' at row %ld", "Data too long for column 'c' at row one"},
+		{"Table '%-.192s' already exists", "Table 't' already exists; try another"},
+		{"Table '%-.192s' already exists", "Error: Table 't' already exists"},
+		{"ratio %f", "ratio half"},
+		{"char %c!", "char xy!"},
+	}
+	for _, tt := range tests {
+		if v, ok := MustCompileTemplate(tt.tmpl).Match(tt.msg); ok {
+			t.Errorf("%q matched %q with %q", tt.tmpl, tt.msg, v)
+		}
+	}
+}
+
+func TestTemplateFormat(t *testing.T) {
+	tests := []struct {
+		tmpl string
+		args []interface{}
+		want string
+	}{
+		{"Duplicate entry '%-.192s' for key '%-.192s'", []interface{}{"a", "PRIMARY"}, "Duplicate entry 'a' for key 'PRIMARY'"},
+		{"Data too long for column '%s' at row %ld", []interface{}{"c", 3}, "Data too long for column 'c' at row 3"},
+		{"Unknown error %u", []interface{}{7}, "Unknown error 7"},
+		{"Error from %`s", []interface{}{"ndb"}, "Error from ndb"},
+		{"%.3s", []interface{}{"abcdef"}, "abc"},
+		{"%d%% done", []interface{}{50}, "50% done"},
+	}
+	for _, tt := range tests {
+		tmpl := MustCompileTemplate(tt.tmpl)
+		got := tmpl.Format(tt.args...)
+		if got != tt.want {
+			t.Errorf("%q formatted %v as %q, want %q", tt.tmpl, tt.args, got, tt.want)
+			continue
+		}
+		if _, ok := tmpl.Match(got); !ok {
+			t.Errorf("%q did not match its own formatting %q", tt.tmpl, got)
+		}
+	}
+}
+
+func TestTemplateSpecificity(t *testing.T) {
+	general := MustCompileTemplate("Got error %d from storage engine %s")
+	specific := MustCompileTemplate("Got error %d from storage engine InnoDB")
+	msg := "Got error 28 from storage engine InnoDB"
+	if _, ok := general.Match(msg); !ok {
+		t.Fatalf("%q did not match %q", general, msg)
+	}
+	if _, ok := specific.Match(msg); !ok {
+		t.Fatalf("%q did not match %q", specific, msg)
+	}
+	if specific.Specificity() <= general.Specificity() {
+		t.Errorf("Specificity of %q = %d, not more than %d of %q", specific, specific.Specificity(), general.Specificity(), general)
+	}
+	if got, want := MustCompileTemplate("%d%% done").Specificity(), len("% done"); got != want {
+		t.Errorf("Specificity of %%d%%%% done = %d, want %d", got, want)
+	}
+}
+
+func TestCompileTemplateErrors(t *testing.T) {
+	for _, tmpl := range []string{"trailing %", "width %-10", "unknown %y", "length %l"} {
+		if _, err := CompileTemplate(tmpl); err == nil {
+			t.Errorf("CompileTemplate(%q) succeeded", tmpl)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Package tidb provides the error codes of TiDB, the MySQL ones it shares included, and helpers to classify the ones TiDB adds.
+package tidb
+
+import (
+	"github.com/orisano/mysqlerr"
+)
+
+// IsRetryable reports whether err is a transient TiDB or storage failure
+// after which the whole transaction can be retried.
+func IsRetryable(err error) bool {
+	switch mysqlerr.Code(err) {
+	case ErrWriteConflictInTiDB, ErrTxnRetryable, ErrInfoSchemaExpired, ErrInfoSchemaChanged,
+		ErrPDServerTimeout, ErrTiKVServerTimeout, ErrTiKVServerBusy, ErrResolveLockTimeout,
+		ErrRegionUnavailable, ErrWriteConflict, ErrTiKVStaleCommand, ErrTiKVMaxTimestampNotSynced,
+		ErrTiFlashServerTimeout, ErrTiFlashServerBusy,
+		mysqlerr.ER_LOCK_DEADLOCK, mysqlerr.ER_LOCK_WAIT_TIMEOUT:
+		return true
+	}
+	return false
+}
+
+// IsWriteConflict reports whether err is an optimistic transaction conflict.
+func IsWriteConflict(err error) bool {
+	switch mysqlerr.Code(err) {
+	case ErrWriteConflict, ErrWriteConflictInTiDB:
+		return true
+	}
+	return false
+}
+
+// IsSchemaChanged reports whether err was caused by a concurrent DDL.
+func IsSchemaChanged(err error) bool {
+	switch mysqlerr.Code(err) {
+	case ErrInfoSchemaExpired, ErrInfoSchemaChanged:
+		return true
+	}
+	return false
+}
+
+// IsMemoryQuotaExceeded reports whether err is a query or instance exceeding its memory quota.
+func IsMemoryQuotaExceeded(err error) bool {
+	switch mysqlerr.Code(err) {
+	case ErrMemExceedThreshold, ErrMemoryExceedForQuery, ErrMemoryExceedForInstance:
+		return true
+	}
+	return false
+}
+
+// IsTxnTooLarge reports whether err is a transaction or an entry exceeding TiDB's size limits.
+func IsTxnTooLarge(err error) bool {
+	switch mysqlerr.Code(err) {
+	case ErrTxnTooLarge, ErrEntryTooLarge:
+		return true
+	}
+	return false
+}
+
+// IsTiDB reports whether err has a TiDB-specific code.
+func IsTiDB(err error) bool {
+	code := mysqlerr.Code(err)
+	return 8000 <= code && code < 10000
+}
+
+// IsStorage reports whether err comes from the storage layer (PD, TiKV, TiFlash).
+func IsStorage(err error) bool {
+	code := mysqlerr.Code(err)
+	return 9000 <= code && code < 10000
+}
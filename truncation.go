@@ -0,0 +1,57 @@
+package mysqlerr
+
+// columnTemplate is a message of a truncation or range error naming a column,
+// with the index of the directive holding it.
+type columnTemplate struct {
+	tmpl   *Template
+	column int
+}
+
+// columnTemplates are the messages of the truncation and range errors naming a column.
+var columnTemplates = map[int][]columnTemplate{
+	ER_DATA_TOO_LONG:          {{MustCompileTemplate("Data too long for column '%s' at row %ld"), 0}},
+	WARN_DATA_TRUNCATED:       {{MustCompileTemplate("Data truncated for column '%s' at row %ld"), 0}},
+	ER_WARN_DATA_OUT_OF_RANGE: {{MustCompileTemplate("Out of range value for column '%s' at row %ld"), 0}},
+	ER_TRUNCATED_WRONG_VALUE_FOR_FIELD: {
+		{MustCompileTemplate("Incorrect %-.32s value: '%-.128s' for column '%.192s' at row %ld"), 2},
+		// MariaDB names the column with its database and table: `db`.`t`.`c`.
+		{MustCompileTemplate("Incorrect %-.32s value: '%-.128s' for column `%.192s`.`%.192s`.`%.192s` at row %ld"), 4},
+	},
+}
+
+// IsDataTruncation reports whether a value did not fit its column or type,
+// as strict SQL modes turn such warnings into errors.
+func IsDataTruncation(err error) bool {
+	switch Code(err) {
+	case ER_DATA_TOO_LONG,
+		WARN_DATA_TRUNCATED,
+		ER_TRUNCATED_WRONG_VALUE,
+		ER_TRUNCATED_WRONG_VALUE_FOR_FIELD:
+		return true
+	}
+	return false
+}
+
+// IsOutOfRange reports whether a numeric value was out of the range of its column or type.
+func IsOutOfRange(err error) bool {
+	switch Code(err) {
+	case ER_WARN_DATA_OUT_OF_RANGE, ER_DATA_OUT_OF_RANGE:
+		return true
+	}
+	return false
+}
+
+// TruncatedColumn returns the column named by a truncation or range error.
+// It reports false if err is not such an error or its message does not name a column.
+func TruncatedColumn(err error) (string, bool) {
+	e, ok := FromError(err)
+	if !ok {
+		return "", false
+	}
+	for _, t := range columnTemplates[int(e.Number)] {
+		if values, ok := t.tmpl.Match(e.Message); ok {
+			return values[t.column], true
+		}
+	}
+	return "", false
+}
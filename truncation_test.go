@@ -0,0 +1,43 @@
+package mysqlerr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestTruncation(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		truncation bool
+		outOfRange bool
+		column     string
+	}{
+		{"too long", &Error{Number: ER_DATA_TOO_LONG, Message: "Data too long for column 'name' at row 1"}, true, false, "name"},
+		{"truncated", &Error{Number: WARN_DATA_TRUNCATED, Message: "Data truncated for column 'status' at row 3"}, true, false, "status"},
+		{"out of range", &Error{Number: ER_WARN_DATA_OUT_OF_RANGE, Message: "Out of range value for column 'age' at row 1"}, false, true, "age"},
+		{"incorrect value", &Error{Number: ER_TRUNCATED_WRONG_VALUE_FOR_FIELD, Message: "Incorrect integer value: 'abc' for column 'id' at row 1"}, true, false, "id"},
+		{"MariaDB incorrect value", &driverError{Number: 1366, Message: "Incorrect integer value: 'abc' for column `test`.`t1`.`id` at row 1"}, true, false, "id"},
+		{"MariaDB too long", &driverError{Number: 1406, Message: "Data too long for column 'name' at row 1"}, true, false, "name"},
+		{"wrong value", &Error{Number: ER_TRUNCATED_WRONG_VALUE, Message: "Truncated incorrect DOUBLE value: 'x'"}, true, false, ""},
+		{"BIGINT out of range", &Error{Number: ER_DATA_OUT_OF_RANGE, Message: "BIGINT UNSIGNED value is out of range in '(1 - 2)'"}, false, true, ""},
+		{"unknown message", &Error{Number: ER_DATA_TOO_LONG, Message: "too long"}, true, false, ""},
+		{"wrapped", fmt.Errorf("insert: %w", &Error{Number: ER_DATA_TOO_LONG, Message: "Data too long for column 'c' at row 2"}), true, false, "c"},
+		{"joined", joinError{errors.New("batch"), &Error{Number: ER_WARN_DATA_OUT_OF_RANGE, Message: "Out of range value for column 'n' at row 9"}}, false, true, "n"},
+		{"duplicate entry", &Error{Number: ER_DUP_ENTRY, Message: "Duplicate entry 'a' for key 'PRIMARY'"}, false, false, ""},
+		{"nil", nil, false, false, ""},
+	}
+	for _, tt := range tests {
+		if got := IsDataTruncation(tt.err); got != tt.truncation {
+			t.Errorf("%s: IsDataTruncation = %v, want %v", tt.name, got, tt.truncation)
+		}
+		if got := IsOutOfRange(tt.err); got != tt.outOfRange {
+			t.Errorf("%s: IsOutOfRange = %v, want %v", tt.name, got, tt.outOfRange)
+		}
+		column, ok := TruncatedColumn(tt.err)
+		if column != tt.column || ok != (tt.column != "") {
+			t.Errorf("%s: TruncatedColumn = %q, %v, want %q", tt.name, column, ok, tt.column)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package mysqlerr
+
+import "fmt"
+
+// UnknownName returns the placeholder Name returns for a code it does not know, such as UNKNOWN_ERROR_4242.
+func UnknownName(code int) string {
+	return fmt.Sprintf("UNKNOWN_ERROR_%d", code)
+}
+
+// Unknown returns an error with code for the codes this package does not know,
+// such as those of a server newer than it. Its SQLSTATE is DefaultSQLState
+// and every classification helper treats it as unspecified.
+func Unknown(code uint16) *Error {
+	return &Error{Number: code, SQLState: DefaultSQLState, Message: fmt.Sprintf("Unknown error %d", code)}
+}
@@ -0,0 +1,62 @@
+// Package vitess teaches mysqlerr the errors of Vitess.
+//
+// vtgate and vttablet report MySQL errors as text such as
+//
+//	target: ks.-80.primary: vttablet: rpc error: code = AlreadyExists desc = Duplicate entry '1' for key 'PRIMARY' (errno 1062) (sqlstate 23000) (CallerID: app): Sql: "insert into t values (:v1)", BindVars: {}
+//
+// and often wrap them in ER_UNKNOWN_ERROR on the MySQL protocol.
+// Importing this package registers an extractor so that mysqlerr.Code and
+// mysqlerr.FromError return the embedded error instead:
+//
+//	import _ "github.com/orisano/mysqlerr/vitess"
+package vitess
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/orisano/mysqlerr"
+)
+
+func init() {
+	mysqlerr.RegisterExtractor(Extract)
+}
+
+var embedded = regexp.MustCompile(`\(errno (\d+)\) \(sqlstate ([0-9A-Z]{5})\)`)
+
+// rpcCode matches the gRPC status vttablet and vtgate prefix their errors with.
+var rpcCode = regexp.MustCompile(`code = (\w+) desc = `)
+
+// Extract returns the MySQL error embedded in the text of a Vitess error.
+// It only looks at err itself, not at the errors it wraps.
+func Extract(err error) (*mysqlerr.Error, bool) {
+	msg := err.Error()
+	loc := embedded.FindStringSubmatchIndex(msg)
+	if loc == nil {
+		return nil, false
+	}
+	code, err := strconv.Atoi(msg[loc[2]:loc[3]])
+	if err != nil || code > 0xffff {
+		return nil, false
+	}
+	text := msg[:loc[0]]
+	if m := rpcCode.FindAllStringIndex(text, -1); m != nil {
+		text = text[m[len(m)-1][1]:]
+	}
+	return &mysqlerr.Error{
+		Number:   uint16(code),
+		SQLState: msg[loc[4]:loc[5]],
+		Message:  strings.TrimSpace(text),
+	}, true
+}
+
+// RPCCode returns the gRPC status code name, such as "AlreadyExists" or "Unavailable",
+// a Vitess error was reported with, or "" if there is none.
+func RPCCode(err error) string {
+	m := rpcCode.FindAllStringSubmatch(err.Error(), -1)
+	if m == nil {
+		return ""
+	}
+	return m[len(m)-1][1]
+}
@@ -0,0 +1,89 @@
+// Package wire encodes and decodes the ERR packets of the MySQL client/server protocol,
+// for servers, proxies and sniffers speaking it.
+package wire
+
+import (
+	"errors"
+
+	"github.com/orisano/mysqlerr"
+)
+
+// ClientProtocol41 is the CLIENT_PROTOCOL_41 capability flag.
+// Without it ERR packets carry no SQLSTATE.
+const ClientProtocol41 uint32 = 0x00000200
+
+const (
+	errHeader      = 0xff
+	sqlStateMarker = '#'
+	// maxPayload is the largest payload of a single packet.
+	maxPayload = 1<<24 - 1
+)
+
+// EncodeERRPacket returns the payload of the ERR packet for a client with CLIENT_PROTOCOL_41.
+// An sqlstate that is not 5 bytes long is replaced by the SQLSTATE of code.
+func EncodeERRPacket(code uint16, sqlstate, msg string) []byte {
+	return AppendERRPacket(nil, code, sqlstate, msg, ClientProtocol41)
+}
+
+// EncodeError returns the payload of the ERR packet for e and a client with capabilities.
+func EncodeError(e *mysqlerr.Error, capabilities uint32) []byte {
+	return AppendERRPacket(nil, e.Number, e.SQLState, e.Message, capabilities)
+}
+
+// AppendERRPacket appends the payload of an ERR packet for a client with capabilities to b.
+// The MySQL 4.0 format without SQLSTATE is used unless capabilities has ClientProtocol41.
+func AppendERRPacket(b []byte, code uint16, sqlstate, msg string, capabilities uint32) []byte {
+	b = append(b, errHeader, byte(code), byte(code>>8))
+	if capabilities&ClientProtocol41 != 0 {
+		if len(sqlstate) != 5 {
+			sqlstate = mysqlerr.SQLStateOf(int(code))
+		}
+		b = append(b, sqlStateMarker)
+		b = append(b, sqlstate...)
+	}
+	return append(b, msg...)
+}
+
+// AppendPacket appends payload to b framed as packets starting at sequence number seq,
+// splitting it as the protocol requires when it exceeds 16MiB-1 bytes.
+func AppendPacket(b []byte, seq uint8, payload []byte) []byte {
+	for {
+		n := len(payload)
+		if n > maxPayload {
+			n = maxPayload
+		}
+		b = append(b, byte(n), byte(n>>8), byte(n>>16), seq)
+		b = append(b, payload[:n]...)
+		payload = payload[n:]
+		seq++
+		// A payload of exactly maxPayload bytes is followed by an empty packet.
+		if n < maxPayload {
+			return b
+		}
+	}
+}
+
+// DecodeERRPacket decodes the payload of an ERR packet sent to a client with capabilities.
+// When the packet carries no SQLSTATE, as before CLIENT_PROTOCOL_41 or during the handshake,
+// the SQLSTATE of the code is filled in from the catalog.
+func DecodeERRPacket(b []byte, capabilities uint32) (*mysqlerr.Error, error) {
+	if len(b) == 0 || b[0] != errHeader {
+		return nil, errors.New("not an ERR packet")
+	}
+	if len(b) < 3 {
+		return nil, errors.New("ERR packet too short")
+	}
+	e := &mysqlerr.Error{Number: uint16(b[1]) | uint16(b[2])<<8}
+	rest := b[3:]
+	if capabilities&ClientProtocol41 != 0 && len(rest) > 0 && rest[0] == sqlStateMarker {
+		if len(rest) < 6 {
+			return nil, errors.New("ERR packet too short for SQLSTATE")
+		}
+		e.SQLState = string(rest[1:6])
+		rest = rest[6:]
+	} else {
+		e.SQLState = mysqlerr.SQLStateOf(int(e.Number))
+	}
+	e.Message = string(rest)
+	return e, nil
+}
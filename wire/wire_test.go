@@ -0,0 +1,104 @@
+package wire
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/orisano/mysqlerr"
+)
+
+func TestEncodeERRPacket(t *testing.T) {
+	tests := []struct {
+		name         string
+		code         uint16
+		sqlstate     string
+		msg          string
+		capabilities uint32
+		want         []byte
+	}{
+		{"protocol 41", 1062, "23000", "dup", ClientProtocol41, []byte("\xff\x26\x04#23000dup")},
+		{"protocol 40", 1062, "23000", "dup", 0, []byte("\xff\x26\x04dup")},
+		{"state from catalog", mysqlerr.ER_LOCK_DEADLOCK, "", "x", ClientProtocol41, []byte("\xff\xbd\x04#40001x")},
+		{"invalid state", mysqlerr.ER_LOCK_DEADLOCK, "4000", "x", ClientProtocol41, []byte("\xff\xbd\x04#40001x")},
+		{"empty message", 1040, "08004", "", ClientProtocol41, []byte("\xff\x10\x04#08004")},
+	}
+	for _, tt := range tests {
+		got := AppendERRPacket(nil, tt.code, tt.sqlstate, tt.msg, tt.capabilities)
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: % x, want % x", tt.name, got, tt.want)
+		}
+	}
+	if got, want := EncodeERRPacket(1062, "23000", "dup"), tests[0].want; !bytes.Equal(got, want) {
+		t.Errorf("EncodeERRPacket: % x, want % x", got, want)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, e := range []*mysqlerr.Error{
+		{Number: 1062, SQLState: "23000", Message: "Duplicate entry 'a' for key 'PRIMARY'"},
+		{Number: 65535, SQLState: "HY000", Message: ""},
+		{Number: 1045, SQLState: "28000", Message: "Access denied for user 'root'@'localhost' (using password: YES)"},
+	} {
+		got, err := DecodeERRPacket(EncodeError(e, ClientProtocol41), ClientProtocol41)
+		if err != nil {
+			t.Errorf("%v: %v", e, err)
+			continue
+		}
+		if *got != *e {
+			t.Errorf("round trip of %v: %v", e, got)
+		}
+	}
+}
+
+func TestDecodeERRPacket(t *testing.T) {
+	// Without CLIENT_PROTOCOL_41, or in the handshake, a leading # is part of the message.
+	e, err := DecodeERRPacket([]byte("\xff\xbd\x04#40001x"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Number != mysqlerr.ER_LOCK_DEADLOCK || e.SQLState != "40001" || e.Message != "#40001x" {
+		t.Errorf("protocol 40: %+v", e)
+	}
+	e, err = DecodeERRPacket([]byte("\xff\xbd\x04deadlock"), ClientProtocol41)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.SQLState != "40001" || e.Message != "deadlock" {
+		t.Errorf("no marker: %+v", e)
+	}
+	for _, b := range [][]byte{nil, []byte("\x00\x00\x00"), []byte("\xff\x26"), []byte("\xff\x26\x04#230")} {
+		if e, err := DecodeERRPacket(b, ClientProtocol41); err == nil {
+			t.Errorf("DecodeERRPacket(% x) = %+v, want an error", b, e)
+		}
+	}
+}
+
+func TestAppendPacket(t *testing.T) {
+	got := AppendPacket(nil, 3, []byte("abc"))
+	if want := []byte("\x03\x00\x00\x03abc"); !bytes.Equal(got, want) {
+		t.Errorf("small payload: % x, want % x", got, want)
+	}
+	for _, n := range []int{maxPayload, maxPayload + 1} {
+		payload := bytes.Repeat([]byte{'x'}, n)
+		b := AppendPacket(nil, 0xff, payload)
+		var sizes []int
+		var seqs []uint8
+		var joined []byte
+		for len(b) > 0 {
+			size := int(b[0]) | int(b[1])<<8 | int(b[2])<<16
+			sizes = append(sizes, size)
+			seqs = append(seqs, b[3])
+			joined = append(joined, b[4:4+size]...)
+			b = b[4+size:]
+		}
+		if len(sizes) != 2 || sizes[0] != maxPayload || sizes[1] != n-maxPayload {
+			t.Errorf("%d bytes: packets of %v", n, sizes)
+		}
+		if seqs[0] != 0xff || seqs[1] != 0 {
+			t.Errorf("%d bytes: sequence numbers %v, want [255 0]", n, seqs)
+		}
+		if !bytes.Equal(joined, payload) {
+			t.Errorf("%d bytes: payload changed", n)
+		}
+	}
+}